@@ -0,0 +1,481 @@
+package cashtrack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	apiv1 "cashtrack/backend/gen/api/v1"
+	"cashtrack/backend/gen/api/v1/apiv1connect"
+	dbgen "cashtrack/backend/gen/db"
+	"connectrpc.com/connect"
+	"connectrpc.com/validate"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const (
+	AccountTypeBank = "bank"
+	AccountTypeCard = "card"
+	AccountTypeCash = "cash"
+)
+
+type AccountService struct {
+	db *Db
+}
+
+type AccountServiceHandler Handler
+
+func NewAccountServiceHandler(db *Db) *AccountServiceHandler {
+	service := &AccountService{db: db}
+	path, handler := apiv1connect.NewAccountServiceHandler(
+		service,
+		connect.WithInterceptors(validate.NewInterceptor(), NewAuthInterceptor(db)),
+	)
+	return &AccountServiceHandler{Path: path, Handler: handler}
+}
+
+func (s *AccountService) ListAccounts(ctx context.Context, req *apiv1.ListAccountsRequest) (*apiv1.ListAccountsResponse, error) {
+	workspace, err := requireWorkspace(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.db.Queries.ListAccounts(ctx, dbgen.ListAccountsParams{
+		WorkspaceID:     workspace.ID,
+		IncludeArchived: req.IncludeArchived,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	accounts := make([]*apiv1.Account, 0, len(rows))
+	for _, row := range rows {
+		accounts = append(accounts, accountToProto(dbgen.GetAccountRow(row)))
+	}
+	return &apiv1.ListAccountsResponse{Accounts: accounts}, nil
+}
+
+// CreateAccount adds an account that no statement is imported for, like a cash wallet.
+func (s *AccountService) CreateAccount(ctx context.Context, req *apiv1.CreateAccountRequest) (*apiv1.CreateAccountResponse, error) {
+	workspace, err := requireWorkspace(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("name is required"))
+	}
+	accountType := strings.ToLower(strings.TrimSpace(req.Type))
+	if accountType == "" {
+		accountType = AccountTypeCash
+	}
+	if accountType != AccountTypeBank && accountType != AccountTypeCard && accountType != AccountTypeCash {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("type must be bank, card or cash"))
+	}
+	currency := normalizeCurrency(req.Currency)
+	if currency == "" {
+		currency = normalizeBaseCurrency(workspace.BaseCurrency)
+	}
+	if !isCurrencyCode(currency) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("currency must be a 3-letter ISO code"))
+	}
+
+	row, err := s.db.Queries.CreateAccount(ctx, dbgen.CreateAccountParams{
+		WorkspaceID: workspace.ID,
+		Name:        name,
+		Type:        accountType,
+		Currency:    textOrNull(currency),
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return &apiv1.CreateAccountResponse{Account: accountToProto(dbgen.GetAccountRow(row))}, nil
+}
+
+func (s *AccountService) RenameAccount(ctx context.Context, req *apiv1.RenameAccountRequest) (*apiv1.RenameAccountResponse, error) {
+	workspace, err := requireWorkspace(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Id == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("id is required"))
+	}
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("name is required"))
+	}
+	affected, err := s.db.Queries.RenameAccount(ctx, dbgen.RenameAccountParams{
+		Name:        name,
+		ID:          int64(req.Id),
+		WorkspaceID: workspace.ID,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if affected == 0 {
+		return nil, connect.NewError(connect.CodeNotFound, errNotFound)
+	}
+	return &apiv1.RenameAccountResponse{}, nil
+}
+
+func (s *AccountService) ArchiveAccount(ctx context.Context, req *apiv1.ArchiveAccountRequest) (*apiv1.ArchiveAccountResponse, error) {
+	workspace, err := requireWorkspace(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Id == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("id is required"))
+	}
+	affected, err := s.db.Queries.SetAccountArchived(ctx, dbgen.SetAccountArchivedParams{
+		Archived:    req.Archived,
+		ID:          int64(req.Id),
+		WorkspaceID: workspace.ID,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if affected == 0 {
+		return nil, connect.NewError(connect.CodeNotFound, errNotFound)
+	}
+	return &apiv1.ArchiveAccountResponse{}, nil
+}
+
+// MergeAccounts folds the source account into the target: its transactions and balances move
+// over, and statements imported later for the source account land on the target.
+func (s *AccountService) MergeAccounts(ctx context.Context, req *apiv1.MergeAccountsRequest) (*apiv1.MergeAccountsResponse, error) {
+	workspace, err := requireWorkspace(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.SourceId == 0 || req.TargetId == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("source_id and target_id are required"))
+	}
+	if req.SourceId == req.TargetId {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("cannot merge an account into itself"))
+	}
+	if err := s.mergeAccounts(ctx, workspace.ID, int64(req.SourceId), int64(req.TargetId)); err != nil {
+		if errors.Is(err, errNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return &apiv1.MergeAccountsResponse{}, nil
+}
+
+func (s *AccountService) mergeAccounts(ctx context.Context, workspaceID int32, sourceID int64, targetID int64) error {
+	tx, err := s.db.conn.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	txQueries := s.db.Queries.WithTx(tx)
+	for _, id := range []int64{sourceID, targetID} {
+		if _, err := txQueries.GetAccount(ctx, dbgen.GetAccountParams{ID: id, WorkspaceID: workspaceID}); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return errNotFound
+			}
+			return fmt.Errorf("load account: %w", err)
+		}
+	}
+
+	target := pgtype.Int8{Int64: targetID, Valid: true}
+	if err := txQueries.MergeAccountInto(ctx, dbgen.MergeAccountIntoParams{
+		TargetID:    target,
+		WorkspaceID: workspaceID,
+		SourceID:    sourceID,
+	}); err != nil {
+		return fmt.Errorf("merge account: %w", err)
+	}
+	if err := txQueries.MoveAccountTransactions(ctx, dbgen.MoveAccountTransactionsParams{
+		TargetID:    target,
+		SourceID:    pgtype.Int8{Int64: sourceID, Valid: true},
+		WorkspaceID: workspaceID,
+	}); err != nil {
+		return fmt.Errorf("move transactions: %w", err)
+	}
+	if err := txQueries.MoveAccountBalances(ctx, dbgen.MoveAccountBalancesParams{
+		TargetID:    targetID,
+		SourceID:    sourceID,
+		WorkspaceID: workspaceID,
+	}); err != nil {
+		return fmt.Errorf("move balances: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+	return nil
+}
+
+// GetAccountBalances returns the end-of-day balance of an account on every day it has
+// transactions or a statement balance.
+func (s *AccountService) GetAccountBalances(ctx context.Context, req *apiv1.GetAccountBalancesRequest) (*apiv1.GetAccountBalancesResponse, error) {
+	workspace, err := requireWorkspace(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.AccountId == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("account_id is required"))
+	}
+	var fromDate, toDate *time.Time
+	for _, bound := range []struct {
+		raw    string
+		target **time.Time
+	}{{req.FromDate, &fromDate}, {req.ToDate, &toDate}} {
+		value := strings.TrimSpace(bound.raw)
+		if value == "" {
+			continue
+		}
+		parsed, err := time.Parse("2006-01-02", value)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("dates must be YYYY-MM-DD"))
+		}
+		*bound.target = &parsed
+	}
+
+	account, err := s.db.Queries.GetAccount(ctx, dbgen.GetAccountParams{ID: int64(req.AccountId), WorkspaceID: workspace.ID})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeNotFound, errNotFound)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	points, err := s.accountBalances(ctx, workspace.ID, account.ID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	result := make([]*apiv1.AccountBalancePoint, 0, len(points))
+	for _, point := range points {
+		if (fromDate != nil && point.Date.Before(*fromDate)) || (toDate != nil && point.Date.After(*toDate)) {
+			continue
+		}
+		result = append(result, &apiv1.AccountBalancePoint{
+			Date:       point.Date.Format("2006-01-02"),
+			Balance:    point.Cents,
+			Checkpoint: point.Checkpoint,
+		})
+	}
+	return &apiv1.GetAccountBalancesResponse{Points: result, Currency: account.Currency.String}, nil
+}
+
+func (s *AccountService) accountBalances(ctx context.Context, workspaceID int32, accountID int64) ([]balancePoint, error) {
+	checkpointRows, err := s.db.Queries.ListAccountBalanceCheckpoints(ctx, dbgen.ListAccountBalanceCheckpointsParams{
+		AccountID:   accountID,
+		WorkspaceID: workspaceID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("query balances: %w", err)
+	}
+	checkpoints := make([]datedCents, 0, len(checkpointRows))
+	for _, row := range checkpointRows {
+		cents, err := numericToCents(row.Balance)
+		if err != nil {
+			return nil, fmt.Errorf("convert balance: %w", err)
+		}
+		checkpoints = append(checkpoints, datedCents{Date: row.BalanceDate.Time, Cents: cents})
+	}
+
+	totalRows, err := s.db.Queries.ListAccountDailyTotals(ctx, dbgen.ListAccountDailyTotalsParams{
+		AccountID:   pgtype.Int8{Int64: accountID, Valid: true},
+		WorkspaceID: workspaceID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("query daily totals: %w", err)
+	}
+	totals := make([]datedCents, 0, len(totalRows))
+	for _, row := range totalRows {
+		cents, err := numericToCents(row.Amount)
+		if err != nil {
+			return nil, fmt.Errorf("convert amount: %w", err)
+		}
+		totals = append(totals, datedCents{Date: row.PostedDate.Time, Cents: cents})
+	}
+	return runningBalances(checkpoints, totals), nil
+}
+
+type datedCents struct {
+	Date  time.Time
+	Cents int64
+}
+
+type balancePoint struct {
+	Date       time.Time
+	Cents      int64
+	Checkpoint bool
+}
+
+// runningBalances replays daily transaction totals from the nearest statement balance. Days after
+// a checkpoint count forward from the latest one before them; days before the first checkpoint
+// count back from it. Without checkpoints the balance starts at zero. Both inputs are sorted by
+// date, and of several checkpoints on one day the last wins.
+func runningBalances(checkpoints []datedCents, totals []datedCents) []balancePoint {
+	dates := make([]time.Time, 0, len(checkpoints)+len(totals))
+	seen := make(map[time.Time]struct{}, cap(dates))
+	for _, entries := range [][]datedCents{checkpoints, totals} {
+		for _, entry := range entries {
+			if _, ok := seen[entry.Date]; ok {
+				continue
+			}
+			seen[entry.Date] = struct{}{}
+			dates = append(dates, entry.Date)
+		}
+	}
+	sort.Slice(dates, func(i, j int) bool { return dates[i].Before(dates[j]) })
+
+	dailyTotals := make(map[time.Time]int64, len(totals))
+	for _, total := range totals {
+		dailyTotals[total.Date] += total.Cents
+	}
+	// cumulative[date] is the sum of all transactions up to and including that day.
+	cumulative := make(map[time.Time]int64, len(dates))
+	var running int64
+	for _, date := range dates {
+		running += dailyTotals[date]
+		cumulative[date] = running
+	}
+	statement := make(map[time.Time]int64, len(checkpoints))
+	for _, checkpoint := range checkpoints {
+		statement[checkpoint.Date] = checkpoint.Cents
+	}
+
+	points := make([]balancePoint, 0, len(dates))
+	anchor := -1
+	for i, date := range dates {
+		if _, ok := statement[date]; ok {
+			anchor = i
+		}
+		var balance int64
+		switch {
+		case anchor >= 0:
+			balance = statement[dates[anchor]] + cumulative[date] - cumulative[dates[anchor]]
+		case len(checkpoints) > 0:
+			first := checkpoints[0].Date
+			balance = statement[first] - (cumulative[first] - cumulative[date])
+		default:
+			balance = cumulative[date]
+		}
+		_, checkpoint := statement[date]
+		points = append(points, balancePoint{Date: date, Cents: balance, Checkpoint: checkpoint})
+	}
+	return points
+}
+
+// syncReportAccounts creates the accounts a report's rows were booked on, links the rows to
+// them and replaces the statement balances stored for the report. Rows with a card number belong
+// to the card; other rows to their account number.
+func syncReportAccounts(ctx context.Context, queries *dbgen.Queries, workspaceID int32, reportID int64, parsed ParsedReport) error {
+	type accountInfo struct {
+		accountType string
+		iban        string
+		currency    string
+	}
+	accounts := make(map[string]*accountInfo)
+	for _, transaction := range parsed.Transactions {
+		identifier := strings.TrimSpace(transaction.SourceCardNumber)
+		accountType := AccountTypeCard
+		if identifier == "" {
+			identifier = strings.TrimSpace(transaction.SourceAccountNumber)
+			accountType = AccountTypeBank
+		}
+		if identifier == "" {
+			continue
+		}
+		if _, ok := accounts[identifier]; !ok {
+			accounts[identifier] = &accountInfo{accountType: accountType, currency: normalizeCurrency(transaction.Currency)}
+		}
+	}
+	for _, account := range parsed.Accounts {
+		identifier := strings.TrimSpace(account.Number)
+		info, ok := accounts[identifier]
+		if !ok {
+			info = &accountInfo{accountType: AccountTypeBank}
+			accounts[identifier] = info
+		}
+		info.iban = strings.TrimSpace(account.IBAN)
+		if currency := normalizeCurrency(account.Currency); currency != "" {
+			info.currency = currency
+		}
+	}
+
+	identifiers := make([]string, 0, len(accounts))
+	for identifier := range accounts {
+		identifiers = append(identifiers, identifier)
+	}
+	sort.Strings(identifiers)
+	accountIDs := make(map[string]int64, len(identifiers))
+	for _, identifier := range identifiers {
+		info := accounts[identifier]
+		id, err := queries.UpsertAccount(ctx, dbgen.UpsertAccountParams{
+			WorkspaceID: workspaceID,
+			Name:        identifier,
+			Type:        info.accountType,
+			Identifier:  textOrNull(identifier),
+			Iban:        textOrNull(info.iban),
+			Currency:    textOrNull(info.currency),
+		})
+		if err != nil {
+			return fmt.Errorf("upsert account: %w", err)
+		}
+		accountIDs[identifier] = id
+	}
+
+	if err := queries.AssignReportTransactionAccounts(ctx, dbgen.AssignReportTransactionAccountsParams{
+		SourceFileID: reportID,
+		WorkspaceID:  workspaceID,
+	}); err != nil {
+		return fmt.Errorf("assign accounts: %w", err)
+	}
+
+	source := pgtype.Int8{Int64: reportID, Valid: true}
+	if err := queries.DeleteAccountBalancesBySource(ctx, dbgen.DeleteAccountBalancesBySourceParams{
+		SourceFileID: source,
+		WorkspaceID:  workspaceID,
+	}); err != nil {
+		return fmt.Errorf("delete balances: %w", err)
+	}
+	for _, account := range parsed.Accounts {
+		for _, balance := range account.Balances {
+			amount, err := numericFromString(balance.Amount)
+			if err != nil {
+				return fmt.Errorf("convert balance %q: %w", balance.Amount, err)
+			}
+			if err := queries.CreateAccountBalance(ctx, dbgen.CreateAccountBalanceParams{
+				AccountID:    accountIDs[strings.TrimSpace(account.Number)],
+				WorkspaceID:  workspaceID,
+				SourceFileID: source,
+				BalanceDate:  pgtype.Date{Time: balance.Date, Valid: true},
+				Balance:      amount,
+			}); err != nil {
+				return fmt.Errorf("insert balance: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+func accountToProto(row dbgen.GetAccountRow) *apiv1.Account {
+	createdAt := ""
+	if row.CreatedAt.Valid {
+		createdAt = row.CreatedAt.Time.Format(time.RFC3339Nano)
+	}
+	return &apiv1.Account{
+		Id:         int32(row.ID),
+		Name:       row.Name,
+		Type:       row.Type,
+		Identifier: row.Identifier.String,
+		Iban:       row.Iban.String,
+		Currency:   row.Currency.String,
+		Archived:   row.Archived,
+		CreatedAt:  createdAt,
+	}
+}
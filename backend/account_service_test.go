@@ -0,0 +1,131 @@
+package cashtrack
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	apiv1 "cashtrack/backend/gen/api/v1"
+)
+
+func TestRunningBalances(t *testing.T) {
+	day := func(d int) time.Time {
+		return time.Date(2026, 1, d, 0, 0, 0, 0, time.UTC)
+	}
+	totals := []datedCents{
+		{Date: day(2), Cents: -1000},
+		{Date: day(5), Cents: 5000},
+		{Date: day(9), Cents: -300},
+		{Date: day(12), Cents: -200},
+	}
+
+	withoutCheckpoints := runningBalances(nil, totals)
+	expected := []balancePoint{
+		{Date: day(2), Cents: -1000},
+		{Date: day(5), Cents: 4000},
+		{Date: day(9), Cents: 3700},
+		{Date: day(12), Cents: 3500},
+	}
+	if !reflect.DeepEqual(withoutCheckpoints, expected) {
+		t.Fatalf("expected %+v, got %+v", expected, withoutCheckpoints)
+	}
+
+	// The statement says 10000 at the end of day 5 and 9000 at the end of day 10, so an
+	// unimported 400 debit happened in between.
+	checkpoints := []datedCents{
+		{Date: day(5), Cents: 10000},
+		{Date: day(10), Cents: 9000},
+	}
+	points := runningBalances(checkpoints, totals)
+	expected = []balancePoint{
+		{Date: day(2), Cents: 5000},
+		{Date: day(5), Cents: 10000, Checkpoint: true},
+		{Date: day(9), Cents: 9700},
+		{Date: day(10), Cents: 9000, Checkpoint: true},
+		{Date: day(12), Cents: 8800},
+	}
+	if !reflect.DeepEqual(points, expected) {
+		t.Fatalf("expected %+v, got %+v", expected, points)
+	}
+}
+
+func TestReportProcessor_CreatesAccountsWithBalances(t *testing.T) {
+	db, cleanup := openTestDB(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	createReportTables(t, db)
+	userID := createUser(t, db, "accounts@example.com")
+	insertReport(t, db, userID, "transactions.csv", mustReadTestFile(t, "ubs_account_transactions.csv"))
+	cardReportID := insertReport(t, db, userID, "transactions (1).csv", mustReadTestFile(t, "credit_card_transactions.csv"))
+
+	processor := newTestReportProcessor(t, db, "worker-1")
+	if err := processor.ProcessPendingReports(ctx); err != nil {
+		t.Fatalf("process pending reports: %v", err)
+	}
+
+	service := &AccountService{db: db}
+	userCtx := contextWithPersonalWorkspace(ctx, userID)
+	listed, err := service.ListAccounts(userCtx, &apiv1.ListAccountsRequest{})
+	if err != nil {
+		t.Fatalf("list accounts: %v", err)
+	}
+	if len(listed.Accounts) != 2 {
+		t.Fatalf("expected 2 accounts, got %+v", listed.Accounts)
+	}
+	byIdentifier := make(map[string]*apiv1.Account)
+	for _, account := range listed.Accounts {
+		byIdentifier[account.Identifier] = account
+	}
+	bank := byIdentifier["0230 00826810.40"]
+	card := byIdentifier["4894 33XX XXXX 9396"]
+	if bank == nil || bank.Type != AccountTypeBank || bank.Iban != "CH44 0023 0230 8268 1040 J" || bank.Currency != "CHF" {
+		t.Fatalf("unexpected bank account %+v", bank)
+	}
+	if card == nil || card.Type != AccountTypeCard {
+		t.Fatalf("unexpected card account %+v", card)
+	}
+
+	balances, err := service.GetAccountBalances(userCtx, &apiv1.GetAccountBalancesRequest{AccountId: bank.Id})
+	if err != nil {
+		t.Fatalf("get balances: %v", err)
+	}
+	first, last := balances.Points[0], balances.Points[len(balances.Points)-1]
+	if first.Date != "2026-01-01" || first.Balance != -144903 || !first.Checkpoint {
+		t.Fatalf("unexpected opening balance %+v", first)
+	}
+	if last.Date != "2026-01-23" || last.Balance != 5292496 || !last.Checkpoint {
+		t.Fatalf("unexpected closing balance %+v", last)
+	}
+
+	if _, err := service.MergeAccounts(userCtx, &apiv1.MergeAccountsRequest{SourceId: card.Id, TargetId: bank.Id}); err != nil {
+		t.Fatalf("merge accounts: %v", err)
+	}
+	var cardTransactions int
+	if err := db.conn.QueryRow(ctx, `SELECT COUNT(*) FROM transactions WHERE account_id = $1`, card.Id).Scan(&cardTransactions); err != nil {
+		t.Fatalf("count transactions: %v", err)
+	}
+	if cardTransactions != 0 {
+		t.Fatalf("expected card transactions to move to the bank account, got %d left", cardTransactions)
+	}
+
+	// Reimporting the card statement lands on the merged account.
+	setReportStatus(t, db, cardReportID, userID, ReportStatusPending)
+	if err := processor.ProcessPendingReports(ctx); err != nil {
+		t.Fatalf("reprocess reports: %v", err)
+	}
+	listed, err = service.ListAccounts(userCtx, &apiv1.ListAccountsRequest{IncludeArchived: true})
+	if err != nil {
+		t.Fatalf("list accounts: %v", err)
+	}
+	if len(listed.Accounts) != 1 || listed.Accounts[0].Id != bank.Id {
+		t.Fatalf("expected only the merged account, got %+v", listed.Accounts)
+	}
+	if err := db.conn.QueryRow(ctx, `SELECT COUNT(*) FROM transactions WHERE source_file_id = $1 AND account_id = $2`, cardReportID, bank.Id).Scan(&cardTransactions); err != nil {
+		t.Fatalf("count transactions: %v", err)
+	}
+	if cardTransactions != 47 {
+		t.Fatalf("expected reimported card transactions on the merged account, got %d", cardTransactions)
+	}
+}
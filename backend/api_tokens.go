@@ -0,0 +1,187 @@
+package cashtrack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	apiv1 "cashtrack/backend/gen/api/v1"
+	"cashtrack/backend/gen/api/v1/apiv1connect"
+	dbgen "cashtrack/backend/gen/db"
+	"connectrpc.com/connect"
+)
+
+const (
+	ApiTokenScopeReadTransactions = "transactions:read"
+	ApiTokenScopeUploadReports    = "reports:upload"
+	ApiTokenScopeManageCategories = "categories:manage"
+)
+
+// apiTokenPrefix starts every token so they are easy to recognize, for example by secret scanners.
+const apiTokenPrefix = "ct_"
+
+// apiTokenDisplayLength is how much of a token is stored in the clear to tell tokens apart.
+const apiTokenDisplayLength = 10
+
+var apiTokenScopes = []string{
+	ApiTokenScopeReadTransactions,
+	ApiTokenScopeUploadReports,
+	ApiTokenScopeManageCategories,
+}
+
+// apiTokenAllows reports whether a token with the given scopes may make the call. Calls outside every
+// scope, such as managing tokens or households, need a session.
+func apiTokenAllows(scopes []string, spec connect.Spec) bool {
+	service := procedureService(spec.Procedure)
+	for _, scope := range scopes {
+		switch scope {
+		case ApiTokenScopeReadTransactions:
+			if service == apiv1connect.TransactionServiceName && spec.IdempotencyLevel == connect.IdempotencyNoSideEffects {
+				return true
+			}
+		case ApiTokenScopeUploadReports:
+			if spec.Procedure == apiv1connect.ReportServiceUploadReportProcedure {
+				return true
+			}
+		case ApiTokenScopeManageCategories:
+			if service == apiv1connect.CategoryServiceName {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func bearerTokenFromHeader(header http.Header) (string, bool) {
+	scheme, token, ok := strings.Cut(header.Get("Authorization"), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+	token = strings.TrimSpace(token)
+	return token, token != ""
+}
+
+// userFromApiToken resolves an unrevoked token to its user and scopes and records that it was used.
+func userFromApiToken(ctx context.Context, db *Db, token string) (*apiv1.User, []string, error) {
+	row, err := db.Queries.GetUserByApiToken(ctx, hashToken(token))
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := db.Queries.TouchApiToken(ctx, row.TokenID); err != nil {
+		log.Warn().Err(err).Int64("token_id", row.TokenID).Msg("failed to record api token use")
+	}
+	user := &apiv1.User{Id: row.ID, Username: row.Username, Language: row.Language, BaseCurrency: row.BaseCurrency}
+	return user, row.Scopes, nil
+}
+
+func (s *AuthService) CreateApiToken(ctx context.Context, req *apiv1.CreateApiTokenRequest) (*apiv1.CreateApiTokenResponse, error) {
+	user, err := requireUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("name is required"))
+	}
+	if utf8.RuneCountInString(name) > 255 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("name must be at most 255 characters"))
+	}
+	scopes, err := normalizeApiTokenScopes(req.Scopes)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	secret, err := randomToken(32)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	token := apiTokenPrefix + secret
+	prefix := token[:apiTokenDisplayLength]
+	row, err := s.db.Queries.CreateApiToken(ctx, dbgen.CreateApiTokenParams{
+		UserID:      user.Id,
+		Name:        name,
+		TokenPrefix: prefix,
+		TokenHash:   hashToken(token),
+		Scopes:      scopes,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return &apiv1.CreateApiTokenResponse{
+		ApiToken: &apiv1.ApiToken{
+			Id:        int32(row.ID),
+			Name:      name,
+			Prefix:    prefix,
+			Scopes:    scopes,
+			CreatedAt: row.CreatedAt.Time.Format(time.RFC3339Nano),
+		},
+		Token: token,
+	}, nil
+}
+
+func (s *AuthService) ListApiTokens(ctx context.Context, req *apiv1.ListApiTokensRequest) (*apiv1.ListApiTokensResponse, error) {
+	user, err := requireUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.db.Queries.ListApiTokens(ctx, user.Id)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	tokens := make([]*apiv1.ApiToken, 0, len(rows))
+	for _, row := range rows {
+		lastUsedAt := ""
+		if row.LastUsedAt.Valid {
+			lastUsedAt = row.LastUsedAt.Time.Format(time.RFC3339Nano)
+		}
+		tokens = append(tokens, &apiv1.ApiToken{
+			Id:         int32(row.ID),
+			Name:       row.Name,
+			Prefix:     row.TokenPrefix,
+			Scopes:     row.Scopes,
+			CreatedAt:  row.CreatedAt.Time.Format(time.RFC3339Nano),
+			LastUsedAt: lastUsedAt,
+		})
+	}
+	return &apiv1.ListApiTokensResponse{ApiTokens: tokens}, nil
+}
+
+func (s *AuthService) RevokeApiToken(ctx context.Context, req *apiv1.RevokeApiTokenRequest) (*apiv1.RevokeApiTokenResponse, error) {
+	user, err := requireUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	revoked, err := s.db.Queries.RevokeApiToken(ctx, dbgen.RevokeApiTokenParams{ID: int64(req.Id), UserID: user.Id})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if revoked == 0 {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("api token not found"))
+	}
+	return &apiv1.RevokeApiTokenResponse{}, nil
+}
+
+// normalizeApiTokenScopes checks the requested scopes and returns them sorted without duplicates.
+func normalizeApiTokenScopes(requested []string) ([]string, error) {
+	if len(requested) == 0 {
+		return nil, errors.New("at least one scope is required")
+	}
+	scopes := make([]string, 0, len(requested))
+	for _, scope := range requested {
+		scope = strings.TrimSpace(scope)
+		if !slices.Contains(apiTokenScopes, scope) {
+			return nil, fmt.Errorf("unknown scope %q", scope)
+		}
+		scopes = append(scopes, scope)
+	}
+	slices.Sort(scopes)
+	return slices.Compact(scopes), nil
+}
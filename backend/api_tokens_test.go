@@ -0,0 +1,145 @@
+package cashtrack
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+
+	apiv1 "cashtrack/backend/gen/api/v1"
+	"cashtrack/backend/gen/api/v1/apiv1connect"
+	"connectrpc.com/connect"
+)
+
+func TestApiTokenAllows(t *testing.T) {
+	listTransactions := connect.Spec{Procedure: apiv1connect.TransactionServiceListTransactionsProcedure, IdempotencyLevel: connect.IdempotencyNoSideEffects}
+	updateCategory := connect.Spec{Procedure: apiv1connect.TransactionServiceUpdateTransactionCategoryProcedure}
+	uploadReport := connect.Spec{Procedure: apiv1connect.ReportServiceUploadReportProcedure}
+	deleteReport := connect.Spec{Procedure: apiv1connect.ReportServiceDeleteReportProcedure}
+	createRule := connect.Spec{Procedure: apiv1connect.CategoryServiceCreateCategoryRuleProcedure}
+	createToken := connect.Spec{Procedure: apiv1connect.AuthServiceCreateApiTokenProcedure}
+
+	tests := []struct {
+		scopes []string
+		spec   connect.Spec
+		allow  bool
+	}{
+		{[]string{ApiTokenScopeReadTransactions}, listTransactions, true},
+		{[]string{ApiTokenScopeReadTransactions}, updateCategory, false},
+		{[]string{ApiTokenScopeUploadReports}, uploadReport, true},
+		{[]string{ApiTokenScopeUploadReports}, deleteReport, false},
+		{[]string{ApiTokenScopeManageCategories}, createRule, true},
+		{[]string{ApiTokenScopeReadTransactions, ApiTokenScopeUploadReports}, uploadReport, true},
+		{apiTokenScopes, createToken, false},
+		{nil, listTransactions, false},
+	}
+	for _, test := range tests {
+		if got := apiTokenAllows(test.scopes, test.spec); got != test.allow {
+			t.Fatalf("apiTokenAllows(%v, %s) = %v, expected %v", test.scopes, test.spec.Procedure, got, test.allow)
+		}
+	}
+}
+
+func TestNormalizeApiTokenScopes(t *testing.T) {
+	scopes, err := normalizeApiTokenScopes([]string{" reports:upload", "transactions:read", "reports:upload"})
+	if err != nil {
+		t.Fatalf("normalize scopes: %v", err)
+	}
+	if expected := []string{"reports:upload", "transactions:read"}; !reflect.DeepEqual(scopes, expected) {
+		t.Fatalf("expected %v, got %v", expected, scopes)
+	}
+	if _, err := normalizeApiTokenScopes(nil); err == nil {
+		t.Fatalf("expected an error without scopes")
+	}
+	if _, err := normalizeApiTokenScopes([]string{"admin"}); err == nil {
+		t.Fatalf("expected an error for an unknown scope")
+	}
+}
+
+func TestBearerTokenFromHeader(t *testing.T) {
+	tests := map[string]string{
+		"Bearer ct_abc":  "ct_abc",
+		"bearer  ct_abc": "ct_abc",
+		"Basic ct_abc":   "",
+		"Bearer ":        "",
+		"":               "",
+	}
+	for value, expected := range tests {
+		header := http.Header{}
+		header.Set("Authorization", value)
+		token, ok := bearerTokenFromHeader(header)
+		if token != expected || ok != (expected != "") {
+			t.Fatalf("bearerTokenFromHeader(%q) = %q, %v, expected %q", value, token, ok, expected)
+		}
+	}
+}
+
+func TestApiTokenAuthorizesScopedCalls(t *testing.T) {
+	db, cleanup := openTestDB(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	_, err := db.conn.Exec(ctx, `
+		CREATE TABLE api_tokens (
+			id bigserial PRIMARY KEY,
+			user_id integer NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+			name varchar(255) NOT NULL,
+			token_prefix varchar(16) NOT NULL,
+			token_hash varchar(64) NOT NULL UNIQUE,
+			scopes text[] NOT NULL,
+			created_at timestamptz NOT NULL DEFAULT now(),
+			last_used_at timestamptz,
+			revoked_at timestamptz
+		);
+	`)
+	if err != nil {
+		t.Fatalf("create api token tables: %v", err)
+	}
+	userID := createUser(t, db, "script@example.com")
+	service := &AuthService{db: db}
+	userCtx := contextWithUser(ctx, &apiv1.User{Id: userID})
+
+	created, err := service.CreateApiToken(userCtx, &apiv1.CreateApiTokenRequest{
+		Name:   "nightly import",
+		Scopes: []string{ApiTokenScopeUploadReports},
+	})
+	if err != nil {
+		t.Fatalf("create token: %v", err)
+	}
+	if created.ApiToken.Prefix != created.Token[:apiTokenDisplayLength] {
+		t.Fatalf("expected the prefix of %q, got %q", created.Token, created.ApiToken.Prefix)
+	}
+
+	interceptor := &authInterceptor{db: db}
+	header := http.Header{}
+	header.Set("Authorization", "Bearer "+created.Token)
+	callCtx, err := interceptor.authorize(ctx, connect.Spec{Procedure: apiv1connect.ReportServiceUploadReportProcedure}, header)
+	if err != nil {
+		t.Fatalf("authorize upload: %v", err)
+	}
+	if workspace, err := requireWorkspace(callCtx); err != nil || workspace.ID != userID {
+		t.Fatalf("expected the token user's workspace, got %+v, %v", workspace, err)
+	}
+	listTransactions := connect.Spec{Procedure: apiv1connect.TransactionServiceListTransactionsProcedure, IdempotencyLevel: connect.IdempotencyNoSideEffects}
+	if _, err := interceptor.authorize(ctx, listTransactions, header); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Fatalf("expected calls outside the scopes to be denied, got %v", err)
+	}
+
+	listed, err := service.ListApiTokens(userCtx, &apiv1.ListApiTokensRequest{})
+	if err != nil {
+		t.Fatalf("list tokens: %v", err)
+	}
+	if len(listed.ApiTokens) != 1 || listed.ApiTokens[0].LastUsedAt == "" {
+		t.Fatalf("expected the used token, got %+v", listed.ApiTokens)
+	}
+
+	if _, err := service.RevokeApiToken(userCtx, &apiv1.RevokeApiTokenRequest{Id: created.ApiToken.Id}); err != nil {
+		t.Fatalf("revoke token: %v", err)
+	}
+	if _, err := interceptor.authorize(ctx, connect.Spec{Procedure: apiv1connect.ReportServiceUploadReportProcedure}, header); connect.CodeOf(err) != connect.CodeUnauthenticated {
+		t.Fatalf("expected a revoked token to be rejected, got %v", err)
+	}
+	if _, err := service.RevokeApiToken(userCtx, &apiv1.RevokeApiTokenRequest{Id: created.ApiToken.Id}); connect.CodeOf(err) != connect.CodeNotFound {
+		t.Fatalf("expected not found for a revoked token, got %v", err)
+	}
+}
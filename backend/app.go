@@ -5,4 +5,5 @@ import "net/http"
 type App struct {
 	Server    *http.Server
 	Processor *ReportProcessor
+	Events    *ReportEvents
 }
@@ -2,11 +2,8 @@ package cashtrack
 
 import (
 	"context"
-	"encoding/base64"
-	"encoding/json"
 	"errors"
 	"net/http"
-	"strings"
 	"time"
 
 	apiv1 "cashtrack/backend/gen/api/v1"
@@ -22,12 +19,17 @@ const sessionCookieName = "session_id"
 const sessionDuration = 7 * 24 * time.Hour
 
 type idTokenClaims struct {
-	Sub   string `json:"sub"`
-	Email string `json:"email"`
-	Name  string `json:"name"`
+	Iss           string       `json:"iss"`
+	Aud           string       `json:"aud"`
+	Exp           int64        `json:"exp"`
+	Iat           int64        `json:"iat"`
+	Sub           string       `json:"sub"`
+	Email         string       `json:"email"`
+	EmailVerified flexibleBool `json:"email_verified"`
+	Name          string       `json:"name"`
 }
 
-func NewAuthHandler(db *Db) *AuthHandler {
+func NewAuthHandler(db *Db, verifier *GoogleTokenVerifier) *AuthHandler {
 	return &AuthHandler{
 		Path: "/auth",
 		Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -42,9 +44,10 @@ func NewAuthHandler(db *Db) *AuthHandler {
 				return
 			}
 
-			claims, err := parseIDToken(credential)
+			claims, err := verifier.Verify(r.Context(), credential)
 			if err != nil {
-				http.Error(w, "invalid credential", http.StatusBadRequest)
+				log.Warn().Err(err).Msg("rejected google credential")
+				http.Error(w, "invalid credential", http.StatusUnauthorized)
 				return
 			}
 
@@ -88,26 +91,10 @@ func NewAuthHandler(db *Db) *AuthHandler {
 	}
 }
 
-func parseIDToken(credential string) (idTokenClaims, error) {
-	parts := strings.Split(credential, ".")
-	if len(parts) < 2 {
-		return idTokenClaims{}, errors.New("invalid token")
-	}
-	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
-	if err != nil {
-		return idTokenClaims{}, err
-	}
-	var claims idTokenClaims
-	if err := json.Unmarshal(payload, &claims); err != nil {
-		return idTokenClaims{}, err
-	}
-	return claims, nil
-}
-
 func ensureUser(ctx context.Context, db *Db, username string) (*apiv1.User, error) {
 	row, err := db.Queries.GetUserByUsername(ctx, username)
 	if err == nil {
-		return &apiv1.User{Id: row.ID, Username: row.Username, Language: row.Language}, nil
+		return &apiv1.User{Id: row.ID, Username: row.Username, Language: row.Language, BaseCurrency: row.BaseCurrency}, nil
 	}
 	if !errors.Is(err, pgx.ErrNoRows) {
 		return nil, err
@@ -120,7 +107,7 @@ func ensureUser(ctx context.Context, db *Db, username string) (*apiv1.User, erro
 	if err != nil {
 		return nil, err
 	}
-	return &apiv1.User{Id: created.ID, Username: created.Username, Language: created.Language}, nil
+	return &apiv1.User{Id: created.ID, Username: created.Username, Language: created.Language, BaseCurrency: created.BaseCurrency}, nil
 }
 
 func createSession(ctx context.Context, db *Db, userID int32) (string, time.Time, error) {
@@ -144,7 +131,7 @@ func getUserBySession(ctx context.Context, db *Db, sessionID string) (*apiv1.Use
 	if err != nil {
 		return nil, time.Time{}, err
 	}
-	return &apiv1.User{Id: row.ID, Username: row.Username, Language: row.Language}, row.Expires.Time, nil
+	return &apiv1.User{Id: row.ID, Username: row.Username, Language: row.Language, BaseCurrency: row.BaseCurrency}, row.Expires.Time, nil
 }
 
 func parseSessionID(sessionID string) (pgtype.UUID, error) {
@@ -2,14 +2,34 @@ package cashtrack
 
 import (
 	"context"
+	"errors"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
 	apiv1 "cashtrack/backend/gen/api/v1"
+	"cashtrack/backend/gen/api/v1/apiv1connect"
+	dbgen "cashtrack/backend/gen/db"
 	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 type authUserContextKey struct{}
+type authWorkspaceContextKey struct{}
+
+// householdHeader selects the household whose workspace a call works on. Calls without it work on
+// the user's personal workspace.
+const householdHeader = "X-Household-Id"
+
+// personalServices work on the signed-in user rather than on a workspace, so the household header
+// does not apply to them.
+var personalServices = map[string]bool{
+	apiv1connect.AuthServiceName:      true,
+	apiv1connect.HouseholdServiceName: true,
+	apiv1connect.TodoServiceName:      true,
+}
 
 func contextWithUser(ctx context.Context, user *apiv1.User) context.Context {
 	return context.WithValue(ctx, authUserContextKey{}, user)
@@ -20,16 +40,126 @@ func userFromContext(ctx context.Context) (*apiv1.User, bool) {
 	return user, ok
 }
 
-func NewAuthInterceptor(db *Db) connect.UnaryInterceptorFunc {
-	return func(next connect.UnaryFunc) connect.UnaryFunc {
-		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
-			user, ok := userFromRequest(ctx, db, req.Header())
-			if ok {
-				ctx = contextWithUser(ctx, user)
+// workspace owns reports, transactions and everything derived from them. Every user has a
+// personal workspace, and every household is one.
+type workspace struct {
+	ID           int32
+	BaseCurrency string
+}
+
+func contextWithWorkspace(ctx context.Context, workspace *workspace) context.Context {
+	return context.WithValue(ctx, authWorkspaceContextKey{}, workspace)
+}
+
+// requireWorkspace returns the workspace a call works on: the selected household's, or the
+// signed-in user's personal one. Personal services are not given a workspace.
+func requireWorkspace(ctx context.Context) (*workspace, error) {
+	if _, err := requireUser(ctx); err != nil {
+		return nil, err
+	}
+	workspace, ok := ctx.Value(authWorkspaceContextKey{}).(*workspace)
+	if !ok {
+		return nil, connect.NewError(connect.CodeInternal, errors.New("no workspace selected"))
+	}
+	return workspace, nil
+}
+
+// NewAuthInterceptor attaches the user of the session or API token, if any, to the context of unary and
+// streaming calls. Handlers decide themselves whether a user is required. API tokens are limited to the
+// calls their scopes cover. When a household is selected the interceptor rejects calls from non-members
+// and changes from viewers before they reach a handler.
+func NewAuthInterceptor(db *Db) connect.Interceptor {
+	return &authInterceptor{db: db}
+}
+
+type authInterceptor struct {
+	db *Db
+}
+
+func (i *authInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		ctx, err := i.authorize(ctx, req.Spec(), req.Header())
+		if err != nil {
+			return nil, err
+		}
+		return next(ctx, req)
+	}
+}
+
+func (i *authInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (i *authInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		ctx, err := i.authorize(ctx, conn.Spec(), conn.RequestHeader())
+		if err != nil {
+			return err
+		}
+		return next(ctx, conn)
+	}
+}
+
+func (i *authInterceptor) authorize(ctx context.Context, spec connect.Spec, header http.Header) (context.Context, error) {
+	var user *apiv1.User
+	if token, ok := bearerTokenFromHeader(header); ok {
+		tokenUser, scopes, err := userFromApiToken(ctx, i.db, token)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("invalid api token"))
 			}
-			return next(ctx, req)
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		if !apiTokenAllows(scopes, spec) {
+			return nil, connect.NewError(connect.CodePermissionDenied, errors.New("api token does not allow this call"))
+		}
+		user = tokenUser
+	} else {
+		sessionUser, ok := userFromRequest(ctx, i.db, header)
+		if !ok {
+			return ctx, nil
+		}
+		user = sessionUser
+	}
+	ctx = contextWithUser(ctx, user)
+
+	if personalServices[procedureService(spec.Procedure)] {
+		return ctx, nil
+	}
+	value := header.Get(householdHeader)
+	if value == "" {
+		personal, err := i.db.Queries.GetUserWorkspace(ctx, pgtype.Int4{Int32: user.Id, Valid: true})
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		return contextWithWorkspace(ctx, &workspace{ID: personal.ID, BaseCurrency: personal.BaseCurrency}), nil
+	}
+	householdID, err := strconv.ParseInt(value, 10, 32)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("invalid household id"))
+	}
+
+	membership, err := i.db.Queries.GetHouseholdMembership(ctx, dbgen.GetHouseholdMembershipParams{
+		HouseholdID: int32(householdID),
+		UserID:      user.Id,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, connect.NewError(connect.CodePermissionDenied, errors.New("not a member of this household"))
 		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if !householdRoleAllows(membership.Role, spec) {
+		return nil, connect.NewError(connect.CodePermissionDenied, errors.New("viewers cannot change household data"))
 	}
+	return contextWithWorkspace(ctx, &workspace{ID: membership.ID, BaseCurrency: membership.BaseCurrency}), nil
+}
+
+// procedureService returns the fully qualified service name of a procedure such as
+// "/api.v1.TransactionService/ListTransactions".
+func procedureService(procedure string) string {
+	service, _, _ := strings.Cut(strings.TrimPrefix(procedure, "/"), "/")
+	return service
 }
 
 func userFromRequest(ctx context.Context, db *Db, header http.Header) (*apiv1.User, bool) {
@@ -13,6 +13,7 @@ import (
 
 	"connectrpc.com/connect"
 	"connectrpc.com/validate"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 type AuthService struct {
@@ -88,6 +89,32 @@ func (s *AuthService) UpdateLanguage(ctx context.Context, req *apiv1.UpdateLangu
 	return &apiv1.UpdateLanguageResponse{User: user}, nil
 }
 
+func (s *AuthService) UpdateBaseCurrency(ctx context.Context, req *apiv1.UpdateBaseCurrencyRequest) (*apiv1.UpdateBaseCurrencyResponse, error) {
+	currency := normalizeCurrency(req.BaseCurrency)
+	if currency == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("base currency is required"))
+	}
+	if !isCurrencyCode(currency) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("base currency must be a 3-letter ISO code"))
+	}
+
+	user, err := requireUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.db.Queries.UpdateUserBaseCurrency(ctx, dbgen.UpdateUserBaseCurrencyParams{
+		BaseCurrency: currency,
+		UserID:       pgtype.Int4{Int32: user.Id, Valid: true},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	user.BaseCurrency = currency
+	return &apiv1.UpdateBaseCurrencyResponse{User: user}, nil
+}
+
 func isSecureRequest(header http.Header) bool {
 	if strings.EqualFold(header.Get("X-Forwarded-Proto"), "https") {
 		return true
@@ -24,7 +24,7 @@ func TestAuthHandlerMissingCredential(t *testing.T) {
 	db, cleanup := openTestDB(t)
 	defer cleanup()
 
-	handler := NewAuthHandler(db).Handler
+	handler := NewAuthHandler(db, newTestGoogleIssuer(t).verifier()).Handler
 	req := httptest.NewRequest(http.MethodGet, "/auth", nil)
 	rec := httptest.NewRecorder()
 
@@ -39,13 +39,10 @@ func TestAuthHandlerCreatesSessionAndRedirects(t *testing.T) {
 	db, cleanup := openTestDB(t)
 	defer cleanup()
 
-	credential := fakeIDToken(t, idTokenClaims{
-		Sub:   "sub-123",
-		Email: "test@example.com",
-		Name:  "Test User",
-	})
+	issuer := newTestGoogleIssuer(t)
+	credential := issuer.sign(issuer.validClaims())
 
-	handler := NewAuthHandler(db).Handler
+	handler := NewAuthHandler(db, issuer.verifier()).Handler
 	req := httptest.NewRequest(http.MethodGet, "/auth?credential="+credential+"&redirect=/todo", nil)
 	rec := httptest.NewRecorder()
 
@@ -196,15 +193,77 @@ func TestAuthLogoutHandler(t *testing.T) {
 	}
 }
 
-func fakeIDToken(t *testing.T, claims idTokenClaims) string {
-	t.Helper()
+func TestAuthUpdateBaseCurrency(t *testing.T) {
+	db, cleanup := openTestDB(t)
+	defer cleanup()
+
+	userID := createUser(t, db, "currency@example.com")
+	sessionID := createSessionForUser(t, db, userID)
+
+	handler := NewAuthServiceHandler(db)
+	server := httptest.NewServer(handler.Handler)
+	defer server.Close()
+
+	client := connect.NewClient[apiv1.UpdateBaseCurrencyRequest, apiv1.UpdateBaseCurrencyResponse](
+		server.Client(),
+		server.URL+apiv1connect.AuthServiceUpdateBaseCurrencyProcedure,
+	)
+	call := func(currency string) (*connect.Response[apiv1.UpdateBaseCurrencyResponse], error) {
+		req := connect.NewRequest(&apiv1.UpdateBaseCurrencyRequest{BaseCurrency: currency})
+		req.Header().Set("Cookie", fmt.Sprintf("%s=%s", sessionCookieName, sessionID))
+		return client.CallUnary(context.Background(), req)
+	}
+
+	if _, err := call("euro"); connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Fatalf("expected invalid argument, got %v", err)
+	}
+
+	res, err := call("eur")
+	if err != nil {
+		t.Fatalf("failed to update base currency: %v", err)
+	}
+	if res.Msg.User.BaseCurrency != "EUR" {
+		t.Fatalf("expected EUR, got %q", res.Msg.User.BaseCurrency)
+	}
+
+	var stored string
+	err = db.conn.QueryRow(context.Background(), `SELECT base_currency FROM workspaces WHERE user_id = $1`, userID).Scan(&stored)
+	if err != nil {
+		t.Fatalf("failed to query user: %v", err)
+	}
+	if stored != "EUR" {
+		t.Fatalf("expected stored EUR, got %q", stored)
+	}
+}
+
+func TestAuthHandlerRejectsForgedCredential(t *testing.T) {
+	db, cleanup := openTestDB(t)
+	defer cleanup()
+
+	issuer := newTestGoogleIssuer(t)
 	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"none"}`))
-	payloadBytes, err := json.Marshal(claims)
+	payload, err := json.Marshal(issuer.validClaims())
 	if err != nil {
 		t.Fatalf("failed to marshal claims: %v", err)
 	}
-	payload := base64.RawURLEncoding.EncodeToString(payloadBytes)
-	return strings.Join([]string{header, payload, ""}, ".")
+	credential := strings.Join([]string{header, base64.RawURLEncoding.EncodeToString(payload), ""}, ".")
+
+	handler := NewAuthHandler(db, issuer.verifier()).Handler
+	req := httptest.NewRequest(http.MethodGet, "/auth?credential="+credential, nil)
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusUnauthorized {
+		t.Fatalf("expected status 401, got %d", rec.Code)
+	}
+	var count int
+	if err := db.conn.QueryRow(context.Background(), `SELECT COUNT(*) FROM users`).Scan(&count); err != nil {
+		t.Fatalf("failed to count users: %v", err)
+	}
+	if count != 0 {
+		t.Fatalf("expected no user to be created, got %d", count)
+	}
 }
 
 func openTestDB(t *testing.T) (*Db, func()) {
@@ -240,7 +299,15 @@ func openTestDB(t *testing.T) (*Db, func()) {
 		CREATE TABLE users (
 			id integer GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
 			username varchar(255) UNIQUE NOT NULL,
-			password varchar(255) NOT NULL
+			password varchar(255) NOT NULL,
+			language varchar(10) NOT NULL DEFAULT 'en'
+		);
+		CREATE TABLE workspaces (
+			id integer GENERATED BY DEFAULT AS IDENTITY (START WITH 1000) PRIMARY KEY,
+			user_id integer UNIQUE REFERENCES users(id) ON DELETE CASCADE,
+			name varchar(255) NOT NULL DEFAULT '',
+			base_currency varchar(3) NOT NULL DEFAULT 'CHF',
+			created_at timestamptz NOT NULL DEFAULT now()
 		);
 		CREATE TABLE sessions (
 			id uuid NOT NULL DEFAULT uuid_generate_v4() PRIMARY KEY,
@@ -279,6 +346,8 @@ func openTestDB(t *testing.T) (*Db, func()) {
 	return db, cleanup
 }
 
+// createUser also creates the user's personal workspace under the same id, so tests can scope rows
+// by the user id. Household workspaces are numbered from 1000.
 func createUser(t *testing.T, db *Db, username string) int32 {
 	t.Helper()
 	var id int32
@@ -287,9 +356,19 @@ func createUser(t *testing.T, db *Db, username string) int32 {
 	if err != nil {
 		t.Fatalf("failed to insert user: %v", err)
 	}
+	if _, err := db.conn.Exec(context.Background(), `INSERT INTO workspaces (id, user_id) VALUES ($1, $1)`, id); err != nil {
+		t.Fatalf("failed to insert workspace: %v", err)
+	}
 	return id
 }
 
+// contextWithPersonalWorkspace signs the user in on their personal workspace, as the auth
+// interceptor does for calls without a household.
+func contextWithPersonalWorkspace(ctx context.Context, userID int32) context.Context {
+	ctx = contextWithUser(ctx, &apiv1.User{Id: userID})
+	return contextWithWorkspace(ctx, &workspace{ID: userID, BaseCurrency: defaultCurrency})
+}
+
 func createSessionForUser(t *testing.T, db *Db, userID int32) string {
 	t.Helper()
 	var sessionID string
@@ -0,0 +1,391 @@
+package cashtrack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	apiv1 "cashtrack/backend/gen/api/v1"
+	"cashtrack/backend/gen/api/v1/apiv1connect"
+	dbgen "cashtrack/backend/gen/db"
+	"connectrpc.com/connect"
+	"connectrpc.com/validate"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const (
+	BudgetPeriodMonthly   = "monthly"
+	BudgetPeriodQuarterly = "quarterly"
+	BudgetPeriodYearly    = "yearly"
+
+	maxBudgetStatusPeriods = 36
+)
+
+type BudgetService struct {
+	db            *Db
+	exchangeRates *ExchangeRateService
+}
+
+type BudgetServiceHandler Handler
+
+func NewBudgetServiceHandler(db *Db, exchangeRates *ExchangeRateService) *BudgetServiceHandler {
+	service := &BudgetService{db: db, exchangeRates: exchangeRates}
+	path, handler := apiv1connect.NewBudgetServiceHandler(
+		service,
+		connect.WithInterceptors(validate.NewInterceptor(), NewAuthInterceptor(db)),
+	)
+	return &BudgetServiceHandler{Path: path, Handler: handler}
+}
+
+func (s *BudgetService) ListBudgets(ctx context.Context, req *apiv1.ListBudgetsRequest) (*apiv1.ListBudgetsResponse, error) {
+	workspace, err := requireWorkspace(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.db.Queries.ListBudgetsByWorkspace(ctx, workspace.ID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	budgets := make([]*apiv1.Budget, 0, len(rows))
+	for _, row := range rows {
+		budget, err := budgetFromRow(row)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		budgets = append(budgets, budget)
+	}
+	return &apiv1.ListBudgetsResponse{Budgets: budgets}, nil
+}
+
+func (s *BudgetService) CreateBudget(ctx context.Context, req *apiv1.CreateBudgetRequest) (*apiv1.CreateBudgetResponse, error) {
+	workspace, err := requireWorkspace(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	period, amount, err := s.validateBudget(ctx, workspace.ID, req.CategoryId, req.Period, req.Amount)
+	if err != nil {
+		return nil, err
+	}
+	row, err := s.db.Queries.CreateBudget(ctx, dbgen.CreateBudgetParams{
+		WorkspaceID: workspace.ID,
+		CategoryID:  int64(req.CategoryId),
+		Period:      period,
+		Amount:      amount,
+		Currency:    normalizeBaseCurrency(workspace.BaseCurrency),
+	})
+	if err != nil {
+		return nil, budgetWriteError(err)
+	}
+	budget, err := budgetFromRow(dbgen.ListBudgetsByWorkspaceRow(row))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return &apiv1.CreateBudgetResponse{Budget: budget}, nil
+}
+
+func (s *BudgetService) UpdateBudget(ctx context.Context, req *apiv1.UpdateBudgetRequest) (*apiv1.UpdateBudgetResponse, error) {
+	workspace, err := requireWorkspace(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Id == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("id is required"))
+	}
+	period, amount, err := s.validateBudget(ctx, workspace.ID, req.CategoryId, req.Period, req.Amount)
+	if err != nil {
+		return nil, err
+	}
+	affected, err := s.db.Queries.UpdateBudget(ctx, dbgen.UpdateBudgetParams{
+		CategoryID:  int64(req.CategoryId),
+		Period:      period,
+		Amount:      amount,
+		Currency:    normalizeBaseCurrency(workspace.BaseCurrency),
+		ID:          int64(req.Id),
+		WorkspaceID: workspace.ID,
+	})
+	if err != nil {
+		return nil, budgetWriteError(err)
+	}
+	if affected == 0 {
+		return nil, connect.NewError(connect.CodeNotFound, errNotFound)
+	}
+	return &apiv1.UpdateBudgetResponse{}, nil
+}
+
+func (s *BudgetService) DeleteBudget(ctx context.Context, req *apiv1.DeleteBudgetRequest) (*apiv1.DeleteBudgetResponse, error) {
+	workspace, err := requireWorkspace(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Id == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("id is required"))
+	}
+	affected, err := s.db.Queries.DeleteBudget(ctx, dbgen.DeleteBudgetParams{
+		ID:          int64(req.Id),
+		WorkspaceID: workspace.ID,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if affected == 0 {
+		return nil, connect.NewError(connect.CodeNotFound, errNotFound)
+	}
+	return &apiv1.DeleteBudgetResponse{}, nil
+}
+
+// GetBudgetStatus returns spent vs. budgeted for the period containing date and the periods before it,
+// oldest first. Spending is the net outflow of the budget's category and all its descendants,
+// converted to the workspace's base currency on each transaction's date.
+func (s *BudgetService) GetBudgetStatus(ctx context.Context, req *apiv1.GetBudgetStatusRequest) (*apiv1.GetBudgetStatusResponse, error) {
+	workspace, err := requireWorkspace(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	date := time.Now().UTC()
+	if value := strings.TrimSpace(req.Date); value != "" {
+		date, err = time.Parse("2006-01-02", value)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("date must be YYYY-MM-DD"))
+		}
+	}
+	periods := int(req.Periods)
+	if periods <= 0 {
+		periods = 1
+	}
+	if periods > maxBudgetStatusPeriods {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("periods must not exceed %d", maxBudgetStatusPeriods))
+	}
+
+	statuses, err := s.budgetStatuses(ctx, workspace.ID, normalizeBaseCurrency(workspace.BaseCurrency), date, periods)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return &apiv1.GetBudgetStatusResponse{Statuses: statuses}, nil
+}
+
+func (s *BudgetService) budgetStatuses(ctx context.Context, workspaceID int32, baseCurrency string, date time.Time, periods int) ([]*apiv1.BudgetStatus, error) {
+	budgets, err := s.db.Queries.ListBudgetsByWorkspace(ctx, workspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("load budgets: %w", err)
+	}
+	if len(budgets) == 0 {
+		return []*apiv1.BudgetStatus{}, nil
+	}
+	categories, err := s.db.Queries.ListCategoriesByWorkspace(ctx, workspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("load categories: %w", err)
+	}
+
+	var from, to time.Time
+	for i, budget := range budgets {
+		start := shiftBudgetPeriod(budget.Period, budgetPeriodStart(budget.Period, date), -(periods - 1))
+		end := shiftBudgetPeriod(budget.Period, budgetPeriodStart(budget.Period, date), 1)
+		if i == 0 || start.Before(from) {
+			from = start
+		}
+		if i == 0 || end.After(to) {
+			to = end
+		}
+	}
+
+	rows, err := s.db.Queries.ListCategorizedTransactions(ctx, dbgen.ListCategorizedTransactionsParams{
+		WorkspaceID: workspaceID,
+		FromDate:    pgtype.Date{Time: from, Valid: true},
+		ToDate:      pgtype.Date{Time: to, Valid: true},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("load transactions: %w", err)
+	}
+	transactions := make([]budgetTransaction, 0, len(rows))
+	for _, row := range rows {
+		value, err := numericToFloat(row.Amount)
+		if err != nil {
+			return nil, fmt.Errorf("parse amount: %w", err)
+		}
+		rate, err := s.exchangeRates.GetRate(ctx, row.Currency, baseCurrency, row.PostedDate.Time)
+		if err != nil {
+			return nil, err
+		}
+		transactions = append(transactions, budgetTransaction{
+			CategoryID: row.CategoryID.Int64,
+			PostedDate: row.PostedDate.Time,
+			Amount:     value * rate,
+		})
+	}
+
+	statuses := make([]*apiv1.BudgetStatus, 0, len(budgets)*periods)
+	for _, budget := range budgets {
+		amount, err := numericToFloat(budget.Amount)
+		if err != nil {
+			return nil, fmt.Errorf("parse budget amount: %w", err)
+		}
+		included := categoryWithDescendants(categories, budget.CategoryID)
+		current := budgetPeriodStart(budget.Period, date)
+		for i := periods - 1; i >= 0; i-- {
+			start := shiftBudgetPeriod(budget.Period, current, -i)
+			end := shiftBudgetPeriod(budget.Period, start, 1)
+
+			budgeted := amount
+			if normalizeCurrency(budget.Currency) != baseCurrency {
+				rate, err := s.exchangeRates.GetRate(ctx, budget.Currency, baseCurrency, start)
+				if err != nil {
+					return nil, err
+				}
+				budgeted *= rate
+			}
+			spent := budgetSpent(transactions, included, start, end)
+
+			statuses = append(statuses, &apiv1.BudgetStatus{
+				BudgetId:    int32(budget.ID),
+				CategoryId:  int32(budget.CategoryID),
+				Period:      budget.Period,
+				PeriodStart: start.Format("2006-01-02"),
+				PeriodEnd:   end.AddDate(0, 0, -1).Format("2006-01-02"),
+				Budgeted:    centsFromFloat(budgeted),
+				Spent:       centsFromFloat(spent),
+				Remaining:   centsFromFloat(budgeted) - centsFromFloat(spent),
+				Currency:    baseCurrency,
+			})
+		}
+	}
+	return statuses, nil
+}
+
+func (s *BudgetService) validateBudget(ctx context.Context, workspaceID int32, categoryID int32, period string, amount int64) (string, pgtype.Numeric, error) {
+	if categoryID == 0 {
+		return "", pgtype.Numeric{}, connect.NewError(connect.CodeInvalidArgument, errors.New("category_id is required"))
+	}
+	period = strings.ToLower(strings.TrimSpace(period))
+	if !isBudgetPeriod(period) {
+		return "", pgtype.Numeric{}, connect.NewError(connect.CodeInvalidArgument, errors.New("period must be monthly, quarterly or yearly"))
+	}
+	if amount <= 0 {
+		return "", pgtype.Numeric{}, connect.NewError(connect.CodeInvalidArgument, errors.New("amount must be positive"))
+	}
+	if _, err := getCategory(ctx, s.db, workspaceID, categoryID); err != nil {
+		if errors.Is(err, errNotFound) {
+			return "", pgtype.Numeric{}, connect.NewError(connect.CodeInvalidArgument, errors.New("category not found"))
+		}
+		return "", pgtype.Numeric{}, connect.NewError(connect.CodeInternal, err)
+	}
+	numericAmount, err := numericFromCents(amount)
+	if err != nil {
+		return "", pgtype.Numeric{}, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	return period, numericAmount, nil
+}
+
+func budgetWriteError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+		return connect.NewError(connect.CodeAlreadyExists, errors.New("budget for this category and period already exists"))
+	}
+	return connect.NewError(connect.CodeInternal, err)
+}
+
+func budgetFromRow(row dbgen.ListBudgetsByWorkspaceRow) (*apiv1.Budget, error) {
+	amount, err := numericToCents(row.Amount)
+	if err != nil {
+		return nil, err
+	}
+	return &apiv1.Budget{
+		Id:         int32(row.ID),
+		CategoryId: int32(row.CategoryID),
+		Period:     row.Period,
+		Amount:     amount,
+		Currency:   row.Currency,
+		CreatedAt:  row.CreatedAt.Time.Format(time.RFC3339Nano),
+	}, nil
+}
+
+func normalizeBaseCurrency(baseCurrency string) string {
+	currency := normalizeCurrency(baseCurrency)
+	if currency == "" {
+		return defaultCurrency
+	}
+	return currency
+}
+
+type budgetTransaction struct {
+	CategoryID int64
+	PostedDate time.Time
+	// Signed amount in the base currency.
+	Amount float64
+}
+
+// budgetSpent is the net outflow in [start, end): refunds reduce spending.
+func budgetSpent(transactions []budgetTransaction, categories map[int64]bool, start time.Time, end time.Time) float64 {
+	var total float64
+	for _, transaction := range transactions {
+		if !categories[transaction.CategoryID] {
+			continue
+		}
+		if transaction.PostedDate.Before(start) || !transaction.PostedDate.Before(end) {
+			continue
+		}
+		total += transaction.Amount
+	}
+	return -total
+}
+
+// categoryWithDescendants returns the category and everything below it in the parent_id tree.
+func categoryWithDescendants(rows []dbgen.ListCategoriesByWorkspaceRow, rootID int64) map[int64]bool {
+	children := make(map[int64][]int64, len(rows))
+	for _, row := range rows {
+		if row.ParentID.Valid {
+			children[row.ParentID.Int64] = append(children[row.ParentID.Int64], row.ID)
+		}
+	}
+	included := map[int64]bool{rootID: true}
+	queue := []int64{rootID}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, child := range children[current] {
+			if included[child] {
+				continue
+			}
+			included[child] = true
+			queue = append(queue, child)
+		}
+	}
+	return included
+}
+
+func isBudgetPeriod(period string) bool {
+	switch period {
+	case BudgetPeriodMonthly, BudgetPeriodQuarterly, BudgetPeriodYearly:
+		return true
+	}
+	return false
+}
+
+func budgetPeriodStart(period string, date time.Time) time.Time {
+	year, month, _ := date.Date()
+	switch period {
+	case BudgetPeriodQuarterly:
+		month = time.Month((int(month)-1)/3*3 + 1)
+	case BudgetPeriodYearly:
+		month = time.January
+	}
+	return time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+}
+
+func shiftBudgetPeriod(period string, start time.Time, count int) time.Time {
+	switch period {
+	case BudgetPeriodQuarterly:
+		return start.AddDate(0, 3*count, 0)
+	case BudgetPeriodYearly:
+		return start.AddDate(count, 0, 0)
+	default:
+		return start.AddDate(0, count, 0)
+	}
+}
@@ -0,0 +1,133 @@
+package cashtrack
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	dbgen "cashtrack/backend/gen/db"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func TestBudgetPeriodStart(t *testing.T) {
+	date := time.Date(2026, 8, 17, 0, 0, 0, 0, time.UTC)
+	cases := map[string]string{
+		BudgetPeriodMonthly:   "2026-08-01",
+		BudgetPeriodQuarterly: "2026-07-01",
+		BudgetPeriodYearly:    "2026-01-01",
+	}
+	for period, expected := range cases {
+		if got := budgetPeriodStart(period, date).Format("2006-01-02"); got != expected {
+			t.Fatalf("%s: expected %s, got %s", period, expected, got)
+		}
+	}
+	if got := shiftBudgetPeriod(BudgetPeriodQuarterly, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), -1); got.Format("2006-01-02") != "2025-10-01" {
+		t.Fatalf("unexpected previous quarter %s", got.Format("2006-01-02"))
+	}
+}
+
+func TestCategoryWithDescendantsRollsUpGroups(t *testing.T) {
+	rows := []dbgen.ListCategoriesByWorkspaceRow{
+		{ID: 1},
+		{ID: 2, ParentID: pgtype.Int8{Int64: 1, Valid: true}},
+		{ID: 3, ParentID: pgtype.Int8{Int64: 2, Valid: true}},
+		{ID: 4},
+		// A cycle must not loop forever.
+		{ID: 5, ParentID: pgtype.Int8{Int64: 6, Valid: true}},
+		{ID: 6, ParentID: pgtype.Int8{Int64: 5, Valid: true}},
+	}
+	included := categoryWithDescendants(rows, 1)
+	if len(included) != 3 || !included[1] || !included[2] || !included[3] {
+		t.Fatalf("unexpected rollup %v", included)
+	}
+	if included := categoryWithDescendants(rows, 5); len(included) != 2 {
+		t.Fatalf("unexpected cyclic rollup %v", included)
+	}
+}
+
+func TestBudgetSpentNetsRefundsWithinPeriod(t *testing.T) {
+	start := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)
+	transactions := []budgetTransaction{
+		{CategoryID: 1, PostedDate: start, Amount: -50},
+		{CategoryID: 2, PostedDate: start.AddDate(0, 0, 10), Amount: -20},
+		{CategoryID: 2, PostedDate: start.AddDate(0, 0, 11), Amount: 5},
+		{CategoryID: 1, PostedDate: end, Amount: -100},
+		{CategoryID: 3, PostedDate: start, Amount: -7},
+	}
+	spent := budgetSpent(transactions, map[int64]bool{1: true, 2: true}, start, end)
+	if centsFromFloat(spent) != 6500 {
+		t.Fatalf("expected 65.00 spent, got %v", spent)
+	}
+}
+
+func TestBudgetStatusesConvertsAndRollsUp(t *testing.T) {
+	db, cleanup := openTestDB(t)
+	defer cleanup()
+
+	createSummaryTables(t, db)
+	ctx := context.Background()
+	_, err := db.conn.Exec(ctx, `
+		CREATE TABLE categories (
+			id bigserial PRIMARY KEY,
+			workspace_id integer NOT NULL REFERENCES workspaces(id) ON DELETE CASCADE,
+			name varchar(255) NOT NULL,
+			created_at timestamptz NOT NULL DEFAULT now(),
+			color varchar(7),
+			parent_id bigint,
+			is_group boolean NOT NULL DEFAULT false
+		);
+		CREATE TABLE budgets (
+			id bigserial PRIMARY KEY,
+			workspace_id integer NOT NULL REFERENCES workspaces(id) ON DELETE CASCADE,
+			category_id bigint NOT NULL REFERENCES categories(id) ON DELETE CASCADE,
+			period varchar(16) NOT NULL,
+			amount numeric(18, 2) NOT NULL,
+			currency varchar(3) NOT NULL,
+			created_at timestamptz NOT NULL DEFAULT now()
+		);
+	`)
+	if err != nil {
+		t.Fatalf("create budget tables: %v", err)
+	}
+	userID := createUser(t, db, "budgets@example.com")
+
+	var groupID, childID int64
+	if err := db.conn.QueryRow(ctx, `INSERT INTO categories (workspace_id, name, is_group) VALUES ($1, 'Food', true) RETURNING id`, userID).Scan(&groupID); err != nil {
+		t.Fatalf("insert group: %v", err)
+	}
+	if err := db.conn.QueryRow(ctx, `INSERT INTO categories (workspace_id, name, parent_id) VALUES ($1, 'Groceries', $2) RETURNING id`, userID, groupID).Scan(&childID); err != nil {
+		t.Fatalf("insert category: %v", err)
+	}
+	if _, err := db.conn.Exec(ctx, `INSERT INTO budgets (workspace_id, category_id, period, amount, currency) VALUES ($1, $2, 'monthly', 500.00, 'CHF')`, userID, groupID); err != nil {
+		t.Fatalf("insert budget: %v", err)
+	}
+	_, err = db.conn.Exec(ctx, `
+		INSERT INTO transactions (workspace_id, posted_date, description, amount, currency, category_id) VALUES
+			($1, '2026-02-10', 'coop', -100.00, 'CHF', $2),
+			($1, '2026-03-05', 'coop', -40.00, 'CHF', $3),
+			($1, '2026-03-06', 'lidl', -100.00, 'EUR', $3),
+			($1, '2026-03-07', 'refund', 10.00, 'CHF', $3),
+			($1, '2026-04-01', 'coop', -999.00, 'CHF', $3)
+	`, userID, groupID, childID)
+	if err != nil {
+		t.Fatalf("insert transactions: %v", err)
+	}
+
+	service := &BudgetService{db: db, exchangeRates: newTestTransactionsService(t, db).exchangeRates}
+	statuses, err := service.budgetStatuses(ctx, userID, "CHF", time.Date(2026, 3, 15, 0, 0, 0, 0, time.UTC), 2)
+	if err != nil {
+		t.Fatalf("budget statuses: %v", err)
+	}
+	if len(statuses) != 2 {
+		t.Fatalf("expected 2 periods, got %d", len(statuses))
+	}
+	february, march := statuses[0], statuses[1]
+	if february.PeriodStart != "2026-02-01" || february.PeriodEnd != "2026-02-28" || february.Spent != 10000 {
+		t.Fatalf("unexpected february status %+v", february)
+	}
+	// 40 CHF + 100 EUR at 0.95 - 10 CHF refund.
+	if march.Spent != 12500 || march.Budgeted != 50000 || march.Remaining != 37500 || march.Currency != "CHF" {
+		t.Fatalf("unexpected march status %+v", march)
+	}
+}
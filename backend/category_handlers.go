@@ -13,6 +13,7 @@ import (
 	"connectrpc.com/validate"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
 type CategoryService struct {
@@ -34,11 +35,11 @@ func NewCategoryServiceHandler(db *Db, transactions *TransactionsService) *Categ
 }
 
 func (s *CategoryService) ListCategories(ctx context.Context, req *apiv1.ListCategoriesRequest) (*apiv1.ListCategoriesResponse, error) {
-	user, err := requireUser(ctx)
+	workspace, err := requireWorkspace(ctx)
 	if err != nil {
 		return nil, err
 	}
-	categories, err := listCategories(ctx, s.db, user.Id)
+	categories, err := listCategories(ctx, s.db, workspace.ID)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
@@ -46,7 +47,7 @@ func (s *CategoryService) ListCategories(ctx context.Context, req *apiv1.ListCat
 }
 
 func (s *CategoryService) CreateCategory(ctx context.Context, req *apiv1.CreateCategoryRequest) (*apiv1.CreateCategoryResponse, error) {
-	user, err := requireUser(ctx)
+	workspace, err := requireWorkspace(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -61,12 +62,12 @@ func (s *CategoryService) CreateCategory(ctx context.Context, req *apiv1.CreateC
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
-	parentID, err := resolveCategoryParent(ctx, s.db, user.Id, 0, req.ParentId)
+	parentID, err := resolveCategoryParent(ctx, s.db, workspace.ID, 0, req.ParentId)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
-	category, err := createCategory(ctx, s.db, user.Id, name, color, parentID, req.IsGroup)
+	category, err := createCategory(ctx, s.db, workspace.ID, name, color, parentID, req.IsGroup)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
@@ -74,7 +75,7 @@ func (s *CategoryService) CreateCategory(ctx context.Context, req *apiv1.CreateC
 }
 
 func (s *CategoryService) UpdateCategory(ctx context.Context, req *apiv1.UpdateCategoryRequest) (*apiv1.UpdateCategoryResponse, error) {
-	user, err := requireUser(ctx)
+	workspace, err := requireWorkspace(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -92,12 +93,12 @@ func (s *CategoryService) UpdateCategory(ctx context.Context, req *apiv1.UpdateC
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
-	parentID, err := resolveCategoryParent(ctx, s.db, user.Id, req.Id, req.ParentId)
+	parentID, err := resolveCategoryParent(ctx, s.db, workspace.ID, req.Id, req.ParentId)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
-	if err := updateCategory(ctx, s.db, user.Id, req.Id, name, color, parentID, req.IsGroup); err != nil {
+	if err := updateCategory(ctx, s.db, workspace.ID, req.Id, name, color, parentID, req.IsGroup); err != nil {
 		if errors.Is(err, errNotFound) {
 			return nil, connect.NewError(connect.CodeNotFound, err)
 		}
@@ -107,7 +108,7 @@ func (s *CategoryService) UpdateCategory(ctx context.Context, req *apiv1.UpdateC
 }
 
 func (s *CategoryService) DeleteCategory(ctx context.Context, req *apiv1.DeleteCategoryRequest) (*apiv1.DeleteCategoryResponse, error) {
-	user, err := requireUser(ctx)
+	workspace, err := requireWorkspace(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -115,7 +116,7 @@ func (s *CategoryService) DeleteCategory(ctx context.Context, req *apiv1.DeleteC
 	if req.Id == 0 {
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("id is required"))
 	}
-	if err := deleteCategory(ctx, s.db, user.Id, req.Id); err != nil {
+	if err := deleteCategory(ctx, s.db, workspace.ID, req.Id); err != nil {
 		if errors.Is(err, errNotFound) {
 			return nil, connect.NewError(connect.CodeNotFound, err)
 		}
@@ -125,11 +126,11 @@ func (s *CategoryService) DeleteCategory(ctx context.Context, req *apiv1.DeleteC
 }
 
 func (s *CategoryService) ListCategoryRules(ctx context.Context, req *apiv1.ListCategoryRulesRequest) (*apiv1.ListCategoryRulesResponse, error) {
-	user, err := requireUser(ctx)
+	workspace, err := requireWorkspace(ctx)
 	if err != nil {
 		return nil, err
 	}
-	rules, err := listCategoryRules(ctx, s.db, user.Id)
+	rules, err := listCategoryRules(ctx, s.db, workspace.ID)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
@@ -137,7 +138,7 @@ func (s *CategoryService) ListCategoryRules(ctx context.Context, req *apiv1.List
 }
 
 func (s *CategoryService) CreateCategoryRule(ctx context.Context, req *apiv1.CreateCategoryRuleRequest) (*apiv1.CreateCategoryRuleResponse, error) {
-	user, err := requireUser(ctx)
+	workspace, err := requireWorkspace(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -145,30 +146,20 @@ func (s *CategoryService) CreateCategoryRule(ctx context.Context, req *apiv1.Cre
 	if req.CategoryId == 0 {
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("category_id is required"))
 	}
-	description := strings.TrimSpace(req.DescriptionContains)
-	if description == "" {
-		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("description_contains is required"))
-	}
-	category, err := getCategory(ctx, s.db, user.Id, req.CategoryId)
+	rule, err := s.categoryRule(ctx, workspace.ID, req.CategoryId, categoryRuleConditions(req))
 	if err != nil {
-		if errors.Is(err, errNotFound) {
-			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("category not found"))
-		}
-		return nil, connect.NewError(connect.CodeInternal, err)
-	}
-	if category.IsGroup {
-		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("category cannot be a group"))
+		return nil, err
 	}
 
-	rule, err := createCategoryRule(ctx, s.db, user.Id, req.CategoryId, description)
+	created, err := createCategoryRule(ctx, s.db, workspace.ID, req.CategoryId, rule.Conditions)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
-	return &apiv1.CreateCategoryRuleResponse{Rule: rule}, nil
+	return &apiv1.CreateCategoryRuleResponse{Rule: created}, nil
 }
 
 func (s *CategoryService) UpdateCategoryRule(ctx context.Context, req *apiv1.UpdateCategoryRuleRequest) (*apiv1.UpdateCategoryRuleResponse, error) {
-	user, err := requireUser(ctx)
+	workspace, err := requireWorkspace(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -179,22 +170,12 @@ func (s *CategoryService) UpdateCategoryRule(ctx context.Context, req *apiv1.Upd
 	if req.CategoryId == 0 {
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("category_id is required"))
 	}
-	description := strings.TrimSpace(req.DescriptionContains)
-	if description == "" {
-		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("description_contains is required"))
-	}
-	category, err := getCategory(ctx, s.db, user.Id, req.CategoryId)
+	rule, err := s.categoryRule(ctx, workspace.ID, req.CategoryId, categoryRuleConditions(req))
 	if err != nil {
-		if errors.Is(err, errNotFound) {
-			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("category not found"))
-		}
-		return nil, connect.NewError(connect.CodeInternal, err)
-	}
-	if category.IsGroup {
-		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("category cannot be a group"))
+		return nil, err
 	}
 
-	if err := updateCategoryRule(ctx, s.db, user.Id, req.Id, req.CategoryId, description); err != nil {
+	if err := updateCategoryRule(ctx, s.db, workspace.ID, req.Id, req.CategoryId, rule.Conditions); err != nil {
 		if errors.Is(err, errNotFound) {
 			return nil, connect.NewError(connect.CodeNotFound, err)
 		}
@@ -204,7 +185,7 @@ func (s *CategoryService) UpdateCategoryRule(ctx context.Context, req *apiv1.Upd
 }
 
 func (s *CategoryService) DeleteCategoryRule(ctx context.Context, req *apiv1.DeleteCategoryRuleRequest) (*apiv1.DeleteCategoryRuleResponse, error) {
-	user, err := requireUser(ctx)
+	workspace, err := requireWorkspace(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -212,7 +193,7 @@ func (s *CategoryService) DeleteCategoryRule(ctx context.Context, req *apiv1.Del
 	if req.Id == 0 {
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("id is required"))
 	}
-	if err := deleteCategoryRule(ctx, s.db, user.Id, req.Id); err != nil {
+	if err := deleteCategoryRule(ctx, s.db, workspace.ID, req.Id); err != nil {
 		if errors.Is(err, errNotFound) {
 			return nil, connect.NewError(connect.CodeNotFound, err)
 		}
@@ -222,20 +203,73 @@ func (s *CategoryService) DeleteCategoryRule(ctx context.Context, req *apiv1.Del
 }
 
 func (s *CategoryService) ApplyCategoryRules(ctx context.Context, req *apiv1.ApplyCategoryRulesRequest) (*apiv1.ApplyCategoryRulesResponse, error) {
-	user, err := requireUser(ctx)
+	workspace, err := requireWorkspace(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	updated, err := s.transactions.ApplyCategoryRules(ctx, user.Id, req.ApplyToAll)
+	updated, err := s.transactions.ApplyCategoryRules(ctx, workspace.ID, req.ApplyToAll)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 	return &apiv1.ApplyCategoryRulesResponse{UpdatedCount: int32(updated)}, nil
 }
 
+func (s *CategoryService) PreviewCategoryRules(ctx context.Context, req *apiv1.PreviewCategoryRulesRequest) (*apiv1.PreviewCategoryRulesResponse, error) {
+	workspace, err := requireWorkspace(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var draft *normalizedRule
+	if req.Draft != nil {
+		if req.Draft.CategoryId == 0 {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("draft.category_id is required"))
+		}
+		rule, err := s.categoryRule(ctx, workspace.ID, req.Draft.CategoryId, categoryRuleConditions(req.Draft))
+		if err != nil {
+			return nil, err
+		}
+		draft = &rule
+	}
+
+	changes, err := s.transactions.PreviewCategoryRules(ctx, workspace.ID, req.ApplyToAll, draft)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	response := &apiv1.PreviewCategoryRulesResponse{Changes: make([]*apiv1.CategoryRuleChange, 0, len(changes))}
+	for _, change := range changes {
+		item := &apiv1.CategoryRuleChange{
+			TransactionId:  int32(change.TransactionID),
+			Description:    change.Description,
+			Amount:         change.AmountCents,
+			Currency:       change.Currency,
+			FromCategoryId: int32PtrFromInt8(change.FromCategoryID),
+			ToCategoryId:   int32PtrFromInt8(change.ToCategoryID),
+		}
+		if change.PostedDate.Valid {
+			item.PostedDate = change.PostedDate.Time.Format("2006-01-02")
+		}
+		if change.Rule != nil {
+			item.RuleId = int32(change.Rule.RuleID)
+			item.DraftRule = change.Rule.RuleID == 0
+		}
+		response.Changes = append(response.Changes, item)
+	}
+	return response, nil
+}
+
+func int32PtrFromInt8(value pgtype.Int8) *int32 {
+	if !value.Valid {
+		return nil
+	}
+	result := int32(value.Int64)
+	return &result
+}
+
 func (s *CategoryService) ReorderCategoryRules(ctx context.Context, req *apiv1.ReorderCategoryRulesRequest) (*apiv1.ReorderCategoryRulesResponse, error) {
-	user, err := requireUser(ctx)
+	workspace, err := requireWorkspace(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -244,7 +278,7 @@ func (s *CategoryService) ReorderCategoryRules(ctx context.Context, req *apiv1.R
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("rule_ids is required"))
 	}
 
-	rules, err := listCategoryRules(ctx, s.db, user.Id)
+	rules, err := listCategoryRules(ctx, s.db, workspace.ID)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
@@ -280,9 +314,9 @@ func (s *CategoryService) ReorderCategoryRules(ctx context.Context, req *apiv1.R
 	txQueries := s.db.Queries.WithTx(tx)
 	for index, id := range req.RuleIds {
 		affected, err := txQueries.UpdateCategoryRulePosition(ctx, dbgen.UpdateCategoryRulePositionParams{
-			Position: int32(index + 1),
-			ID:       int64(id),
-			UserID:   user.Id,
+			Position:    int32(index + 1),
+			ID:          int64(id),
+			WorkspaceID: workspace.ID,
 		})
 		if err != nil {
 			return nil, connect.NewError(connect.CodeInternal, err)
@@ -299,8 +333,8 @@ func (s *CategoryService) ReorderCategoryRules(ctx context.Context, req *apiv1.R
 	return &apiv1.ReorderCategoryRulesResponse{}, nil
 }
 
-func listCategories(ctx context.Context, db *Db, userID int32) ([]*apiv1.Category, error) {
-	rows, err := db.Queries.ListCategoriesByUser(ctx, userID)
+func listCategories(ctx context.Context, db *Db, workspaceID int32) ([]*apiv1.Category, error) {
+	rows, err := db.Queries.ListCategoriesByWorkspace(ctx, workspaceID)
 	if err != nil {
 		return nil, err
 	}
@@ -327,13 +361,13 @@ func listCategories(ctx context.Context, db *Db, userID int32) ([]*apiv1.Categor
 	return categories, nil
 }
 
-func createCategory(ctx context.Context, db *Db, userID int32, name string, color pgtype.Text, parentID pgtype.Int8, isGroup bool) (*apiv1.Category, error) {
+func createCategory(ctx context.Context, db *Db, workspaceID int32, name string, color pgtype.Text, parentID pgtype.Int8, isGroup bool) (*apiv1.Category, error) {
 	row, err := db.Queries.CreateCategory(ctx, dbgen.CreateCategoryParams{
-		UserID:   userID,
-		Name:     name,
-		Color:    color,
-		ParentID: parentID,
-		IsGroup:  isGroup,
+		WorkspaceID: workspaceID,
+		Name:        name,
+		Color:       color,
+		ParentID:    parentID,
+		IsGroup:     isGroup,
 	})
 	if err != nil {
 		return nil, err
@@ -356,14 +390,14 @@ func createCategory(ctx context.Context, db *Db, userID int32, name string, colo
 	}, nil
 }
 
-func updateCategory(ctx context.Context, db *Db, userID int32, id int32, name string, color pgtype.Text, parentID pgtype.Int8, isGroup bool) error {
+func updateCategory(ctx context.Context, db *Db, workspaceID int32, id int32, name string, color pgtype.Text, parentID pgtype.Int8, isGroup bool) error {
 	affected, err := db.Queries.UpdateCategory(ctx, dbgen.UpdateCategoryParams{
-		Name:     name,
-		Color:    color,
-		ParentID: parentID,
-		IsGroup:  isGroup,
-		ID:       int64(id),
-		UserID:   userID,
+		Name:        name,
+		Color:       color,
+		ParentID:    parentID,
+		IsGroup:     isGroup,
+		ID:          int64(id),
+		WorkspaceID: workspaceID,
 	})
 	if err != nil {
 		return err
@@ -374,10 +408,10 @@ func updateCategory(ctx context.Context, db *Db, userID int32, id int32, name st
 	return nil
 }
 
-func deleteCategory(ctx context.Context, db *Db, userID int32, id int32) error {
+func deleteCategory(ctx context.Context, db *Db, workspaceID int32, id int32) error {
 	affected, err := db.Queries.DeleteCategory(ctx, dbgen.DeleteCategoryParams{
-		ID:     int64(id),
-		UserID: userID,
+		ID:          int64(id),
+		WorkspaceID: workspaceID,
 	})
 	if err != nil {
 		return err
@@ -388,10 +422,69 @@ func deleteCategory(ctx context.Context, db *Db, userID int32, id int32) error {
 	return nil
 }
 
-func getCategory(ctx context.Context, db *Db, userID int32, id int32) (*dbgen.GetCategoryByIDRow, error) {
+// categoryRuleRequest is implemented by the requests that carry a rule's conditions.
+type categoryRuleRequest interface {
+	GetDescriptionContains() string
+	GetDescriptionRegex() string
+	GetDescriptionExact() string
+	GetDescriptionPrefix() string
+	GetAmountMin() int64
+	GetAmountMax() int64
+	GetEntryType() string
+	GetCurrency() string
+	GetAccount() string
+	GetParserName() string
+	ProtoReflect() protoreflect.Message
+}
+
+// categoryRuleConditions reads a rule's conditions from a request.
+func categoryRuleConditions(req categoryRuleRequest) CategoryRuleConditions {
+	return CategoryRuleConditions{
+		DescriptionContains: req.GetDescriptionContains(),
+		DescriptionRegex:    req.GetDescriptionRegex(),
+		DescriptionExact:    req.GetDescriptionExact(),
+		DescriptionPrefix:   req.GetDescriptionPrefix(),
+		AmountMin:           categoryRuleAmount(req, "amount_min", req.GetAmountMin()),
+		AmountMax:           categoryRuleAmount(req, "amount_max", req.GetAmountMax()),
+		EntryType:           req.GetEntryType(),
+		Currency:            req.GetCurrency(),
+		Account:             req.GetAccount(),
+		ParserName:          req.GetParserName(),
+	}
+}
+
+// categoryRuleAmount keeps an unset amount nil. The getter returns zero for it, which is a valid bound.
+func categoryRuleAmount(req categoryRuleRequest, field protoreflect.Name, value int64) *int64 {
+	message := req.ProtoReflect()
+	if !message.Has(message.Descriptor().Fields().ByName(field)) {
+		return nil
+	}
+	return &value
+}
+
+// categoryRule validates a rule's conditions and category and returns the rule compiled for matching.
+func (s *CategoryService) categoryRule(ctx context.Context, workspaceID int32, categoryID int32, conditions CategoryRuleConditions) (normalizedRule, error) {
+	rule, err := compileCategoryRule(CategoryRuleEntry{CategoryID: int64(categoryID), CategoryRuleConditions: conditions})
+	if err != nil {
+		return rule, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	category, err := getCategory(ctx, s.db, workspaceID, categoryID)
+	if err != nil {
+		if errors.Is(err, errNotFound) {
+			return rule, connect.NewError(connect.CodeInvalidArgument, errors.New("category not found"))
+		}
+		return rule, connect.NewError(connect.CodeInternal, err)
+	}
+	if category.IsGroup {
+		return rule, connect.NewError(connect.CodeInvalidArgument, errors.New("category cannot be a group"))
+	}
+	return rule, nil
+}
+
+func getCategory(ctx context.Context, db *Db, workspaceID int32, id int32) (*dbgen.GetCategoryByIDRow, error) {
 	row, err := db.Queries.GetCategoryByID(ctx, dbgen.GetCategoryByIDParams{
-		ID:     int64(id),
-		UserID: userID,
+		ID:          int64(id),
+		WorkspaceID: workspaceID,
 	})
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -402,7 +495,7 @@ func getCategory(ctx context.Context, db *Db, userID int32, id int32) (*dbgen.Ge
 	return &row, nil
 }
 
-func resolveCategoryParent(ctx context.Context, db *Db, userID int32, categoryID int32, parentID int32) (pgtype.Int8, error) {
+func resolveCategoryParent(ctx context.Context, db *Db, workspaceID int32, categoryID int32, parentID int32) (pgtype.Int8, error) {
 	if parentID < 0 {
 		return pgtype.Int8{}, errors.New("parent_id must be positive")
 	}
@@ -412,14 +505,14 @@ func resolveCategoryParent(ctx context.Context, db *Db, userID int32, categoryID
 	if categoryID != 0 && parentID == categoryID {
 		return pgtype.Int8{}, errors.New("parent_id must be different from id")
 	}
-	if _, err := getCategory(ctx, db, userID, parentID); err != nil {
+	if _, err := getCategory(ctx, db, workspaceID, parentID); err != nil {
 		if errors.Is(err, errNotFound) {
 			return pgtype.Int8{}, errors.New("parent category not found")
 		}
 		return pgtype.Int8{}, err
 	}
 	if categoryID != 0 {
-		rows, err := db.Queries.ListCategoriesByUser(ctx, userID)
+		rows, err := db.Queries.ListCategoriesByWorkspace(ctx, workspaceID)
 		if err != nil {
 			return pgtype.Int8{}, err
 		}
@@ -430,7 +523,7 @@ func resolveCategoryParent(ctx context.Context, db *Db, userID int32, categoryID
 	return pgtype.Int8{Int64: int64(parentID), Valid: true}, nil
 }
 
-func hasCategoryParentCycle(rows []dbgen.ListCategoriesByUserRow, categoryID int32, parentID int32) bool {
+func hasCategoryParentCycle(rows []dbgen.ListCategoriesByWorkspaceRow, categoryID int32, parentID int32) bool {
 	if categoryID == 0 || parentID == 0 {
 		return false
 	}
@@ -459,49 +552,75 @@ func hasCategoryParentCycle(rows []dbgen.ListCategoriesByUserRow, categoryID int
 	return false
 }
 
-func listCategoryRules(ctx context.Context, db *Db, userID int32) ([]*apiv1.CategoryRule, error) {
-	rows, err := db.Queries.ListCategoryRulesByUser(ctx, userID)
+func listCategoryRules(ctx context.Context, db *Db, workspaceID int32) ([]*apiv1.CategoryRule, error) {
+	rows, err := db.Queries.ListCategoryRulesByWorkspace(ctx, workspaceID)
 	if err != nil {
 		return nil, err
 	}
 
 	rules := make([]*apiv1.CategoryRule, 0, len(rows))
 	for _, row := range rows {
-		rules = append(rules, &apiv1.CategoryRule{
-			Id:                  int32(row.ID),
-			CategoryId:          int32(row.CategoryID),
-			DescriptionContains: row.DescriptionContains,
-			Position:            row.Position,
-			CreatedAt:           row.CreatedAt.Time.Format(time.RFC3339Nano),
-		})
+		rules = append(rules, categoryRuleFromRow(row))
 	}
 	return rules, nil
 }
 
-func createCategoryRule(ctx context.Context, db *Db, userID int32, categoryID int32, description string) (*apiv1.CategoryRule, error) {
+func categoryRuleFromRow(row dbgen.ListCategoryRulesByWorkspaceRow) *apiv1.CategoryRule {
+	conditions := categoryRuleConditionsFromRow(row)
+	return &apiv1.CategoryRule{
+		Id:                  int32(row.ID),
+		CategoryId:          int32(row.CategoryID),
+		DescriptionContains: conditions.DescriptionContains,
+		Position:            row.Position,
+		CreatedAt:           row.CreatedAt.Time.Format(time.RFC3339Nano),
+		DescriptionRegex:    conditions.DescriptionRegex,
+		DescriptionExact:    conditions.DescriptionExact,
+		DescriptionPrefix:   conditions.DescriptionPrefix,
+		AmountMin:           conditions.AmountMin,
+		AmountMax:           conditions.AmountMax,
+		EntryType:           conditions.EntryType,
+		Currency:            conditions.Currency,
+		Account:             conditions.Account,
+		ParserName:          conditions.ParserName,
+	}
+}
+
+func createCategoryRule(ctx context.Context, db *Db, workspaceID int32, categoryID int32, conditions CategoryRuleConditions) (*apiv1.CategoryRule, error) {
 	row, err := db.Queries.CreateCategoryRule(ctx, dbgen.CreateCategoryRuleParams{
-		UserID:              userID,
+		WorkspaceID:         workspaceID,
 		CategoryID:          int64(categoryID),
-		DescriptionContains: description,
+		DescriptionContains: conditions.DescriptionContains,
+		DescriptionRegex:    conditions.DescriptionRegex,
+		DescriptionExact:    conditions.DescriptionExact,
+		DescriptionPrefix:   conditions.DescriptionPrefix,
+		AmountMin:           int8FromPtr(conditions.AmountMin),
+		AmountMax:           int8FromPtr(conditions.AmountMax),
+		EntryType:           conditions.EntryType,
+		Currency:            conditions.Currency,
+		Account:             conditions.Account,
+		ParserName:          conditions.ParserName,
 	})
 	if err != nil {
 		return nil, err
 	}
-	return &apiv1.CategoryRule{
-		Id:                  int32(row.ID),
-		CategoryId:          int32(row.CategoryID),
-		DescriptionContains: row.DescriptionContains,
-		Position:            row.Position,
-		CreatedAt:           row.CreatedAt.Time.Format(time.RFC3339Nano),
-	}, nil
+	return categoryRuleFromRow(dbgen.ListCategoryRulesByWorkspaceRow(row)), nil
 }
 
-func updateCategoryRule(ctx context.Context, db *Db, userID int32, id int32, categoryID int32, description string) error {
+func updateCategoryRule(ctx context.Context, db *Db, workspaceID int32, id int32, categoryID int32, conditions CategoryRuleConditions) error {
 	affected, err := db.Queries.UpdateCategoryRule(ctx, dbgen.UpdateCategoryRuleParams{
 		CategoryID:          int64(categoryID),
-		DescriptionContains: description,
+		DescriptionContains: conditions.DescriptionContains,
+		DescriptionRegex:    conditions.DescriptionRegex,
+		DescriptionExact:    conditions.DescriptionExact,
+		DescriptionPrefix:   conditions.DescriptionPrefix,
+		AmountMin:           int8FromPtr(conditions.AmountMin),
+		AmountMax:           int8FromPtr(conditions.AmountMax),
+		EntryType:           conditions.EntryType,
+		Currency:            conditions.Currency,
+		Account:             conditions.Account,
+		ParserName:          conditions.ParserName,
 		ID:                  int64(id),
-		UserID:              userID,
+		WorkspaceID:         workspaceID,
 	})
 	if err != nil {
 		return err
@@ -512,10 +631,10 @@ func updateCategoryRule(ctx context.Context, db *Db, userID int32, id int32, cat
 	return nil
 }
 
-func deleteCategoryRule(ctx context.Context, db *Db, userID int32, id int32) error {
+func deleteCategoryRule(ctx context.Context, db *Db, workspaceID int32, id int32) error {
 	affected, err := db.Queries.DeleteCategoryRule(ctx, dbgen.DeleteCategoryRuleParams{
-		ID:     int64(id),
-		UserID: userID,
+		ID:          int64(id),
+		WorkspaceID: workspaceID,
 	})
 	if err != nil {
 		return err
@@ -0,0 +1,264 @@
+package cashtrack
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+
+	dbgen "cashtrack/backend/gen/db"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// CategoryRuleConditions are combined with AND; empty conditions are ignored.
+// Amounts are absolute values in cents, so use EntryType to tell debits from credits.
+type CategoryRuleConditions struct {
+	DescriptionContains string
+	DescriptionRegex    string
+	DescriptionExact    string
+	DescriptionPrefix   string
+	AmountMin           *int64
+	AmountMax           *int64
+	EntryType           string
+	Currency            string
+	// Matches either the source account number or the card number.
+	Account    string
+	ParserName string
+}
+
+type CategoryRuleEntry struct {
+	// Zero for a draft rule that is only previewed.
+	ID         int64
+	CategoryID int64
+	CategoryRuleConditions
+}
+
+// normalizeCategoryRuleConditions cleans up conditions entered by a user and rejects invalid ones.
+// The description regex comes back compiled, so matching does not compile it again.
+func normalizeCategoryRuleConditions(conditions CategoryRuleConditions) (CategoryRuleConditions, *regexp.Regexp, error) {
+	conditions.DescriptionContains = strings.TrimSpace(conditions.DescriptionContains)
+	conditions.DescriptionRegex = strings.TrimSpace(conditions.DescriptionRegex)
+	conditions.DescriptionExact = strings.TrimSpace(conditions.DescriptionExact)
+	conditions.DescriptionPrefix = strings.TrimSpace(conditions.DescriptionPrefix)
+	conditions.EntryType = strings.ToLower(strings.TrimSpace(conditions.EntryType))
+	conditions.Currency = normalizeCurrency(conditions.Currency)
+	conditions.Account = strings.TrimSpace(conditions.Account)
+	conditions.ParserName = strings.TrimSpace(conditions.ParserName)
+
+	var regex *regexp.Regexp
+	if conditions.DescriptionRegex != "" {
+		compiled, err := compileRuleRegex(conditions.DescriptionRegex)
+		if err != nil {
+			return conditions, nil, fmt.Errorf("description_regex is invalid: %w", err)
+		}
+		regex = compiled
+	}
+	if conditions.AmountMin != nil && *conditions.AmountMin < 0 {
+		return conditions, nil, errors.New("amount_min must not be negative")
+	}
+	if conditions.AmountMax != nil && *conditions.AmountMax < 0 {
+		return conditions, nil, errors.New("amount_max must not be negative")
+	}
+	if conditions.AmountMin != nil && conditions.AmountMax != nil && *conditions.AmountMin > *conditions.AmountMax {
+		return conditions, nil, errors.New("amount_min must not be greater than amount_max")
+	}
+	if conditions.EntryType != "" && conditions.EntryType != EntryTypeDebit && conditions.EntryType != EntryTypeCredit {
+		return conditions, nil, errors.New("entry_type must be debit or credit")
+	}
+	if conditions.Currency != "" && !isCurrencyCode(conditions.Currency) {
+		return conditions, nil, errors.New("currency must be a 3-letter ISO code")
+	}
+	if conditions.empty() {
+		return conditions, nil, errors.New("at least one condition is required")
+	}
+	return conditions, regex, nil
+}
+
+func (c CategoryRuleConditions) empty() bool {
+	return c.DescriptionContains == "" &&
+		c.DescriptionRegex == "" &&
+		c.DescriptionExact == "" &&
+		c.DescriptionPrefix == "" &&
+		c.AmountMin == nil &&
+		c.AmountMax == nil &&
+		c.EntryType == "" &&
+		c.Currency == "" &&
+		c.Account == "" &&
+		c.ParserName == ""
+}
+
+func categoryRuleConditionsFromRow(row dbgen.ListCategoryRulesByWorkspaceRow) CategoryRuleConditions {
+	return CategoryRuleConditions{
+		DescriptionContains: row.DescriptionContains,
+		DescriptionRegex:    row.DescriptionRegex,
+		DescriptionExact:    row.DescriptionExact,
+		DescriptionPrefix:   row.DescriptionPrefix,
+		AmountMin:           int64PtrOrNil(row.AmountMin),
+		AmountMax:           int64PtrOrNil(row.AmountMax),
+		EntryType:           row.EntryType,
+		Currency:            row.Currency,
+		Account:             row.Account,
+		ParserName:          row.ParserName,
+	}
+}
+
+// categoryRuleSubject is what rules are matched against, for both parsed and stored transactions.
+type categoryRuleSubject struct {
+	Description   string
+	AmountCents   int64
+	EntryType     string
+	Currency      string
+	AccountNumber string
+	CardNumber    string
+	ParserName    string
+}
+
+type normalizedRule struct {
+	RuleID     int64
+	CategoryID int64
+	// Conditions as cleaned up by normalizeCategoryRuleConditions, for storing the rule.
+	Conditions CategoryRuleConditions
+	contains   string
+	exact      string
+	prefix     string
+	regex      *regexp.Regexp
+	amountMin  *int64
+	amountMax  *int64
+	entryType  string
+	currency   string
+	account    string
+	parserName string
+}
+
+// normalizeRules prepares rules for matching. Rules without conditions, or with a regex that no
+// longer compiles, are skipped rather than matching everything.
+func normalizeRules(rules []CategoryRuleEntry) []normalizedRule {
+	normalized := make([]normalizedRule, 0, len(rules))
+	for _, rule := range rules {
+		next, err := compileCategoryRule(rule)
+		if err != nil {
+			log.Warn().Err(err).Int64("category_id", rule.CategoryID).Msg("skipping invalid category rule")
+			continue
+		}
+		normalized = append(normalized, next)
+	}
+	return normalized
+}
+
+// compileCategoryRule validates a rule and prepares it for matching. Text conditions are
+// case-insensitive.
+func compileCategoryRule(rule CategoryRuleEntry) (normalizedRule, error) {
+	conditions, regex, err := normalizeCategoryRuleConditions(rule.CategoryRuleConditions)
+	if err != nil {
+		return normalizedRule{}, err
+	}
+	return normalizedRule{
+		RuleID:     rule.ID,
+		CategoryID: rule.CategoryID,
+		Conditions: conditions,
+		contains:   strings.ToLower(conditions.DescriptionContains),
+		exact:      strings.ToLower(conditions.DescriptionExact),
+		prefix:     strings.ToLower(conditions.DescriptionPrefix),
+		regex:      regex,
+		amountMin:  conditions.AmountMin,
+		amountMax:  conditions.AmountMax,
+		entryType:  conditions.EntryType,
+		currency:   conditions.Currency,
+		account:    normalizeRuleAccount(conditions.Account),
+		parserName: strings.ToLower(conditions.ParserName),
+	}, nil
+}
+
+func (r normalizedRule) matches(subject categoryRuleSubject) bool {
+	description := strings.ToLower(strings.TrimSpace(subject.Description))
+	if r.contains != "" && !strings.Contains(description, r.contains) {
+		return false
+	}
+	if r.exact != "" && description != r.exact {
+		return false
+	}
+	if r.prefix != "" && !strings.HasPrefix(description, r.prefix) {
+		return false
+	}
+	if r.regex != nil && !r.regex.MatchString(subject.Description) {
+		return false
+	}
+	amount := subject.AmountCents
+	if amount < 0 {
+		amount = -amount
+	}
+	if r.amountMin != nil && amount < *r.amountMin {
+		return false
+	}
+	if r.amountMax != nil && amount > *r.amountMax {
+		return false
+	}
+	if r.entryType != "" && strings.ToLower(subject.EntryType) != r.entryType {
+		return false
+	}
+	if r.currency != "" && normalizeCurrency(subject.Currency) != r.currency {
+		return false
+	}
+	if r.account != "" && normalizeRuleAccount(subject.AccountNumber) != r.account && normalizeRuleAccount(subject.CardNumber) != r.account {
+		return false
+	}
+	if r.parserName != "" && strings.ToLower(subject.ParserName) != r.parserName {
+		return false
+	}
+	return true
+}
+
+// matchCategoryRule returns the category of the first matching rule; rules are ordered by position.
+func matchCategoryRule(subject categoryRuleSubject, rules []normalizedRule) *int64 {
+	if rule := firstMatchingRule(subject, rules); rule != nil {
+		value := rule.CategoryID
+		return &value
+	}
+	return nil
+}
+
+func firstMatchingRule(subject categoryRuleSubject, rules []normalizedRule) *normalizedRule {
+	for i := range rules {
+		if rules[i].matches(subject) {
+			return &rules[i]
+		}
+	}
+	return nil
+}
+
+func categoryIDFromRules(subject categoryRuleSubject, rules []normalizedRule) pgtype.Int8 {
+	if derivedID := matchCategoryRule(subject, rules); derivedID != nil {
+		return pgtype.Int8{Int64: *derivedID, Valid: true}
+	}
+	return pgtype.Int8{}
+}
+
+func compileRuleRegex(pattern string) (*regexp.Regexp, error) {
+	return regexp.Compile("(?i)" + pattern)
+}
+
+// normalizeRuleAccount ignores spacing and case, since statements format account numbers differently.
+func normalizeRuleAccount(value string) string {
+	return strings.ToLower(strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) {
+			return -1
+		}
+		return r
+	}, value))
+}
+
+func int64PtrOrNil(value pgtype.Int8) *int64 {
+	if !value.Valid {
+		return nil
+	}
+	return &value.Int64
+}
+
+func int8FromPtr(value *int64) pgtype.Int8 {
+	if value == nil {
+		return pgtype.Int8{}
+	}
+	return pgtype.Int8{Int64: *value, Valid: true}
+}
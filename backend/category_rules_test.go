@@ -0,0 +1,164 @@
+package cashtrack
+
+import (
+	"context"
+	"testing"
+
+	apiv1 "cashtrack/backend/gen/api/v1"
+)
+
+func TestMatchCategoryRuleCombinesConditions(t *testing.T) {
+	rules := normalizeRules([]CategoryRuleEntry{
+		{CategoryID: 1, CategoryRuleConditions: CategoryRuleConditions{DescriptionRegex: `^coop-\d+`, EntryType: EntryTypeDebit}},
+		{CategoryID: 2, CategoryRuleConditions: CategoryRuleConditions{DescriptionPrefix: "SBB", AmountMax: int64Ptr(10000)}},
+		{CategoryID: 3, CategoryRuleConditions: CategoryRuleConditions{DescriptionExact: "salary", Currency: "chf", Account: "0230 00826810.40"}},
+		{CategoryID: 4, CategoryRuleConditions: CategoryRuleConditions{AmountMin: int64Ptr(50000), ParserName: "ubs_account"}},
+		{CategoryID: 5, CategoryRuleConditions: CategoryRuleConditions{DescriptionContains: "twint"}},
+	})
+
+	cases := []struct {
+		name    string
+		subject categoryRuleSubject
+		want    int64
+	}{
+		{"regex", categoryRuleSubject{Description: "Coop-1234 Zurich", EntryType: EntryTypeDebit}, 1},
+		{"regex wrong entry type", categoryRuleSubject{Description: "Coop-1234 Zurich", EntryType: EntryTypeCredit}, 0},
+		{"prefix within amount", categoryRuleSubject{Description: "sbb mobile", AmountCents: -9900}, 2},
+		{"prefix above amount", categoryRuleSubject{Description: "sbb mobile", AmountCents: -10001}, 0},
+		{"exact with card account", categoryRuleSubject{Description: " Salary ", Currency: "CHF", CardNumber: "023000826810.40"}, 3},
+		{"exact is not contains", categoryRuleSubject{Description: "salary bonus", Currency: "CHF", AccountNumber: "0230 00826810.40"}, 0},
+		{"amount and parser", categoryRuleSubject{Description: "rent", AmountCents: -150000, ParserName: "ubs_account"}, 4},
+		{"first match wins", categoryRuleSubject{Description: "TWINT sbb", AmountCents: -500}, 5},
+		{"no match", categoryRuleSubject{Description: "unknown"}, 0},
+	}
+	for _, tc := range cases {
+		got := matchCategoryRule(tc.subject, rules)
+		switch {
+		case tc.want == 0 && got != nil:
+			t.Fatalf("%s: expected no match, got %d", tc.name, *got)
+		case tc.want != 0 && (got == nil || *got != tc.want):
+			t.Fatalf("%s: expected category %d, got %v", tc.name, tc.want, got)
+		}
+	}
+}
+
+func TestNormalizeRulesSkipsRulesWithoutConditions(t *testing.T) {
+	rules := normalizeRules([]CategoryRuleEntry{
+		{CategoryID: 1, CategoryRuleConditions: CategoryRuleConditions{DescriptionContains: "  "}},
+		{CategoryID: 2, CategoryRuleConditions: CategoryRuleConditions{DescriptionRegex: "("}},
+	})
+	if len(rules) != 0 {
+		t.Fatalf("expected invalid rules to be skipped, got %d", len(rules))
+	}
+}
+
+func TestNormalizeCategoryRuleConditionsValidates(t *testing.T) {
+	invalid := []CategoryRuleConditions{
+		{},
+		{DescriptionContains: "   "},
+		{DescriptionRegex: "[a-"},
+		{AmountMin: int64Ptr(-1)},
+		{AmountMin: int64Ptr(200), AmountMax: int64Ptr(100)},
+		{EntryType: "refund"},
+		{Currency: "euro"},
+	}
+	for _, conditions := range invalid {
+		if _, _, err := normalizeCategoryRuleConditions(conditions); err == nil {
+			t.Fatalf("expected %+v to be rejected", conditions)
+		}
+	}
+
+	normalized, _, err := normalizeCategoryRuleConditions(CategoryRuleConditions{
+		DescriptionPrefix: "  Migros ",
+		EntryType:         " Debit",
+		Currency:          "eur",
+		AmountMin:         int64Ptr(0),
+	})
+	if err != nil {
+		t.Fatalf("normalize: %v", err)
+	}
+	if normalized.DescriptionPrefix != "Migros" || normalized.EntryType != EntryTypeDebit || normalized.Currency != "EUR" {
+		t.Fatalf("unexpected normalized conditions %+v", normalized)
+	}
+}
+
+func TestTransactionsPreviewCategoryRulesDoesNotWrite(t *testing.T) {
+	db, cleanup := openTestDB(t)
+	defer cleanup()
+
+	createReportTables(t, db)
+	userID := createUser(t, db, "rules-preview@example.com")
+	reportID := insertReport(t, db, userID, "transactions.csv", []byte("data"))
+	insertDummyTransaction(t, db, userID, reportID)
+
+	var groceriesID, everythingID int64
+	ctx := context.Background()
+	if err := db.conn.QueryRow(ctx, `INSERT INTO categories (workspace_id, name) VALUES ($1, 'Groceries') RETURNING id`, userID).Scan(&groceriesID); err != nil {
+		t.Fatalf("insert category: %v", err)
+	}
+	if err := db.conn.QueryRow(ctx, `INSERT INTO categories (workspace_id, name) VALUES ($1, 'Everything') RETURNING id`, userID).Scan(&everythingID); err != nil {
+		t.Fatalf("insert category: %v", err)
+	}
+	var ruleID int64
+	if err := db.conn.QueryRow(ctx, `INSERT INTO category_rules (workspace_id, category_id, description_contains) VALUES ($1, $2, 'dummy') RETURNING id`, userID, groceriesID).Scan(&ruleID); err != nil {
+		t.Fatalf("insert rule: %v", err)
+	}
+
+	service := newTestTransactionsService(t, db)
+	changes, err := service.PreviewCategoryRules(ctx, userID, false, nil)
+	if err != nil {
+		t.Fatalf("preview: %v", err)
+	}
+	if len(changes) != 1 || changes[0].FromCategoryID.Valid || changes[0].ToCategoryID.Int64 != groceriesID || changes[0].Rule.RuleID != ruleID {
+		t.Fatalf("unexpected preview %+v", changes)
+	}
+
+	// A draft only matters where no saved rule matches first.
+	draft, err := compileCategoryRule(CategoryRuleEntry{CategoryID: everythingID, CategoryRuleConditions: CategoryRuleConditions{AmountMin: int64Ptr(0)}})
+	if err != nil {
+		t.Fatalf("compile draft: %v", err)
+	}
+	changes, err = service.PreviewCategoryRules(ctx, userID, false, &draft)
+	if err != nil {
+		t.Fatalf("preview draft: %v", err)
+	}
+	if len(changes) != 1 || changes[0].Rule.RuleID != ruleID {
+		t.Fatalf("expected saved rule to win over draft, got %+v", changes)
+	}
+
+	var categorized int
+	if err := db.conn.QueryRow(ctx, `SELECT count(*) FROM transactions WHERE category_id IS NOT NULL`).Scan(&categorized); err != nil {
+		t.Fatalf("count categorized: %v", err)
+	}
+	if categorized != 0 {
+		t.Fatalf("preview must not write, %d transactions categorized", categorized)
+	}
+
+	updated, err := service.ApplyCategoryRules(ctx, userID, false)
+	if err != nil {
+		t.Fatalf("apply: %v", err)
+	}
+	if updated != 1 {
+		t.Fatalf("expected apply to update the previewed transaction, got %d", updated)
+	}
+	changes, err = service.PreviewCategoryRules(ctx, userID, false, nil)
+	if err != nil {
+		t.Fatalf("preview after apply: %v", err)
+	}
+	if len(changes) != 0 {
+		t.Fatalf("expected no pending changes after apply, got %+v", changes)
+	}
+}
+
+func TestCategoryRuleConditionsKeepsUnsetAmountsNil(t *testing.T) {
+	conditions := categoryRuleConditions(&apiv1.CreateCategoryRuleRequest{DescriptionContains: "coop", AmountMin: int64Ptr(0)})
+	if conditions.AmountMin == nil || *conditions.AmountMin != 0 {
+		t.Fatalf("expected a zero amount_min to be kept, got %v", conditions.AmountMin)
+	}
+	if conditions.AmountMax != nil {
+		t.Fatalf("expected an unset amount_max to stay nil, got %v", *conditions.AmountMax)
+	}
+	if conditions.DescriptionContains != "coop" {
+		t.Fatalf("unexpected conditions %+v", conditions)
+	}
+}
@@ -18,7 +18,14 @@ func main() {
 	if err != nil {
 		panic(err)
 	}
-	go app.Processor.Run(ctx, 10*time.Second)
+	processorDone := make(chan struct{})
+	go func() {
+		defer close(processorDone)
+		app.Processor.Run(ctx, 30*time.Second)
+	}()
+
+	// Watch streams never end on their own, so close them before waiting for connections to drain.
+	app.Server.RegisterOnShutdown(app.Events.Close)
 
 	errCh := make(chan error, 1)
 	go func() {
@@ -38,4 +45,6 @@ func main() {
 	if err := app.Server.Shutdown(shutdownCtx); err != nil {
 		panic(err)
 	}
+	stop()
+	<-processorDone
 }
@@ -12,12 +12,28 @@ import (
 
 type Config struct {
 	ServerConfig `envPrefix:"SERVER_" envDefault:""`
-	Db           DbConfig     `envPrefix:"DB_" envDefault:""`
-	Google       GoogleConfig `envPrefix:"GOOGLE_" envDefault:""`
+	Db           DbConfig              `envPrefix:"DB_" envDefault:""`
+	Google       GoogleConfig          `envPrefix:"GOOGLE_" envDefault:""`
+	Rates        ExchangeRateConfig    `envPrefix:"EXCHANGE_RATES_" envDefault:""`
+	Processor    ReportProcessorConfig `envPrefix:"PROCESSOR_" envDefault:""`
 }
 
 type GoogleConfig struct {
+	ClientID     string `envDefault:"1010772966942-khflv7f816n0bqebf7mll7hb0eu589r0.apps.googleusercontent.com"`
 	ClientSecret string `envDefault:""`
+	JWKSURL      string `env:"JWKS_URL" envDefault:"https://www.googleapis.com/oauth2/v3/certs"`
+}
+
+type ExchangeRateConfig struct {
+	// Comma separated list of providers tried in order: ecb, snb, static, exchangeratehost.
+	Providers           string `envDefault:"exchangeratehost"`
+	StaticFile          string `envDefault:""`
+	ECBURL              string `env:"ECB_URL" envDefault:"https://www.ecb.europa.eu/stats/eurofxref/eurofxref-hist-90d.xml"`
+	ECBHistoryURL       string `env:"ECB_HISTORY_URL" envDefault:"https://www.ecb.europa.eu/stats/eurofxref/eurofxref-hist.xml"`
+	SNBURL              string `env:"SNB_URL" envDefault:"https://data.snb.ch/api/cube/devkum/data/csv/en"`
+	ExchangeRateHostURL string `env:"EXCHANGERATEHOST_URL" envDefault:"https://api.exchangerate.host"`
+	// Currency used to cross rates that no provider quotes directly.
+	PivotCurrency string `envDefault:"EUR"`
 }
 
 func loadOptional(file string) error {
@@ -0,0 +1,282 @@
+package cashtrack
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	apiv1 "cashtrack/backend/gen/api/v1"
+	"cashtrack/backend/gen/api/v1/apiv1connect"
+	dbgen "cashtrack/backend/gen/db"
+	"connectrpc.com/connect"
+	"connectrpc.com/validate"
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	defaultCSVTemplateTestRows = 50
+	maxCSVTemplateTestRows     = 500
+)
+
+type CsvTemplateService struct {
+	db *Db
+}
+
+type CsvTemplateServiceHandler Handler
+
+func NewCsvTemplateServiceHandler(db *Db) *CsvTemplateServiceHandler {
+	service := &CsvTemplateService{db: db}
+	path, handler := apiv1connect.NewCsvTemplateServiceHandler(
+		service,
+		connect.WithInterceptors(validate.NewInterceptor(), NewAuthInterceptor(db)),
+	)
+	return &CsvTemplateServiceHandler{Path: path, Handler: handler}
+}
+
+func (s *CsvTemplateService) ListCsvTemplates(ctx context.Context, req *apiv1.ListCsvTemplatesRequest) (*apiv1.ListCsvTemplatesResponse, error) {
+	workspace, err := requireWorkspace(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.db.Queries.ListCsvTemplatesByWorkspace(ctx, workspace.ID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	templates := make([]*apiv1.CsvTemplate, 0, len(rows))
+	for _, row := range rows {
+		templates = append(templates, csvTemplateToProto(row))
+	}
+	return &apiv1.ListCsvTemplatesResponse{Templates: templates}, nil
+}
+
+func (s *CsvTemplateService) CreateCsvTemplate(ctx context.Context, req *apiv1.CreateCsvTemplateRequest) (*apiv1.CreateCsvTemplateResponse, error) {
+	workspace, err := requireWorkspace(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	template, err := csvTemplateFromRequest(req.Template)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	row, err := s.db.Queries.CreateCsvTemplate(ctx, dbgen.CreateCsvTemplateParams{
+		WorkspaceID:        workspace.ID,
+		Name:               template.Name,
+		Signature:          template.Signature,
+		Delimiter:          template.Delimiter,
+		DateColumn:         template.DateColumn,
+		DateFormat:         template.DateFormat,
+		DecimalSeparator:   template.DecimalSeparator,
+		DescriptionColumns: template.DescriptionColumns,
+		AmountColumn:       template.AmountColumn,
+		DebitColumn:        template.DebitColumn,
+		CreditColumn:       template.CreditColumn,
+		CurrencyColumn:     template.CurrencyColumn,
+		AccountColumn:      template.AccountColumn,
+		DefaultCurrency:    template.DefaultCurrency,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return &apiv1.CreateCsvTemplateResponse{Template: csvTemplateToProto(dbgen.ListCsvTemplatesByWorkspaceRow(row))}, nil
+}
+
+func (s *CsvTemplateService) UpdateCsvTemplate(ctx context.Context, req *apiv1.UpdateCsvTemplateRequest) (*apiv1.UpdateCsvTemplateResponse, error) {
+	workspace, err := requireWorkspace(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Template.GetId() == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("id is required"))
+	}
+	template, err := csvTemplateFromRequest(req.Template)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	affected, err := s.db.Queries.UpdateCsvTemplate(ctx, dbgen.UpdateCsvTemplateParams{
+		Name:               template.Name,
+		Signature:          template.Signature,
+		Delimiter:          template.Delimiter,
+		DateColumn:         template.DateColumn,
+		DateFormat:         template.DateFormat,
+		DecimalSeparator:   template.DecimalSeparator,
+		DescriptionColumns: template.DescriptionColumns,
+		AmountColumn:       template.AmountColumn,
+		DebitColumn:        template.DebitColumn,
+		CreditColumn:       template.CreditColumn,
+		CurrencyColumn:     template.CurrencyColumn,
+		AccountColumn:      template.AccountColumn,
+		DefaultCurrency:    template.DefaultCurrency,
+		ID:                 int64(req.Template.Id),
+		WorkspaceID:        workspace.ID,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if affected == 0 {
+		return nil, connect.NewError(connect.CodeNotFound, errNotFound)
+	}
+	return &apiv1.UpdateCsvTemplateResponse{}, nil
+}
+
+func (s *CsvTemplateService) DeleteCsvTemplate(ctx context.Context, req *apiv1.DeleteCsvTemplateRequest) (*apiv1.DeleteCsvTemplateResponse, error) {
+	workspace, err := requireWorkspace(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Id == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("id is required"))
+	}
+	affected, err := s.db.Queries.DeleteCsvTemplate(ctx, dbgen.DeleteCsvTemplateParams{
+		ID:          int64(req.Id),
+		WorkspaceID: workspace.ID,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if affected == 0 {
+		return nil, connect.NewError(connect.CodeNotFound, errNotFound)
+	}
+	return &apiv1.DeleteCsvTemplateResponse{}, nil
+}
+
+// TestCsvTemplate runs a template, saved or not, against an uploaded report without importing it.
+// Problems that stop parsing altogether, like a missing header row, are reported as row 0.
+func (s *CsvTemplateService) TestCsvTemplate(ctx context.Context, req *apiv1.TestCsvTemplateRequest) (*apiv1.TestCsvTemplateResponse, error) {
+	workspace, err := requireWorkspace(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.ReportId == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("report_id is required"))
+	}
+	template, err := csvTemplateFromRequest(req.Template)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	parser, err := NewGenericCSVParser(template)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultCSVTemplateTestRows
+	}
+	if limit > maxCSVTemplateTestRows {
+		limit = maxCSVTemplateTestRows
+	}
+
+	report, err := s.db.Queries.GetReportByID(ctx, dbgen.GetReportByIDParams{
+		ID:          int64(req.ReportId),
+		WorkspaceID: workspace.ID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeNotFound, errors.New("file not found"))
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	response := &apiv1.TestCsvTemplateResponse{
+		SignatureMatches: parser.CanParse(reportSample(report.Data), report.Filename),
+		Rows:             []*apiv1.CsvTemplateRow{},
+		Errors:           []*apiv1.CsvTemplateRowError{},
+	}
+	transactions, diagnostics, err := parser.parseRows(report.Data)
+	if err != nil {
+		response.Errors = append(response.Errors, &apiv1.CsvTemplateRowError{Message: err.Error()})
+		return response, nil
+	}
+	response.TotalRows = int32(len(transactions) + len(diagnostics))
+	for _, transaction := range transactions {
+		if len(response.Rows) >= limit {
+			break
+		}
+		row, err := csvTemplateRowToProto(transaction)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		response.Rows = append(response.Rows, row)
+	}
+	for _, diagnostic := range diagnostics {
+		if len(response.Errors) >= limit {
+			break
+		}
+		response.Errors = append(response.Errors, &apiv1.CsvTemplateRowError{
+			Row:     int32(diagnostic.Row),
+			Message: diagnostic.Reason,
+		})
+	}
+	return response, nil
+}
+
+func csvTemplateFromRequest(template *apiv1.CsvTemplate) (CSVTemplate, error) {
+	if template == nil {
+		return CSVTemplate{}, errors.New("template is required")
+	}
+	return normalizeCSVTemplate(CSVTemplate{
+		ID:                 int64(template.Id),
+		Name:               template.Name,
+		Signature:          template.Signature,
+		Delimiter:          template.Delimiter,
+		DateColumn:         template.DateColumn,
+		DateFormat:         template.DateFormat,
+		DecimalSeparator:   template.DecimalSeparator,
+		DescriptionColumns: template.DescriptionColumns,
+		AmountColumn:       template.AmountColumn,
+		DebitColumn:        template.DebitColumn,
+		CreditColumn:       template.CreditColumn,
+		CurrencyColumn:     template.CurrencyColumn,
+		AccountColumn:      template.AccountColumn,
+		DefaultCurrency:    template.DefaultCurrency,
+	})
+}
+
+func csvTemplateToProto(row dbgen.ListCsvTemplatesByWorkspaceRow) *apiv1.CsvTemplate {
+	createdAt := ""
+	if row.CreatedAt.Valid {
+		createdAt = row.CreatedAt.Time.Format(time.RFC3339Nano)
+	}
+	return &apiv1.CsvTemplate{
+		Id:                 int32(row.ID),
+		Name:               row.Name,
+		Signature:          row.Signature,
+		Delimiter:          row.Delimiter,
+		DateColumn:         row.DateColumn,
+		DateFormat:         row.DateFormat,
+		DecimalSeparator:   row.DecimalSeparator,
+		DescriptionColumns: row.DescriptionColumns,
+		AmountColumn:       row.AmountColumn,
+		DebitColumn:        row.DebitColumn,
+		CreditColumn:       row.CreditColumn,
+		CurrencyColumn:     row.CurrencyColumn,
+		AccountColumn:      row.AccountColumn,
+		DefaultCurrency:    row.DefaultCurrency,
+		CreatedAt:          createdAt,
+	}
+}
+
+func csvTemplateRowToProto(transaction ParsedTransaction) (*apiv1.CsvTemplateRow, error) {
+	amount, err := numericFromString(transaction.Amount)
+	if err != nil {
+		return nil, err
+	}
+	cents, err := numericToCents(amount)
+	if err != nil {
+		return nil, err
+	}
+	return &apiv1.CsvTemplateRow{
+		Row:           int32(transaction.SourceFileRow),
+		PostedDate:    transaction.PostedDate.Format("2006-01-02"),
+		Description:   transaction.Description,
+		Amount:        cents,
+		Currency:      transaction.Currency,
+		EntryType:     transaction.EntryType,
+		AccountNumber: transaction.SourceAccountNumber,
+		TransactionId: transaction.TransactionID,
+	}, nil
+}
@@ -0,0 +1,420 @@
+package cashtrack
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/csv"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	dbgen "cashtrack/backend/gen/db"
+)
+
+// CSVTemplate maps the columns of a bank's CSV export, so new banks can be imported without a
+// dedicated parser. Columns are referenced by their header; the header row is the first row that
+// contains all mapped columns, so preambles before it are skipped.
+type CSVTemplate struct {
+	ID   int64
+	Name string
+	// Text that must appear in the first lines of a report for the template to be used.
+	Signature  string
+	Delimiter  string
+	DateColumn string
+	// Layout with YYYY, YY, MM and DD placeholders, e.g. DD.MM.YYYY.
+	DateFormat         string
+	DecimalSeparator   string
+	DescriptionColumns []string
+	// Either a signed amount column, or separate debit and credit columns.
+	AmountColumn    string
+	DebitColumn     string
+	CreditColumn    string
+	CurrencyColumn  string
+	AccountColumn   string
+	DefaultCurrency string
+}
+
+// normalizeCSVTemplate cleans up a template entered by a user and rejects invalid ones.
+func normalizeCSVTemplate(template CSVTemplate) (CSVTemplate, error) {
+	template.Name = strings.TrimSpace(template.Name)
+	template.Signature = strings.TrimSpace(template.Signature)
+	template.DateColumn = strings.TrimSpace(template.DateColumn)
+	template.DateFormat = strings.TrimSpace(template.DateFormat)
+	template.DecimalSeparator = strings.TrimSpace(template.DecimalSeparator)
+	template.AmountColumn = strings.TrimSpace(template.AmountColumn)
+	template.DebitColumn = strings.TrimSpace(template.DebitColumn)
+	template.CreditColumn = strings.TrimSpace(template.CreditColumn)
+	template.CurrencyColumn = strings.TrimSpace(template.CurrencyColumn)
+	template.AccountColumn = strings.TrimSpace(template.AccountColumn)
+	template.DefaultCurrency = normalizeCurrency(template.DefaultCurrency)
+
+	descriptions := make([]string, 0, len(template.DescriptionColumns))
+	for _, column := range template.DescriptionColumns {
+		if column = strings.TrimSpace(column); column != "" {
+			descriptions = append(descriptions, column)
+		}
+	}
+	template.DescriptionColumns = descriptions
+
+	switch strings.ToLower(template.Delimiter) {
+	case "":
+		template.Delimiter = ","
+	case "\\t", "tab":
+		template.Delimiter = "\t"
+	}
+	if template.DecimalSeparator == "" {
+		template.DecimalSeparator = "."
+	}
+
+	if template.Name == "" {
+		return template, errors.New("name is required")
+	}
+	if template.Signature == "" {
+		return template, errors.New("signature is required")
+	}
+	if delimiter, size := utf8.DecodeRuneInString(template.Delimiter); size != len(template.Delimiter) || delimiter == '"' || delimiter == '\n' || delimiter == '\r' {
+		return template, errors.New("delimiter must be a single character")
+	}
+	if template.DecimalSeparator != "." && template.DecimalSeparator != "," {
+		return template, errors.New("decimal_separator must be . or ,")
+	}
+	if template.DateColumn == "" {
+		return template, errors.New("date_column is required")
+	}
+	if _, err := csvDateLayout(template.DateFormat); err != nil {
+		return template, err
+	}
+	if len(template.DescriptionColumns) == 0 {
+		return template, errors.New("at least one description column is required")
+	}
+	hasDebitCredit := template.DebitColumn != "" || template.CreditColumn != ""
+	if template.AmountColumn == "" && !hasDebitCredit {
+		return template, errors.New("amount_column or debit_column and credit_column are required")
+	}
+	if template.AmountColumn != "" && hasDebitCredit {
+		return template, errors.New("use either amount_column or debit_column and credit_column")
+	}
+	if template.DefaultCurrency != "" && !isCurrencyCode(template.DefaultCurrency) {
+		return template, errors.New("default_currency must be a 3-letter ISO code")
+	}
+	return template, nil
+}
+
+func (t CSVTemplate) columns() []string {
+	columns := []string{t.DateColumn}
+	columns = append(columns, t.DescriptionColumns...)
+	for _, column := range []string{t.AmountColumn, t.DebitColumn, t.CreditColumn, t.CurrencyColumn, t.AccountColumn} {
+		if column != "" {
+			columns = append(columns, column)
+		}
+	}
+	return columns
+}
+
+func csvTemplateFromRow(row dbgen.ListCsvTemplatesByWorkspaceRow) CSVTemplate {
+	return CSVTemplate{
+		ID:                 row.ID,
+		Name:               row.Name,
+		Signature:          row.Signature,
+		Delimiter:          row.Delimiter,
+		DateColumn:         row.DateColumn,
+		DateFormat:         row.DateFormat,
+		DecimalSeparator:   row.DecimalSeparator,
+		DescriptionColumns: row.DescriptionColumns,
+		AmountColumn:       row.AmountColumn,
+		DebitColumn:        row.DebitColumn,
+		CreditColumn:       row.CreditColumn,
+		CurrencyColumn:     row.CurrencyColumn,
+		AccountColumn:      row.AccountColumn,
+		DefaultCurrency:    row.DefaultCurrency,
+	}
+}
+
+// loadCSVTemplateParsers builds parsers for the workspace's templates. Templates that no longer pass
+// validation are skipped rather than failing every upload.
+func loadCSVTemplateParsers(ctx context.Context, queries *dbgen.Queries, workspaceID int32) ([]ReportParser, error) {
+	rows, err := queries.ListCsvTemplatesByWorkspace(ctx, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	parsers := make([]ReportParser, 0, len(rows))
+	for _, row := range rows {
+		parser, err := NewGenericCSVParser(csvTemplateFromRow(row))
+		if err != nil {
+			log.Warn().Err(err).Int64("template_id", row.ID).Msg("skipping invalid csv template")
+			continue
+		}
+		parsers = append(parsers, parser)
+	}
+	return parsers, nil
+}
+
+// csvDateLayout turns a YYYY/YY/MM/DD pattern into a Go time layout.
+func csvDateLayout(format string) (string, error) {
+	layout := strings.ToUpper(strings.TrimSpace(format))
+	if layout == "" {
+		return "", errors.New("date_format is required")
+	}
+	if !strings.Contains(layout, "YY") || !strings.Contains(layout, "MM") || !strings.Contains(layout, "DD") {
+		return "", errors.New("date_format must contain YYYY or YY, MM and DD")
+	}
+	layout = strings.NewReplacer("YYYY", "2006", "YY", "06", "MM", "01", "DD", "02").Replace(layout)
+	return layout, nil
+}
+
+// GenericCSVParser parses reports with a user's CSVTemplate.
+type GenericCSVParser struct {
+	template CSVTemplate
+	layout   string
+}
+
+func NewGenericCSVParser(template CSVTemplate) (*GenericCSVParser, error) {
+	template, err := normalizeCSVTemplate(template)
+	if err != nil {
+		return nil, err
+	}
+	layout, err := csvDateLayout(template.DateFormat)
+	if err != nil {
+		return nil, err
+	}
+	return &GenericCSVParser{template: template, layout: layout}, nil
+}
+
+func (p *GenericCSVParser) Name() string {
+	if p.template.ID == 0 {
+		return "csv_template"
+	}
+	return fmt.Sprintf("csv_template_%d", p.template.ID)
+}
+
+func (p *GenericCSVParser) Version() int {
+	return 1
+}
+
+func (p *GenericCSVParser) CanParse(sample string, filename string) bool {
+	return strings.Contains(strings.ToLower(sample), strings.ToLower(p.template.Signature))
+}
+
+func (p *GenericCSVParser) Parse(data []byte) (ParsedReport, error) {
+	transactions, diagnostics, err := p.parseRows(data)
+	if err != nil {
+		return ParsedReport{}, err
+	}
+	return ParsedReport{ParserName: p.Name(), Transactions: transactions, Diagnostics: diagnostics}, nil
+}
+
+// parseRows returns every row that parses, and an error diagnostic for each one that doesn't. Rows without
+// a date are skipped, since exports often end with balance or total lines.
+func (p *GenericCSVParser) parseRows(data []byte) ([]ParsedTransaction, []ParseDiagnostic, error) {
+	reader := csv.NewReader(strings.NewReader(stripBOM(string(data))))
+	reader.Comma, _ = utf8.DecodeRuneInString(p.template.Delimiter)
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+
+	var headers map[string]int
+	for headers == nil {
+		record, err := reader.Read()
+		if err != nil {
+			if err == io.EOF {
+				return nil, nil, fmt.Errorf("no header row with columns %s found", strings.Join(p.template.columns(), ", "))
+			}
+			return nil, nil, fmt.Errorf("read csv: %w", err)
+		}
+		index := headerIndex(record)
+		found := true
+		for _, column := range p.template.columns() {
+			if _, ok := index[column]; !ok {
+				found = false
+				break
+			}
+		}
+		if found {
+			headers = index
+		}
+	}
+
+	rowNumber := 0
+	occurrences := make(map[string]int)
+	transactions := make([]ParsedTransaction, 0)
+	diagnostics := make([]ParseDiagnostic, 0)
+	for {
+		record, err := reader.Read()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, nil, fmt.Errorf("read csv: %w", err)
+		}
+		if len(record) == 0 || (len(record) == 1 && strings.TrimSpace(record[0]) == "") {
+			continue
+		}
+
+		rowNumber++
+		transaction, err := p.parseRecord(headers, record, occurrences)
+		if err != nil {
+			diagnostics = append(diagnostics, rowError(rowNumber, strings.Join(record, p.template.Delimiter), "%s", err))
+			continue
+		}
+		if transaction == nil {
+			continue
+		}
+		transaction.SourceFileRow = rowNumber
+		transactions = append(transactions, *transaction)
+	}
+	return transactions, diagnostics, nil
+}
+
+// parseRecord counts identical rows in occurrences, so that two equal purchases on the same day get
+// distinct transaction ids.
+func (p *GenericCSVParser) parseRecord(headers map[string]int, record []string, occurrences map[string]int) (*ParsedTransaction, error) {
+	dateRaw := fieldByHeader(headers, record, p.template.DateColumn)
+	if dateRaw == "" {
+		return nil, nil
+	}
+	postedDate, err := time.Parse(p.layout, dateRaw)
+	if err != nil {
+		return nil, fmt.Errorf("date %q does not match %s", dateRaw, p.template.DateFormat)
+	}
+
+	var entryType, amount string
+	if p.template.AmountColumn != "" {
+		amountRaw := fieldByHeader(headers, record, p.template.AmountColumn)
+		value, err := parseTemplateAmount(amountRaw, p.template.DecimalSeparator)
+		if err != nil {
+			return nil, err
+		}
+		entryType = EntryTypeCredit
+		if strings.HasPrefix(value, "-") {
+			entryType = EntryTypeDebit
+		}
+		amount = value
+	} else {
+		debit, err := parseTemplateAmount(fieldByHeader(headers, record, p.template.DebitColumn), p.template.DecimalSeparator)
+		if err != nil && !errors.Is(err, errEmptyAmount) {
+			return nil, fmt.Errorf("debit: %w", err)
+		}
+		credit, err := parseTemplateAmount(fieldByHeader(headers, record, p.template.CreditColumn), p.template.DecimalSeparator)
+		if err != nil && !errors.Is(err, errEmptyAmount) {
+			return nil, fmt.Errorf("credit: %w", err)
+		}
+		// Some banks fill the unused column with zero.
+		if isZeroAmount(debit) && credit != "" {
+			debit = ""
+		}
+		entryType, amount = resolveEntryType(debit, credit)
+		if entryType == "" {
+			return nil, errEmptyAmount
+		}
+	}
+	amount, err = normalizeAmount(amount, entryType)
+	if err != nil {
+		return nil, err
+	}
+
+	description := joinNonEmpty(p.descriptionParts(headers, record)...)
+	accountNumber := ""
+	if p.template.AccountColumn != "" {
+		accountNumber = fieldByHeader(headers, record, p.template.AccountColumn)
+	}
+	currency := p.template.DefaultCurrency
+	if p.template.CurrencyColumn != "" {
+		if value := normalizeCurrency(fieldByHeader(headers, record, p.template.CurrencyColumn)); value != "" {
+			currency = value
+		}
+	}
+	if currency == "" {
+		currency = defaultCurrency
+	}
+	if !isCurrencyCode(currency) {
+		return nil, fmt.Errorf("currency %q is not a 3-letter ISO code", currency)
+	}
+	key := strings.Join([]string{accountNumber, dateRaw, description, amount}, "|")
+	occurrence := occurrences[key]
+	occurrences[key]++
+
+	return &ParsedTransaction{
+		PostedDate:          postedDate,
+		Description:         description,
+		Amount:              amount,
+		Currency:            currency,
+		TransactionID:       buildCSVTemplateTransactionID(accountNumber, dateRaw, description, amount, occurrence),
+		EntryType:           entryType,
+		SourceAccountNumber: accountNumber,
+		ParserName:          p.Name(),
+	}, nil
+}
+
+func (p *GenericCSVParser) descriptionParts(headers map[string]int, record []string) []string {
+	parts := make([]string, 0, len(p.template.DescriptionColumns))
+	for _, column := range p.template.DescriptionColumns {
+		parts = append(parts, fieldByHeader(headers, record, column))
+	}
+	return parts
+}
+
+var errEmptyAmount = errors.New("amount is empty")
+
+// parseTemplateAmount accepts thousands separators (spaces, apostrophes and the other separator),
+// trailing minus signs and accounting-style parentheses, and returns a plain signed decimal.
+func parseTemplateAmount(raw string, decimalSeparator string) (string, error) {
+	value := strings.TrimSpace(raw)
+	if value == "" {
+		return "", errEmptyAmount
+	}
+	negative := false
+	if strings.HasPrefix(value, "(") && strings.HasSuffix(value, ")") {
+		negative = true
+		value = strings.TrimSuffix(strings.TrimPrefix(value, "("), ")")
+	}
+	if strings.HasSuffix(value, "-") {
+		negative = true
+		value = strings.TrimSuffix(value, "-")
+	}
+	value = strings.NewReplacer(" ", "", "\u00a0", "", "'", "", "\u2019", "", "+", "").Replace(value)
+	if strings.HasPrefix(value, "-") {
+		negative = !negative
+		value = strings.TrimPrefix(value, "-")
+	}
+	if decimalSeparator == "," {
+		value = strings.ReplaceAll(value, ".", "")
+		value = strings.ReplaceAll(value, ",", ".")
+	} else {
+		value = strings.ReplaceAll(value, ",", "")
+	}
+	if _, err := strconv.ParseFloat(value, 64); err != nil || value == "" {
+		return "", fmt.Errorf("amount %q is not a number", raw)
+	}
+	if negative {
+		value = "-" + value
+	}
+	return value, nil
+}
+
+func isZeroAmount(value string) bool {
+	if value == "" {
+		return false
+	}
+	parsed, err := strconv.ParseFloat(value, 64)
+	return err == nil && parsed == 0
+}
+
+// buildCSVTemplateTransactionID hashes the row's values and how many identical rows came before it. The
+// template is left out, so rows keep their ids when a template is deleted and created again.
+func buildCSVTemplateTransactionID(accountNumber string, date string, description string, amount string, occurrence int) string {
+	hash := sha1.New()
+	hash.Write([]byte(accountNumber))
+	hash.Write([]byte("|"))
+	hash.Write([]byte(date))
+	hash.Write([]byte("|"))
+	hash.Write([]byte(description))
+	hash.Write([]byte("|"))
+	hash.Write([]byte(amount))
+	hash.Write([]byte("|"))
+	hash.Write([]byte(strconv.Itoa(occurrence)))
+	return "csv-" + hex.EncodeToString(hash.Sum(nil))
+}
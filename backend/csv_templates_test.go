@@ -0,0 +1,252 @@
+package cashtrack
+
+import (
+	"context"
+	"testing"
+
+	dbgen "cashtrack/backend/gen/db"
+)
+
+const templateTestReport = `Kontoauszug Example Bank
+Konto;CH12 3456 7890
+
+Datum;Buchungstext;Zusatz;Belastung;Gutschrift;Währung
+05.01.2026;"Migros; Zürich";Karte 1234;1'234,50;;CHF
+06.01.2026;Lohn;;0,00;5000,00;chf
+07.01.2026;Kaputt;;abc;;CHF
+;Saldo;;;;
+`
+
+func testCSVTemplate() CSVTemplate {
+	return CSVTemplate{
+		Name:               "Example Bank",
+		Signature:          "kontoauszug example",
+		Delimiter:          ";",
+		DateColumn:         "Datum",
+		DateFormat:         "dd.mm.yyyy",
+		DecimalSeparator:   ",",
+		DescriptionColumns: []string{"Buchungstext", " Zusatz "},
+		DebitColumn:        "Belastung",
+		CreditColumn:       "Gutschrift",
+		CurrencyColumn:     "Währung",
+	}
+}
+
+func TestGenericCSVParserParsesRowsAndCollectsErrors(t *testing.T) {
+	parser, err := NewGenericCSVParser(testCSVTemplate())
+	if err != nil {
+		t.Fatalf("new parser: %v", err)
+	}
+	data := []byte(templateTestReport)
+	if !parser.CanParse(reportSample(data), "export.csv") {
+		t.Fatalf("expected signature to match")
+	}
+
+	transactions, diagnostics, err := parser.parseRows(data)
+	if err != nil {
+		t.Fatalf("parse rows: %v", err)
+	}
+	if len(transactions) != 2 {
+		t.Fatalf("expected 2 transactions, got %+v", transactions)
+	}
+	first := transactions[0]
+	if first.Amount != "-1234.50" || first.EntryType != EntryTypeDebit || first.Currency != "CHF" {
+		t.Fatalf("unexpected first transaction %+v", first)
+	}
+	if first.Description != "Migros; Zürich; Karte 1234" || first.SourceFileRow != 1 {
+		t.Fatalf("unexpected first transaction %+v", first)
+	}
+	if second := transactions[1]; second.Amount != "5000.00" || second.EntryType != EntryTypeCredit {
+		t.Fatalf("expected zero debit to be ignored, got %+v", second)
+	}
+	if len(diagnostics) != 1 || diagnostics[0].Row != 3 || diagnostics[0].Severity != DiagnosticSeverityError {
+		t.Fatalf("expected an error for row 3, got %+v", diagnostics)
+	}
+
+	report, err := parser.Parse(data)
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if len(report.Transactions) != 2 || len(report.Diagnostics) != 1 || report.Diagnostics[0].Record == "" {
+		t.Fatalf("expected valid rows and a diagnostic with the raw record, got %+v", report)
+	}
+}
+
+func TestGenericCSVParserDistinguishesIdenticalRows(t *testing.T) {
+	parser, err := NewGenericCSVParser(testCSVTemplate())
+	if err != nil {
+		t.Fatalf("new parser: %v", err)
+	}
+	data := []byte(`Datum;Buchungstext;Zusatz;Belastung;Gutschrift;Währung
+05.01.2026;Kaffee;;4,50;;CHF
+05.01.2026;Kaffee;;4,50;;CHF
+06.01.2026;Kaffee;;4,50;;CHF
+`)
+	transactions, _, err := parser.parseRows(data)
+	if err != nil {
+		t.Fatalf("parse rows: %v", err)
+	}
+	if len(transactions) != 3 {
+		t.Fatalf("expected 3 transactions, got %+v", transactions)
+	}
+	if transactions[0].TransactionID == transactions[1].TransactionID {
+		t.Fatalf("expected identical rows to get distinct ids, got %q twice", transactions[0].TransactionID)
+	}
+	again, _, err := parser.parseRows(data)
+	if err != nil {
+		t.Fatalf("parse rows: %v", err)
+	}
+	for i := range transactions {
+		if again[i].TransactionID != transactions[i].TransactionID {
+			t.Fatalf("expected ids to be stable across imports, row %d got %q and %q", i+1, transactions[i].TransactionID, again[i].TransactionID)
+		}
+	}
+
+	recreated := testCSVTemplate()
+	recreated.ID = 42
+	parser, err = NewGenericCSVParser(recreated)
+	if err != nil {
+		t.Fatalf("new parser: %v", err)
+	}
+	again, _, err = parser.parseRows(data)
+	if err != nil {
+		t.Fatalf("parse rows: %v", err)
+	}
+	if again[0].TransactionID != transactions[0].TransactionID {
+		t.Fatalf("expected ids to survive recreating the template, got %q and %q", transactions[0].TransactionID, again[0].TransactionID)
+	}
+}
+
+func TestGenericCSVParserRequiresHeaderRow(t *testing.T) {
+	template := testCSVTemplate()
+	template.DebitColumn = ""
+	template.CreditColumn = ""
+	template.AmountColumn = "Betrag"
+	parser, err := NewGenericCSVParser(template)
+	if err != nil {
+		t.Fatalf("new parser: %v", err)
+	}
+	if _, _, err := parser.parseRows([]byte(templateTestReport)); err == nil {
+		t.Fatalf("expected missing amount column to fail")
+	}
+}
+
+func TestNormalizeCSVTemplateValidates(t *testing.T) {
+	invalid := []func(*CSVTemplate){
+		func(t *CSVTemplate) { t.Name = " " },
+		func(t *CSVTemplate) { t.Signature = "" },
+		func(t *CSVTemplate) { t.Delimiter = ";;" },
+		func(t *CSVTemplate) { t.DecimalSeparator = "'" },
+		func(t *CSVTemplate) { t.DateFormat = "DD.MM" },
+		func(t *CSVTemplate) { t.DescriptionColumns = []string{" "} },
+		func(t *CSVTemplate) { t.DebitColumn, t.CreditColumn = "", "" },
+		func(t *CSVTemplate) { t.AmountColumn = "Betrag" },
+		func(t *CSVTemplate) { t.DefaultCurrency = "franc" },
+	}
+	for i, mutate := range invalid {
+		template := testCSVTemplate()
+		mutate(&template)
+		if _, err := normalizeCSVTemplate(template); err == nil {
+			t.Fatalf("expected case %d to be rejected", i)
+		}
+	}
+
+	template := testCSVTemplate()
+	template.Delimiter = "tab"
+	normalized, err := normalizeCSVTemplate(template)
+	if err != nil {
+		t.Fatalf("normalize: %v", err)
+	}
+	if normalized.Delimiter != "\t" || len(normalized.DescriptionColumns) != 2 || normalized.DescriptionColumns[1] != "Zusatz" {
+		t.Fatalf("unexpected normalized template %+v", normalized)
+	}
+}
+
+func TestParseTemplateAmount(t *testing.T) {
+	cases := []struct {
+		raw       string
+		separator string
+		want      string
+	}{
+		{"1,234.50", ".", "1234.50"},
+		{"1.234,50", ",", "1234.50"},
+		{"-12.00", ".", "-12.00"},
+		{"12.00-", ".", "-12.00"},
+		{"(7.25)", ".", "-7.25"},
+		{"+3'000.10", ".", "3000.10"},
+	}
+	for _, tc := range cases {
+		got, err := parseTemplateAmount(tc.raw, tc.separator)
+		if err != nil || got != tc.want {
+			t.Fatalf("%q: expected %q, got %q (%v)", tc.raw, tc.want, got, err)
+		}
+	}
+	if _, err := parseTemplateAmount("12 EUR", "."); err == nil {
+		t.Fatalf("expected non-numeric amount to be rejected")
+	}
+}
+
+func TestReportParsingServicePrefersUserParsers(t *testing.T) {
+	template := testCSVTemplate()
+	template.ID = 7
+	template.Signature = "Account number:"
+	template.DateColumn = "Booking date"
+	template.DateFormat = "YYYY-MM-DD"
+	template.DecimalSeparator = "."
+	template.DescriptionColumns = []string{"Description1"}
+	template.DebitColumn = "Debit"
+	template.CreditColumn = "Credit"
+	template.CurrencyColumn = "Currency"
+	parser, err := NewGenericCSVParser(template)
+	if err != nil {
+		t.Fatalf("new parser: %v", err)
+	}
+
+	report, err := NewReportParsingService().ParseWith(mustReadTestFile(t, "ubs_account_transactions.csv"), "transactions.csv", []ReportParser{parser})
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if report.ParserName != "csv_template_7" {
+		t.Fatalf("expected the template to win over the built-in parser, got %q", report.ParserName)
+	}
+	if len(report.Transactions) != 22 {
+		t.Fatalf("expected 22 transactions, got %d", len(report.Transactions))
+	}
+}
+
+func TestReportProcessorUsesUserCSVTemplates(t *testing.T) {
+	db, cleanup := openTestDB(t)
+	defer cleanup()
+
+	createReportTables(t, db)
+	userID := createUser(t, db, "csv-templates@example.com")
+	template, err := normalizeCSVTemplate(testCSVTemplate())
+	if err != nil {
+		t.Fatalf("normalize template: %v", err)
+	}
+	_, err = db.Queries.CreateCsvTemplate(context.Background(), dbgen.CreateCsvTemplateParams{
+		WorkspaceID:        userID,
+		Name:               template.Name,
+		Signature:          template.Signature,
+		Delimiter:          template.Delimiter,
+		DateColumn:         template.DateColumn,
+		DateFormat:         template.DateFormat,
+		DecimalSeparator:   template.DecimalSeparator,
+		DescriptionColumns: template.DescriptionColumns,
+		DebitColumn:        template.DebitColumn,
+		CreditColumn:       template.CreditColumn,
+		CurrencyColumn:     template.CurrencyColumn,
+	})
+	if err != nil {
+		t.Fatalf("create template: %v", err)
+	}
+	valid := "Kontoauszug Example Bank\nDatum;Buchungstext;Zusatz;Belastung;Gutschrift;Währung\n05.01.2026;Migros;;12,50;;CHF\n"
+	reportID := insertReport(t, db, userID, "export.csv", []byte(valid))
+
+	processor := newTestReportProcessor(t, db, "worker-1")
+	if err := processor.ProcessPendingReports(context.Background()); err != nil {
+		t.Fatalf("process reports: %v", err)
+	}
+	assertReportStatus(t, db, reportID, userID, ReportStatusProcessed)
+	assertTransactionCount(t, db, reportID, 1)
+}
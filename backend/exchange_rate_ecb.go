@@ -0,0 +1,121 @@
+package cashtrack
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const ecbRatesTTL = 6 * time.Hour
+
+// ECBRateProvider reads the euro foreign exchange reference rates published by the European Central Bank.
+// The recent feed is used by default and the full history is only downloaded for older dates.
+type ECBRateProvider struct {
+	url        string
+	historyURL string
+	httpClient *http.Client
+	now        func() time.Time
+
+	lock    sync.Mutex
+	recent  *cachedCurrencyTable
+	history *cachedCurrencyTable
+}
+
+type cachedCurrencyTable struct {
+	table     *currencyTable
+	fetchedAt time.Time
+}
+
+func NewECBRateProvider(url string, historyURL string, httpClient *http.Client) *ECBRateProvider {
+	return &ECBRateProvider{
+		url:        url,
+		historyURL: historyURL,
+		httpClient: httpClient,
+		now:        time.Now,
+	}
+}
+
+func (p *ECBRateProvider) Name() string {
+	return "ecb"
+}
+
+func (p *ECBRateProvider) Rate(ctx context.Context, baseCurrency string, targetCurrency string, date time.Time) (float64, error) {
+	dateKey := date.Format("2006-01-02")
+	table, err := p.table(ctx, &p.recent, p.url)
+	if err != nil {
+		return 0, err
+	}
+	if dateKey < table.oldest() && p.historyURL != "" {
+		table, err = p.table(ctx, &p.history, p.historyURL)
+		if err != nil {
+			return 0, err
+		}
+	}
+	return table.rate(baseCurrency, targetCurrency, dateKey)
+}
+
+func (p *ECBRateProvider) table(ctx context.Context, cached **cachedCurrencyTable, url string) (*currencyTable, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	now := p.now()
+	if *cached != nil && now.Sub((*cached).fetchedAt) < ecbRatesTTL {
+		return (*cached).table, nil
+	}
+	resp, err := fetchURL(ctx, p.httpClient, url)
+	if err != nil {
+		if *cached != nil {
+			log.Warn().Err(err).Str("url", url).Msg("failed to refresh ecb rates, using cached rates")
+			return (*cached).table, nil
+		}
+		return nil, err
+	}
+	defer resp.Body.Close()
+	table, err := parseECBRates(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	*cached = &cachedCurrencyTable{table: table, fetchedAt: now}
+	return table, nil
+}
+
+type ecbEnvelope struct {
+	Cube struct {
+		Days []struct {
+			Time  string `xml:"time,attr"`
+			Rates []struct {
+				Currency string  `xml:"currency,attr"`
+				Rate     float64 `xml:"rate,attr"`
+			} `xml:"Cube"`
+		} `xml:"Cube"`
+	} `xml:"Cube"`
+}
+
+func parseECBRates(r io.Reader) (*currencyTable, error) {
+	var envelope ecbEnvelope
+	if err := xml.NewDecoder(r).Decode(&envelope); err != nil {
+		return nil, fmt.Errorf("decode ecb rates: %w", err)
+	}
+	table := newCurrencyTable("EUR")
+	for _, day := range envelope.Cube.Days {
+		if _, err := time.Parse("2006-01-02", day.Time); err != nil {
+			return nil, fmt.Errorf("invalid ecb date %q", day.Time)
+		}
+		for _, rate := range day.Rates {
+			if rate.Rate <= 0 {
+				continue
+			}
+			// ECB quotes how many units of the currency one euro buys.
+			table.set(day.Time, rate.Currency, 1/rate.Rate)
+		}
+	}
+	if len(table.dates) == 0 {
+		return nil, fmt.Errorf("ecb feed contains no rates")
+	}
+	table.finish()
+	return table, nil
+}
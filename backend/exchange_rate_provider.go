@@ -0,0 +1,200 @@
+package cashtrack
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+)
+
+var errRateNotFound = errors.New("exchange rate not found")
+
+type ExchangeRateProvider interface {
+	Name() string
+	Rate(ctx context.Context, baseCurrency string, targetCurrency string, date time.Time) (float64, error)
+}
+
+func NewExchangeRateProvider(config ExchangeRateConfig) (ExchangeRateProvider, error) {
+	httpClient := &http.Client{Timeout: 10 * time.Second}
+	var providers []ExchangeRateProvider
+	for _, name := range strings.Split(config.Providers, ",") {
+		switch strings.ToLower(strings.TrimSpace(name)) {
+		case "":
+			continue
+		case "ecb":
+			providers = append(providers, NewECBRateProvider(config.ECBURL, config.ECBHistoryURL, httpClient))
+		case "snb":
+			providers = append(providers, NewSNBRateProvider(config.SNBURL, httpClient))
+		case "static":
+			if config.StaticFile == "" {
+				return nil, errors.New("static exchange rate provider requires a rates file")
+			}
+			provider, err := LoadStaticRateProvider(config.StaticFile)
+			if err != nil {
+				return nil, err
+			}
+			providers = append(providers, provider)
+		case "exchangeratehost":
+			providers = append(providers, NewExchangeRateHostProvider(config.ExchangeRateHostURL, httpClient))
+		default:
+			return nil, fmt.Errorf("unknown exchange rate provider %q", name)
+		}
+	}
+	if len(providers) == 0 {
+		return nil, errors.New("no exchange rate providers configured")
+	}
+	if len(providers) == 1 {
+		return providers[0], nil
+	}
+	return NewChainRateProvider(providers...), nil
+}
+
+type ChainRateProvider struct {
+	providers []ExchangeRateProvider
+}
+
+func NewChainRateProvider(providers ...ExchangeRateProvider) *ChainRateProvider {
+	return &ChainRateProvider{providers: providers}
+}
+
+func (p *ChainRateProvider) Name() string {
+	names := make([]string, 0, len(p.providers))
+	for _, provider := range p.providers {
+		names = append(names, provider.Name())
+	}
+	return "chain(" + strings.Join(names, ",") + ")"
+}
+
+func (p *ChainRateProvider) Rate(ctx context.Context, baseCurrency string, targetCurrency string, date time.Time) (float64, error) {
+	var errs []error
+	for _, provider := range p.providers {
+		rate, err := provider.Rate(ctx, baseCurrency, targetCurrency, date)
+		if err == nil {
+			return rate, nil
+		}
+		if ctx.Err() != nil {
+			return 0, ctx.Err()
+		}
+		log.Debug().Err(err).Str("provider", provider.Name()).Str("base", baseCurrency).Str("target", targetCurrency).Msg("exchange rate provider failed, trying next")
+		errs = append(errs, fmt.Errorf("%s: %w", provider.Name(), err))
+	}
+	return 0, errors.Join(errs...)
+}
+
+// currencyTable holds rates of many currencies against one reference currency,
+// keyed by publication date. ECB and SNB both publish data in this shape.
+type currencyTable struct {
+	reference string
+	dates     []string
+	rates     map[string]map[string]float64
+}
+
+func newCurrencyTable(reference string) *currencyTable {
+	return &currencyTable{reference: reference, rates: make(map[string]map[string]float64)}
+}
+
+// set records how many units of the reference currency one unit of currency is worth.
+func (t *currencyTable) set(dateKey string, currency string, value float64) {
+	day, ok := t.rates[dateKey]
+	if !ok {
+		day = make(map[string]float64)
+		t.rates[dateKey] = day
+		t.dates = append(t.dates, dateKey)
+	}
+	day[strings.ToUpper(currency)] = value
+}
+
+func (t *currencyTable) finish() {
+	sort.Strings(t.dates)
+}
+
+func (t *currencyTable) oldest() string {
+	if len(t.dates) == 0 {
+		return ""
+	}
+	return t.dates[0]
+}
+
+// rate returns the cross rate published on the latest date not after the requested one,
+// since no rates are published on weekends and holidays.
+func (t *currencyTable) rate(baseCurrency string, targetCurrency string, dateKey string) (float64, error) {
+	index := sort.Search(len(t.dates), func(i int) bool { return t.dates[i] > dateKey })
+	if index == 0 {
+		return 0, fmt.Errorf("%w: no rates published before %s", errRateNotFound, dateKey)
+	}
+	day := t.rates[t.dates[index-1]]
+	base, ok := t.value(day, baseCurrency)
+	if !ok {
+		return 0, fmt.Errorf("%w: %s", errRateNotFound, baseCurrency)
+	}
+	target, ok := t.value(day, targetCurrency)
+	if !ok {
+		return 0, fmt.Errorf("%w: %s", errRateNotFound, targetCurrency)
+	}
+	return base / target, nil
+}
+
+func (t *currencyTable) value(day map[string]float64, currency string) (float64, bool) {
+	if currency == t.reference {
+		return 1, true
+	}
+	value, ok := day[currency]
+	return value, ok && value > 0
+}
+
+func fetchURL(ctx context.Context, httpClient *http.Client, url string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		resp.Body.Close()
+		return nil, fmt.Errorf("rate request failed: %s", resp.Status)
+	}
+	return resp, nil
+}
+
+type ExchangeRateHostProvider struct {
+	baseURL    string
+	httpClient *http.Client
+}
+
+func NewExchangeRateHostProvider(baseURL string, httpClient *http.Client) *ExchangeRateHostProvider {
+	return &ExchangeRateHostProvider{
+		baseURL:    strings.TrimRight(baseURL, "/"),
+		httpClient: httpClient,
+	}
+}
+
+func (p *ExchangeRateHostProvider) Name() string {
+	return "exchangeratehost"
+}
+
+func (p *ExchangeRateHostProvider) Rate(ctx context.Context, baseCurrency string, targetCurrency string, date time.Time) (float64, error) {
+	dateKey := date.Format("2006-01-02")
+	url := fmt.Sprintf("%s/%s?base=%s&symbols=%s", p.baseURL, dateKey, baseCurrency, targetCurrency)
+	resp, err := fetchURL(ctx, p.httpClient, url)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	var payload struct {
+		Rates map[string]float64 `json:"rates"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&payload); err != nil {
+		return 0, err
+	}
+	rate, ok := payload.Rates[targetCurrency]
+	if !ok || rate == 0 {
+		return 0, fmt.Errorf("missing %s rate for %s on %s", targetCurrency, baseCurrency, dateKey)
+	}
+	return rate, nil
+}
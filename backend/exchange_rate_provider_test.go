@@ -0,0 +1,172 @@
+package cashtrack
+
+import (
+	"context"
+	"errors"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestECBRateProvider(t *testing.T) {
+	data := mustReadTestFile(t, "ecb_rates.xml")
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		_, _ = w.Write(data)
+	}))
+	defer server.Close()
+
+	provider := NewECBRateProvider(server.URL, "", server.Client())
+	ctx := context.Background()
+
+	rate, err := provider.Rate(ctx, "EUR", "CHF", time.Date(2024, 1, 4, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("rate: %v", err)
+	}
+	assertRate(t, rate, 0.9312)
+
+	// Saturday falls back to Friday's publication.
+	rate, err = provider.Rate(ctx, "USD", "CHF", time.Date(2024, 1, 6, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("rate: %v", err)
+	}
+	assertRate(t, rate, 0.9305/1.0921)
+
+	rate, err = provider.Rate(ctx, "CHF", "EUR", time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("rate: %v", err)
+	}
+	assertRate(t, rate, 1/0.9305)
+
+	if _, err := provider.Rate(ctx, "USD", "CHF", time.Date(2023, 12, 29, 0, 0, 0, 0, time.UTC)); !errors.Is(err, errRateNotFound) {
+		t.Fatalf("expected missing rate before feed start, got %v", err)
+	}
+	if _, err := provider.Rate(ctx, "XYZ", "CHF", time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC)); !errors.Is(err, errRateNotFound) {
+		t.Fatalf("expected missing rate for unknown currency, got %v", err)
+	}
+	if requests != 1 {
+		t.Fatalf("expected feed to be fetched once, got %d", requests)
+	}
+}
+
+func TestParseSNBRates(t *testing.T) {
+	f, err := os.Open(filepath.Join("testdata", "snb_rates.csv"))
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	defer f.Close()
+
+	table, err := parseSNBRates(f)
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+
+	rate, err := table.rate("USD", "CHF", "2024-01-15")
+	if err != nil {
+		t.Fatalf("rate: %v", err)
+	}
+	assertRate(t, rate, 0.8607)
+
+	rate, err = table.rate("JPY", "CHF", "2023-12-20")
+	if err != nil {
+		t.Fatalf("rate: %v", err)
+	}
+	assertRate(t, rate, 0.005983)
+
+	rate, err = table.rate("EUR", "USD", "2023-12-01")
+	if err != nil {
+		t.Fatalf("rate: %v", err)
+	}
+	assertRate(t, rate, 0.9406/0.8602)
+
+	if _, err := table.rate("JPY", "CHF", "2024-01-15"); !errors.Is(err, errRateNotFound) {
+		t.Fatalf("expected missing JPY rate, got %v", err)
+	}
+}
+
+func TestStaticRateProvider(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rates.csv")
+	content := "date,base,target,rate\n,EUR,CHF,0.95\n2024-01-01,USD,CHF,0.85\n2024-02-01,USD,CHF,0.87\n"
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("write rates: %v", err)
+	}
+	provider, err := LoadStaticRateProvider(path)
+	if err != nil {
+		t.Fatalf("load: %v", err)
+	}
+	ctx := context.Background()
+
+	cases := []struct {
+		base   string
+		target string
+		date   time.Time
+		want   float64
+	}{
+		{"EUR", "CHF", time.Date(2020, 5, 1, 0, 0, 0, 0, time.UTC), 0.95},
+		{"USD", "CHF", time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC), 0.85},
+		{"USD", "CHF", time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), 0.87},
+		{"CHF", "USD", time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), 1 / 0.87},
+	}
+	for _, tc := range cases {
+		rate, err := provider.Rate(ctx, tc.base, tc.target, tc.date)
+		if err != nil {
+			t.Fatalf("%s/%s: %v", tc.base, tc.target, err)
+		}
+		assertRate(t, rate, tc.want)
+	}
+
+	if _, err := provider.Rate(ctx, "USD", "CHF", time.Date(2023, 12, 31, 0, 0, 0, 0, time.UTC)); !errors.Is(err, errRateNotFound) {
+		t.Fatalf("expected missing rate, got %v", err)
+	}
+}
+
+func TestChainRateProviderFallsBack(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	static, err := NewStaticRateProvider([]StaticRate{{Base: "GBP", Target: "CHF", Rate: 1.1}})
+	if err != nil {
+		t.Fatalf("static: %v", err)
+	}
+	chain := NewChainRateProvider(NewECBRateProvider(server.URL, "", server.Client()), static)
+
+	rate, err := chain.Rate(context.Background(), "GBP", "CHF", time.Now())
+	if err != nil {
+		t.Fatalf("rate: %v", err)
+	}
+	assertRate(t, rate, 1.1)
+
+	if _, err := chain.Rate(context.Background(), "USD", "CHF", time.Now()); err == nil {
+		t.Fatalf("expected error when no provider has the rate")
+	}
+}
+
+func TestNewExchangeRateProvider(t *testing.T) {
+	provider, err := NewExchangeRateProvider(ExchangeRateConfig{Providers: "ecb, snb"})
+	if err != nil {
+		t.Fatalf("provider: %v", err)
+	}
+	if provider.Name() != "chain(ecb,snb)" {
+		t.Fatalf("unexpected provider %q", provider.Name())
+	}
+	if _, err := NewExchangeRateProvider(ExchangeRateConfig{Providers: "static"}); err == nil {
+		t.Fatalf("expected static provider without file to fail")
+	}
+	if _, err := NewExchangeRateProvider(ExchangeRateConfig{Providers: "unknown"}); err == nil {
+		t.Fatalf("expected unknown provider to fail")
+	}
+}
+
+func assertRate(t *testing.T, got float64, want float64) {
+	t.Helper()
+	if math.Abs(got-want) > 1e-9 {
+		t.Fatalf("expected rate %v, got %v", want, got)
+	}
+}
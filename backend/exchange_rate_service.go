@@ -2,9 +2,8 @@ package cashtrack
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
 	"fmt"
-	"net/http"
 	"strings"
 	"sync"
 	"time"
@@ -14,28 +13,61 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const defaultCurrency = "CHF"
+
 type ExchangeRateService struct {
 	db            *Db
-	httpClient    *http.Client
+	provider      ExchangeRateProvider
+	pivot         string
 	rateCache     map[string]float64
 	rateCacheLock sync.Mutex
 }
 
-func NewExchangeRateService(db *Db) *ExchangeRateService {
+func NewExchangeRateService(db *Db, provider ExchangeRateProvider, config ExchangeRateConfig) *ExchangeRateService {
 	return &ExchangeRateService{
-		db:         db,
-		httpClient: &http.Client{Timeout: 10 * time.Second},
-		rateCache:  make(map[string]float64),
+		db:        db,
+		provider:  provider,
+		pivot:     normalizeCurrency(config.PivotCurrency),
+		rateCache: make(map[string]float64),
 	}
 }
 
-func (s *ExchangeRateService) GetRateToCHF(ctx context.Context, baseCurrency string, date time.Time) (float64, error) {
-	base := strings.ToUpper(strings.TrimSpace(baseCurrency))
-	if base == "" || base == "CHF" {
+// GetRate returns how many units of toCurrency one unit of fromCurrency is worth on the given date.
+// When no provider quotes the pair directly, the rate is crossed through the pivot currency.
+func (s *ExchangeRateService) GetRate(ctx context.Context, fromCurrency string, toCurrency string, date time.Time) (float64, error) {
+	from := normalizeCurrency(fromCurrency)
+	to := normalizeCurrency(toCurrency)
+	if from == "" {
+		from = defaultCurrency
+	}
+	if to == "" {
+		to = defaultCurrency
+	}
+	if from == to {
 		return 1, nil
 	}
-	dateKey := date.Format("2006-01-02")
-	cacheKey := base + "|" + dateKey
+
+	rate, err := s.directRate(ctx, from, to, date)
+	if err == nil {
+		return rate, nil
+	}
+	if s.pivot == "" || s.pivot == from || s.pivot == to {
+		return 0, err
+	}
+
+	toPivot, pivotErr := s.directRate(ctx, from, s.pivot, date)
+	if pivotErr != nil {
+		return 0, errors.Join(err, pivotErr)
+	}
+	fromPivot, pivotErr := s.directRate(ctx, s.pivot, to, date)
+	if pivotErr != nil {
+		return 0, errors.Join(err, pivotErr)
+	}
+	return toPivot * fromPivot, nil
+}
+
+func (s *ExchangeRateService) directRate(ctx context.Context, from string, to string, date time.Time) (float64, error) {
+	cacheKey := from + "|" + to + "|" + date.Format("2006-01-02")
 
 	s.rateCacheLock.Lock()
 	if rate, ok := s.rateCache[cacheKey]; ok {
@@ -44,62 +76,41 @@ func (s *ExchangeRateService) GetRateToCHF(ctx context.Context, baseCurrency str
 	}
 	s.rateCacheLock.Unlock()
 
-	ratedb, err := s.getRateFromDB(ctx, base, date)
-	if err == nil && ratedb > 0 {
-		s.rateCacheLock.Lock()
-		s.rateCache[cacheKey] = ratedb
-		s.rateCacheLock.Unlock()
-		return ratedb, nil
+	rate, err := s.getRateFromDB(ctx, from, to, date)
+	if err != nil || rate <= 0 {
+		if inverse, inverseErr := s.getRateFromDB(ctx, to, from, date); inverseErr == nil && inverse > 0 {
+			rate, err = 1/inverse, nil
+		}
+	}
+	if err == nil && rate > 0 {
+		s.cacheRate(cacheKey, rate)
+		return rate, nil
 	}
 
-	rate, err := s.getRateFromAPI(ctx, base, date)
+	rate, err = s.provider.Rate(ctx, from, to, date)
 	if err != nil {
-		return 0, err
+		return 0, fmt.Errorf("get %s/%s rate from %s: %w", from, to, s.provider.Name(), err)
 	}
 
-	if err := s.storeRate(ctx, base, "CHF", date, rate); err != nil {
-		log.Warn().Err(err).Str("currency", base).Time("date", date).Msg("failed to store exchange rate")
+	if err := s.storeRate(ctx, from, to, date, rate); err != nil {
+		log.Warn().Err(err).Str("from", from).Str("to", to).Time("date", date).Msg("failed to store exchange rate")
 	}
 
-	s.rateCacheLock.Lock()
-	s.rateCache[cacheKey] = rate
-	s.rateCacheLock.Unlock()
+	s.cacheRate(cacheKey, rate)
 	return rate, nil
 }
 
-func (s *ExchangeRateService) getRateFromAPI(ctx context.Context, baseCurrency string, date time.Time) (float64, error) {
-	dateKey := date.Format("2006-01-02")
-	url := fmt.Sprintf("https://api.exchangerate.host/%s?base=%s&symbols=CHF", dateKey, baseCurrency)
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
-	if err != nil {
-		return 0, err
-	}
-	resp, err := s.httpClient.Do(req)
-	if err != nil {
-		return 0, err
-	}
-	defer resp.Body.Close()
-	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return 0, fmt.Errorf("rate request failed: %s", resp.Status)
-	}
-	var payload struct {
-		Rates map[string]float64 `json:"rates"`
-	}
-	if err := json.NewDecoder(resp.Body).Decode(&payload); err != nil {
-		return 0, err
-	}
-	rate, ok := payload.Rates["CHF"]
-	if !ok || rate == 0 {
-		return 0, fmt.Errorf("missing CHF rate for %s on %s", baseCurrency, dateKey)
-	}
-	return rate, nil
+func (s *ExchangeRateService) cacheRate(cacheKey string, rate float64) {
+	s.rateCacheLock.Lock()
+	s.rateCache[cacheKey] = rate
+	s.rateCacheLock.Unlock()
 }
 
-func (s *ExchangeRateService) getRateFromDB(ctx context.Context, baseCurrency string, date time.Time) (float64, error) {
+func (s *ExchangeRateService) getRateFromDB(ctx context.Context, baseCurrency string, targetCurrency string, date time.Time) (float64, error) {
 	rate, err := s.db.Queries.GetExchangeRate(ctx, db.GetExchangeRateParams{
 		RateDate:       pgtype.Date{Time: date, Valid: true},
 		BaseCurrency:   baseCurrency,
-		TargetCurrency: "CHF",
+		TargetCurrency: targetCurrency,
 	})
 	if err != nil {
 		return 0, err
@@ -119,3 +130,19 @@ func (s *ExchangeRateService) storeRate(ctx context.Context, baseCurrency string
 		Rate:           numericRate,
 	})
 }
+
+func normalizeCurrency(currency string) string {
+	return strings.ToUpper(strings.TrimSpace(currency))
+}
+
+func isCurrencyCode(currency string) bool {
+	if len(currency) != 3 {
+		return false
+	}
+	for _, r := range currency {
+		if r < 'A' || r > 'Z' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,138 @@
+package cashtrack
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+)
+
+const snbRatesTTL = 6 * time.Hour
+
+// SNBRateProvider reads Swiss franc exchange rates from the Swiss National Bank data portal CSV export.
+type SNBRateProvider struct {
+	url        string
+	httpClient *http.Client
+	now        func() time.Time
+
+	lock   sync.Mutex
+	cached *cachedCurrencyTable
+}
+
+func NewSNBRateProvider(url string, httpClient *http.Client) *SNBRateProvider {
+	return &SNBRateProvider{
+		url:        url,
+		httpClient: httpClient,
+		now:        time.Now,
+	}
+}
+
+func (p *SNBRateProvider) Name() string {
+	return "snb"
+}
+
+func (p *SNBRateProvider) Rate(ctx context.Context, baseCurrency string, targetCurrency string, date time.Time) (float64, error) {
+	table, err := p.table(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return table.rate(baseCurrency, targetCurrency, date.Format("2006-01-02"))
+}
+
+func (p *SNBRateProvider) table(ctx context.Context) (*currencyTable, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	now := p.now()
+	if p.cached != nil && now.Sub(p.cached.fetchedAt) < snbRatesTTL {
+		return p.cached.table, nil
+	}
+	resp, err := fetchURL(ctx, p.httpClient, p.url)
+	if err != nil {
+		if p.cached != nil {
+			log.Warn().Err(err).Str("url", p.url).Msg("failed to refresh snb rates, using cached rates")
+			return p.cached.table, nil
+		}
+		return nil, err
+	}
+	defer resp.Body.Close()
+	table, err := parseSNBRates(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	p.cached = &cachedCurrencyTable{table: table, fetchedAt: now}
+	return table, nil
+}
+
+// parseSNBRates reads the "Date;D0;Value" table of an SNB cube export. D0 holds the currency code
+// followed by the quoted unit (e.g. USD1, JPY100) and Value the price of those units in CHF.
+// Dates are either days or months, both of which sort correctly against ISO dates.
+func parseSNBRates(r io.Reader) (*currencyTable, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("read snb rates: %w", err)
+	}
+	reader := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(data, []byte("\ufeff"))))
+	reader.Comma = ';'
+	reader.FieldsPerRecord = -1
+
+	table := newCurrencyTable("CHF")
+	var headers map[string]int
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("read snb rates: %w", err)
+		}
+		if headers == nil {
+			if len(record) > 0 && strings.TrimSpace(record[0]) == "Date" {
+				headers = headerIndex(record)
+			}
+			continue
+		}
+		dateKey := fieldByHeader(headers, record, "Date")
+		series := fieldByHeader(headers, record, "D0")
+		valueRaw := fieldByHeader(headers, record, "Value")
+		if dateKey == "" || series == "" || valueRaw == "" {
+			continue
+		}
+		currency, units, ok := splitSNBSeries(series)
+		if !ok {
+			continue
+		}
+		value, err := strconv.ParseFloat(valueRaw, 64)
+		if err != nil || value <= 0 {
+			continue
+		}
+		table.set(dateKey, currency, value/units)
+	}
+	if headers == nil {
+		return nil, fmt.Errorf("snb export is missing the data header")
+	}
+	if len(table.dates) == 0 {
+		return nil, fmt.Errorf("snb export contains no rates")
+	}
+	table.finish()
+	return table, nil
+}
+
+func splitSNBSeries(series string) (string, float64, bool) {
+	split := strings.IndexFunc(series, unicode.IsDigit)
+	if split != 3 {
+		return "", 0, false
+	}
+	units, err := strconv.ParseFloat(series[split:], 64)
+	if err != nil || units <= 0 {
+		return "", 0, false
+	}
+	return strings.ToUpper(series[:split]), units, true
+}
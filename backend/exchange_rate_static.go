@@ -0,0 +1,146 @@
+package cashtrack
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type StaticRate struct {
+	Date   string  `json:"date"`
+	Base   string  `json:"base"`
+	Target string  `json:"target"`
+	Rate   float64 `json:"rate"`
+}
+
+// StaticRateProvider serves rates from a fixed list, for offline deployments and tests.
+// A rate without a date applies to every date; otherwise the latest rate not after the
+// requested date is used. Inverse pairs are derived automatically.
+type StaticRateProvider struct {
+	rates map[string][]StaticRate
+}
+
+func NewStaticRateProvider(rates []StaticRate) (*StaticRateProvider, error) {
+	provider := &StaticRateProvider{rates: make(map[string][]StaticRate)}
+	for _, rate := range rates {
+		rate.Base = strings.ToUpper(strings.TrimSpace(rate.Base))
+		rate.Target = strings.ToUpper(strings.TrimSpace(rate.Target))
+		rate.Date = strings.TrimSpace(rate.Date)
+		if rate.Base == "" || rate.Target == "" {
+			return nil, fmt.Errorf("static rate is missing a currency: %+v", rate)
+		}
+		if rate.Rate <= 0 {
+			return nil, fmt.Errorf("static rate %s/%s must be positive", rate.Base, rate.Target)
+		}
+		if rate.Date != "" {
+			if _, err := time.Parse("2006-01-02", rate.Date); err != nil {
+				return nil, fmt.Errorf("invalid static rate date %q", rate.Date)
+			}
+		}
+		key := rate.Base + "|" + rate.Target
+		provider.rates[key] = append(provider.rates[key], rate)
+	}
+	for _, list := range provider.rates {
+		sort.SliceStable(list, func(i, j int) bool { return list[i].Date < list[j].Date })
+	}
+	return provider, nil
+}
+
+func LoadStaticRateProvider(path string) (*StaticRateProvider, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read static rates: %w", err)
+	}
+	var rates []StaticRate
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		if err := json.Unmarshal(data, &rates); err != nil {
+			return nil, fmt.Errorf("decode static rates: %w", err)
+		}
+	case ".csv":
+		rates, err = parseStaticRatesCSV(data)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unsupported static rates file %q", path)
+	}
+	return NewStaticRateProvider(rates)
+}
+
+func parseStaticRatesCSV(data []byte) ([]StaticRate, error) {
+	reader := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(data, []byte("\ufeff"))))
+	reader.FieldsPerRecord = -1
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("read static rates header: %w", err)
+	}
+	for i := range header {
+		header[i] = strings.ToLower(strings.TrimSpace(header[i]))
+	}
+	headers := headerIndex(header)
+	for _, name := range []string{"base", "target", "rate"} {
+		if _, ok := headers[name]; !ok {
+			return nil, fmt.Errorf("static rates file is missing column %q", name)
+		}
+	}
+
+	var rates []StaticRate
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("read static rates: %w", err)
+		}
+		rateRaw := fieldByHeader(headers, record, "rate")
+		if rateRaw == "" {
+			continue
+		}
+		rate, err := strconv.ParseFloat(rateRaw, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid rate on line %d: %w", line, err)
+		}
+		rates = append(rates, StaticRate{
+			Date:   fieldByHeader(headers, record, "date"),
+			Base:   fieldByHeader(headers, record, "base"),
+			Target: fieldByHeader(headers, record, "target"),
+			Rate:   rate,
+		})
+	}
+	return rates, nil
+}
+
+func (p *StaticRateProvider) Name() string {
+	return "static"
+}
+
+func (p *StaticRateProvider) Rate(ctx context.Context, baseCurrency string, targetCurrency string, date time.Time) (float64, error) {
+	dateKey := date.Format("2006-01-02")
+	if rate, ok := p.lookup(baseCurrency, targetCurrency, dateKey); ok {
+		return rate, nil
+	}
+	if rate, ok := p.lookup(targetCurrency, baseCurrency, dateKey); ok {
+		return 1 / rate, nil
+	}
+	return 0, fmt.Errorf("%w: %s/%s on %s", errRateNotFound, baseCurrency, targetCurrency, dateKey)
+}
+
+func (p *StaticRateProvider) lookup(baseCurrency string, targetCurrency string, dateKey string) (float64, bool) {
+	list := p.rates[baseCurrency+"|"+targetCurrency]
+	index := sort.Search(len(list), func(i int) bool { return list[i].Date > dateKey })
+	if index == 0 {
+		return 0, false
+	}
+	return list[index-1].Rate, true
+}
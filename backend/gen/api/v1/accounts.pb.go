@@ -0,0 +1,889 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: api/v1/accounts.proto
+
+package apiv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Account struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Identifier    string                 `protobuf:"bytes,4,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	Iban          string                 `protobuf:"bytes,5,opt,name=iban,proto3" json:"iban,omitempty"`
+	Currency      string                 `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency,omitempty"`
+	Archived      bool                   `protobuf:"varint,7,opt,name=archived,proto3" json:"archived,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Account) Reset() {
+	*x = Account{}
+	mi := &file_api_v1_accounts_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Account) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Account) ProtoMessage() {}
+
+func (x *Account) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_accounts_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Account.ProtoReflect.Descriptor instead.
+func (*Account) Descriptor() ([]byte, []int) {
+	return file_api_v1_accounts_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Account) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Account) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Account) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Account) GetIdentifier() string {
+	if x != nil {
+		return x.Identifier
+	}
+	return ""
+}
+
+func (x *Account) GetIban() string {
+	if x != nil {
+		return x.Iban
+	}
+	return ""
+}
+
+func (x *Account) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Account) GetArchived() bool {
+	if x != nil {
+		return x.Archived
+	}
+	return false
+}
+
+func (x *Account) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type AccountBalancePoint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Balance       int64                  `protobuf:"varint,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	Checkpoint    bool                   `protobuf:"varint,3,opt,name=checkpoint,proto3" json:"checkpoint,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccountBalancePoint) Reset() {
+	*x = AccountBalancePoint{}
+	mi := &file_api_v1_accounts_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountBalancePoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountBalancePoint) ProtoMessage() {}
+
+func (x *AccountBalancePoint) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_accounts_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountBalancePoint.ProtoReflect.Descriptor instead.
+func (*AccountBalancePoint) Descriptor() ([]byte, []int) {
+	return file_api_v1_accounts_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AccountBalancePoint) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *AccountBalancePoint) GetBalance() int64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+func (x *AccountBalancePoint) GetCheckpoint() bool {
+	if x != nil {
+		return x.Checkpoint
+	}
+	return false
+}
+
+type ListAccountsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	IncludeArchived bool                   `protobuf:"varint,1,opt,name=include_archived,json=includeArchived,proto3" json:"include_archived,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListAccountsRequest) Reset() {
+	*x = ListAccountsRequest{}
+	mi := &file_api_v1_accounts_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccountsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccountsRequest) ProtoMessage() {}
+
+func (x *ListAccountsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_accounts_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccountsRequest.ProtoReflect.Descriptor instead.
+func (*ListAccountsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_accounts_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListAccountsRequest) GetIncludeArchived() bool {
+	if x != nil {
+		return x.IncludeArchived
+	}
+	return false
+}
+
+type ListAccountsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Accounts      []*Account             `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAccountsResponse) Reset() {
+	*x = ListAccountsResponse{}
+	mi := &file_api_v1_accounts_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccountsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccountsResponse) ProtoMessage() {}
+
+func (x *ListAccountsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_accounts_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccountsResponse.ProtoReflect.Descriptor instead.
+func (*ListAccountsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_accounts_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListAccountsResponse) GetAccounts() []*Account {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+type CreateAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Currency      string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAccountRequest) Reset() {
+	*x = CreateAccountRequest{}
+	mi := &file_api_v1_accounts_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAccountRequest) ProtoMessage() {}
+
+func (x *CreateAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_accounts_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAccountRequest.ProtoReflect.Descriptor instead.
+func (*CreateAccountRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_accounts_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateAccountRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateAccountRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CreateAccountRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type CreateAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Account       *Account               `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAccountResponse) Reset() {
+	*x = CreateAccountResponse{}
+	mi := &file_api_v1_accounts_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAccountResponse) ProtoMessage() {}
+
+func (x *CreateAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_accounts_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAccountResponse.ProtoReflect.Descriptor instead.
+func (*CreateAccountResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_accounts_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateAccountResponse) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+type RenameAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameAccountRequest) Reset() {
+	*x = RenameAccountRequest{}
+	mi := &file_api_v1_accounts_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameAccountRequest) ProtoMessage() {}
+
+func (x *RenameAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_accounts_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameAccountRequest.ProtoReflect.Descriptor instead.
+func (*RenameAccountRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_accounts_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RenameAccountRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RenameAccountRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type RenameAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameAccountResponse) Reset() {
+	*x = RenameAccountResponse{}
+	mi := &file_api_v1_accounts_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameAccountResponse) ProtoMessage() {}
+
+func (x *RenameAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_accounts_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameAccountResponse.ProtoReflect.Descriptor instead.
+func (*RenameAccountResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_accounts_proto_rawDescGZIP(), []int{7}
+}
+
+type ArchiveAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Archived      bool                   `protobuf:"varint,2,opt,name=archived,proto3" json:"archived,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveAccountRequest) Reset() {
+	*x = ArchiveAccountRequest{}
+	mi := &file_api_v1_accounts_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveAccountRequest) ProtoMessage() {}
+
+func (x *ArchiveAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_accounts_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveAccountRequest.ProtoReflect.Descriptor instead.
+func (*ArchiveAccountRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_accounts_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ArchiveAccountRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ArchiveAccountRequest) GetArchived() bool {
+	if x != nil {
+		return x.Archived
+	}
+	return false
+}
+
+type ArchiveAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveAccountResponse) Reset() {
+	*x = ArchiveAccountResponse{}
+	mi := &file_api_v1_accounts_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveAccountResponse) ProtoMessage() {}
+
+func (x *ArchiveAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_accounts_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveAccountResponse.ProtoReflect.Descriptor instead.
+func (*ArchiveAccountResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_accounts_proto_rawDescGZIP(), []int{9}
+}
+
+type MergeAccountsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SourceId      int32                  `protobuf:"varint,1,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	TargetId      int32                  `protobuf:"varint,2,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeAccountsRequest) Reset() {
+	*x = MergeAccountsRequest{}
+	mi := &file_api_v1_accounts_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeAccountsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeAccountsRequest) ProtoMessage() {}
+
+func (x *MergeAccountsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_accounts_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeAccountsRequest.ProtoReflect.Descriptor instead.
+func (*MergeAccountsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_accounts_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *MergeAccountsRequest) GetSourceId() int32 {
+	if x != nil {
+		return x.SourceId
+	}
+	return 0
+}
+
+func (x *MergeAccountsRequest) GetTargetId() int32 {
+	if x != nil {
+		return x.TargetId
+	}
+	return 0
+}
+
+type MergeAccountsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeAccountsResponse) Reset() {
+	*x = MergeAccountsResponse{}
+	mi := &file_api_v1_accounts_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeAccountsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeAccountsResponse) ProtoMessage() {}
+
+func (x *MergeAccountsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_accounts_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeAccountsResponse.ProtoReflect.Descriptor instead.
+func (*MergeAccountsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_accounts_proto_rawDescGZIP(), []int{11}
+}
+
+type GetAccountBalancesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     int32                  `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	FromDate      string                 `protobuf:"bytes,2,opt,name=from_date,json=fromDate,proto3" json:"from_date,omitempty"`
+	ToDate        string                 `protobuf:"bytes,3,opt,name=to_date,json=toDate,proto3" json:"to_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccountBalancesRequest) Reset() {
+	*x = GetAccountBalancesRequest{}
+	mi := &file_api_v1_accounts_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountBalancesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountBalancesRequest) ProtoMessage() {}
+
+func (x *GetAccountBalancesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_accounts_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountBalancesRequest.ProtoReflect.Descriptor instead.
+func (*GetAccountBalancesRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_accounts_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetAccountBalancesRequest) GetAccountId() int32 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *GetAccountBalancesRequest) GetFromDate() string {
+	if x != nil {
+		return x.FromDate
+	}
+	return ""
+}
+
+func (x *GetAccountBalancesRequest) GetToDate() string {
+	if x != nil {
+		return x.ToDate
+	}
+	return ""
+}
+
+type GetAccountBalancesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Points        []*AccountBalancePoint `protobuf:"bytes,1,rep,name=points,proto3" json:"points,omitempty"`
+	Currency      string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccountBalancesResponse) Reset() {
+	*x = GetAccountBalancesResponse{}
+	mi := &file_api_v1_accounts_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountBalancesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountBalancesResponse) ProtoMessage() {}
+
+func (x *GetAccountBalancesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_accounts_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountBalancesResponse.ProtoReflect.Descriptor instead.
+func (*GetAccountBalancesResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_accounts_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetAccountBalancesResponse) GetPoints() []*AccountBalancePoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+func (x *GetAccountBalancesResponse) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+var File_api_v1_accounts_proto protoreflect.FileDescriptor
+
+const file_api_v1_accounts_proto_rawDesc = "" +
+	"\n" +
+	"\x15api/v1/accounts.proto\x12\x06api.v1\"\xcc\x01\n" +
+	"\aAccount\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x1e\n" +
+	"\n" +
+	"identifier\x18\x04 \x01(\tR\n" +
+	"identifier\x12\x12\n" +
+	"\x04iban\x18\x05 \x01(\tR\x04iban\x12\x1a\n" +
+	"\bcurrency\x18\x06 \x01(\tR\bcurrency\x12\x1a\n" +
+	"\barchived\x18\a \x01(\bR\barchived\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\tR\tcreatedAt\"c\n" +
+	"\x13AccountBalancePoint\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x18\n" +
+	"\abalance\x18\x02 \x01(\x03R\abalance\x12\x1e\n" +
+	"\n" +
+	"checkpoint\x18\x03 \x01(\bR\n" +
+	"checkpoint\"@\n" +
+	"\x13ListAccountsRequest\x12)\n" +
+	"\x10include_archived\x18\x01 \x01(\bR\x0fincludeArchived\"C\n" +
+	"\x14ListAccountsResponse\x12+\n" +
+	"\baccounts\x18\x01 \x03(\v2\x0f.api.v1.AccountR\baccounts\"Z\n" +
+	"\x14CreateAccountRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\"B\n" +
+	"\x15CreateAccountResponse\x12)\n" +
+	"\aaccount\x18\x01 \x01(\v2\x0f.api.v1.AccountR\aaccount\":\n" +
+	"\x14RenameAccountRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"\x17\n" +
+	"\x15RenameAccountResponse\"C\n" +
+	"\x15ArchiveAccountRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1a\n" +
+	"\barchived\x18\x02 \x01(\bR\barchived\"\x18\n" +
+	"\x16ArchiveAccountResponse\"P\n" +
+	"\x14MergeAccountsRequest\x12\x1b\n" +
+	"\tsource_id\x18\x01 \x01(\x05R\bsourceId\x12\x1b\n" +
+	"\ttarget_id\x18\x02 \x01(\x05R\btargetId\"\x17\n" +
+	"\x15MergeAccountsResponse\"p\n" +
+	"\x19GetAccountBalancesRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\x05R\taccountId\x12\x1b\n" +
+	"\tfrom_date\x18\x02 \x01(\tR\bfromDate\x12\x17\n" +
+	"\ato_date\x18\x03 \x01(\tR\x06toDate\"m\n" +
+	"\x1aGetAccountBalancesResponse\x123\n" +
+	"\x06points\x18\x01 \x03(\v2\x1b.api.v1.AccountBalancePointR\x06points\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency2\x85\x04\n" +
+	"\x0eAccountService\x12N\n" +
+	"\fListAccounts\x12\x1b.api.v1.ListAccountsRequest\x1a\x1c.api.v1.ListAccountsResponse\"\x03\x90\x02\x01\x12N\n" +
+	"\rCreateAccount\x12\x1c.api.v1.CreateAccountRequest\x1a\x1d.api.v1.CreateAccountResponse\"\x00\x12N\n" +
+	"\rRenameAccount\x12\x1c.api.v1.RenameAccountRequest\x1a\x1d.api.v1.RenameAccountResponse\"\x00\x12Q\n" +
+	"\x0eArchiveAccount\x12\x1d.api.v1.ArchiveAccountRequest\x1a\x1e.api.v1.ArchiveAccountResponse\"\x00\x12N\n" +
+	"\rMergeAccounts\x12\x1c.api.v1.MergeAccountsRequest\x1a\x1d.api.v1.MergeAccountsResponse\"\x00\x12`\n" +
+	"\x12GetAccountBalances\x12!.api.v1.GetAccountBalancesRequest\x1a\".api.v1.GetAccountBalancesResponse\"\x03\x90\x02\x01Bx\n" +
+	"\n" +
+	"com.api.v1B\rAccountsProtoP\x01Z\"cashtrack/backend/gen/api/v1;apiv1\xa2\x02\x03AXX\xaa\x02\x06Api.V1\xca\x02\x06Api\\V1\xe2\x02\x12Api\\V1\\GPBMetadata\xea\x02\aApi::V1b\x06proto3"
+
+var (
+	file_api_v1_accounts_proto_rawDescOnce sync.Once
+	file_api_v1_accounts_proto_rawDescData []byte
+)
+
+func file_api_v1_accounts_proto_rawDescGZIP() []byte {
+	file_api_v1_accounts_proto_rawDescOnce.Do(func() {
+		file_api_v1_accounts_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_v1_accounts_proto_rawDesc), len(file_api_v1_accounts_proto_rawDesc)))
+	})
+	return file_api_v1_accounts_proto_rawDescData
+}
+
+var file_api_v1_accounts_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_api_v1_accounts_proto_goTypes = []any{
+	(*Account)(nil),                    // 0: api.v1.Account
+	(*AccountBalancePoint)(nil),        // 1: api.v1.AccountBalancePoint
+	(*ListAccountsRequest)(nil),        // 2: api.v1.ListAccountsRequest
+	(*ListAccountsResponse)(nil),       // 3: api.v1.ListAccountsResponse
+	(*CreateAccountRequest)(nil),       // 4: api.v1.CreateAccountRequest
+	(*CreateAccountResponse)(nil),      // 5: api.v1.CreateAccountResponse
+	(*RenameAccountRequest)(nil),       // 6: api.v1.RenameAccountRequest
+	(*RenameAccountResponse)(nil),      // 7: api.v1.RenameAccountResponse
+	(*ArchiveAccountRequest)(nil),      // 8: api.v1.ArchiveAccountRequest
+	(*ArchiveAccountResponse)(nil),     // 9: api.v1.ArchiveAccountResponse
+	(*MergeAccountsRequest)(nil),       // 10: api.v1.MergeAccountsRequest
+	(*MergeAccountsResponse)(nil),      // 11: api.v1.MergeAccountsResponse
+	(*GetAccountBalancesRequest)(nil),  // 12: api.v1.GetAccountBalancesRequest
+	(*GetAccountBalancesResponse)(nil), // 13: api.v1.GetAccountBalancesResponse
+}
+var file_api_v1_accounts_proto_depIdxs = []int32{
+	0,  // 0: api.v1.ListAccountsResponse.accounts:type_name -> api.v1.Account
+	0,  // 1: api.v1.CreateAccountResponse.account:type_name -> api.v1.Account
+	1,  // 2: api.v1.GetAccountBalancesResponse.points:type_name -> api.v1.AccountBalancePoint
+	2,  // 3: api.v1.AccountService.ListAccounts:input_type -> api.v1.ListAccountsRequest
+	4,  // 4: api.v1.AccountService.CreateAccount:input_type -> api.v1.CreateAccountRequest
+	6,  // 5: api.v1.AccountService.RenameAccount:input_type -> api.v1.RenameAccountRequest
+	8,  // 6: api.v1.AccountService.ArchiveAccount:input_type -> api.v1.ArchiveAccountRequest
+	10, // 7: api.v1.AccountService.MergeAccounts:input_type -> api.v1.MergeAccountsRequest
+	12, // 8: api.v1.AccountService.GetAccountBalances:input_type -> api.v1.GetAccountBalancesRequest
+	3,  // 9: api.v1.AccountService.ListAccounts:output_type -> api.v1.ListAccountsResponse
+	5,  // 10: api.v1.AccountService.CreateAccount:output_type -> api.v1.CreateAccountResponse
+	7,  // 11: api.v1.AccountService.RenameAccount:output_type -> api.v1.RenameAccountResponse
+	9,  // 12: api.v1.AccountService.ArchiveAccount:output_type -> api.v1.ArchiveAccountResponse
+	11, // 13: api.v1.AccountService.MergeAccounts:output_type -> api.v1.MergeAccountsResponse
+	13, // 14: api.v1.AccountService.GetAccountBalances:output_type -> api.v1.GetAccountBalancesResponse
+	9,  // [9:15] is the sub-list for method output_type
+	3,  // [3:9] is the sub-list for method input_type
+	3,  // [3:3] is the sub-list for extension type_name
+	3,  // [3:3] is the sub-list for extension extendee
+	0,  // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_api_v1_accounts_proto_init() }
+func file_api_v1_accounts_proto_init() {
+	if File_api_v1_accounts_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_accounts_proto_rawDesc), len(file_api_v1_accounts_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   14,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_v1_accounts_proto_goTypes,
+		DependencyIndexes: file_api_v1_accounts_proto_depIdxs,
+		MessageInfos:      file_api_v1_accounts_proto_msgTypes,
+	}.Build()
+	File_api_v1_accounts_proto = out.File
+	file_api_v1_accounts_proto_goTypes = nil
+	file_api_v1_accounts_proto_depIdxs = nil
+}
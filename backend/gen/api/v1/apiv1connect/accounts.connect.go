@@ -0,0 +1,282 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: api/v1/accounts.proto
+
+package apiv1connect
+
+import (
+	v1 "cashtrack/backend/gen/api/v1"
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// AccountServiceName is the fully-qualified name of the AccountService service.
+	AccountServiceName = "api.v1.AccountService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// AccountServiceListAccountsProcedure is the fully-qualified name of the AccountService's
+	// ListAccounts RPC.
+	AccountServiceListAccountsProcedure = "/api.v1.AccountService/ListAccounts"
+	// AccountServiceCreateAccountProcedure is the fully-qualified name of the AccountService's
+	// CreateAccount RPC.
+	AccountServiceCreateAccountProcedure = "/api.v1.AccountService/CreateAccount"
+	// AccountServiceRenameAccountProcedure is the fully-qualified name of the AccountService's
+	// RenameAccount RPC.
+	AccountServiceRenameAccountProcedure = "/api.v1.AccountService/RenameAccount"
+	// AccountServiceArchiveAccountProcedure is the fully-qualified name of the AccountService's
+	// ArchiveAccount RPC.
+	AccountServiceArchiveAccountProcedure = "/api.v1.AccountService/ArchiveAccount"
+	// AccountServiceMergeAccountsProcedure is the fully-qualified name of the AccountService's
+	// MergeAccounts RPC.
+	AccountServiceMergeAccountsProcedure = "/api.v1.AccountService/MergeAccounts"
+	// AccountServiceGetAccountBalancesProcedure is the fully-qualified name of the AccountService's
+	// GetAccountBalances RPC.
+	AccountServiceGetAccountBalancesProcedure = "/api.v1.AccountService/GetAccountBalances"
+)
+
+// AccountServiceClient is a client for the api.v1.AccountService service.
+type AccountServiceClient interface {
+	ListAccounts(context.Context, *v1.ListAccountsRequest) (*v1.ListAccountsResponse, error)
+	CreateAccount(context.Context, *v1.CreateAccountRequest) (*v1.CreateAccountResponse, error)
+	RenameAccount(context.Context, *v1.RenameAccountRequest) (*v1.RenameAccountResponse, error)
+	ArchiveAccount(context.Context, *v1.ArchiveAccountRequest) (*v1.ArchiveAccountResponse, error)
+	MergeAccounts(context.Context, *v1.MergeAccountsRequest) (*v1.MergeAccountsResponse, error)
+	GetAccountBalances(context.Context, *v1.GetAccountBalancesRequest) (*v1.GetAccountBalancesResponse, error)
+}
+
+// NewAccountServiceClient constructs a client for the api.v1.AccountService service. By default, it
+// uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewAccountServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) AccountServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	accountServiceMethods := v1.File_api_v1_accounts_proto.Services().ByName("AccountService").Methods()
+	return &accountServiceClient{
+		listAccounts: connect.NewClient[v1.ListAccountsRequest, v1.ListAccountsResponse](
+			httpClient,
+			baseURL+AccountServiceListAccountsProcedure,
+			connect.WithSchema(accountServiceMethods.ByName("ListAccounts")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		createAccount: connect.NewClient[v1.CreateAccountRequest, v1.CreateAccountResponse](
+			httpClient,
+			baseURL+AccountServiceCreateAccountProcedure,
+			connect.WithSchema(accountServiceMethods.ByName("CreateAccount")),
+			connect.WithClientOptions(opts...),
+		),
+		renameAccount: connect.NewClient[v1.RenameAccountRequest, v1.RenameAccountResponse](
+			httpClient,
+			baseURL+AccountServiceRenameAccountProcedure,
+			connect.WithSchema(accountServiceMethods.ByName("RenameAccount")),
+			connect.WithClientOptions(opts...),
+		),
+		archiveAccount: connect.NewClient[v1.ArchiveAccountRequest, v1.ArchiveAccountResponse](
+			httpClient,
+			baseURL+AccountServiceArchiveAccountProcedure,
+			connect.WithSchema(accountServiceMethods.ByName("ArchiveAccount")),
+			connect.WithClientOptions(opts...),
+		),
+		mergeAccounts: connect.NewClient[v1.MergeAccountsRequest, v1.MergeAccountsResponse](
+			httpClient,
+			baseURL+AccountServiceMergeAccountsProcedure,
+			connect.WithSchema(accountServiceMethods.ByName("MergeAccounts")),
+			connect.WithClientOptions(opts...),
+		),
+		getAccountBalances: connect.NewClient[v1.GetAccountBalancesRequest, v1.GetAccountBalancesResponse](
+			httpClient,
+			baseURL+AccountServiceGetAccountBalancesProcedure,
+			connect.WithSchema(accountServiceMethods.ByName("GetAccountBalances")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// accountServiceClient implements AccountServiceClient.
+type accountServiceClient struct {
+	listAccounts       *connect.Client[v1.ListAccountsRequest, v1.ListAccountsResponse]
+	createAccount      *connect.Client[v1.CreateAccountRequest, v1.CreateAccountResponse]
+	renameAccount      *connect.Client[v1.RenameAccountRequest, v1.RenameAccountResponse]
+	archiveAccount     *connect.Client[v1.ArchiveAccountRequest, v1.ArchiveAccountResponse]
+	mergeAccounts      *connect.Client[v1.MergeAccountsRequest, v1.MergeAccountsResponse]
+	getAccountBalances *connect.Client[v1.GetAccountBalancesRequest, v1.GetAccountBalancesResponse]
+}
+
+// ListAccounts calls api.v1.AccountService.ListAccounts.
+func (c *accountServiceClient) ListAccounts(ctx context.Context, req *v1.ListAccountsRequest) (*v1.ListAccountsResponse, error) {
+	response, err := c.listAccounts.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// CreateAccount calls api.v1.AccountService.CreateAccount.
+func (c *accountServiceClient) CreateAccount(ctx context.Context, req *v1.CreateAccountRequest) (*v1.CreateAccountResponse, error) {
+	response, err := c.createAccount.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// RenameAccount calls api.v1.AccountService.RenameAccount.
+func (c *accountServiceClient) RenameAccount(ctx context.Context, req *v1.RenameAccountRequest) (*v1.RenameAccountResponse, error) {
+	response, err := c.renameAccount.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ArchiveAccount calls api.v1.AccountService.ArchiveAccount.
+func (c *accountServiceClient) ArchiveAccount(ctx context.Context, req *v1.ArchiveAccountRequest) (*v1.ArchiveAccountResponse, error) {
+	response, err := c.archiveAccount.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// MergeAccounts calls api.v1.AccountService.MergeAccounts.
+func (c *accountServiceClient) MergeAccounts(ctx context.Context, req *v1.MergeAccountsRequest) (*v1.MergeAccountsResponse, error) {
+	response, err := c.mergeAccounts.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetAccountBalances calls api.v1.AccountService.GetAccountBalances.
+func (c *accountServiceClient) GetAccountBalances(ctx context.Context, req *v1.GetAccountBalancesRequest) (*v1.GetAccountBalancesResponse, error) {
+	response, err := c.getAccountBalances.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// AccountServiceHandler is an implementation of the api.v1.AccountService service.
+type AccountServiceHandler interface {
+	ListAccounts(context.Context, *v1.ListAccountsRequest) (*v1.ListAccountsResponse, error)
+	CreateAccount(context.Context, *v1.CreateAccountRequest) (*v1.CreateAccountResponse, error)
+	RenameAccount(context.Context, *v1.RenameAccountRequest) (*v1.RenameAccountResponse, error)
+	ArchiveAccount(context.Context, *v1.ArchiveAccountRequest) (*v1.ArchiveAccountResponse, error)
+	MergeAccounts(context.Context, *v1.MergeAccountsRequest) (*v1.MergeAccountsResponse, error)
+	GetAccountBalances(context.Context, *v1.GetAccountBalancesRequest) (*v1.GetAccountBalancesResponse, error)
+}
+
+// NewAccountServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewAccountServiceHandler(svc AccountServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	accountServiceMethods := v1.File_api_v1_accounts_proto.Services().ByName("AccountService").Methods()
+	accountServiceListAccountsHandler := connect.NewUnaryHandlerSimple(
+		AccountServiceListAccountsProcedure,
+		svc.ListAccounts,
+		connect.WithSchema(accountServiceMethods.ByName("ListAccounts")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	accountServiceCreateAccountHandler := connect.NewUnaryHandlerSimple(
+		AccountServiceCreateAccountProcedure,
+		svc.CreateAccount,
+		connect.WithSchema(accountServiceMethods.ByName("CreateAccount")),
+		connect.WithHandlerOptions(opts...),
+	)
+	accountServiceRenameAccountHandler := connect.NewUnaryHandlerSimple(
+		AccountServiceRenameAccountProcedure,
+		svc.RenameAccount,
+		connect.WithSchema(accountServiceMethods.ByName("RenameAccount")),
+		connect.WithHandlerOptions(opts...),
+	)
+	accountServiceArchiveAccountHandler := connect.NewUnaryHandlerSimple(
+		AccountServiceArchiveAccountProcedure,
+		svc.ArchiveAccount,
+		connect.WithSchema(accountServiceMethods.ByName("ArchiveAccount")),
+		connect.WithHandlerOptions(opts...),
+	)
+	accountServiceMergeAccountsHandler := connect.NewUnaryHandlerSimple(
+		AccountServiceMergeAccountsProcedure,
+		svc.MergeAccounts,
+		connect.WithSchema(accountServiceMethods.ByName("MergeAccounts")),
+		connect.WithHandlerOptions(opts...),
+	)
+	accountServiceGetAccountBalancesHandler := connect.NewUnaryHandlerSimple(
+		AccountServiceGetAccountBalancesProcedure,
+		svc.GetAccountBalances,
+		connect.WithSchema(accountServiceMethods.ByName("GetAccountBalances")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/api.v1.AccountService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case AccountServiceListAccountsProcedure:
+			accountServiceListAccountsHandler.ServeHTTP(w, r)
+		case AccountServiceCreateAccountProcedure:
+			accountServiceCreateAccountHandler.ServeHTTP(w, r)
+		case AccountServiceRenameAccountProcedure:
+			accountServiceRenameAccountHandler.ServeHTTP(w, r)
+		case AccountServiceArchiveAccountProcedure:
+			accountServiceArchiveAccountHandler.ServeHTTP(w, r)
+		case AccountServiceMergeAccountsProcedure:
+			accountServiceMergeAccountsHandler.ServeHTTP(w, r)
+		case AccountServiceGetAccountBalancesProcedure:
+			accountServiceGetAccountBalancesHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedAccountServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedAccountServiceHandler struct{}
+
+func (UnimplementedAccountServiceHandler) ListAccounts(context.Context, *v1.ListAccountsRequest) (*v1.ListAccountsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.AccountService.ListAccounts is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) CreateAccount(context.Context, *v1.CreateAccountRequest) (*v1.CreateAccountResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.AccountService.CreateAccount is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) RenameAccount(context.Context, *v1.RenameAccountRequest) (*v1.RenameAccountResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.AccountService.RenameAccount is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) ArchiveAccount(context.Context, *v1.ArchiveAccountRequest) (*v1.ArchiveAccountResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.AccountService.ArchiveAccount is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) MergeAccounts(context.Context, *v1.MergeAccountsRequest) (*v1.MergeAccountsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.AccountService.MergeAccounts is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) GetAccountBalances(context.Context, *v1.GetAccountBalancesRequest) (*v1.GetAccountBalancesResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.AccountService.GetAccountBalances is not implemented"))
+}
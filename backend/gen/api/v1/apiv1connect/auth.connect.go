@@ -40,6 +40,18 @@ const (
 	// AuthServiceUpdateLanguageProcedure is the fully-qualified name of the AuthService's
 	// UpdateLanguage RPC.
 	AuthServiceUpdateLanguageProcedure = "/api.v1.AuthService/UpdateLanguage"
+	// AuthServiceUpdateBaseCurrencyProcedure is the fully-qualified name of the AuthService's
+	// UpdateBaseCurrency RPC.
+	AuthServiceUpdateBaseCurrencyProcedure = "/api.v1.AuthService/UpdateBaseCurrency"
+	// AuthServiceCreateApiTokenProcedure is the fully-qualified name of the AuthService's
+	// CreateApiToken RPC.
+	AuthServiceCreateApiTokenProcedure = "/api.v1.AuthService/CreateApiToken"
+	// AuthServiceListApiTokensProcedure is the fully-qualified name of the AuthService's ListApiTokens
+	// RPC.
+	AuthServiceListApiTokensProcedure = "/api.v1.AuthService/ListApiTokens"
+	// AuthServiceRevokeApiTokenProcedure is the fully-qualified name of the AuthService's
+	// RevokeApiToken RPC.
+	AuthServiceRevokeApiTokenProcedure = "/api.v1.AuthService/RevokeApiToken"
 )
 
 // AuthServiceClient is a client for the api.v1.AuthService service.
@@ -47,6 +59,10 @@ type AuthServiceClient interface {
 	Me(context.Context, *v1.AuthMeRequest) (*v1.AuthMeResponse, error)
 	Logout(context.Context, *v1.AuthLogoutRequest) (*v1.AuthLogoutResponse, error)
 	UpdateLanguage(context.Context, *v1.UpdateLanguageRequest) (*v1.UpdateLanguageResponse, error)
+	UpdateBaseCurrency(context.Context, *v1.UpdateBaseCurrencyRequest) (*v1.UpdateBaseCurrencyResponse, error)
+	CreateApiToken(context.Context, *v1.CreateApiTokenRequest) (*v1.CreateApiTokenResponse, error)
+	ListApiTokens(context.Context, *v1.ListApiTokensRequest) (*v1.ListApiTokensResponse, error)
+	RevokeApiToken(context.Context, *v1.RevokeApiTokenRequest) (*v1.RevokeApiTokenResponse, error)
 }
 
 // NewAuthServiceClient constructs a client for the api.v1.AuthService service. By default, it uses
@@ -78,14 +94,43 @@ func NewAuthServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(authServiceMethods.ByName("UpdateLanguage")),
 			connect.WithClientOptions(opts...),
 		),
+		updateBaseCurrency: connect.NewClient[v1.UpdateBaseCurrencyRequest, v1.UpdateBaseCurrencyResponse](
+			httpClient,
+			baseURL+AuthServiceUpdateBaseCurrencyProcedure,
+			connect.WithSchema(authServiceMethods.ByName("UpdateBaseCurrency")),
+			connect.WithClientOptions(opts...),
+		),
+		createApiToken: connect.NewClient[v1.CreateApiTokenRequest, v1.CreateApiTokenResponse](
+			httpClient,
+			baseURL+AuthServiceCreateApiTokenProcedure,
+			connect.WithSchema(authServiceMethods.ByName("CreateApiToken")),
+			connect.WithClientOptions(opts...),
+		),
+		listApiTokens: connect.NewClient[v1.ListApiTokensRequest, v1.ListApiTokensResponse](
+			httpClient,
+			baseURL+AuthServiceListApiTokensProcedure,
+			connect.WithSchema(authServiceMethods.ByName("ListApiTokens")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		revokeApiToken: connect.NewClient[v1.RevokeApiTokenRequest, v1.RevokeApiTokenResponse](
+			httpClient,
+			baseURL+AuthServiceRevokeApiTokenProcedure,
+			connect.WithSchema(authServiceMethods.ByName("RevokeApiToken")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // authServiceClient implements AuthServiceClient.
 type authServiceClient struct {
-	me             *connect.Client[v1.AuthMeRequest, v1.AuthMeResponse]
-	logout         *connect.Client[v1.AuthLogoutRequest, v1.AuthLogoutResponse]
-	updateLanguage *connect.Client[v1.UpdateLanguageRequest, v1.UpdateLanguageResponse]
+	me                 *connect.Client[v1.AuthMeRequest, v1.AuthMeResponse]
+	logout             *connect.Client[v1.AuthLogoutRequest, v1.AuthLogoutResponse]
+	updateLanguage     *connect.Client[v1.UpdateLanguageRequest, v1.UpdateLanguageResponse]
+	updateBaseCurrency *connect.Client[v1.UpdateBaseCurrencyRequest, v1.UpdateBaseCurrencyResponse]
+	createApiToken     *connect.Client[v1.CreateApiTokenRequest, v1.CreateApiTokenResponse]
+	listApiTokens      *connect.Client[v1.ListApiTokensRequest, v1.ListApiTokensResponse]
+	revokeApiToken     *connect.Client[v1.RevokeApiTokenRequest, v1.RevokeApiTokenResponse]
 }
 
 // Me calls api.v1.AuthService.Me.
@@ -115,11 +160,51 @@ func (c *authServiceClient) UpdateLanguage(ctx context.Context, req *v1.UpdateLa
 	return nil, err
 }
 
+// UpdateBaseCurrency calls api.v1.AuthService.UpdateBaseCurrency.
+func (c *authServiceClient) UpdateBaseCurrency(ctx context.Context, req *v1.UpdateBaseCurrencyRequest) (*v1.UpdateBaseCurrencyResponse, error) {
+	response, err := c.updateBaseCurrency.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// CreateApiToken calls api.v1.AuthService.CreateApiToken.
+func (c *authServiceClient) CreateApiToken(ctx context.Context, req *v1.CreateApiTokenRequest) (*v1.CreateApiTokenResponse, error) {
+	response, err := c.createApiToken.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListApiTokens calls api.v1.AuthService.ListApiTokens.
+func (c *authServiceClient) ListApiTokens(ctx context.Context, req *v1.ListApiTokensRequest) (*v1.ListApiTokensResponse, error) {
+	response, err := c.listApiTokens.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// RevokeApiToken calls api.v1.AuthService.RevokeApiToken.
+func (c *authServiceClient) RevokeApiToken(ctx context.Context, req *v1.RevokeApiTokenRequest) (*v1.RevokeApiTokenResponse, error) {
+	response, err := c.revokeApiToken.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
 // AuthServiceHandler is an implementation of the api.v1.AuthService service.
 type AuthServiceHandler interface {
 	Me(context.Context, *v1.AuthMeRequest) (*v1.AuthMeResponse, error)
 	Logout(context.Context, *v1.AuthLogoutRequest) (*v1.AuthLogoutResponse, error)
 	UpdateLanguage(context.Context, *v1.UpdateLanguageRequest) (*v1.UpdateLanguageResponse, error)
+	UpdateBaseCurrency(context.Context, *v1.UpdateBaseCurrencyRequest) (*v1.UpdateBaseCurrencyResponse, error)
+	CreateApiToken(context.Context, *v1.CreateApiTokenRequest) (*v1.CreateApiTokenResponse, error)
+	ListApiTokens(context.Context, *v1.ListApiTokensRequest) (*v1.ListApiTokensResponse, error)
+	RevokeApiToken(context.Context, *v1.RevokeApiTokenRequest) (*v1.RevokeApiTokenResponse, error)
 }
 
 // NewAuthServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -147,6 +232,31 @@ func NewAuthServiceHandler(svc AuthServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(authServiceMethods.ByName("UpdateLanguage")),
 		connect.WithHandlerOptions(opts...),
 	)
+	authServiceUpdateBaseCurrencyHandler := connect.NewUnaryHandlerSimple(
+		AuthServiceUpdateBaseCurrencyProcedure,
+		svc.UpdateBaseCurrency,
+		connect.WithSchema(authServiceMethods.ByName("UpdateBaseCurrency")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceCreateApiTokenHandler := connect.NewUnaryHandlerSimple(
+		AuthServiceCreateApiTokenProcedure,
+		svc.CreateApiToken,
+		connect.WithSchema(authServiceMethods.ByName("CreateApiToken")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceListApiTokensHandler := connect.NewUnaryHandlerSimple(
+		AuthServiceListApiTokensProcedure,
+		svc.ListApiTokens,
+		connect.WithSchema(authServiceMethods.ByName("ListApiTokens")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceRevokeApiTokenHandler := connect.NewUnaryHandlerSimple(
+		AuthServiceRevokeApiTokenProcedure,
+		svc.RevokeApiToken,
+		connect.WithSchema(authServiceMethods.ByName("RevokeApiToken")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/api.v1.AuthService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case AuthServiceMeProcedure:
@@ -155,6 +265,14 @@ func NewAuthServiceHandler(svc AuthServiceHandler, opts ...connect.HandlerOption
 			authServiceLogoutHandler.ServeHTTP(w, r)
 		case AuthServiceUpdateLanguageProcedure:
 			authServiceUpdateLanguageHandler.ServeHTTP(w, r)
+		case AuthServiceUpdateBaseCurrencyProcedure:
+			authServiceUpdateBaseCurrencyHandler.ServeHTTP(w, r)
+		case AuthServiceCreateApiTokenProcedure:
+			authServiceCreateApiTokenHandler.ServeHTTP(w, r)
+		case AuthServiceListApiTokensProcedure:
+			authServiceListApiTokensHandler.ServeHTTP(w, r)
+		case AuthServiceRevokeApiTokenProcedure:
+			authServiceRevokeApiTokenHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -175,3 +293,19 @@ func (UnimplementedAuthServiceHandler) Logout(context.Context, *v1.AuthLogoutReq
 func (UnimplementedAuthServiceHandler) UpdateLanguage(context.Context, *v1.UpdateLanguageRequest) (*v1.UpdateLanguageResponse, error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.AuthService.UpdateLanguage is not implemented"))
 }
+
+func (UnimplementedAuthServiceHandler) UpdateBaseCurrency(context.Context, *v1.UpdateBaseCurrencyRequest) (*v1.UpdateBaseCurrencyResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.AuthService.UpdateBaseCurrency is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) CreateApiToken(context.Context, *v1.CreateApiTokenRequest) (*v1.CreateApiTokenResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.AuthService.CreateApiToken is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) ListApiTokens(context.Context, *v1.ListApiTokensRequest) (*v1.ListApiTokensResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.AuthService.ListApiTokens is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) RevokeApiToken(context.Context, *v1.RevokeApiTokenRequest) (*v1.RevokeApiTokenResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.AuthService.RevokeApiToken is not implemented"))
+}
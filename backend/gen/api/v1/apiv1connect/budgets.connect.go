@@ -0,0 +1,249 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: api/v1/budgets.proto
+
+package apiv1connect
+
+import (
+	v1 "cashtrack/backend/gen/api/v1"
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// BudgetServiceName is the fully-qualified name of the BudgetService service.
+	BudgetServiceName = "api.v1.BudgetService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// BudgetServiceListBudgetsProcedure is the fully-qualified name of the BudgetService's ListBudgets
+	// RPC.
+	BudgetServiceListBudgetsProcedure = "/api.v1.BudgetService/ListBudgets"
+	// BudgetServiceCreateBudgetProcedure is the fully-qualified name of the BudgetService's
+	// CreateBudget RPC.
+	BudgetServiceCreateBudgetProcedure = "/api.v1.BudgetService/CreateBudget"
+	// BudgetServiceUpdateBudgetProcedure is the fully-qualified name of the BudgetService's
+	// UpdateBudget RPC.
+	BudgetServiceUpdateBudgetProcedure = "/api.v1.BudgetService/UpdateBudget"
+	// BudgetServiceDeleteBudgetProcedure is the fully-qualified name of the BudgetService's
+	// DeleteBudget RPC.
+	BudgetServiceDeleteBudgetProcedure = "/api.v1.BudgetService/DeleteBudget"
+	// BudgetServiceGetBudgetStatusProcedure is the fully-qualified name of the BudgetService's
+	// GetBudgetStatus RPC.
+	BudgetServiceGetBudgetStatusProcedure = "/api.v1.BudgetService/GetBudgetStatus"
+)
+
+// BudgetServiceClient is a client for the api.v1.BudgetService service.
+type BudgetServiceClient interface {
+	ListBudgets(context.Context, *v1.ListBudgetsRequest) (*v1.ListBudgetsResponse, error)
+	CreateBudget(context.Context, *v1.CreateBudgetRequest) (*v1.CreateBudgetResponse, error)
+	UpdateBudget(context.Context, *v1.UpdateBudgetRequest) (*v1.UpdateBudgetResponse, error)
+	DeleteBudget(context.Context, *v1.DeleteBudgetRequest) (*v1.DeleteBudgetResponse, error)
+	GetBudgetStatus(context.Context, *v1.GetBudgetStatusRequest) (*v1.GetBudgetStatusResponse, error)
+}
+
+// NewBudgetServiceClient constructs a client for the api.v1.BudgetService service. By default, it
+// uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewBudgetServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) BudgetServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	budgetServiceMethods := v1.File_api_v1_budgets_proto.Services().ByName("BudgetService").Methods()
+	return &budgetServiceClient{
+		listBudgets: connect.NewClient[v1.ListBudgetsRequest, v1.ListBudgetsResponse](
+			httpClient,
+			baseURL+BudgetServiceListBudgetsProcedure,
+			connect.WithSchema(budgetServiceMethods.ByName("ListBudgets")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		createBudget: connect.NewClient[v1.CreateBudgetRequest, v1.CreateBudgetResponse](
+			httpClient,
+			baseURL+BudgetServiceCreateBudgetProcedure,
+			connect.WithSchema(budgetServiceMethods.ByName("CreateBudget")),
+			connect.WithClientOptions(opts...),
+		),
+		updateBudget: connect.NewClient[v1.UpdateBudgetRequest, v1.UpdateBudgetResponse](
+			httpClient,
+			baseURL+BudgetServiceUpdateBudgetProcedure,
+			connect.WithSchema(budgetServiceMethods.ByName("UpdateBudget")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteBudget: connect.NewClient[v1.DeleteBudgetRequest, v1.DeleteBudgetResponse](
+			httpClient,
+			baseURL+BudgetServiceDeleteBudgetProcedure,
+			connect.WithSchema(budgetServiceMethods.ByName("DeleteBudget")),
+			connect.WithClientOptions(opts...),
+		),
+		getBudgetStatus: connect.NewClient[v1.GetBudgetStatusRequest, v1.GetBudgetStatusResponse](
+			httpClient,
+			baseURL+BudgetServiceGetBudgetStatusProcedure,
+			connect.WithSchema(budgetServiceMethods.ByName("GetBudgetStatus")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// budgetServiceClient implements BudgetServiceClient.
+type budgetServiceClient struct {
+	listBudgets     *connect.Client[v1.ListBudgetsRequest, v1.ListBudgetsResponse]
+	createBudget    *connect.Client[v1.CreateBudgetRequest, v1.CreateBudgetResponse]
+	updateBudget    *connect.Client[v1.UpdateBudgetRequest, v1.UpdateBudgetResponse]
+	deleteBudget    *connect.Client[v1.DeleteBudgetRequest, v1.DeleteBudgetResponse]
+	getBudgetStatus *connect.Client[v1.GetBudgetStatusRequest, v1.GetBudgetStatusResponse]
+}
+
+// ListBudgets calls api.v1.BudgetService.ListBudgets.
+func (c *budgetServiceClient) ListBudgets(ctx context.Context, req *v1.ListBudgetsRequest) (*v1.ListBudgetsResponse, error) {
+	response, err := c.listBudgets.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// CreateBudget calls api.v1.BudgetService.CreateBudget.
+func (c *budgetServiceClient) CreateBudget(ctx context.Context, req *v1.CreateBudgetRequest) (*v1.CreateBudgetResponse, error) {
+	response, err := c.createBudget.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// UpdateBudget calls api.v1.BudgetService.UpdateBudget.
+func (c *budgetServiceClient) UpdateBudget(ctx context.Context, req *v1.UpdateBudgetRequest) (*v1.UpdateBudgetResponse, error) {
+	response, err := c.updateBudget.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// DeleteBudget calls api.v1.BudgetService.DeleteBudget.
+func (c *budgetServiceClient) DeleteBudget(ctx context.Context, req *v1.DeleteBudgetRequest) (*v1.DeleteBudgetResponse, error) {
+	response, err := c.deleteBudget.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetBudgetStatus calls api.v1.BudgetService.GetBudgetStatus.
+func (c *budgetServiceClient) GetBudgetStatus(ctx context.Context, req *v1.GetBudgetStatusRequest) (*v1.GetBudgetStatusResponse, error) {
+	response, err := c.getBudgetStatus.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// BudgetServiceHandler is an implementation of the api.v1.BudgetService service.
+type BudgetServiceHandler interface {
+	ListBudgets(context.Context, *v1.ListBudgetsRequest) (*v1.ListBudgetsResponse, error)
+	CreateBudget(context.Context, *v1.CreateBudgetRequest) (*v1.CreateBudgetResponse, error)
+	UpdateBudget(context.Context, *v1.UpdateBudgetRequest) (*v1.UpdateBudgetResponse, error)
+	DeleteBudget(context.Context, *v1.DeleteBudgetRequest) (*v1.DeleteBudgetResponse, error)
+	GetBudgetStatus(context.Context, *v1.GetBudgetStatusRequest) (*v1.GetBudgetStatusResponse, error)
+}
+
+// NewBudgetServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewBudgetServiceHandler(svc BudgetServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	budgetServiceMethods := v1.File_api_v1_budgets_proto.Services().ByName("BudgetService").Methods()
+	budgetServiceListBudgetsHandler := connect.NewUnaryHandlerSimple(
+		BudgetServiceListBudgetsProcedure,
+		svc.ListBudgets,
+		connect.WithSchema(budgetServiceMethods.ByName("ListBudgets")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	budgetServiceCreateBudgetHandler := connect.NewUnaryHandlerSimple(
+		BudgetServiceCreateBudgetProcedure,
+		svc.CreateBudget,
+		connect.WithSchema(budgetServiceMethods.ByName("CreateBudget")),
+		connect.WithHandlerOptions(opts...),
+	)
+	budgetServiceUpdateBudgetHandler := connect.NewUnaryHandlerSimple(
+		BudgetServiceUpdateBudgetProcedure,
+		svc.UpdateBudget,
+		connect.WithSchema(budgetServiceMethods.ByName("UpdateBudget")),
+		connect.WithHandlerOptions(opts...),
+	)
+	budgetServiceDeleteBudgetHandler := connect.NewUnaryHandlerSimple(
+		BudgetServiceDeleteBudgetProcedure,
+		svc.DeleteBudget,
+		connect.WithSchema(budgetServiceMethods.ByName("DeleteBudget")),
+		connect.WithHandlerOptions(opts...),
+	)
+	budgetServiceGetBudgetStatusHandler := connect.NewUnaryHandlerSimple(
+		BudgetServiceGetBudgetStatusProcedure,
+		svc.GetBudgetStatus,
+		connect.WithSchema(budgetServiceMethods.ByName("GetBudgetStatus")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/api.v1.BudgetService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case BudgetServiceListBudgetsProcedure:
+			budgetServiceListBudgetsHandler.ServeHTTP(w, r)
+		case BudgetServiceCreateBudgetProcedure:
+			budgetServiceCreateBudgetHandler.ServeHTTP(w, r)
+		case BudgetServiceUpdateBudgetProcedure:
+			budgetServiceUpdateBudgetHandler.ServeHTTP(w, r)
+		case BudgetServiceDeleteBudgetProcedure:
+			budgetServiceDeleteBudgetHandler.ServeHTTP(w, r)
+		case BudgetServiceGetBudgetStatusProcedure:
+			budgetServiceGetBudgetStatusHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedBudgetServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedBudgetServiceHandler struct{}
+
+func (UnimplementedBudgetServiceHandler) ListBudgets(context.Context, *v1.ListBudgetsRequest) (*v1.ListBudgetsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.BudgetService.ListBudgets is not implemented"))
+}
+
+func (UnimplementedBudgetServiceHandler) CreateBudget(context.Context, *v1.CreateBudgetRequest) (*v1.CreateBudgetResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.BudgetService.CreateBudget is not implemented"))
+}
+
+func (UnimplementedBudgetServiceHandler) UpdateBudget(context.Context, *v1.UpdateBudgetRequest) (*v1.UpdateBudgetResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.BudgetService.UpdateBudget is not implemented"))
+}
+
+func (UnimplementedBudgetServiceHandler) DeleteBudget(context.Context, *v1.DeleteBudgetRequest) (*v1.DeleteBudgetResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.BudgetService.DeleteBudget is not implemented"))
+}
+
+func (UnimplementedBudgetServiceHandler) GetBudgetStatus(context.Context, *v1.GetBudgetStatusRequest) (*v1.GetBudgetStatusResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.BudgetService.GetBudgetStatus is not implemented"))
+}
@@ -60,6 +60,9 @@ const (
 	// CategoryServiceApplyCategoryRulesProcedure is the fully-qualified name of the CategoryService's
 	// ApplyCategoryRules RPC.
 	CategoryServiceApplyCategoryRulesProcedure = "/api.v1.CategoryService/ApplyCategoryRules"
+	// CategoryServicePreviewCategoryRulesProcedure is the fully-qualified name of the CategoryService's
+	// PreviewCategoryRules RPC.
+	CategoryServicePreviewCategoryRulesProcedure = "/api.v1.CategoryService/PreviewCategoryRules"
 	// CategoryServiceReorderCategoryRulesProcedure is the fully-qualified name of the CategoryService's
 	// ReorderCategoryRules RPC.
 	CategoryServiceReorderCategoryRulesProcedure = "/api.v1.CategoryService/ReorderCategoryRules"
@@ -76,6 +79,7 @@ type CategoryServiceClient interface {
 	UpdateCategoryRule(context.Context, *v1.UpdateCategoryRuleRequest) (*v1.UpdateCategoryRuleResponse, error)
 	DeleteCategoryRule(context.Context, *v1.DeleteCategoryRuleRequest) (*v1.DeleteCategoryRuleResponse, error)
 	ApplyCategoryRules(context.Context, *v1.ApplyCategoryRulesRequest) (*v1.ApplyCategoryRulesResponse, error)
+	PreviewCategoryRules(context.Context, *v1.PreviewCategoryRulesRequest) (*v1.PreviewCategoryRulesResponse, error)
 	ReorderCategoryRules(context.Context, *v1.ReorderCategoryRulesRequest) (*v1.ReorderCategoryRulesResponse, error)
 }
 
@@ -94,6 +98,7 @@ func NewCategoryServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 			httpClient,
 			baseURL+CategoryServiceListCategoriesProcedure,
 			connect.WithSchema(categoryServiceMethods.ByName("ListCategories")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 		createCategory: connect.NewClient[v1.CreateCategoryRequest, v1.CreateCategoryResponse](
@@ -118,6 +123,7 @@ func NewCategoryServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 			httpClient,
 			baseURL+CategoryServiceListCategoryRulesProcedure,
 			connect.WithSchema(categoryServiceMethods.ByName("ListCategoryRules")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 		createCategoryRule: connect.NewClient[v1.CreateCategoryRuleRequest, v1.CreateCategoryRuleResponse](
@@ -144,6 +150,13 @@ func NewCategoryServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 			connect.WithSchema(categoryServiceMethods.ByName("ApplyCategoryRules")),
 			connect.WithClientOptions(opts...),
 		),
+		previewCategoryRules: connect.NewClient[v1.PreviewCategoryRulesRequest, v1.PreviewCategoryRulesResponse](
+			httpClient,
+			baseURL+CategoryServicePreviewCategoryRulesProcedure,
+			connect.WithSchema(categoryServiceMethods.ByName("PreviewCategoryRules")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
 		reorderCategoryRules: connect.NewClient[v1.ReorderCategoryRulesRequest, v1.ReorderCategoryRulesResponse](
 			httpClient,
 			baseURL+CategoryServiceReorderCategoryRulesProcedure,
@@ -164,6 +177,7 @@ type categoryServiceClient struct {
 	updateCategoryRule   *connect.Client[v1.UpdateCategoryRuleRequest, v1.UpdateCategoryRuleResponse]
 	deleteCategoryRule   *connect.Client[v1.DeleteCategoryRuleRequest, v1.DeleteCategoryRuleResponse]
 	applyCategoryRules   *connect.Client[v1.ApplyCategoryRulesRequest, v1.ApplyCategoryRulesResponse]
+	previewCategoryRules *connect.Client[v1.PreviewCategoryRulesRequest, v1.PreviewCategoryRulesResponse]
 	reorderCategoryRules *connect.Client[v1.ReorderCategoryRulesRequest, v1.ReorderCategoryRulesResponse]
 }
 
@@ -248,6 +262,15 @@ func (c *categoryServiceClient) ApplyCategoryRules(ctx context.Context, req *v1.
 	return nil, err
 }
 
+// PreviewCategoryRules calls api.v1.CategoryService.PreviewCategoryRules.
+func (c *categoryServiceClient) PreviewCategoryRules(ctx context.Context, req *v1.PreviewCategoryRulesRequest) (*v1.PreviewCategoryRulesResponse, error) {
+	response, err := c.previewCategoryRules.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
 // ReorderCategoryRules calls api.v1.CategoryService.ReorderCategoryRules.
 func (c *categoryServiceClient) ReorderCategoryRules(ctx context.Context, req *v1.ReorderCategoryRulesRequest) (*v1.ReorderCategoryRulesResponse, error) {
 	response, err := c.reorderCategoryRules.CallUnary(ctx, connect.NewRequest(req))
@@ -268,6 +291,7 @@ type CategoryServiceHandler interface {
 	UpdateCategoryRule(context.Context, *v1.UpdateCategoryRuleRequest) (*v1.UpdateCategoryRuleResponse, error)
 	DeleteCategoryRule(context.Context, *v1.DeleteCategoryRuleRequest) (*v1.DeleteCategoryRuleResponse, error)
 	ApplyCategoryRules(context.Context, *v1.ApplyCategoryRulesRequest) (*v1.ApplyCategoryRulesResponse, error)
+	PreviewCategoryRules(context.Context, *v1.PreviewCategoryRulesRequest) (*v1.PreviewCategoryRulesResponse, error)
 	ReorderCategoryRules(context.Context, *v1.ReorderCategoryRulesRequest) (*v1.ReorderCategoryRulesResponse, error)
 }
 
@@ -282,6 +306,7 @@ func NewCategoryServiceHandler(svc CategoryServiceHandler, opts ...connect.Handl
 		CategoryServiceListCategoriesProcedure,
 		svc.ListCategories,
 		connect.WithSchema(categoryServiceMethods.ByName("ListCategories")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	categoryServiceCreateCategoryHandler := connect.NewUnaryHandlerSimple(
@@ -306,6 +331,7 @@ func NewCategoryServiceHandler(svc CategoryServiceHandler, opts ...connect.Handl
 		CategoryServiceListCategoryRulesProcedure,
 		svc.ListCategoryRules,
 		connect.WithSchema(categoryServiceMethods.ByName("ListCategoryRules")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	categoryServiceCreateCategoryRuleHandler := connect.NewUnaryHandlerSimple(
@@ -332,6 +358,13 @@ func NewCategoryServiceHandler(svc CategoryServiceHandler, opts ...connect.Handl
 		connect.WithSchema(categoryServiceMethods.ByName("ApplyCategoryRules")),
 		connect.WithHandlerOptions(opts...),
 	)
+	categoryServicePreviewCategoryRulesHandler := connect.NewUnaryHandlerSimple(
+		CategoryServicePreviewCategoryRulesProcedure,
+		svc.PreviewCategoryRules,
+		connect.WithSchema(categoryServiceMethods.ByName("PreviewCategoryRules")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
 	categoryServiceReorderCategoryRulesHandler := connect.NewUnaryHandlerSimple(
 		CategoryServiceReorderCategoryRulesProcedure,
 		svc.ReorderCategoryRules,
@@ -358,6 +391,8 @@ func NewCategoryServiceHandler(svc CategoryServiceHandler, opts ...connect.Handl
 			categoryServiceDeleteCategoryRuleHandler.ServeHTTP(w, r)
 		case CategoryServiceApplyCategoryRulesProcedure:
 			categoryServiceApplyCategoryRulesHandler.ServeHTTP(w, r)
+		case CategoryServicePreviewCategoryRulesProcedure:
+			categoryServicePreviewCategoryRulesHandler.ServeHTTP(w, r)
 		case CategoryServiceReorderCategoryRulesProcedure:
 			categoryServiceReorderCategoryRulesHandler.ServeHTTP(w, r)
 		default:
@@ -405,6 +440,10 @@ func (UnimplementedCategoryServiceHandler) ApplyCategoryRules(context.Context, *
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.CategoryService.ApplyCategoryRules is not implemented"))
 }
 
+func (UnimplementedCategoryServiceHandler) PreviewCategoryRules(context.Context, *v1.PreviewCategoryRulesRequest) (*v1.PreviewCategoryRulesResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.CategoryService.PreviewCategoryRules is not implemented"))
+}
+
 func (UnimplementedCategoryServiceHandler) ReorderCategoryRules(context.Context, *v1.ReorderCategoryRulesRequest) (*v1.ReorderCategoryRulesResponse, error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.CategoryService.ReorderCategoryRules is not implemented"))
 }
@@ -0,0 +1,249 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: api/v1/csv_templates.proto
+
+package apiv1connect
+
+import (
+	v1 "cashtrack/backend/gen/api/v1"
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// CsvTemplateServiceName is the fully-qualified name of the CsvTemplateService service.
+	CsvTemplateServiceName = "api.v1.CsvTemplateService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// CsvTemplateServiceListCsvTemplatesProcedure is the fully-qualified name of the
+	// CsvTemplateService's ListCsvTemplates RPC.
+	CsvTemplateServiceListCsvTemplatesProcedure = "/api.v1.CsvTemplateService/ListCsvTemplates"
+	// CsvTemplateServiceCreateCsvTemplateProcedure is the fully-qualified name of the
+	// CsvTemplateService's CreateCsvTemplate RPC.
+	CsvTemplateServiceCreateCsvTemplateProcedure = "/api.v1.CsvTemplateService/CreateCsvTemplate"
+	// CsvTemplateServiceUpdateCsvTemplateProcedure is the fully-qualified name of the
+	// CsvTemplateService's UpdateCsvTemplate RPC.
+	CsvTemplateServiceUpdateCsvTemplateProcedure = "/api.v1.CsvTemplateService/UpdateCsvTemplate"
+	// CsvTemplateServiceDeleteCsvTemplateProcedure is the fully-qualified name of the
+	// CsvTemplateService's DeleteCsvTemplate RPC.
+	CsvTemplateServiceDeleteCsvTemplateProcedure = "/api.v1.CsvTemplateService/DeleteCsvTemplate"
+	// CsvTemplateServiceTestCsvTemplateProcedure is the fully-qualified name of the
+	// CsvTemplateService's TestCsvTemplate RPC.
+	CsvTemplateServiceTestCsvTemplateProcedure = "/api.v1.CsvTemplateService/TestCsvTemplate"
+)
+
+// CsvTemplateServiceClient is a client for the api.v1.CsvTemplateService service.
+type CsvTemplateServiceClient interface {
+	ListCsvTemplates(context.Context, *v1.ListCsvTemplatesRequest) (*v1.ListCsvTemplatesResponse, error)
+	CreateCsvTemplate(context.Context, *v1.CreateCsvTemplateRequest) (*v1.CreateCsvTemplateResponse, error)
+	UpdateCsvTemplate(context.Context, *v1.UpdateCsvTemplateRequest) (*v1.UpdateCsvTemplateResponse, error)
+	DeleteCsvTemplate(context.Context, *v1.DeleteCsvTemplateRequest) (*v1.DeleteCsvTemplateResponse, error)
+	TestCsvTemplate(context.Context, *v1.TestCsvTemplateRequest) (*v1.TestCsvTemplateResponse, error)
+}
+
+// NewCsvTemplateServiceClient constructs a client for the api.v1.CsvTemplateService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewCsvTemplateServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) CsvTemplateServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	csvTemplateServiceMethods := v1.File_api_v1_csv_templates_proto.Services().ByName("CsvTemplateService").Methods()
+	return &csvTemplateServiceClient{
+		listCsvTemplates: connect.NewClient[v1.ListCsvTemplatesRequest, v1.ListCsvTemplatesResponse](
+			httpClient,
+			baseURL+CsvTemplateServiceListCsvTemplatesProcedure,
+			connect.WithSchema(csvTemplateServiceMethods.ByName("ListCsvTemplates")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		createCsvTemplate: connect.NewClient[v1.CreateCsvTemplateRequest, v1.CreateCsvTemplateResponse](
+			httpClient,
+			baseURL+CsvTemplateServiceCreateCsvTemplateProcedure,
+			connect.WithSchema(csvTemplateServiceMethods.ByName("CreateCsvTemplate")),
+			connect.WithClientOptions(opts...),
+		),
+		updateCsvTemplate: connect.NewClient[v1.UpdateCsvTemplateRequest, v1.UpdateCsvTemplateResponse](
+			httpClient,
+			baseURL+CsvTemplateServiceUpdateCsvTemplateProcedure,
+			connect.WithSchema(csvTemplateServiceMethods.ByName("UpdateCsvTemplate")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteCsvTemplate: connect.NewClient[v1.DeleteCsvTemplateRequest, v1.DeleteCsvTemplateResponse](
+			httpClient,
+			baseURL+CsvTemplateServiceDeleteCsvTemplateProcedure,
+			connect.WithSchema(csvTemplateServiceMethods.ByName("DeleteCsvTemplate")),
+			connect.WithClientOptions(opts...),
+		),
+		testCsvTemplate: connect.NewClient[v1.TestCsvTemplateRequest, v1.TestCsvTemplateResponse](
+			httpClient,
+			baseURL+CsvTemplateServiceTestCsvTemplateProcedure,
+			connect.WithSchema(csvTemplateServiceMethods.ByName("TestCsvTemplate")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// csvTemplateServiceClient implements CsvTemplateServiceClient.
+type csvTemplateServiceClient struct {
+	listCsvTemplates  *connect.Client[v1.ListCsvTemplatesRequest, v1.ListCsvTemplatesResponse]
+	createCsvTemplate *connect.Client[v1.CreateCsvTemplateRequest, v1.CreateCsvTemplateResponse]
+	updateCsvTemplate *connect.Client[v1.UpdateCsvTemplateRequest, v1.UpdateCsvTemplateResponse]
+	deleteCsvTemplate *connect.Client[v1.DeleteCsvTemplateRequest, v1.DeleteCsvTemplateResponse]
+	testCsvTemplate   *connect.Client[v1.TestCsvTemplateRequest, v1.TestCsvTemplateResponse]
+}
+
+// ListCsvTemplates calls api.v1.CsvTemplateService.ListCsvTemplates.
+func (c *csvTemplateServiceClient) ListCsvTemplates(ctx context.Context, req *v1.ListCsvTemplatesRequest) (*v1.ListCsvTemplatesResponse, error) {
+	response, err := c.listCsvTemplates.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// CreateCsvTemplate calls api.v1.CsvTemplateService.CreateCsvTemplate.
+func (c *csvTemplateServiceClient) CreateCsvTemplate(ctx context.Context, req *v1.CreateCsvTemplateRequest) (*v1.CreateCsvTemplateResponse, error) {
+	response, err := c.createCsvTemplate.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// UpdateCsvTemplate calls api.v1.CsvTemplateService.UpdateCsvTemplate.
+func (c *csvTemplateServiceClient) UpdateCsvTemplate(ctx context.Context, req *v1.UpdateCsvTemplateRequest) (*v1.UpdateCsvTemplateResponse, error) {
+	response, err := c.updateCsvTemplate.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// DeleteCsvTemplate calls api.v1.CsvTemplateService.DeleteCsvTemplate.
+func (c *csvTemplateServiceClient) DeleteCsvTemplate(ctx context.Context, req *v1.DeleteCsvTemplateRequest) (*v1.DeleteCsvTemplateResponse, error) {
+	response, err := c.deleteCsvTemplate.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// TestCsvTemplate calls api.v1.CsvTemplateService.TestCsvTemplate.
+func (c *csvTemplateServiceClient) TestCsvTemplate(ctx context.Context, req *v1.TestCsvTemplateRequest) (*v1.TestCsvTemplateResponse, error) {
+	response, err := c.testCsvTemplate.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// CsvTemplateServiceHandler is an implementation of the api.v1.CsvTemplateService service.
+type CsvTemplateServiceHandler interface {
+	ListCsvTemplates(context.Context, *v1.ListCsvTemplatesRequest) (*v1.ListCsvTemplatesResponse, error)
+	CreateCsvTemplate(context.Context, *v1.CreateCsvTemplateRequest) (*v1.CreateCsvTemplateResponse, error)
+	UpdateCsvTemplate(context.Context, *v1.UpdateCsvTemplateRequest) (*v1.UpdateCsvTemplateResponse, error)
+	DeleteCsvTemplate(context.Context, *v1.DeleteCsvTemplateRequest) (*v1.DeleteCsvTemplateResponse, error)
+	TestCsvTemplate(context.Context, *v1.TestCsvTemplateRequest) (*v1.TestCsvTemplateResponse, error)
+}
+
+// NewCsvTemplateServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewCsvTemplateServiceHandler(svc CsvTemplateServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	csvTemplateServiceMethods := v1.File_api_v1_csv_templates_proto.Services().ByName("CsvTemplateService").Methods()
+	csvTemplateServiceListCsvTemplatesHandler := connect.NewUnaryHandlerSimple(
+		CsvTemplateServiceListCsvTemplatesProcedure,
+		svc.ListCsvTemplates,
+		connect.WithSchema(csvTemplateServiceMethods.ByName("ListCsvTemplates")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	csvTemplateServiceCreateCsvTemplateHandler := connect.NewUnaryHandlerSimple(
+		CsvTemplateServiceCreateCsvTemplateProcedure,
+		svc.CreateCsvTemplate,
+		connect.WithSchema(csvTemplateServiceMethods.ByName("CreateCsvTemplate")),
+		connect.WithHandlerOptions(opts...),
+	)
+	csvTemplateServiceUpdateCsvTemplateHandler := connect.NewUnaryHandlerSimple(
+		CsvTemplateServiceUpdateCsvTemplateProcedure,
+		svc.UpdateCsvTemplate,
+		connect.WithSchema(csvTemplateServiceMethods.ByName("UpdateCsvTemplate")),
+		connect.WithHandlerOptions(opts...),
+	)
+	csvTemplateServiceDeleteCsvTemplateHandler := connect.NewUnaryHandlerSimple(
+		CsvTemplateServiceDeleteCsvTemplateProcedure,
+		svc.DeleteCsvTemplate,
+		connect.WithSchema(csvTemplateServiceMethods.ByName("DeleteCsvTemplate")),
+		connect.WithHandlerOptions(opts...),
+	)
+	csvTemplateServiceTestCsvTemplateHandler := connect.NewUnaryHandlerSimple(
+		CsvTemplateServiceTestCsvTemplateProcedure,
+		svc.TestCsvTemplate,
+		connect.WithSchema(csvTemplateServiceMethods.ByName("TestCsvTemplate")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/api.v1.CsvTemplateService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case CsvTemplateServiceListCsvTemplatesProcedure:
+			csvTemplateServiceListCsvTemplatesHandler.ServeHTTP(w, r)
+		case CsvTemplateServiceCreateCsvTemplateProcedure:
+			csvTemplateServiceCreateCsvTemplateHandler.ServeHTTP(w, r)
+		case CsvTemplateServiceUpdateCsvTemplateProcedure:
+			csvTemplateServiceUpdateCsvTemplateHandler.ServeHTTP(w, r)
+		case CsvTemplateServiceDeleteCsvTemplateProcedure:
+			csvTemplateServiceDeleteCsvTemplateHandler.ServeHTTP(w, r)
+		case CsvTemplateServiceTestCsvTemplateProcedure:
+			csvTemplateServiceTestCsvTemplateHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedCsvTemplateServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedCsvTemplateServiceHandler struct{}
+
+func (UnimplementedCsvTemplateServiceHandler) ListCsvTemplates(context.Context, *v1.ListCsvTemplatesRequest) (*v1.ListCsvTemplatesResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.CsvTemplateService.ListCsvTemplates is not implemented"))
+}
+
+func (UnimplementedCsvTemplateServiceHandler) CreateCsvTemplate(context.Context, *v1.CreateCsvTemplateRequest) (*v1.CreateCsvTemplateResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.CsvTemplateService.CreateCsvTemplate is not implemented"))
+}
+
+func (UnimplementedCsvTemplateServiceHandler) UpdateCsvTemplate(context.Context, *v1.UpdateCsvTemplateRequest) (*v1.UpdateCsvTemplateResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.CsvTemplateService.UpdateCsvTemplate is not implemented"))
+}
+
+func (UnimplementedCsvTemplateServiceHandler) DeleteCsvTemplate(context.Context, *v1.DeleteCsvTemplateRequest) (*v1.DeleteCsvTemplateResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.CsvTemplateService.DeleteCsvTemplate is not implemented"))
+}
+
+func (UnimplementedCsvTemplateServiceHandler) TestCsvTemplate(context.Context, *v1.TestCsvTemplateRequest) (*v1.TestCsvTemplateResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.CsvTemplateService.TestCsvTemplate is not implemented"))
+}
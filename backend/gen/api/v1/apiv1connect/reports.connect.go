@@ -45,6 +45,18 @@ const (
 	// ReportServiceDeleteReportProcedure is the fully-qualified name of the ReportService's
 	// DeleteReport RPC.
 	ReportServiceDeleteReportProcedure = "/api.v1.ReportService/DeleteReport"
+	// ReportServiceWatchReportsProcedure is the fully-qualified name of the ReportService's
+	// WatchReports RPC.
+	ReportServiceWatchReportsProcedure = "/api.v1.ReportService/WatchReports"
+	// ReportServiceGetReportDiagnosticsProcedure is the fully-qualified name of the ReportService's
+	// GetReportDiagnostics RPC.
+	ReportServiceGetReportDiagnosticsProcedure = "/api.v1.ReportService/GetReportDiagnostics"
+	// ReportServiceReprocessReportProcedure is the fully-qualified name of the ReportService's
+	// ReprocessReport RPC.
+	ReportServiceReprocessReportProcedure = "/api.v1.ReportService/ReprocessReport"
+	// ReportServiceReprocessReportsProcedure is the fully-qualified name of the ReportService's
+	// ReprocessReports RPC.
+	ReportServiceReprocessReportsProcedure = "/api.v1.ReportService/ReprocessReports"
 )
 
 // ReportServiceClient is a client for the api.v1.ReportService service.
@@ -53,6 +65,10 @@ type ReportServiceClient interface {
 	ListReports(context.Context, *v1.ListReportsRequest) (*v1.ListReportsResponse, error)
 	DownloadReport(context.Context, *v1.DownloadReportRequest) (*v1.DownloadReportResponse, error)
 	DeleteReport(context.Context, *v1.DeleteReportRequest) (*v1.DeleteReportResponse, error)
+	WatchReports(context.Context, *v1.WatchReportsRequest) (*connect.ServerStreamForClient[v1.WatchReportsResponse], error)
+	GetReportDiagnostics(context.Context, *v1.GetReportDiagnosticsRequest) (*v1.GetReportDiagnosticsResponse, error)
+	ReprocessReport(context.Context, *v1.ReprocessReportRequest) (*v1.ReprocessReportResponse, error)
+	ReprocessReports(context.Context, *v1.ReprocessReportsRequest) (*v1.ReprocessReportsResponse, error)
 }
 
 // NewReportServiceClient constructs a client for the api.v1.ReportService service. By default, it
@@ -76,12 +92,14 @@ func NewReportServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			httpClient,
 			baseURL+ReportServiceListReportsProcedure,
 			connect.WithSchema(reportServiceMethods.ByName("ListReports")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 		downloadReport: connect.NewClient[v1.DownloadReportRequest, v1.DownloadReportResponse](
 			httpClient,
 			baseURL+ReportServiceDownloadReportProcedure,
 			connect.WithSchema(reportServiceMethods.ByName("DownloadReport")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 		deleteReport: connect.NewClient[v1.DeleteReportRequest, v1.DeleteReportResponse](
@@ -90,15 +108,45 @@ func NewReportServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(reportServiceMethods.ByName("DeleteReport")),
 			connect.WithClientOptions(opts...),
 		),
+		watchReports: connect.NewClient[v1.WatchReportsRequest, v1.WatchReportsResponse](
+			httpClient,
+			baseURL+ReportServiceWatchReportsProcedure,
+			connect.WithSchema(reportServiceMethods.ByName("WatchReports")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getReportDiagnostics: connect.NewClient[v1.GetReportDiagnosticsRequest, v1.GetReportDiagnosticsResponse](
+			httpClient,
+			baseURL+ReportServiceGetReportDiagnosticsProcedure,
+			connect.WithSchema(reportServiceMethods.ByName("GetReportDiagnostics")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		reprocessReport: connect.NewClient[v1.ReprocessReportRequest, v1.ReprocessReportResponse](
+			httpClient,
+			baseURL+ReportServiceReprocessReportProcedure,
+			connect.WithSchema(reportServiceMethods.ByName("ReprocessReport")),
+			connect.WithClientOptions(opts...),
+		),
+		reprocessReports: connect.NewClient[v1.ReprocessReportsRequest, v1.ReprocessReportsResponse](
+			httpClient,
+			baseURL+ReportServiceReprocessReportsProcedure,
+			connect.WithSchema(reportServiceMethods.ByName("ReprocessReports")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // reportServiceClient implements ReportServiceClient.
 type reportServiceClient struct {
-	uploadReport   *connect.Client[v1.UploadReportRequest, v1.UploadReportResponse]
-	listReports    *connect.Client[v1.ListReportsRequest, v1.ListReportsResponse]
-	downloadReport *connect.Client[v1.DownloadReportRequest, v1.DownloadReportResponse]
-	deleteReport   *connect.Client[v1.DeleteReportRequest, v1.DeleteReportResponse]
+	uploadReport         *connect.Client[v1.UploadReportRequest, v1.UploadReportResponse]
+	listReports          *connect.Client[v1.ListReportsRequest, v1.ListReportsResponse]
+	downloadReport       *connect.Client[v1.DownloadReportRequest, v1.DownloadReportResponse]
+	deleteReport         *connect.Client[v1.DeleteReportRequest, v1.DeleteReportResponse]
+	watchReports         *connect.Client[v1.WatchReportsRequest, v1.WatchReportsResponse]
+	getReportDiagnostics *connect.Client[v1.GetReportDiagnosticsRequest, v1.GetReportDiagnosticsResponse]
+	reprocessReport      *connect.Client[v1.ReprocessReportRequest, v1.ReprocessReportResponse]
+	reprocessReports     *connect.Client[v1.ReprocessReportsRequest, v1.ReprocessReportsResponse]
 }
 
 // UploadReport calls api.v1.ReportService.UploadReport.
@@ -137,12 +185,48 @@ func (c *reportServiceClient) DeleteReport(ctx context.Context, req *v1.DeleteRe
 	return nil, err
 }
 
+// WatchReports calls api.v1.ReportService.WatchReports.
+func (c *reportServiceClient) WatchReports(ctx context.Context, req *v1.WatchReportsRequest) (*connect.ServerStreamForClient[v1.WatchReportsResponse], error) {
+	return c.watchReports.CallServerStream(ctx, connect.NewRequest(req))
+}
+
+// GetReportDiagnostics calls api.v1.ReportService.GetReportDiagnostics.
+func (c *reportServiceClient) GetReportDiagnostics(ctx context.Context, req *v1.GetReportDiagnosticsRequest) (*v1.GetReportDiagnosticsResponse, error) {
+	response, err := c.getReportDiagnostics.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ReprocessReport calls api.v1.ReportService.ReprocessReport.
+func (c *reportServiceClient) ReprocessReport(ctx context.Context, req *v1.ReprocessReportRequest) (*v1.ReprocessReportResponse, error) {
+	response, err := c.reprocessReport.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ReprocessReports calls api.v1.ReportService.ReprocessReports.
+func (c *reportServiceClient) ReprocessReports(ctx context.Context, req *v1.ReprocessReportsRequest) (*v1.ReprocessReportsResponse, error) {
+	response, err := c.reprocessReports.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
 // ReportServiceHandler is an implementation of the api.v1.ReportService service.
 type ReportServiceHandler interface {
 	UploadReport(context.Context, *v1.UploadReportRequest) (*v1.UploadReportResponse, error)
 	ListReports(context.Context, *v1.ListReportsRequest) (*v1.ListReportsResponse, error)
 	DownloadReport(context.Context, *v1.DownloadReportRequest) (*v1.DownloadReportResponse, error)
 	DeleteReport(context.Context, *v1.DeleteReportRequest) (*v1.DeleteReportResponse, error)
+	WatchReports(context.Context, *v1.WatchReportsRequest, *connect.ServerStream[v1.WatchReportsResponse]) error
+	GetReportDiagnostics(context.Context, *v1.GetReportDiagnosticsRequest) (*v1.GetReportDiagnosticsResponse, error)
+	ReprocessReport(context.Context, *v1.ReprocessReportRequest) (*v1.ReprocessReportResponse, error)
+	ReprocessReports(context.Context, *v1.ReprocessReportsRequest) (*v1.ReprocessReportsResponse, error)
 }
 
 // NewReportServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -162,12 +246,14 @@ func NewReportServiceHandler(svc ReportServiceHandler, opts ...connect.HandlerOp
 		ReportServiceListReportsProcedure,
 		svc.ListReports,
 		connect.WithSchema(reportServiceMethods.ByName("ListReports")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	reportServiceDownloadReportHandler := connect.NewUnaryHandlerSimple(
 		ReportServiceDownloadReportProcedure,
 		svc.DownloadReport,
 		connect.WithSchema(reportServiceMethods.ByName("DownloadReport")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	reportServiceDeleteReportHandler := connect.NewUnaryHandlerSimple(
@@ -176,6 +262,32 @@ func NewReportServiceHandler(svc ReportServiceHandler, opts ...connect.HandlerOp
 		connect.WithSchema(reportServiceMethods.ByName("DeleteReport")),
 		connect.WithHandlerOptions(opts...),
 	)
+	reportServiceWatchReportsHandler := connect.NewServerStreamHandlerSimple(
+		ReportServiceWatchReportsProcedure,
+		svc.WatchReports,
+		connect.WithSchema(reportServiceMethods.ByName("WatchReports")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	reportServiceGetReportDiagnosticsHandler := connect.NewUnaryHandlerSimple(
+		ReportServiceGetReportDiagnosticsProcedure,
+		svc.GetReportDiagnostics,
+		connect.WithSchema(reportServiceMethods.ByName("GetReportDiagnostics")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	reportServiceReprocessReportHandler := connect.NewUnaryHandlerSimple(
+		ReportServiceReprocessReportProcedure,
+		svc.ReprocessReport,
+		connect.WithSchema(reportServiceMethods.ByName("ReprocessReport")),
+		connect.WithHandlerOptions(opts...),
+	)
+	reportServiceReprocessReportsHandler := connect.NewUnaryHandlerSimple(
+		ReportServiceReprocessReportsProcedure,
+		svc.ReprocessReports,
+		connect.WithSchema(reportServiceMethods.ByName("ReprocessReports")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/api.v1.ReportService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case ReportServiceUploadReportProcedure:
@@ -186,6 +298,14 @@ func NewReportServiceHandler(svc ReportServiceHandler, opts ...connect.HandlerOp
 			reportServiceDownloadReportHandler.ServeHTTP(w, r)
 		case ReportServiceDeleteReportProcedure:
 			reportServiceDeleteReportHandler.ServeHTTP(w, r)
+		case ReportServiceWatchReportsProcedure:
+			reportServiceWatchReportsHandler.ServeHTTP(w, r)
+		case ReportServiceGetReportDiagnosticsProcedure:
+			reportServiceGetReportDiagnosticsHandler.ServeHTTP(w, r)
+		case ReportServiceReprocessReportProcedure:
+			reportServiceReprocessReportHandler.ServeHTTP(w, r)
+		case ReportServiceReprocessReportsProcedure:
+			reportServiceReprocessReportsHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -210,3 +330,19 @@ func (UnimplementedReportServiceHandler) DownloadReport(context.Context, *v1.Dow
 func (UnimplementedReportServiceHandler) DeleteReport(context.Context, *v1.DeleteReportRequest) (*v1.DeleteReportResponse, error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.ReportService.DeleteReport is not implemented"))
 }
+
+func (UnimplementedReportServiceHandler) WatchReports(context.Context, *v1.WatchReportsRequest, *connect.ServerStream[v1.WatchReportsResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.ReportService.WatchReports is not implemented"))
+}
+
+func (UnimplementedReportServiceHandler) GetReportDiagnostics(context.Context, *v1.GetReportDiagnosticsRequest) (*v1.GetReportDiagnosticsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.ReportService.GetReportDiagnostics is not implemented"))
+}
+
+func (UnimplementedReportServiceHandler) ReprocessReport(context.Context, *v1.ReprocessReportRequest) (*v1.ReprocessReportResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.ReportService.ReprocessReport is not implemented"))
+}
+
+func (UnimplementedReportServiceHandler) ReprocessReports(context.Context, *v1.ReprocessReportsRequest) (*v1.ReprocessReportsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.ReportService.ReprocessReports is not implemented"))
+}
@@ -39,12 +39,56 @@ const (
 	// TransactionServiceUpdateTransactionCategoryProcedure is the fully-qualified name of the
 	// TransactionService's UpdateTransactionCategory RPC.
 	TransactionServiceUpdateTransactionCategoryProcedure = "/api.v1.TransactionService/UpdateTransactionCategory"
+	// TransactionServiceGetTransactionAnalyticsProcedure is the fully-qualified name of the
+	// TransactionService's GetTransactionAnalytics RPC.
+	TransactionServiceGetTransactionAnalyticsProcedure = "/api.v1.TransactionService/GetTransactionAnalytics"
+	// TransactionServiceSetTransactionSplitsProcedure is the fully-qualified name of the
+	// TransactionService's SetTransactionSplits RPC.
+	TransactionServiceSetTransactionSplitsProcedure = "/api.v1.TransactionService/SetTransactionSplits"
+	// TransactionServiceDeleteTransactionSplitsProcedure is the fully-qualified name of the
+	// TransactionService's DeleteTransactionSplits RPC.
+	TransactionServiceDeleteTransactionSplitsProcedure = "/api.v1.TransactionService/DeleteTransactionSplits"
+	// TransactionServiceDetectTransfersProcedure is the fully-qualified name of the
+	// TransactionService's DetectTransfers RPC.
+	TransactionServiceDetectTransfersProcedure = "/api.v1.TransactionService/DetectTransfers"
+	// TransactionServiceListTransfersProcedure is the fully-qualified name of the TransactionService's
+	// ListTransfers RPC.
+	TransactionServiceListTransfersProcedure = "/api.v1.TransactionService/ListTransfers"
+	// TransactionServiceConfirmTransferProcedure is the fully-qualified name of the
+	// TransactionService's ConfirmTransfer RPC.
+	TransactionServiceConfirmTransferProcedure = "/api.v1.TransactionService/ConfirmTransfer"
+	// TransactionServiceUnlinkTransferProcedure is the fully-qualified name of the TransactionService's
+	// UnlinkTransfer RPC.
+	TransactionServiceUnlinkTransferProcedure = "/api.v1.TransactionService/UnlinkTransfer"
+	// TransactionServiceExportTransactionsProcedure is the fully-qualified name of the
+	// TransactionService's ExportTransactions RPC.
+	TransactionServiceExportTransactionsProcedure = "/api.v1.TransactionService/ExportTransactions"
+	// TransactionServiceListLedgerAccountMappingsProcedure is the fully-qualified name of the
+	// TransactionService's ListLedgerAccountMappings RPC.
+	TransactionServiceListLedgerAccountMappingsProcedure = "/api.v1.TransactionService/ListLedgerAccountMappings"
+	// TransactionServiceSetLedgerAccountMappingProcedure is the fully-qualified name of the
+	// TransactionService's SetLedgerAccountMapping RPC.
+	TransactionServiceSetLedgerAccountMappingProcedure = "/api.v1.TransactionService/SetLedgerAccountMapping"
+	// TransactionServiceDeleteLedgerAccountMappingProcedure is the fully-qualified name of the
+	// TransactionService's DeleteLedgerAccountMapping RPC.
+	TransactionServiceDeleteLedgerAccountMappingProcedure = "/api.v1.TransactionService/DeleteLedgerAccountMapping"
 )
 
 // TransactionServiceClient is a client for the api.v1.TransactionService service.
 type TransactionServiceClient interface {
 	ListTransactions(context.Context, *v1.ListTransactionsRequest) (*v1.ListTransactionsResponse, error)
 	UpdateTransactionCategory(context.Context, *v1.UpdateTransactionCategoryRequest) (*v1.UpdateTransactionCategoryResponse, error)
+	GetTransactionAnalytics(context.Context, *v1.GetTransactionAnalyticsRequest) (*v1.GetTransactionAnalyticsResponse, error)
+	SetTransactionSplits(context.Context, *v1.SetTransactionSplitsRequest) (*v1.SetTransactionSplitsResponse, error)
+	DeleteTransactionSplits(context.Context, *v1.DeleteTransactionSplitsRequest) (*v1.DeleteTransactionSplitsResponse, error)
+	DetectTransfers(context.Context, *v1.DetectTransfersRequest) (*v1.DetectTransfersResponse, error)
+	ListTransfers(context.Context, *v1.ListTransfersRequest) (*v1.ListTransfersResponse, error)
+	ConfirmTransfer(context.Context, *v1.ConfirmTransferRequest) (*v1.ConfirmTransferResponse, error)
+	UnlinkTransfer(context.Context, *v1.UnlinkTransferRequest) (*v1.UnlinkTransferResponse, error)
+	ExportTransactions(context.Context, *v1.ExportTransactionsRequest) (*connect.ServerStreamForClient[v1.ExportTransactionsResponse], error)
+	ListLedgerAccountMappings(context.Context, *v1.ListLedgerAccountMappingsRequest) (*v1.ListLedgerAccountMappingsResponse, error)
+	SetLedgerAccountMapping(context.Context, *v1.SetLedgerAccountMappingRequest) (*v1.SetLedgerAccountMappingResponse, error)
+	DeleteLedgerAccountMapping(context.Context, *v1.DeleteLedgerAccountMappingRequest) (*v1.DeleteLedgerAccountMappingResponse, error)
 }
 
 // NewTransactionServiceClient constructs a client for the api.v1.TransactionService service. By
@@ -62,6 +106,7 @@ func NewTransactionServiceClient(httpClient connect.HTTPClient, baseURL string,
 			httpClient,
 			baseURL+TransactionServiceListTransactionsProcedure,
 			connect.WithSchema(transactionServiceMethods.ByName("ListTransactions")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 		updateTransactionCategory: connect.NewClient[v1.UpdateTransactionCategoryRequest, v1.UpdateTransactionCategoryResponse](
@@ -70,13 +115,94 @@ func NewTransactionServiceClient(httpClient connect.HTTPClient, baseURL string,
 			connect.WithSchema(transactionServiceMethods.ByName("UpdateTransactionCategory")),
 			connect.WithClientOptions(opts...),
 		),
+		getTransactionAnalytics: connect.NewClient[v1.GetTransactionAnalyticsRequest, v1.GetTransactionAnalyticsResponse](
+			httpClient,
+			baseURL+TransactionServiceGetTransactionAnalyticsProcedure,
+			connect.WithSchema(transactionServiceMethods.ByName("GetTransactionAnalytics")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		setTransactionSplits: connect.NewClient[v1.SetTransactionSplitsRequest, v1.SetTransactionSplitsResponse](
+			httpClient,
+			baseURL+TransactionServiceSetTransactionSplitsProcedure,
+			connect.WithSchema(transactionServiceMethods.ByName("SetTransactionSplits")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteTransactionSplits: connect.NewClient[v1.DeleteTransactionSplitsRequest, v1.DeleteTransactionSplitsResponse](
+			httpClient,
+			baseURL+TransactionServiceDeleteTransactionSplitsProcedure,
+			connect.WithSchema(transactionServiceMethods.ByName("DeleteTransactionSplits")),
+			connect.WithClientOptions(opts...),
+		),
+		detectTransfers: connect.NewClient[v1.DetectTransfersRequest, v1.DetectTransfersResponse](
+			httpClient,
+			baseURL+TransactionServiceDetectTransfersProcedure,
+			connect.WithSchema(transactionServiceMethods.ByName("DetectTransfers")),
+			connect.WithClientOptions(opts...),
+		),
+		listTransfers: connect.NewClient[v1.ListTransfersRequest, v1.ListTransfersResponse](
+			httpClient,
+			baseURL+TransactionServiceListTransfersProcedure,
+			connect.WithSchema(transactionServiceMethods.ByName("ListTransfers")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		confirmTransfer: connect.NewClient[v1.ConfirmTransferRequest, v1.ConfirmTransferResponse](
+			httpClient,
+			baseURL+TransactionServiceConfirmTransferProcedure,
+			connect.WithSchema(transactionServiceMethods.ByName("ConfirmTransfer")),
+			connect.WithClientOptions(opts...),
+		),
+		unlinkTransfer: connect.NewClient[v1.UnlinkTransferRequest, v1.UnlinkTransferResponse](
+			httpClient,
+			baseURL+TransactionServiceUnlinkTransferProcedure,
+			connect.WithSchema(transactionServiceMethods.ByName("UnlinkTransfer")),
+			connect.WithClientOptions(opts...),
+		),
+		exportTransactions: connect.NewClient[v1.ExportTransactionsRequest, v1.ExportTransactionsResponse](
+			httpClient,
+			baseURL+TransactionServiceExportTransactionsProcedure,
+			connect.WithSchema(transactionServiceMethods.ByName("ExportTransactions")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		listLedgerAccountMappings: connect.NewClient[v1.ListLedgerAccountMappingsRequest, v1.ListLedgerAccountMappingsResponse](
+			httpClient,
+			baseURL+TransactionServiceListLedgerAccountMappingsProcedure,
+			connect.WithSchema(transactionServiceMethods.ByName("ListLedgerAccountMappings")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		setLedgerAccountMapping: connect.NewClient[v1.SetLedgerAccountMappingRequest, v1.SetLedgerAccountMappingResponse](
+			httpClient,
+			baseURL+TransactionServiceSetLedgerAccountMappingProcedure,
+			connect.WithSchema(transactionServiceMethods.ByName("SetLedgerAccountMapping")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteLedgerAccountMapping: connect.NewClient[v1.DeleteLedgerAccountMappingRequest, v1.DeleteLedgerAccountMappingResponse](
+			httpClient,
+			baseURL+TransactionServiceDeleteLedgerAccountMappingProcedure,
+			connect.WithSchema(transactionServiceMethods.ByName("DeleteLedgerAccountMapping")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // transactionServiceClient implements TransactionServiceClient.
 type transactionServiceClient struct {
-	listTransactions          *connect.Client[v1.ListTransactionsRequest, v1.ListTransactionsResponse]
-	updateTransactionCategory *connect.Client[v1.UpdateTransactionCategoryRequest, v1.UpdateTransactionCategoryResponse]
+	listTransactions           *connect.Client[v1.ListTransactionsRequest, v1.ListTransactionsResponse]
+	updateTransactionCategory  *connect.Client[v1.UpdateTransactionCategoryRequest, v1.UpdateTransactionCategoryResponse]
+	getTransactionAnalytics    *connect.Client[v1.GetTransactionAnalyticsRequest, v1.GetTransactionAnalyticsResponse]
+	setTransactionSplits       *connect.Client[v1.SetTransactionSplitsRequest, v1.SetTransactionSplitsResponse]
+	deleteTransactionSplits    *connect.Client[v1.DeleteTransactionSplitsRequest, v1.DeleteTransactionSplitsResponse]
+	detectTransfers            *connect.Client[v1.DetectTransfersRequest, v1.DetectTransfersResponse]
+	listTransfers              *connect.Client[v1.ListTransfersRequest, v1.ListTransfersResponse]
+	confirmTransfer            *connect.Client[v1.ConfirmTransferRequest, v1.ConfirmTransferResponse]
+	unlinkTransfer             *connect.Client[v1.UnlinkTransferRequest, v1.UnlinkTransferResponse]
+	exportTransactions         *connect.Client[v1.ExportTransactionsRequest, v1.ExportTransactionsResponse]
+	listLedgerAccountMappings  *connect.Client[v1.ListLedgerAccountMappingsRequest, v1.ListLedgerAccountMappingsResponse]
+	setLedgerAccountMapping    *connect.Client[v1.SetLedgerAccountMappingRequest, v1.SetLedgerAccountMappingResponse]
+	deleteLedgerAccountMapping *connect.Client[v1.DeleteLedgerAccountMappingRequest, v1.DeleteLedgerAccountMappingResponse]
 }
 
 // ListTransactions calls api.v1.TransactionService.ListTransactions.
@@ -97,10 +223,116 @@ func (c *transactionServiceClient) UpdateTransactionCategory(ctx context.Context
 	return nil, err
 }
 
+// GetTransactionAnalytics calls api.v1.TransactionService.GetTransactionAnalytics.
+func (c *transactionServiceClient) GetTransactionAnalytics(ctx context.Context, req *v1.GetTransactionAnalyticsRequest) (*v1.GetTransactionAnalyticsResponse, error) {
+	response, err := c.getTransactionAnalytics.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// SetTransactionSplits calls api.v1.TransactionService.SetTransactionSplits.
+func (c *transactionServiceClient) SetTransactionSplits(ctx context.Context, req *v1.SetTransactionSplitsRequest) (*v1.SetTransactionSplitsResponse, error) {
+	response, err := c.setTransactionSplits.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// DeleteTransactionSplits calls api.v1.TransactionService.DeleteTransactionSplits.
+func (c *transactionServiceClient) DeleteTransactionSplits(ctx context.Context, req *v1.DeleteTransactionSplitsRequest) (*v1.DeleteTransactionSplitsResponse, error) {
+	response, err := c.deleteTransactionSplits.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// DetectTransfers calls api.v1.TransactionService.DetectTransfers.
+func (c *transactionServiceClient) DetectTransfers(ctx context.Context, req *v1.DetectTransfersRequest) (*v1.DetectTransfersResponse, error) {
+	response, err := c.detectTransfers.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListTransfers calls api.v1.TransactionService.ListTransfers.
+func (c *transactionServiceClient) ListTransfers(ctx context.Context, req *v1.ListTransfersRequest) (*v1.ListTransfersResponse, error) {
+	response, err := c.listTransfers.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ConfirmTransfer calls api.v1.TransactionService.ConfirmTransfer.
+func (c *transactionServiceClient) ConfirmTransfer(ctx context.Context, req *v1.ConfirmTransferRequest) (*v1.ConfirmTransferResponse, error) {
+	response, err := c.confirmTransfer.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// UnlinkTransfer calls api.v1.TransactionService.UnlinkTransfer.
+func (c *transactionServiceClient) UnlinkTransfer(ctx context.Context, req *v1.UnlinkTransferRequest) (*v1.UnlinkTransferResponse, error) {
+	response, err := c.unlinkTransfer.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ExportTransactions calls api.v1.TransactionService.ExportTransactions.
+func (c *transactionServiceClient) ExportTransactions(ctx context.Context, req *v1.ExportTransactionsRequest) (*connect.ServerStreamForClient[v1.ExportTransactionsResponse], error) {
+	return c.exportTransactions.CallServerStream(ctx, connect.NewRequest(req))
+}
+
+// ListLedgerAccountMappings calls api.v1.TransactionService.ListLedgerAccountMappings.
+func (c *transactionServiceClient) ListLedgerAccountMappings(ctx context.Context, req *v1.ListLedgerAccountMappingsRequest) (*v1.ListLedgerAccountMappingsResponse, error) {
+	response, err := c.listLedgerAccountMappings.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// SetLedgerAccountMapping calls api.v1.TransactionService.SetLedgerAccountMapping.
+func (c *transactionServiceClient) SetLedgerAccountMapping(ctx context.Context, req *v1.SetLedgerAccountMappingRequest) (*v1.SetLedgerAccountMappingResponse, error) {
+	response, err := c.setLedgerAccountMapping.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// DeleteLedgerAccountMapping calls api.v1.TransactionService.DeleteLedgerAccountMapping.
+func (c *transactionServiceClient) DeleteLedgerAccountMapping(ctx context.Context, req *v1.DeleteLedgerAccountMappingRequest) (*v1.DeleteLedgerAccountMappingResponse, error) {
+	response, err := c.deleteLedgerAccountMapping.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
 // TransactionServiceHandler is an implementation of the api.v1.TransactionService service.
 type TransactionServiceHandler interface {
 	ListTransactions(context.Context, *v1.ListTransactionsRequest) (*v1.ListTransactionsResponse, error)
 	UpdateTransactionCategory(context.Context, *v1.UpdateTransactionCategoryRequest) (*v1.UpdateTransactionCategoryResponse, error)
+	GetTransactionAnalytics(context.Context, *v1.GetTransactionAnalyticsRequest) (*v1.GetTransactionAnalyticsResponse, error)
+	SetTransactionSplits(context.Context, *v1.SetTransactionSplitsRequest) (*v1.SetTransactionSplitsResponse, error)
+	DeleteTransactionSplits(context.Context, *v1.DeleteTransactionSplitsRequest) (*v1.DeleteTransactionSplitsResponse, error)
+	DetectTransfers(context.Context, *v1.DetectTransfersRequest) (*v1.DetectTransfersResponse, error)
+	ListTransfers(context.Context, *v1.ListTransfersRequest) (*v1.ListTransfersResponse, error)
+	ConfirmTransfer(context.Context, *v1.ConfirmTransferRequest) (*v1.ConfirmTransferResponse, error)
+	UnlinkTransfer(context.Context, *v1.UnlinkTransferRequest) (*v1.UnlinkTransferResponse, error)
+	ExportTransactions(context.Context, *v1.ExportTransactionsRequest, *connect.ServerStream[v1.ExportTransactionsResponse]) error
+	ListLedgerAccountMappings(context.Context, *v1.ListLedgerAccountMappingsRequest) (*v1.ListLedgerAccountMappingsResponse, error)
+	SetLedgerAccountMapping(context.Context, *v1.SetLedgerAccountMappingRequest) (*v1.SetLedgerAccountMappingResponse, error)
+	DeleteLedgerAccountMapping(context.Context, *v1.DeleteLedgerAccountMappingRequest) (*v1.DeleteLedgerAccountMappingResponse, error)
 }
 
 // NewTransactionServiceHandler builds an HTTP handler from the service implementation. It returns
@@ -114,6 +346,7 @@ func NewTransactionServiceHandler(svc TransactionServiceHandler, opts ...connect
 		TransactionServiceListTransactionsProcedure,
 		svc.ListTransactions,
 		connect.WithSchema(transactionServiceMethods.ByName("ListTransactions")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	transactionServiceUpdateTransactionCategoryHandler := connect.NewUnaryHandlerSimple(
@@ -122,12 +355,104 @@ func NewTransactionServiceHandler(svc TransactionServiceHandler, opts ...connect
 		connect.WithSchema(transactionServiceMethods.ByName("UpdateTransactionCategory")),
 		connect.WithHandlerOptions(opts...),
 	)
+	transactionServiceGetTransactionAnalyticsHandler := connect.NewUnaryHandlerSimple(
+		TransactionServiceGetTransactionAnalyticsProcedure,
+		svc.GetTransactionAnalytics,
+		connect.WithSchema(transactionServiceMethods.ByName("GetTransactionAnalytics")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	transactionServiceSetTransactionSplitsHandler := connect.NewUnaryHandlerSimple(
+		TransactionServiceSetTransactionSplitsProcedure,
+		svc.SetTransactionSplits,
+		connect.WithSchema(transactionServiceMethods.ByName("SetTransactionSplits")),
+		connect.WithHandlerOptions(opts...),
+	)
+	transactionServiceDeleteTransactionSplitsHandler := connect.NewUnaryHandlerSimple(
+		TransactionServiceDeleteTransactionSplitsProcedure,
+		svc.DeleteTransactionSplits,
+		connect.WithSchema(transactionServiceMethods.ByName("DeleteTransactionSplits")),
+		connect.WithHandlerOptions(opts...),
+	)
+	transactionServiceDetectTransfersHandler := connect.NewUnaryHandlerSimple(
+		TransactionServiceDetectTransfersProcedure,
+		svc.DetectTransfers,
+		connect.WithSchema(transactionServiceMethods.ByName("DetectTransfers")),
+		connect.WithHandlerOptions(opts...),
+	)
+	transactionServiceListTransfersHandler := connect.NewUnaryHandlerSimple(
+		TransactionServiceListTransfersProcedure,
+		svc.ListTransfers,
+		connect.WithSchema(transactionServiceMethods.ByName("ListTransfers")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	transactionServiceConfirmTransferHandler := connect.NewUnaryHandlerSimple(
+		TransactionServiceConfirmTransferProcedure,
+		svc.ConfirmTransfer,
+		connect.WithSchema(transactionServiceMethods.ByName("ConfirmTransfer")),
+		connect.WithHandlerOptions(opts...),
+	)
+	transactionServiceUnlinkTransferHandler := connect.NewUnaryHandlerSimple(
+		TransactionServiceUnlinkTransferProcedure,
+		svc.UnlinkTransfer,
+		connect.WithSchema(transactionServiceMethods.ByName("UnlinkTransfer")),
+		connect.WithHandlerOptions(opts...),
+	)
+	transactionServiceExportTransactionsHandler := connect.NewServerStreamHandlerSimple(
+		TransactionServiceExportTransactionsProcedure,
+		svc.ExportTransactions,
+		connect.WithSchema(transactionServiceMethods.ByName("ExportTransactions")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	transactionServiceListLedgerAccountMappingsHandler := connect.NewUnaryHandlerSimple(
+		TransactionServiceListLedgerAccountMappingsProcedure,
+		svc.ListLedgerAccountMappings,
+		connect.WithSchema(transactionServiceMethods.ByName("ListLedgerAccountMappings")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	transactionServiceSetLedgerAccountMappingHandler := connect.NewUnaryHandlerSimple(
+		TransactionServiceSetLedgerAccountMappingProcedure,
+		svc.SetLedgerAccountMapping,
+		connect.WithSchema(transactionServiceMethods.ByName("SetLedgerAccountMapping")),
+		connect.WithHandlerOptions(opts...),
+	)
+	transactionServiceDeleteLedgerAccountMappingHandler := connect.NewUnaryHandlerSimple(
+		TransactionServiceDeleteLedgerAccountMappingProcedure,
+		svc.DeleteLedgerAccountMapping,
+		connect.WithSchema(transactionServiceMethods.ByName("DeleteLedgerAccountMapping")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/api.v1.TransactionService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case TransactionServiceListTransactionsProcedure:
 			transactionServiceListTransactionsHandler.ServeHTTP(w, r)
 		case TransactionServiceUpdateTransactionCategoryProcedure:
 			transactionServiceUpdateTransactionCategoryHandler.ServeHTTP(w, r)
+		case TransactionServiceGetTransactionAnalyticsProcedure:
+			transactionServiceGetTransactionAnalyticsHandler.ServeHTTP(w, r)
+		case TransactionServiceSetTransactionSplitsProcedure:
+			transactionServiceSetTransactionSplitsHandler.ServeHTTP(w, r)
+		case TransactionServiceDeleteTransactionSplitsProcedure:
+			transactionServiceDeleteTransactionSplitsHandler.ServeHTTP(w, r)
+		case TransactionServiceDetectTransfersProcedure:
+			transactionServiceDetectTransfersHandler.ServeHTTP(w, r)
+		case TransactionServiceListTransfersProcedure:
+			transactionServiceListTransfersHandler.ServeHTTP(w, r)
+		case TransactionServiceConfirmTransferProcedure:
+			transactionServiceConfirmTransferHandler.ServeHTTP(w, r)
+		case TransactionServiceUnlinkTransferProcedure:
+			transactionServiceUnlinkTransferHandler.ServeHTTP(w, r)
+		case TransactionServiceExportTransactionsProcedure:
+			transactionServiceExportTransactionsHandler.ServeHTTP(w, r)
+		case TransactionServiceListLedgerAccountMappingsProcedure:
+			transactionServiceListLedgerAccountMappingsHandler.ServeHTTP(w, r)
+		case TransactionServiceSetLedgerAccountMappingProcedure:
+			transactionServiceSetLedgerAccountMappingHandler.ServeHTTP(w, r)
+		case TransactionServiceDeleteLedgerAccountMappingProcedure:
+			transactionServiceDeleteLedgerAccountMappingHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -144,3 +469,47 @@ func (UnimplementedTransactionServiceHandler) ListTransactions(context.Context,
 func (UnimplementedTransactionServiceHandler) UpdateTransactionCategory(context.Context, *v1.UpdateTransactionCategoryRequest) (*v1.UpdateTransactionCategoryResponse, error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.TransactionService.UpdateTransactionCategory is not implemented"))
 }
+
+func (UnimplementedTransactionServiceHandler) GetTransactionAnalytics(context.Context, *v1.GetTransactionAnalyticsRequest) (*v1.GetTransactionAnalyticsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.TransactionService.GetTransactionAnalytics is not implemented"))
+}
+
+func (UnimplementedTransactionServiceHandler) SetTransactionSplits(context.Context, *v1.SetTransactionSplitsRequest) (*v1.SetTransactionSplitsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.TransactionService.SetTransactionSplits is not implemented"))
+}
+
+func (UnimplementedTransactionServiceHandler) DeleteTransactionSplits(context.Context, *v1.DeleteTransactionSplitsRequest) (*v1.DeleteTransactionSplitsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.TransactionService.DeleteTransactionSplits is not implemented"))
+}
+
+func (UnimplementedTransactionServiceHandler) DetectTransfers(context.Context, *v1.DetectTransfersRequest) (*v1.DetectTransfersResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.TransactionService.DetectTransfers is not implemented"))
+}
+
+func (UnimplementedTransactionServiceHandler) ListTransfers(context.Context, *v1.ListTransfersRequest) (*v1.ListTransfersResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.TransactionService.ListTransfers is not implemented"))
+}
+
+func (UnimplementedTransactionServiceHandler) ConfirmTransfer(context.Context, *v1.ConfirmTransferRequest) (*v1.ConfirmTransferResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.TransactionService.ConfirmTransfer is not implemented"))
+}
+
+func (UnimplementedTransactionServiceHandler) UnlinkTransfer(context.Context, *v1.UnlinkTransferRequest) (*v1.UnlinkTransferResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.TransactionService.UnlinkTransfer is not implemented"))
+}
+
+func (UnimplementedTransactionServiceHandler) ExportTransactions(context.Context, *v1.ExportTransactionsRequest, *connect.ServerStream[v1.ExportTransactionsResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.TransactionService.ExportTransactions is not implemented"))
+}
+
+func (UnimplementedTransactionServiceHandler) ListLedgerAccountMappings(context.Context, *v1.ListLedgerAccountMappingsRequest) (*v1.ListLedgerAccountMappingsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.TransactionService.ListLedgerAccountMappings is not implemented"))
+}
+
+func (UnimplementedTransactionServiceHandler) SetLedgerAccountMapping(context.Context, *v1.SetLedgerAccountMappingRequest) (*v1.SetLedgerAccountMappingResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.TransactionService.SetLedgerAccountMapping is not implemented"))
+}
+
+func (UnimplementedTransactionServiceHandler) DeleteLedgerAccountMapping(context.Context, *v1.DeleteLedgerAccountMappingRequest) (*v1.DeleteLedgerAccountMappingResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.TransactionService.DeleteLedgerAccountMapping is not implemented"))
+}
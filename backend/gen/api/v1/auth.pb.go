@@ -26,6 +26,7 @@ type User struct {
 	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
 	Language      string                 `protobuf:"bytes,3,opt,name=language,proto3" json:"language,omitempty"`
+	BaseCurrency  string                 `protobuf:"bytes,4,opt,name=base_currency,json=baseCurrency,proto3" json:"base_currency,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -81,6 +82,13 @@ func (x *User) GetLanguage() string {
 	return ""
 }
 
+func (x *User) GetBaseCurrency() string {
+	if x != nil {
+		return x.BaseCurrency
+	}
+	return ""
+}
+
 type AuthMeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -321,15 +329,452 @@ func (x *UpdateLanguageResponse) GetUser() *User {
 	return nil
 }
 
+type UpdateBaseCurrencyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BaseCurrency  string                 `protobuf:"bytes,1,opt,name=base_currency,json=baseCurrency,proto3" json:"base_currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateBaseCurrencyRequest) Reset() {
+	*x = UpdateBaseCurrencyRequest{}
+	mi := &file_api_v1_auth_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateBaseCurrencyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBaseCurrencyRequest) ProtoMessage() {}
+
+func (x *UpdateBaseCurrencyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_auth_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBaseCurrencyRequest.ProtoReflect.Descriptor instead.
+func (*UpdateBaseCurrencyRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_auth_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdateBaseCurrencyRequest) GetBaseCurrency() string {
+	if x != nil {
+		return x.BaseCurrency
+	}
+	return ""
+}
+
+type UpdateBaseCurrencyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateBaseCurrencyResponse) Reset() {
+	*x = UpdateBaseCurrencyResponse{}
+	mi := &file_api_v1_auth_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateBaseCurrencyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBaseCurrencyResponse) ProtoMessage() {}
+
+func (x *UpdateBaseCurrencyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_auth_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBaseCurrencyResponse.ProtoReflect.Descriptor instead.
+func (*UpdateBaseCurrencyResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_auth_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdateBaseCurrencyResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type ApiToken struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Prefix        string                 `protobuf:"bytes,3,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Scopes        []string               `protobuf:"bytes,4,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	LastUsedAt    string                 `protobuf:"bytes,6,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApiToken) Reset() {
+	*x = ApiToken{}
+	mi := &file_api_v1_auth_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApiToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiToken) ProtoMessage() {}
+
+func (x *ApiToken) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_auth_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiToken.ProtoReflect.Descriptor instead.
+func (*ApiToken) Descriptor() ([]byte, []int) {
+	return file_api_v1_auth_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ApiToken) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ApiToken) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ApiToken) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *ApiToken) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *ApiToken) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *ApiToken) GetLastUsedAt() string {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return ""
+}
+
+type CreateApiTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Scopes        []string               `protobuf:"bytes,2,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateApiTokenRequest) Reset() {
+	*x = CreateApiTokenRequest{}
+	mi := &file_api_v1_auth_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateApiTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateApiTokenRequest) ProtoMessage() {}
+
+func (x *CreateApiTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_auth_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateApiTokenRequest.ProtoReflect.Descriptor instead.
+func (*CreateApiTokenRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_auth_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CreateApiTokenRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateApiTokenRequest) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+type CreateApiTokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiToken      *ApiToken              `protobuf:"bytes,1,opt,name=api_token,json=apiToken,proto3" json:"api_token,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateApiTokenResponse) Reset() {
+	*x = CreateApiTokenResponse{}
+	mi := &file_api_v1_auth_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateApiTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateApiTokenResponse) ProtoMessage() {}
+
+func (x *CreateApiTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_auth_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateApiTokenResponse.ProtoReflect.Descriptor instead.
+func (*CreateApiTokenResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_auth_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CreateApiTokenResponse) GetApiToken() *ApiToken {
+	if x != nil {
+		return x.ApiToken
+	}
+	return nil
+}
+
+func (x *CreateApiTokenResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type ListApiTokensRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListApiTokensRequest) Reset() {
+	*x = ListApiTokensRequest{}
+	mi := &file_api_v1_auth_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListApiTokensRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApiTokensRequest) ProtoMessage() {}
+
+func (x *ListApiTokensRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_auth_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListApiTokensRequest.ProtoReflect.Descriptor instead.
+func (*ListApiTokensRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_auth_proto_rawDescGZIP(), []int{12}
+}
+
+type ListApiTokensResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiTokens     []*ApiToken            `protobuf:"bytes,1,rep,name=api_tokens,json=apiTokens,proto3" json:"api_tokens,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListApiTokensResponse) Reset() {
+	*x = ListApiTokensResponse{}
+	mi := &file_api_v1_auth_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListApiTokensResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApiTokensResponse) ProtoMessage() {}
+
+func (x *ListApiTokensResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_auth_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListApiTokensResponse.ProtoReflect.Descriptor instead.
+func (*ListApiTokensResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_auth_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListApiTokensResponse) GetApiTokens() []*ApiToken {
+	if x != nil {
+		return x.ApiTokens
+	}
+	return nil
+}
+
+type RevokeApiTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeApiTokenRequest) Reset() {
+	*x = RevokeApiTokenRequest{}
+	mi := &file_api_v1_auth_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeApiTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeApiTokenRequest) ProtoMessage() {}
+
+func (x *RevokeApiTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_auth_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeApiTokenRequest.ProtoReflect.Descriptor instead.
+func (*RevokeApiTokenRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_auth_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RevokeApiTokenRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type RevokeApiTokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeApiTokenResponse) Reset() {
+	*x = RevokeApiTokenResponse{}
+	mi := &file_api_v1_auth_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeApiTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeApiTokenResponse) ProtoMessage() {}
+
+func (x *RevokeApiTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_auth_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeApiTokenResponse.ProtoReflect.Descriptor instead.
+func (*RevokeApiTokenResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_auth_proto_rawDescGZIP(), []int{15}
+}
+
 var File_api_v1_auth_proto protoreflect.FileDescriptor
 
 const file_api_v1_auth_proto_rawDesc = "" +
 	"\n" +
-	"\x11api/v1/auth.proto\x12\x06api.v1\"N\n" +
+	"\x11api/v1/auth.proto\x12\x06api.v1\"s\n" +
 	"\x04User\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1a\n" +
 	"\busername\x18\x02 \x01(\tR\busername\x12\x1a\n" +
-	"\blanguage\x18\x03 \x01(\tR\blanguage\"\x0f\n" +
+	"\blanguage\x18\x03 \x01(\tR\blanguage\x12#\n" +
+	"\rbase_currency\x18\x04 \x01(\tR\fbaseCurrency\"\x0f\n" +
 	"\rAuthMeRequest\"2\n" +
 	"\x0eAuthMeResponse\x12 \n" +
 	"\x04user\x18\x01 \x01(\v2\f.api.v1.UserR\x04user\"\x13\n" +
@@ -338,11 +783,41 @@ const file_api_v1_auth_proto_rawDesc = "" +
 	"\x15UpdateLanguageRequest\x12\x1a\n" +
 	"\blanguage\x18\x01 \x01(\tR\blanguage\":\n" +
 	"\x16UpdateLanguageResponse\x12 \n" +
-	"\x04user\x18\x01 \x01(\v2\f.api.v1.UserR\x04user2\xda\x01\n" +
+	"\x04user\x18\x01 \x01(\v2\f.api.v1.UserR\x04user\"@\n" +
+	"\x19UpdateBaseCurrencyRequest\x12#\n" +
+	"\rbase_currency\x18\x01 \x01(\tR\fbaseCurrency\">\n" +
+	"\x1aUpdateBaseCurrencyResponse\x12 \n" +
+	"\x04user\x18\x01 \x01(\v2\f.api.v1.UserR\x04user\"\x9f\x01\n" +
+	"\bApiToken\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06prefix\x18\x03 \x01(\tR\x06prefix\x12\x16\n" +
+	"\x06scopes\x18\x04 \x03(\tR\x06scopes\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\tR\tcreatedAt\x12 \n" +
+	"\flast_used_at\x18\x06 \x01(\tR\n" +
+	"lastUsedAt\"C\n" +
+	"\x15CreateApiTokenRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06scopes\x18\x02 \x03(\tR\x06scopes\"]\n" +
+	"\x16CreateApiTokenResponse\x12-\n" +
+	"\tapi_token\x18\x01 \x01(\v2\x10.api.v1.ApiTokenR\bapiToken\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\"\x16\n" +
+	"\x14ListApiTokensRequest\"H\n" +
+	"\x15ListApiTokensResponse\x12/\n" +
+	"\n" +
+	"api_tokens\x18\x01 \x03(\v2\x10.api.v1.ApiTokenR\tapiTokens\"'\n" +
+	"\x15RevokeApiTokenRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"\x18\n" +
+	"\x16RevokeApiTokenResponse2\xb2\x04\n" +
 	"\vAuthService\x125\n" +
 	"\x02Me\x12\x15.api.v1.AuthMeRequest\x1a\x16.api.v1.AuthMeResponse\"\x00\x12A\n" +
 	"\x06Logout\x12\x19.api.v1.AuthLogoutRequest\x1a\x1a.api.v1.AuthLogoutResponse\"\x00\x12Q\n" +
-	"\x0eUpdateLanguage\x12\x1d.api.v1.UpdateLanguageRequest\x1a\x1e.api.v1.UpdateLanguageResponse\"\x00Bt\n" +
+	"\x0eUpdateLanguage\x12\x1d.api.v1.UpdateLanguageRequest\x1a\x1e.api.v1.UpdateLanguageResponse\"\x00\x12]\n" +
+	"\x12UpdateBaseCurrency\x12!.api.v1.UpdateBaseCurrencyRequest\x1a\".api.v1.UpdateBaseCurrencyResponse\"\x00\x12Q\n" +
+	"\x0eCreateApiToken\x12\x1d.api.v1.CreateApiTokenRequest\x1a\x1e.api.v1.CreateApiTokenResponse\"\x00\x12Q\n" +
+	"\rListApiTokens\x12\x1c.api.v1.ListApiTokensRequest\x1a\x1d.api.v1.ListApiTokensResponse\"\x03\x90\x02\x01\x12Q\n" +
+	"\x0eRevokeApiToken\x12\x1d.api.v1.RevokeApiTokenRequest\x1a\x1e.api.v1.RevokeApiTokenResponse\"\x00Bt\n" +
 	"\n" +
 	"com.api.v1B\tAuthProtoP\x01Z\"cashtrack/backend/gen/api/v1;apiv1\xa2\x02\x03AXX\xaa\x02\x06Api.V1\xca\x02\x06Api\\V1\xe2\x02\x12Api\\V1\\GPBMetadata\xea\x02\aApi::V1b\x06proto3"
 
@@ -358,30 +833,50 @@ func file_api_v1_auth_proto_rawDescGZIP() []byte {
 	return file_api_v1_auth_proto_rawDescData
 }
 
-var file_api_v1_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_api_v1_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_api_v1_auth_proto_goTypes = []any{
-	(*User)(nil),                   // 0: api.v1.User
-	(*AuthMeRequest)(nil),          // 1: api.v1.AuthMeRequest
-	(*AuthMeResponse)(nil),         // 2: api.v1.AuthMeResponse
-	(*AuthLogoutRequest)(nil),      // 3: api.v1.AuthLogoutRequest
-	(*AuthLogoutResponse)(nil),     // 4: api.v1.AuthLogoutResponse
-	(*UpdateLanguageRequest)(nil),  // 5: api.v1.UpdateLanguageRequest
-	(*UpdateLanguageResponse)(nil), // 6: api.v1.UpdateLanguageResponse
+	(*User)(nil),                       // 0: api.v1.User
+	(*AuthMeRequest)(nil),              // 1: api.v1.AuthMeRequest
+	(*AuthMeResponse)(nil),             // 2: api.v1.AuthMeResponse
+	(*AuthLogoutRequest)(nil),          // 3: api.v1.AuthLogoutRequest
+	(*AuthLogoutResponse)(nil),         // 4: api.v1.AuthLogoutResponse
+	(*UpdateLanguageRequest)(nil),      // 5: api.v1.UpdateLanguageRequest
+	(*UpdateLanguageResponse)(nil),     // 6: api.v1.UpdateLanguageResponse
+	(*UpdateBaseCurrencyRequest)(nil),  // 7: api.v1.UpdateBaseCurrencyRequest
+	(*UpdateBaseCurrencyResponse)(nil), // 8: api.v1.UpdateBaseCurrencyResponse
+	(*ApiToken)(nil),                   // 9: api.v1.ApiToken
+	(*CreateApiTokenRequest)(nil),      // 10: api.v1.CreateApiTokenRequest
+	(*CreateApiTokenResponse)(nil),     // 11: api.v1.CreateApiTokenResponse
+	(*ListApiTokensRequest)(nil),       // 12: api.v1.ListApiTokensRequest
+	(*ListApiTokensResponse)(nil),      // 13: api.v1.ListApiTokensResponse
+	(*RevokeApiTokenRequest)(nil),      // 14: api.v1.RevokeApiTokenRequest
+	(*RevokeApiTokenResponse)(nil),     // 15: api.v1.RevokeApiTokenResponse
 }
 var file_api_v1_auth_proto_depIdxs = []int32{
-	0, // 0: api.v1.AuthMeResponse.user:type_name -> api.v1.User
-	0, // 1: api.v1.UpdateLanguageResponse.user:type_name -> api.v1.User
-	1, // 2: api.v1.AuthService.Me:input_type -> api.v1.AuthMeRequest
-	3, // 3: api.v1.AuthService.Logout:input_type -> api.v1.AuthLogoutRequest
-	5, // 4: api.v1.AuthService.UpdateLanguage:input_type -> api.v1.UpdateLanguageRequest
-	2, // 5: api.v1.AuthService.Me:output_type -> api.v1.AuthMeResponse
-	4, // 6: api.v1.AuthService.Logout:output_type -> api.v1.AuthLogoutResponse
-	6, // 7: api.v1.AuthService.UpdateLanguage:output_type -> api.v1.UpdateLanguageResponse
-	5, // [5:8] is the sub-list for method output_type
-	2, // [2:5] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	0,  // 0: api.v1.AuthMeResponse.user:type_name -> api.v1.User
+	0,  // 1: api.v1.UpdateLanguageResponse.user:type_name -> api.v1.User
+	0,  // 2: api.v1.UpdateBaseCurrencyResponse.user:type_name -> api.v1.User
+	9,  // 3: api.v1.CreateApiTokenResponse.api_token:type_name -> api.v1.ApiToken
+	9,  // 4: api.v1.ListApiTokensResponse.api_tokens:type_name -> api.v1.ApiToken
+	1,  // 5: api.v1.AuthService.Me:input_type -> api.v1.AuthMeRequest
+	3,  // 6: api.v1.AuthService.Logout:input_type -> api.v1.AuthLogoutRequest
+	5,  // 7: api.v1.AuthService.UpdateLanguage:input_type -> api.v1.UpdateLanguageRequest
+	7,  // 8: api.v1.AuthService.UpdateBaseCurrency:input_type -> api.v1.UpdateBaseCurrencyRequest
+	10, // 9: api.v1.AuthService.CreateApiToken:input_type -> api.v1.CreateApiTokenRequest
+	12, // 10: api.v1.AuthService.ListApiTokens:input_type -> api.v1.ListApiTokensRequest
+	14, // 11: api.v1.AuthService.RevokeApiToken:input_type -> api.v1.RevokeApiTokenRequest
+	2,  // 12: api.v1.AuthService.Me:output_type -> api.v1.AuthMeResponse
+	4,  // 13: api.v1.AuthService.Logout:output_type -> api.v1.AuthLogoutResponse
+	6,  // 14: api.v1.AuthService.UpdateLanguage:output_type -> api.v1.UpdateLanguageResponse
+	8,  // 15: api.v1.AuthService.UpdateBaseCurrency:output_type -> api.v1.UpdateBaseCurrencyResponse
+	11, // 16: api.v1.AuthService.CreateApiToken:output_type -> api.v1.CreateApiTokenResponse
+	13, // 17: api.v1.AuthService.ListApiTokens:output_type -> api.v1.ListApiTokensResponse
+	15, // 18: api.v1.AuthService.RevokeApiToken:output_type -> api.v1.RevokeApiTokenResponse
+	12, // [12:19] is the sub-list for method output_type
+	5,  // [5:12] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_api_v1_auth_proto_init() }
@@ -395,7 +890,7 @@ func file_api_v1_auth_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_auth_proto_rawDesc), len(file_api_v1_auth_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -0,0 +1,810 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: api/v1/budgets.proto
+
+package apiv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Budget struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CategoryId    int32                  `protobuf:"varint,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Period        string                 `protobuf:"bytes,3,opt,name=period,proto3" json:"period,omitempty"`
+	Amount        int64                  `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency      string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Budget) Reset() {
+	*x = Budget{}
+	mi := &file_api_v1_budgets_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Budget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Budget) ProtoMessage() {}
+
+func (x *Budget) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_budgets_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Budget.ProtoReflect.Descriptor instead.
+func (*Budget) Descriptor() ([]byte, []int) {
+	return file_api_v1_budgets_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Budget) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Budget) GetCategoryId() int32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *Budget) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *Budget) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Budget) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Budget) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type BudgetStatus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BudgetId      int32                  `protobuf:"varint,1,opt,name=budget_id,json=budgetId,proto3" json:"budget_id,omitempty"`
+	CategoryId    int32                  `protobuf:"varint,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Period        string                 `protobuf:"bytes,3,opt,name=period,proto3" json:"period,omitempty"`
+	PeriodStart   string                 `protobuf:"bytes,4,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`
+	PeriodEnd     string                 `protobuf:"bytes,5,opt,name=period_end,json=periodEnd,proto3" json:"period_end,omitempty"`
+	Budgeted      int64                  `protobuf:"varint,6,opt,name=budgeted,proto3" json:"budgeted,omitempty"`
+	Spent         int64                  `protobuf:"varint,7,opt,name=spent,proto3" json:"spent,omitempty"`
+	Remaining     int64                  `protobuf:"varint,8,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	Currency      string                 `protobuf:"bytes,9,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BudgetStatus) Reset() {
+	*x = BudgetStatus{}
+	mi := &file_api_v1_budgets_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BudgetStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BudgetStatus) ProtoMessage() {}
+
+func (x *BudgetStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_budgets_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BudgetStatus.ProtoReflect.Descriptor instead.
+func (*BudgetStatus) Descriptor() ([]byte, []int) {
+	return file_api_v1_budgets_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BudgetStatus) GetBudgetId() int32 {
+	if x != nil {
+		return x.BudgetId
+	}
+	return 0
+}
+
+func (x *BudgetStatus) GetCategoryId() int32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *BudgetStatus) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *BudgetStatus) GetPeriodStart() string {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return ""
+}
+
+func (x *BudgetStatus) GetPeriodEnd() string {
+	if x != nil {
+		return x.PeriodEnd
+	}
+	return ""
+}
+
+func (x *BudgetStatus) GetBudgeted() int64 {
+	if x != nil {
+		return x.Budgeted
+	}
+	return 0
+}
+
+func (x *BudgetStatus) GetSpent() int64 {
+	if x != nil {
+		return x.Spent
+	}
+	return 0
+}
+
+func (x *BudgetStatus) GetRemaining() int64 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
+func (x *BudgetStatus) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type ListBudgetsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBudgetsRequest) Reset() {
+	*x = ListBudgetsRequest{}
+	mi := &file_api_v1_budgets_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBudgetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBudgetsRequest) ProtoMessage() {}
+
+func (x *ListBudgetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_budgets_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBudgetsRequest.ProtoReflect.Descriptor instead.
+func (*ListBudgetsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_budgets_proto_rawDescGZIP(), []int{2}
+}
+
+type ListBudgetsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Budgets       []*Budget              `protobuf:"bytes,1,rep,name=budgets,proto3" json:"budgets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBudgetsResponse) Reset() {
+	*x = ListBudgetsResponse{}
+	mi := &file_api_v1_budgets_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBudgetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBudgetsResponse) ProtoMessage() {}
+
+func (x *ListBudgetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_budgets_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBudgetsResponse.ProtoReflect.Descriptor instead.
+func (*ListBudgetsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_budgets_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListBudgetsResponse) GetBudgets() []*Budget {
+	if x != nil {
+		return x.Budgets
+	}
+	return nil
+}
+
+type CreateBudgetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId    int32                  `protobuf:"varint,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Period        string                 `protobuf:"bytes,2,opt,name=period,proto3" json:"period,omitempty"`
+	Amount        int64                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBudgetRequest) Reset() {
+	*x = CreateBudgetRequest{}
+	mi := &file_api_v1_budgets_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBudgetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBudgetRequest) ProtoMessage() {}
+
+func (x *CreateBudgetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_budgets_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBudgetRequest.ProtoReflect.Descriptor instead.
+func (*CreateBudgetRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_budgets_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateBudgetRequest) GetCategoryId() int32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *CreateBudgetRequest) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *CreateBudgetRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type CreateBudgetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Budget        *Budget                `protobuf:"bytes,1,opt,name=budget,proto3" json:"budget,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBudgetResponse) Reset() {
+	*x = CreateBudgetResponse{}
+	mi := &file_api_v1_budgets_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBudgetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBudgetResponse) ProtoMessage() {}
+
+func (x *CreateBudgetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_budgets_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBudgetResponse.ProtoReflect.Descriptor instead.
+func (*CreateBudgetResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_budgets_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateBudgetResponse) GetBudget() *Budget {
+	if x != nil {
+		return x.Budget
+	}
+	return nil
+}
+
+type UpdateBudgetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CategoryId    int32                  `protobuf:"varint,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Period        string                 `protobuf:"bytes,3,opt,name=period,proto3" json:"period,omitempty"`
+	Amount        int64                  `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateBudgetRequest) Reset() {
+	*x = UpdateBudgetRequest{}
+	mi := &file_api_v1_budgets_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateBudgetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBudgetRequest) ProtoMessage() {}
+
+func (x *UpdateBudgetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_budgets_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBudgetRequest.ProtoReflect.Descriptor instead.
+func (*UpdateBudgetRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_budgets_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdateBudgetRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateBudgetRequest) GetCategoryId() int32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *UpdateBudgetRequest) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *UpdateBudgetRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type UpdateBudgetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateBudgetResponse) Reset() {
+	*x = UpdateBudgetResponse{}
+	mi := &file_api_v1_budgets_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateBudgetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBudgetResponse) ProtoMessage() {}
+
+func (x *UpdateBudgetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_budgets_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBudgetResponse.ProtoReflect.Descriptor instead.
+func (*UpdateBudgetResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_budgets_proto_rawDescGZIP(), []int{7}
+}
+
+type DeleteBudgetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBudgetRequest) Reset() {
+	*x = DeleteBudgetRequest{}
+	mi := &file_api_v1_budgets_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBudgetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBudgetRequest) ProtoMessage() {}
+
+func (x *DeleteBudgetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_budgets_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBudgetRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBudgetRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_budgets_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeleteBudgetRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteBudgetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBudgetResponse) Reset() {
+	*x = DeleteBudgetResponse{}
+	mi := &file_api_v1_budgets_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBudgetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBudgetResponse) ProtoMessage() {}
+
+func (x *DeleteBudgetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_budgets_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBudgetResponse.ProtoReflect.Descriptor instead.
+func (*DeleteBudgetResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_budgets_proto_rawDescGZIP(), []int{9}
+}
+
+type GetBudgetStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Periods       int32                  `protobuf:"varint,2,opt,name=periods,proto3" json:"periods,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBudgetStatusRequest) Reset() {
+	*x = GetBudgetStatusRequest{}
+	mi := &file_api_v1_budgets_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBudgetStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBudgetStatusRequest) ProtoMessage() {}
+
+func (x *GetBudgetStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_budgets_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBudgetStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetBudgetStatusRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_budgets_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetBudgetStatusRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *GetBudgetStatusRequest) GetPeriods() int32 {
+	if x != nil {
+		return x.Periods
+	}
+	return 0
+}
+
+type GetBudgetStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Statuses      []*BudgetStatus        `protobuf:"bytes,1,rep,name=statuses,proto3" json:"statuses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBudgetStatusResponse) Reset() {
+	*x = GetBudgetStatusResponse{}
+	mi := &file_api_v1_budgets_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBudgetStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBudgetStatusResponse) ProtoMessage() {}
+
+func (x *GetBudgetStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_budgets_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBudgetStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetBudgetStatusResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_budgets_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetBudgetStatusResponse) GetStatuses() []*BudgetStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+var File_api_v1_budgets_proto protoreflect.FileDescriptor
+
+const file_api_v1_budgets_proto_rawDesc = "" +
+	"\n" +
+	"\x14api/v1/budgets.proto\x12\x06api.v1\"\xa4\x01\n" +
+	"\x06Budget\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1f\n" +
+	"\vcategory_id\x18\x02 \x01(\x05R\n" +
+	"categoryId\x12\x16\n" +
+	"\x06period\x18\x03 \x01(\tR\x06period\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x03R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\x05 \x01(\tR\bcurrency\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt\"\x92\x02\n" +
+	"\fBudgetStatus\x12\x1b\n" +
+	"\tbudget_id\x18\x01 \x01(\x05R\bbudgetId\x12\x1f\n" +
+	"\vcategory_id\x18\x02 \x01(\x05R\n" +
+	"categoryId\x12\x16\n" +
+	"\x06period\x18\x03 \x01(\tR\x06period\x12!\n" +
+	"\fperiod_start\x18\x04 \x01(\tR\vperiodStart\x12\x1d\n" +
+	"\n" +
+	"period_end\x18\x05 \x01(\tR\tperiodEnd\x12\x1a\n" +
+	"\bbudgeted\x18\x06 \x01(\x03R\bbudgeted\x12\x14\n" +
+	"\x05spent\x18\a \x01(\x03R\x05spent\x12\x1c\n" +
+	"\tremaining\x18\b \x01(\x03R\tremaining\x12\x1a\n" +
+	"\bcurrency\x18\t \x01(\tR\bcurrency\"\x14\n" +
+	"\x12ListBudgetsRequest\"?\n" +
+	"\x13ListBudgetsResponse\x12(\n" +
+	"\abudgets\x18\x01 \x03(\v2\x0e.api.v1.BudgetR\abudgets\"f\n" +
+	"\x13CreateBudgetRequest\x12\x1f\n" +
+	"\vcategory_id\x18\x01 \x01(\x05R\n" +
+	"categoryId\x12\x16\n" +
+	"\x06period\x18\x02 \x01(\tR\x06period\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x03R\x06amount\">\n" +
+	"\x14CreateBudgetResponse\x12&\n" +
+	"\x06budget\x18\x01 \x01(\v2\x0e.api.v1.BudgetR\x06budget\"v\n" +
+	"\x13UpdateBudgetRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1f\n" +
+	"\vcategory_id\x18\x02 \x01(\x05R\n" +
+	"categoryId\x12\x16\n" +
+	"\x06period\x18\x03 \x01(\tR\x06period\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x03R\x06amount\"\x16\n" +
+	"\x14UpdateBudgetResponse\"%\n" +
+	"\x13DeleteBudgetRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"\x16\n" +
+	"\x14DeleteBudgetResponse\"F\n" +
+	"\x16GetBudgetStatusRequest\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x18\n" +
+	"\aperiods\x18\x02 \x01(\x05R\aperiods\"K\n" +
+	"\x17GetBudgetStatusResponse\x120\n" +
+	"\bstatuses\x18\x01 \x03(\v2\x14.api.v1.BudgetStatusR\bstatuses2\x9c\x03\n" +
+	"\rBudgetService\x12K\n" +
+	"\vListBudgets\x12\x1a.api.v1.ListBudgetsRequest\x1a\x1b.api.v1.ListBudgetsResponse\"\x03\x90\x02\x01\x12K\n" +
+	"\fCreateBudget\x12\x1b.api.v1.CreateBudgetRequest\x1a\x1c.api.v1.CreateBudgetResponse\"\x00\x12K\n" +
+	"\fUpdateBudget\x12\x1b.api.v1.UpdateBudgetRequest\x1a\x1c.api.v1.UpdateBudgetResponse\"\x00\x12K\n" +
+	"\fDeleteBudget\x12\x1b.api.v1.DeleteBudgetRequest\x1a\x1c.api.v1.DeleteBudgetResponse\"\x00\x12W\n" +
+	"\x0fGetBudgetStatus\x12\x1e.api.v1.GetBudgetStatusRequest\x1a\x1f.api.v1.GetBudgetStatusResponse\"\x03\x90\x02\x01Bw\n" +
+	"\n" +
+	"com.api.v1B\fBudgetsProtoP\x01Z\"cashtrack/backend/gen/api/v1;apiv1\xa2\x02\x03AXX\xaa\x02\x06Api.V1\xca\x02\x06Api\\V1\xe2\x02\x12Api\\V1\\GPBMetadata\xea\x02\aApi::V1b\x06proto3"
+
+var (
+	file_api_v1_budgets_proto_rawDescOnce sync.Once
+	file_api_v1_budgets_proto_rawDescData []byte
+)
+
+func file_api_v1_budgets_proto_rawDescGZIP() []byte {
+	file_api_v1_budgets_proto_rawDescOnce.Do(func() {
+		file_api_v1_budgets_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_v1_budgets_proto_rawDesc), len(file_api_v1_budgets_proto_rawDesc)))
+	})
+	return file_api_v1_budgets_proto_rawDescData
+}
+
+var file_api_v1_budgets_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_api_v1_budgets_proto_goTypes = []any{
+	(*Budget)(nil),                  // 0: api.v1.Budget
+	(*BudgetStatus)(nil),            // 1: api.v1.BudgetStatus
+	(*ListBudgetsRequest)(nil),      // 2: api.v1.ListBudgetsRequest
+	(*ListBudgetsResponse)(nil),     // 3: api.v1.ListBudgetsResponse
+	(*CreateBudgetRequest)(nil),     // 4: api.v1.CreateBudgetRequest
+	(*CreateBudgetResponse)(nil),    // 5: api.v1.CreateBudgetResponse
+	(*UpdateBudgetRequest)(nil),     // 6: api.v1.UpdateBudgetRequest
+	(*UpdateBudgetResponse)(nil),    // 7: api.v1.UpdateBudgetResponse
+	(*DeleteBudgetRequest)(nil),     // 8: api.v1.DeleteBudgetRequest
+	(*DeleteBudgetResponse)(nil),    // 9: api.v1.DeleteBudgetResponse
+	(*GetBudgetStatusRequest)(nil),  // 10: api.v1.GetBudgetStatusRequest
+	(*GetBudgetStatusResponse)(nil), // 11: api.v1.GetBudgetStatusResponse
+}
+var file_api_v1_budgets_proto_depIdxs = []int32{
+	0,  // 0: api.v1.ListBudgetsResponse.budgets:type_name -> api.v1.Budget
+	0,  // 1: api.v1.CreateBudgetResponse.budget:type_name -> api.v1.Budget
+	1,  // 2: api.v1.GetBudgetStatusResponse.statuses:type_name -> api.v1.BudgetStatus
+	2,  // 3: api.v1.BudgetService.ListBudgets:input_type -> api.v1.ListBudgetsRequest
+	4,  // 4: api.v1.BudgetService.CreateBudget:input_type -> api.v1.CreateBudgetRequest
+	6,  // 5: api.v1.BudgetService.UpdateBudget:input_type -> api.v1.UpdateBudgetRequest
+	8,  // 6: api.v1.BudgetService.DeleteBudget:input_type -> api.v1.DeleteBudgetRequest
+	10, // 7: api.v1.BudgetService.GetBudgetStatus:input_type -> api.v1.GetBudgetStatusRequest
+	3,  // 8: api.v1.BudgetService.ListBudgets:output_type -> api.v1.ListBudgetsResponse
+	5,  // 9: api.v1.BudgetService.CreateBudget:output_type -> api.v1.CreateBudgetResponse
+	7,  // 10: api.v1.BudgetService.UpdateBudget:output_type -> api.v1.UpdateBudgetResponse
+	9,  // 11: api.v1.BudgetService.DeleteBudget:output_type -> api.v1.DeleteBudgetResponse
+	11, // 12: api.v1.BudgetService.GetBudgetStatus:output_type -> api.v1.GetBudgetStatusResponse
+	8,  // [8:13] is the sub-list for method output_type
+	3,  // [3:8] is the sub-list for method input_type
+	3,  // [3:3] is the sub-list for extension type_name
+	3,  // [3:3] is the sub-list for extension extendee
+	0,  // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_api_v1_budgets_proto_init() }
+func file_api_v1_budgets_proto_init() {
+	if File_api_v1_budgets_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_budgets_proto_rawDesc), len(file_api_v1_budgets_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   12,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_v1_budgets_proto_goTypes,
+		DependencyIndexes: file_api_v1_budgets_proto_depIdxs,
+		MessageInfos:      file_api_v1_budgets_proto_msgTypes,
+	}.Build()
+	File_api_v1_budgets_proto = out.File
+	file_api_v1_budgets_proto_goTypes = nil
+	file_api_v1_budgets_proto_depIdxs = nil
+}
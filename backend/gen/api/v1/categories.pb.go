@@ -112,6 +112,15 @@ type CategoryRule struct {
 	DescriptionContains string                 `protobuf:"bytes,3,opt,name=description_contains,json=descriptionContains,proto3" json:"description_contains,omitempty"`
 	Position            int32                  `protobuf:"varint,4,opt,name=position,proto3" json:"position,omitempty"`
 	CreatedAt           string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	DescriptionRegex    string                 `protobuf:"bytes,6,opt,name=description_regex,json=descriptionRegex,proto3" json:"description_regex,omitempty"`
+	DescriptionExact    string                 `protobuf:"bytes,7,opt,name=description_exact,json=descriptionExact,proto3" json:"description_exact,omitempty"`
+	DescriptionPrefix   string                 `protobuf:"bytes,8,opt,name=description_prefix,json=descriptionPrefix,proto3" json:"description_prefix,omitempty"`
+	AmountMin           *int64                 `protobuf:"varint,9,opt,name=amount_min,json=amountMin,proto3,oneof" json:"amount_min,omitempty"`
+	AmountMax           *int64                 `protobuf:"varint,10,opt,name=amount_max,json=amountMax,proto3,oneof" json:"amount_max,omitempty"`
+	EntryType           string                 `protobuf:"bytes,11,opt,name=entry_type,json=entryType,proto3" json:"entry_type,omitempty"`
+	Currency            string                 `protobuf:"bytes,12,opt,name=currency,proto3" json:"currency,omitempty"`
+	Account             string                 `protobuf:"bytes,13,opt,name=account,proto3" json:"account,omitempty"`
+	ParserName          string                 `protobuf:"bytes,14,opt,name=parser_name,json=parserName,proto3" json:"parser_name,omitempty"`
 	unknownFields       protoimpl.UnknownFields
 	sizeCache           protoimpl.SizeCache
 }
@@ -181,6 +190,69 @@ func (x *CategoryRule) GetCreatedAt() string {
 	return ""
 }
 
+func (x *CategoryRule) GetDescriptionRegex() string {
+	if x != nil {
+		return x.DescriptionRegex
+	}
+	return ""
+}
+
+func (x *CategoryRule) GetDescriptionExact() string {
+	if x != nil {
+		return x.DescriptionExact
+	}
+	return ""
+}
+
+func (x *CategoryRule) GetDescriptionPrefix() string {
+	if x != nil {
+		return x.DescriptionPrefix
+	}
+	return ""
+}
+
+func (x *CategoryRule) GetAmountMin() int64 {
+	if x != nil && x.AmountMin != nil {
+		return *x.AmountMin
+	}
+	return 0
+}
+
+func (x *CategoryRule) GetAmountMax() int64 {
+	if x != nil && x.AmountMax != nil {
+		return *x.AmountMax
+	}
+	return 0
+}
+
+func (x *CategoryRule) GetEntryType() string {
+	if x != nil {
+		return x.EntryType
+	}
+	return ""
+}
+
+func (x *CategoryRule) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *CategoryRule) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *CategoryRule) GetParserName() string {
+	if x != nil {
+		return x.ParserName
+	}
+	return ""
+}
+
 type ListCategoriesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -649,6 +721,15 @@ type CreateCategoryRuleRequest struct {
 	state               protoimpl.MessageState `protogen:"open.v1"`
 	CategoryId          int32                  `protobuf:"varint,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
 	DescriptionContains string                 `protobuf:"bytes,2,opt,name=description_contains,json=descriptionContains,proto3" json:"description_contains,omitempty"`
+	DescriptionRegex    string                 `protobuf:"bytes,3,opt,name=description_regex,json=descriptionRegex,proto3" json:"description_regex,omitempty"`
+	DescriptionExact    string                 `protobuf:"bytes,4,opt,name=description_exact,json=descriptionExact,proto3" json:"description_exact,omitempty"`
+	DescriptionPrefix   string                 `protobuf:"bytes,5,opt,name=description_prefix,json=descriptionPrefix,proto3" json:"description_prefix,omitempty"`
+	AmountMin           *int64                 `protobuf:"varint,6,opt,name=amount_min,json=amountMin,proto3,oneof" json:"amount_min,omitempty"`
+	AmountMax           *int64                 `protobuf:"varint,7,opt,name=amount_max,json=amountMax,proto3,oneof" json:"amount_max,omitempty"`
+	EntryType           string                 `protobuf:"bytes,8,opt,name=entry_type,json=entryType,proto3" json:"entry_type,omitempty"`
+	Currency            string                 `protobuf:"bytes,9,opt,name=currency,proto3" json:"currency,omitempty"`
+	Account             string                 `protobuf:"bytes,10,opt,name=account,proto3" json:"account,omitempty"`
+	ParserName          string                 `protobuf:"bytes,11,opt,name=parser_name,json=parserName,proto3" json:"parser_name,omitempty"`
 	unknownFields       protoimpl.UnknownFields
 	sizeCache           protoimpl.SizeCache
 }
@@ -697,6 +778,69 @@ func (x *CreateCategoryRuleRequest) GetDescriptionContains() string {
 	return ""
 }
 
+func (x *CreateCategoryRuleRequest) GetDescriptionRegex() string {
+	if x != nil {
+		return x.DescriptionRegex
+	}
+	return ""
+}
+
+func (x *CreateCategoryRuleRequest) GetDescriptionExact() string {
+	if x != nil {
+		return x.DescriptionExact
+	}
+	return ""
+}
+
+func (x *CreateCategoryRuleRequest) GetDescriptionPrefix() string {
+	if x != nil {
+		return x.DescriptionPrefix
+	}
+	return ""
+}
+
+func (x *CreateCategoryRuleRequest) GetAmountMin() int64 {
+	if x != nil && x.AmountMin != nil {
+		return *x.AmountMin
+	}
+	return 0
+}
+
+func (x *CreateCategoryRuleRequest) GetAmountMax() int64 {
+	if x != nil && x.AmountMax != nil {
+		return *x.AmountMax
+	}
+	return 0
+}
+
+func (x *CreateCategoryRuleRequest) GetEntryType() string {
+	if x != nil {
+		return x.EntryType
+	}
+	return ""
+}
+
+func (x *CreateCategoryRuleRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *CreateCategoryRuleRequest) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *CreateCategoryRuleRequest) GetParserName() string {
+	if x != nil {
+		return x.ParserName
+	}
+	return ""
+}
+
 type CreateCategoryRuleResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Rule          *CategoryRule          `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
@@ -746,6 +890,15 @@ type UpdateCategoryRuleRequest struct {
 	Id                  int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	CategoryId          int32                  `protobuf:"varint,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
 	DescriptionContains string                 `protobuf:"bytes,3,opt,name=description_contains,json=descriptionContains,proto3" json:"description_contains,omitempty"`
+	DescriptionRegex    string                 `protobuf:"bytes,4,opt,name=description_regex,json=descriptionRegex,proto3" json:"description_regex,omitempty"`
+	DescriptionExact    string                 `protobuf:"bytes,5,opt,name=description_exact,json=descriptionExact,proto3" json:"description_exact,omitempty"`
+	DescriptionPrefix   string                 `protobuf:"bytes,6,opt,name=description_prefix,json=descriptionPrefix,proto3" json:"description_prefix,omitempty"`
+	AmountMin           *int64                 `protobuf:"varint,7,opt,name=amount_min,json=amountMin,proto3,oneof" json:"amount_min,omitempty"`
+	AmountMax           *int64                 `protobuf:"varint,8,opt,name=amount_max,json=amountMax,proto3,oneof" json:"amount_max,omitempty"`
+	EntryType           string                 `protobuf:"bytes,9,opt,name=entry_type,json=entryType,proto3" json:"entry_type,omitempty"`
+	Currency            string                 `protobuf:"bytes,10,opt,name=currency,proto3" json:"currency,omitempty"`
+	Account             string                 `protobuf:"bytes,11,opt,name=account,proto3" json:"account,omitempty"`
+	ParserName          string                 `protobuf:"bytes,12,opt,name=parser_name,json=parserName,proto3" json:"parser_name,omitempty"`
 	unknownFields       protoimpl.UnknownFields
 	sizeCache           protoimpl.SizeCache
 }
@@ -801,6 +954,69 @@ func (x *UpdateCategoryRuleRequest) GetDescriptionContains() string {
 	return ""
 }
 
+func (x *UpdateCategoryRuleRequest) GetDescriptionRegex() string {
+	if x != nil {
+		return x.DescriptionRegex
+	}
+	return ""
+}
+
+func (x *UpdateCategoryRuleRequest) GetDescriptionExact() string {
+	if x != nil {
+		return x.DescriptionExact
+	}
+	return ""
+}
+
+func (x *UpdateCategoryRuleRequest) GetDescriptionPrefix() string {
+	if x != nil {
+		return x.DescriptionPrefix
+	}
+	return ""
+}
+
+func (x *UpdateCategoryRuleRequest) GetAmountMin() int64 {
+	if x != nil && x.AmountMin != nil {
+		return *x.AmountMin
+	}
+	return 0
+}
+
+func (x *UpdateCategoryRuleRequest) GetAmountMax() int64 {
+	if x != nil && x.AmountMax != nil {
+		return *x.AmountMax
+	}
+	return 0
+}
+
+func (x *UpdateCategoryRuleRequest) GetEntryType() string {
+	if x != nil {
+		return x.EntryType
+	}
+	return ""
+}
+
+func (x *UpdateCategoryRuleRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *UpdateCategoryRuleRequest) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *UpdateCategoryRuleRequest) GetParserName() string {
+	if x != nil {
+		return x.ParserName
+	}
+	return ""
+}
+
 type UpdateCategoryRuleResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -1005,6 +1221,334 @@ func (x *ApplyCategoryRulesResponse) GetUpdatedCount() int32 {
 	return 0
 }
 
+type CategoryRuleDraft struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId          int32                  `protobuf:"varint,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	DescriptionContains string                 `protobuf:"bytes,2,opt,name=description_contains,json=descriptionContains,proto3" json:"description_contains,omitempty"`
+	DescriptionRegex    string                 `protobuf:"bytes,3,opt,name=description_regex,json=descriptionRegex,proto3" json:"description_regex,omitempty"`
+	DescriptionExact    string                 `protobuf:"bytes,4,opt,name=description_exact,json=descriptionExact,proto3" json:"description_exact,omitempty"`
+	DescriptionPrefix   string                 `protobuf:"bytes,5,opt,name=description_prefix,json=descriptionPrefix,proto3" json:"description_prefix,omitempty"`
+	AmountMin           *int64                 `protobuf:"varint,6,opt,name=amount_min,json=amountMin,proto3,oneof" json:"amount_min,omitempty"`
+	AmountMax           *int64                 `protobuf:"varint,7,opt,name=amount_max,json=amountMax,proto3,oneof" json:"amount_max,omitempty"`
+	EntryType           string                 `protobuf:"bytes,8,opt,name=entry_type,json=entryType,proto3" json:"entry_type,omitempty"`
+	Currency            string                 `protobuf:"bytes,9,opt,name=currency,proto3" json:"currency,omitempty"`
+	Account             string                 `protobuf:"bytes,10,opt,name=account,proto3" json:"account,omitempty"`
+	ParserName          string                 `protobuf:"bytes,11,opt,name=parser_name,json=parserName,proto3" json:"parser_name,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *CategoryRuleDraft) Reset() {
+	*x = CategoryRuleDraft{}
+	mi := &file_api_v1_categories_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryRuleDraft) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryRuleDraft) ProtoMessage() {}
+
+func (x *CategoryRuleDraft) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_categories_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryRuleDraft.ProtoReflect.Descriptor instead.
+func (*CategoryRuleDraft) Descriptor() ([]byte, []int) {
+	return file_api_v1_categories_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CategoryRuleDraft) GetCategoryId() int32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *CategoryRuleDraft) GetDescriptionContains() string {
+	if x != nil {
+		return x.DescriptionContains
+	}
+	return ""
+}
+
+func (x *CategoryRuleDraft) GetDescriptionRegex() string {
+	if x != nil {
+		return x.DescriptionRegex
+	}
+	return ""
+}
+
+func (x *CategoryRuleDraft) GetDescriptionExact() string {
+	if x != nil {
+		return x.DescriptionExact
+	}
+	return ""
+}
+
+func (x *CategoryRuleDraft) GetDescriptionPrefix() string {
+	if x != nil {
+		return x.DescriptionPrefix
+	}
+	return ""
+}
+
+func (x *CategoryRuleDraft) GetAmountMin() int64 {
+	if x != nil && x.AmountMin != nil {
+		return *x.AmountMin
+	}
+	return 0
+}
+
+func (x *CategoryRuleDraft) GetAmountMax() int64 {
+	if x != nil && x.AmountMax != nil {
+		return *x.AmountMax
+	}
+	return 0
+}
+
+func (x *CategoryRuleDraft) GetEntryType() string {
+	if x != nil {
+		return x.EntryType
+	}
+	return ""
+}
+
+func (x *CategoryRuleDraft) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *CategoryRuleDraft) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *CategoryRuleDraft) GetParserName() string {
+	if x != nil {
+		return x.ParserName
+	}
+	return ""
+}
+
+type CategoryRuleChange struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TransactionId  int32                  `protobuf:"varint,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	PostedDate     string                 `protobuf:"bytes,2,opt,name=posted_date,json=postedDate,proto3" json:"posted_date,omitempty"`
+	Description    string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Amount         int64                  `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency       string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	FromCategoryId *int32                 `protobuf:"varint,6,opt,name=from_category_id,json=fromCategoryId,proto3,oneof" json:"from_category_id,omitempty"`
+	ToCategoryId   *int32                 `protobuf:"varint,7,opt,name=to_category_id,json=toCategoryId,proto3,oneof" json:"to_category_id,omitempty"`
+	RuleId         int32                  `protobuf:"varint,8,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	DraftRule      bool                   `protobuf:"varint,9,opt,name=draft_rule,json=draftRule,proto3" json:"draft_rule,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CategoryRuleChange) Reset() {
+	*x = CategoryRuleChange{}
+	mi := &file_api_v1_categories_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryRuleChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryRuleChange) ProtoMessage() {}
+
+func (x *CategoryRuleChange) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_categories_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryRuleChange.ProtoReflect.Descriptor instead.
+func (*CategoryRuleChange) Descriptor() ([]byte, []int) {
+	return file_api_v1_categories_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CategoryRuleChange) GetTransactionId() int32 {
+	if x != nil {
+		return x.TransactionId
+	}
+	return 0
+}
+
+func (x *CategoryRuleChange) GetPostedDate() string {
+	if x != nil {
+		return x.PostedDate
+	}
+	return ""
+}
+
+func (x *CategoryRuleChange) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CategoryRuleChange) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *CategoryRuleChange) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *CategoryRuleChange) GetFromCategoryId() int32 {
+	if x != nil && x.FromCategoryId != nil {
+		return *x.FromCategoryId
+	}
+	return 0
+}
+
+func (x *CategoryRuleChange) GetToCategoryId() int32 {
+	if x != nil && x.ToCategoryId != nil {
+		return *x.ToCategoryId
+	}
+	return 0
+}
+
+func (x *CategoryRuleChange) GetRuleId() int32 {
+	if x != nil {
+		return x.RuleId
+	}
+	return 0
+}
+
+func (x *CategoryRuleChange) GetDraftRule() bool {
+	if x != nil {
+		return x.DraftRule
+	}
+	return false
+}
+
+type PreviewCategoryRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApplyToAll    bool                   `protobuf:"varint,1,opt,name=apply_to_all,json=applyToAll,proto3" json:"apply_to_all,omitempty"`
+	Draft         *CategoryRuleDraft     `protobuf:"bytes,2,opt,name=draft,proto3" json:"draft,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreviewCategoryRulesRequest) Reset() {
+	*x = PreviewCategoryRulesRequest{}
+	mi := &file_api_v1_categories_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewCategoryRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewCategoryRulesRequest) ProtoMessage() {}
+
+func (x *PreviewCategoryRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_categories_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewCategoryRulesRequest.ProtoReflect.Descriptor instead.
+func (*PreviewCategoryRulesRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_categories_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *PreviewCategoryRulesRequest) GetApplyToAll() bool {
+	if x != nil {
+		return x.ApplyToAll
+	}
+	return false
+}
+
+func (x *PreviewCategoryRulesRequest) GetDraft() *CategoryRuleDraft {
+	if x != nil {
+		return x.Draft
+	}
+	return nil
+}
+
+type PreviewCategoryRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Changes       []*CategoryRuleChange  `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreviewCategoryRulesResponse) Reset() {
+	*x = PreviewCategoryRulesResponse{}
+	mi := &file_api_v1_categories_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewCategoryRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewCategoryRulesResponse) ProtoMessage() {}
+
+func (x *PreviewCategoryRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_categories_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewCategoryRulesResponse.ProtoReflect.Descriptor instead.
+func (*PreviewCategoryRulesResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_categories_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *PreviewCategoryRulesResponse) GetChanges() []*CategoryRuleChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
 type ReorderCategoryRulesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	RuleIds       []int32                `protobuf:"varint,1,rep,packed,name=rule_ids,json=ruleIds,proto3" json:"rule_ids,omitempty"`
@@ -1014,7 +1558,7 @@ type ReorderCategoryRulesRequest struct {
 
 func (x *ReorderCategoryRulesRequest) Reset() {
 	*x = ReorderCategoryRulesRequest{}
-	mi := &file_api_v1_categories_proto_msgTypes[20]
+	mi := &file_api_v1_categories_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1026,7 +1570,7 @@ func (x *ReorderCategoryRulesRequest) String() string {
 func (*ReorderCategoryRulesRequest) ProtoMessage() {}
 
 func (x *ReorderCategoryRulesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_categories_proto_msgTypes[20]
+	mi := &file_api_v1_categories_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1039,7 +1583,7 @@ func (x *ReorderCategoryRulesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReorderCategoryRulesRequest.ProtoReflect.Descriptor instead.
 func (*ReorderCategoryRulesRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_categories_proto_rawDescGZIP(), []int{20}
+	return file_api_v1_categories_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *ReorderCategoryRulesRequest) GetRuleIds() []int32 {
@@ -1057,7 +1601,7 @@ type ReorderCategoryRulesResponse struct {
 
 func (x *ReorderCategoryRulesResponse) Reset() {
 	*x = ReorderCategoryRulesResponse{}
-	mi := &file_api_v1_categories_proto_msgTypes[21]
+	mi := &file_api_v1_categories_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1069,7 +1613,7 @@ func (x *ReorderCategoryRulesResponse) String() string {
 func (*ReorderCategoryRulesResponse) ProtoMessage() {}
 
 func (x *ReorderCategoryRulesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_categories_proto_msgTypes[21]
+	mi := &file_api_v1_categories_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1082,7 +1626,7 @@ func (x *ReorderCategoryRulesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReorderCategoryRulesResponse.ProtoReflect.Descriptor instead.
 func (*ReorderCategoryRulesResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_categories_proto_rawDescGZIP(), []int{21}
+	return file_api_v1_categories_proto_rawDescGZIP(), []int{25}
 }
 
 var File_api_v1_categories_proto protoreflect.FileDescriptor
@@ -1097,7 +1641,7 @@ const file_api_v1_categories_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x04 \x01(\tR\tcreatedAt\x12\x1b\n" +
 	"\tparent_id\x18\x05 \x01(\x05R\bparentId\x12\x19\n" +
-	"\bis_group\x18\x06 \x01(\bR\aisGroup\"\xad\x01\n" +
+	"\bis_group\x18\x06 \x01(\bR\aisGroup\"\x92\x04\n" +
 	"\fCategoryRule\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1f\n" +
 	"\vcategory_id\x18\x02 \x01(\x05R\n" +
@@ -1105,7 +1649,23 @@ const file_api_v1_categories_proto_rawDesc = "" +
 	"\x14description_contains\x18\x03 \x01(\tR\x13descriptionContains\x12\x1a\n" +
 	"\bposition\x18\x04 \x01(\x05R\bposition\x12\x1d\n" +
 	"\n" +
-	"created_at\x18\x05 \x01(\tR\tcreatedAt\"\x17\n" +
+	"created_at\x18\x05 \x01(\tR\tcreatedAt\x12+\n" +
+	"\x11description_regex\x18\x06 \x01(\tR\x10descriptionRegex\x12+\n" +
+	"\x11description_exact\x18\a \x01(\tR\x10descriptionExact\x12-\n" +
+	"\x12description_prefix\x18\b \x01(\tR\x11descriptionPrefix\x12\"\n" +
+	"\n" +
+	"amount_min\x18\t \x01(\x03H\x00R\tamountMin\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"amount_max\x18\n" +
+	" \x01(\x03H\x01R\tamountMax\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"entry_type\x18\v \x01(\tR\tentryType\x12\x1a\n" +
+	"\bcurrency\x18\f \x01(\tR\bcurrency\x12\x18\n" +
+	"\aaccount\x18\r \x01(\tR\aaccount\x12\x1f\n" +
+	"\vparser_name\x18\x0e \x01(\tR\n" +
+	"parserNameB\r\n" +
+	"\v_amount_minB\r\n" +
+	"\v_amount_max\"\x17\n" +
 	"\x15ListCategoriesRequest\"J\n" +
 	"\x16ListCategoriesResponse\x120\n" +
 	"\n" +
@@ -1130,18 +1690,50 @@ const file_api_v1_categories_proto_rawDesc = "" +
 	"\x16DeleteCategoryResponse\"\x1a\n" +
 	"\x18ListCategoryRulesRequest\"G\n" +
 	"\x19ListCategoryRulesResponse\x12*\n" +
-	"\x05rules\x18\x01 \x03(\v2\x14.api.v1.CategoryRuleR\x05rules\"o\n" +
+	"\x05rules\x18\x01 \x03(\v2\x14.api.v1.CategoryRuleR\x05rules\"\xd4\x03\n" +
 	"\x19CreateCategoryRuleRequest\x12\x1f\n" +
 	"\vcategory_id\x18\x01 \x01(\x05R\n" +
 	"categoryId\x121\n" +
-	"\x14description_contains\x18\x02 \x01(\tR\x13descriptionContains\"F\n" +
+	"\x14description_contains\x18\x02 \x01(\tR\x13descriptionContains\x12+\n" +
+	"\x11description_regex\x18\x03 \x01(\tR\x10descriptionRegex\x12+\n" +
+	"\x11description_exact\x18\x04 \x01(\tR\x10descriptionExact\x12-\n" +
+	"\x12description_prefix\x18\x05 \x01(\tR\x11descriptionPrefix\x12\"\n" +
+	"\n" +
+	"amount_min\x18\x06 \x01(\x03H\x00R\tamountMin\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"amount_max\x18\a \x01(\x03H\x01R\tamountMax\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"entry_type\x18\b \x01(\tR\tentryType\x12\x1a\n" +
+	"\bcurrency\x18\t \x01(\tR\bcurrency\x12\x18\n" +
+	"\aaccount\x18\n" +
+	" \x01(\tR\aaccount\x12\x1f\n" +
+	"\vparser_name\x18\v \x01(\tR\n" +
+	"parserNameB\r\n" +
+	"\v_amount_minB\r\n" +
+	"\v_amount_max\"F\n" +
 	"\x1aCreateCategoryRuleResponse\x12(\n" +
-	"\x04rule\x18\x01 \x01(\v2\x14.api.v1.CategoryRuleR\x04rule\"\x7f\n" +
+	"\x04rule\x18\x01 \x01(\v2\x14.api.v1.CategoryRuleR\x04rule\"\xe4\x03\n" +
 	"\x19UpdateCategoryRuleRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1f\n" +
 	"\vcategory_id\x18\x02 \x01(\x05R\n" +
 	"categoryId\x121\n" +
-	"\x14description_contains\x18\x03 \x01(\tR\x13descriptionContains\"\x1c\n" +
+	"\x14description_contains\x18\x03 \x01(\tR\x13descriptionContains\x12+\n" +
+	"\x11description_regex\x18\x04 \x01(\tR\x10descriptionRegex\x12+\n" +
+	"\x11description_exact\x18\x05 \x01(\tR\x10descriptionExact\x12-\n" +
+	"\x12description_prefix\x18\x06 \x01(\tR\x11descriptionPrefix\x12\"\n" +
+	"\n" +
+	"amount_min\x18\a \x01(\x03H\x00R\tamountMin\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"amount_max\x18\b \x01(\x03H\x01R\tamountMax\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"entry_type\x18\t \x01(\tR\tentryType\x12\x1a\n" +
+	"\bcurrency\x18\n" +
+	" \x01(\tR\bcurrency\x12\x18\n" +
+	"\aaccount\x18\v \x01(\tR\aaccount\x12\x1f\n" +
+	"\vparser_name\x18\f \x01(\tR\n" +
+	"parserNameB\r\n" +
+	"\v_amount_minB\r\n" +
+	"\v_amount_max\"\x1c\n" +
 	"\x1aUpdateCategoryRuleResponse\"+\n" +
 	"\x19DeleteCategoryRuleRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\"\x1c\n" +
@@ -1150,20 +1742,61 @@ const file_api_v1_categories_proto_rawDesc = "" +
 	"\fapply_to_all\x18\x01 \x01(\bR\n" +
 	"applyToAll\"A\n" +
 	"\x1aApplyCategoryRulesResponse\x12#\n" +
-	"\rupdated_count\x18\x01 \x01(\x05R\fupdatedCount\"8\n" +
+	"\rupdated_count\x18\x01 \x01(\x05R\fupdatedCount\"\xcc\x03\n" +
+	"\x11CategoryRuleDraft\x12\x1f\n" +
+	"\vcategory_id\x18\x01 \x01(\x05R\n" +
+	"categoryId\x121\n" +
+	"\x14description_contains\x18\x02 \x01(\tR\x13descriptionContains\x12+\n" +
+	"\x11description_regex\x18\x03 \x01(\tR\x10descriptionRegex\x12+\n" +
+	"\x11description_exact\x18\x04 \x01(\tR\x10descriptionExact\x12-\n" +
+	"\x12description_prefix\x18\x05 \x01(\tR\x11descriptionPrefix\x12\"\n" +
+	"\n" +
+	"amount_min\x18\x06 \x01(\x03H\x00R\tamountMin\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"amount_max\x18\a \x01(\x03H\x01R\tamountMax\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"entry_type\x18\b \x01(\tR\tentryType\x12\x1a\n" +
+	"\bcurrency\x18\t \x01(\tR\bcurrency\x12\x18\n" +
+	"\aaccount\x18\n" +
+	" \x01(\tR\aaccount\x12\x1f\n" +
+	"\vparser_name\x18\v \x01(\tR\n" +
+	"parserNameB\r\n" +
+	"\v_amount_minB\r\n" +
+	"\v_amount_max\"\xec\x02\n" +
+	"\x12CategoryRuleChange\x12%\n" +
+	"\x0etransaction_id\x18\x01 \x01(\x05R\rtransactionId\x12\x1f\n" +
+	"\vposted_date\x18\x02 \x01(\tR\n" +
+	"postedDate\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x03R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\x05 \x01(\tR\bcurrency\x12-\n" +
+	"\x10from_category_id\x18\x06 \x01(\x05H\x00R\x0efromCategoryId\x88\x01\x01\x12)\n" +
+	"\x0eto_category_id\x18\a \x01(\x05H\x01R\ftoCategoryId\x88\x01\x01\x12\x17\n" +
+	"\arule_id\x18\b \x01(\x05R\x06ruleId\x12\x1d\n" +
+	"\n" +
+	"draft_rule\x18\t \x01(\bR\tdraftRuleB\x13\n" +
+	"\x11_from_category_idB\x11\n" +
+	"\x0f_to_category_id\"p\n" +
+	"\x1bPreviewCategoryRulesRequest\x12 \n" +
+	"\fapply_to_all\x18\x01 \x01(\bR\n" +
+	"applyToAll\x12/\n" +
+	"\x05draft\x18\x02 \x01(\v2\x19.api.v1.CategoryRuleDraftR\x05draft\"T\n" +
+	"\x1cPreviewCategoryRulesResponse\x124\n" +
+	"\achanges\x18\x01 \x03(\v2\x1a.api.v1.CategoryRuleChangeR\achanges\"8\n" +
 	"\x1bReorderCategoryRulesRequest\x12\x19\n" +
 	"\brule_ids\x18\x01 \x03(\x05R\aruleIds\"\x1e\n" +
-	"\x1cReorderCategoryRulesResponse2\x9a\a\n" +
-	"\x0fCategoryService\x12Q\n" +
-	"\x0eListCategories\x12\x1d.api.v1.ListCategoriesRequest\x1a\x1e.api.v1.ListCategoriesResponse\"\x00\x12Q\n" +
+	"\x1cReorderCategoryRulesResponse2\x88\b\n" +
+	"\x0fCategoryService\x12T\n" +
+	"\x0eListCategories\x12\x1d.api.v1.ListCategoriesRequest\x1a\x1e.api.v1.ListCategoriesResponse\"\x03\x90\x02\x01\x12Q\n" +
 	"\x0eCreateCategory\x12\x1d.api.v1.CreateCategoryRequest\x1a\x1e.api.v1.CreateCategoryResponse\"\x00\x12Q\n" +
 	"\x0eUpdateCategory\x12\x1d.api.v1.UpdateCategoryRequest\x1a\x1e.api.v1.UpdateCategoryResponse\"\x00\x12Q\n" +
-	"\x0eDeleteCategory\x12\x1d.api.v1.DeleteCategoryRequest\x1a\x1e.api.v1.DeleteCategoryResponse\"\x00\x12Z\n" +
-	"\x11ListCategoryRules\x12 .api.v1.ListCategoryRulesRequest\x1a!.api.v1.ListCategoryRulesResponse\"\x00\x12]\n" +
+	"\x0eDeleteCategory\x12\x1d.api.v1.DeleteCategoryRequest\x1a\x1e.api.v1.DeleteCategoryResponse\"\x00\x12]\n" +
+	"\x11ListCategoryRules\x12 .api.v1.ListCategoryRulesRequest\x1a!.api.v1.ListCategoryRulesResponse\"\x03\x90\x02\x01\x12]\n" +
 	"\x12CreateCategoryRule\x12!.api.v1.CreateCategoryRuleRequest\x1a\".api.v1.CreateCategoryRuleResponse\"\x00\x12]\n" +
 	"\x12UpdateCategoryRule\x12!.api.v1.UpdateCategoryRuleRequest\x1a\".api.v1.UpdateCategoryRuleResponse\"\x00\x12]\n" +
 	"\x12DeleteCategoryRule\x12!.api.v1.DeleteCategoryRuleRequest\x1a\".api.v1.DeleteCategoryRuleResponse\"\x00\x12]\n" +
-	"\x12ApplyCategoryRules\x12!.api.v1.ApplyCategoryRulesRequest\x1a\".api.v1.ApplyCategoryRulesResponse\"\x00\x12c\n" +
+	"\x12ApplyCategoryRules\x12!.api.v1.ApplyCategoryRulesRequest\x1a\".api.v1.ApplyCategoryRulesResponse\"\x00\x12f\n" +
+	"\x14PreviewCategoryRules\x12#.api.v1.PreviewCategoryRulesRequest\x1a$.api.v1.PreviewCategoryRulesResponse\"\x03\x90\x02\x01\x12c\n" +
 	"\x14ReorderCategoryRules\x12#.api.v1.ReorderCategoryRulesRequest\x1a$.api.v1.ReorderCategoryRulesResponse\"\x00Bz\n" +
 	"\n" +
 	"com.api.v1B\x0fCategoriesProtoP\x01Z\"cashtrack/backend/gen/api/v1;apiv1\xa2\x02\x03AXX\xaa\x02\x06Api.V1\xca\x02\x06Api\\V1\xe2\x02\x12Api\\V1\\GPBMetadata\xea\x02\aApi::V1b\x06proto3"
@@ -1180,7 +1813,7 @@ func file_api_v1_categories_proto_rawDescGZIP() []byte {
 	return file_api_v1_categories_proto_rawDescData
 }
 
-var file_api_v1_categories_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_api_v1_categories_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
 var file_api_v1_categories_proto_goTypes = []any{
 	(*Category)(nil),                     // 0: api.v1.Category
 	(*CategoryRule)(nil),                 // 1: api.v1.CategoryRule
@@ -1202,39 +1835,47 @@ var file_api_v1_categories_proto_goTypes = []any{
 	(*DeleteCategoryRuleResponse)(nil),   // 17: api.v1.DeleteCategoryRuleResponse
 	(*ApplyCategoryRulesRequest)(nil),    // 18: api.v1.ApplyCategoryRulesRequest
 	(*ApplyCategoryRulesResponse)(nil),   // 19: api.v1.ApplyCategoryRulesResponse
-	(*ReorderCategoryRulesRequest)(nil),  // 20: api.v1.ReorderCategoryRulesRequest
-	(*ReorderCategoryRulesResponse)(nil), // 21: api.v1.ReorderCategoryRulesResponse
+	(*CategoryRuleDraft)(nil),            // 20: api.v1.CategoryRuleDraft
+	(*CategoryRuleChange)(nil),           // 21: api.v1.CategoryRuleChange
+	(*PreviewCategoryRulesRequest)(nil),  // 22: api.v1.PreviewCategoryRulesRequest
+	(*PreviewCategoryRulesResponse)(nil), // 23: api.v1.PreviewCategoryRulesResponse
+	(*ReorderCategoryRulesRequest)(nil),  // 24: api.v1.ReorderCategoryRulesRequest
+	(*ReorderCategoryRulesResponse)(nil), // 25: api.v1.ReorderCategoryRulesResponse
 }
 var file_api_v1_categories_proto_depIdxs = []int32{
 	0,  // 0: api.v1.ListCategoriesResponse.categories:type_name -> api.v1.Category
 	0,  // 1: api.v1.CreateCategoryResponse.category:type_name -> api.v1.Category
 	1,  // 2: api.v1.ListCategoryRulesResponse.rules:type_name -> api.v1.CategoryRule
 	1,  // 3: api.v1.CreateCategoryRuleResponse.rule:type_name -> api.v1.CategoryRule
-	2,  // 4: api.v1.CategoryService.ListCategories:input_type -> api.v1.ListCategoriesRequest
-	4,  // 5: api.v1.CategoryService.CreateCategory:input_type -> api.v1.CreateCategoryRequest
-	6,  // 6: api.v1.CategoryService.UpdateCategory:input_type -> api.v1.UpdateCategoryRequest
-	8,  // 7: api.v1.CategoryService.DeleteCategory:input_type -> api.v1.DeleteCategoryRequest
-	10, // 8: api.v1.CategoryService.ListCategoryRules:input_type -> api.v1.ListCategoryRulesRequest
-	12, // 9: api.v1.CategoryService.CreateCategoryRule:input_type -> api.v1.CreateCategoryRuleRequest
-	14, // 10: api.v1.CategoryService.UpdateCategoryRule:input_type -> api.v1.UpdateCategoryRuleRequest
-	16, // 11: api.v1.CategoryService.DeleteCategoryRule:input_type -> api.v1.DeleteCategoryRuleRequest
-	18, // 12: api.v1.CategoryService.ApplyCategoryRules:input_type -> api.v1.ApplyCategoryRulesRequest
-	20, // 13: api.v1.CategoryService.ReorderCategoryRules:input_type -> api.v1.ReorderCategoryRulesRequest
-	3,  // 14: api.v1.CategoryService.ListCategories:output_type -> api.v1.ListCategoriesResponse
-	5,  // 15: api.v1.CategoryService.CreateCategory:output_type -> api.v1.CreateCategoryResponse
-	7,  // 16: api.v1.CategoryService.UpdateCategory:output_type -> api.v1.UpdateCategoryResponse
-	9,  // 17: api.v1.CategoryService.DeleteCategory:output_type -> api.v1.DeleteCategoryResponse
-	11, // 18: api.v1.CategoryService.ListCategoryRules:output_type -> api.v1.ListCategoryRulesResponse
-	13, // 19: api.v1.CategoryService.CreateCategoryRule:output_type -> api.v1.CreateCategoryRuleResponse
-	15, // 20: api.v1.CategoryService.UpdateCategoryRule:output_type -> api.v1.UpdateCategoryRuleResponse
-	17, // 21: api.v1.CategoryService.DeleteCategoryRule:output_type -> api.v1.DeleteCategoryRuleResponse
-	19, // 22: api.v1.CategoryService.ApplyCategoryRules:output_type -> api.v1.ApplyCategoryRulesResponse
-	21, // 23: api.v1.CategoryService.ReorderCategoryRules:output_type -> api.v1.ReorderCategoryRulesResponse
-	14, // [14:24] is the sub-list for method output_type
-	4,  // [4:14] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	20, // 4: api.v1.PreviewCategoryRulesRequest.draft:type_name -> api.v1.CategoryRuleDraft
+	21, // 5: api.v1.PreviewCategoryRulesResponse.changes:type_name -> api.v1.CategoryRuleChange
+	2,  // 6: api.v1.CategoryService.ListCategories:input_type -> api.v1.ListCategoriesRequest
+	4,  // 7: api.v1.CategoryService.CreateCategory:input_type -> api.v1.CreateCategoryRequest
+	6,  // 8: api.v1.CategoryService.UpdateCategory:input_type -> api.v1.UpdateCategoryRequest
+	8,  // 9: api.v1.CategoryService.DeleteCategory:input_type -> api.v1.DeleteCategoryRequest
+	10, // 10: api.v1.CategoryService.ListCategoryRules:input_type -> api.v1.ListCategoryRulesRequest
+	12, // 11: api.v1.CategoryService.CreateCategoryRule:input_type -> api.v1.CreateCategoryRuleRequest
+	14, // 12: api.v1.CategoryService.UpdateCategoryRule:input_type -> api.v1.UpdateCategoryRuleRequest
+	16, // 13: api.v1.CategoryService.DeleteCategoryRule:input_type -> api.v1.DeleteCategoryRuleRequest
+	18, // 14: api.v1.CategoryService.ApplyCategoryRules:input_type -> api.v1.ApplyCategoryRulesRequest
+	22, // 15: api.v1.CategoryService.PreviewCategoryRules:input_type -> api.v1.PreviewCategoryRulesRequest
+	24, // 16: api.v1.CategoryService.ReorderCategoryRules:input_type -> api.v1.ReorderCategoryRulesRequest
+	3,  // 17: api.v1.CategoryService.ListCategories:output_type -> api.v1.ListCategoriesResponse
+	5,  // 18: api.v1.CategoryService.CreateCategory:output_type -> api.v1.CreateCategoryResponse
+	7,  // 19: api.v1.CategoryService.UpdateCategory:output_type -> api.v1.UpdateCategoryResponse
+	9,  // 20: api.v1.CategoryService.DeleteCategory:output_type -> api.v1.DeleteCategoryResponse
+	11, // 21: api.v1.CategoryService.ListCategoryRules:output_type -> api.v1.ListCategoryRulesResponse
+	13, // 22: api.v1.CategoryService.CreateCategoryRule:output_type -> api.v1.CreateCategoryRuleResponse
+	15, // 23: api.v1.CategoryService.UpdateCategoryRule:output_type -> api.v1.UpdateCategoryRuleResponse
+	17, // 24: api.v1.CategoryService.DeleteCategoryRule:output_type -> api.v1.DeleteCategoryRuleResponse
+	19, // 25: api.v1.CategoryService.ApplyCategoryRules:output_type -> api.v1.ApplyCategoryRulesResponse
+	23, // 26: api.v1.CategoryService.PreviewCategoryRules:output_type -> api.v1.PreviewCategoryRulesResponse
+	25, // 27: api.v1.CategoryService.ReorderCategoryRules:output_type -> api.v1.ReorderCategoryRulesResponse
+	17, // [17:28] is the sub-list for method output_type
+	6,  // [6:17] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_api_v1_categories_proto_init() }
@@ -1242,13 +1883,18 @@ func file_api_v1_categories_proto_init() {
 	if File_api_v1_categories_proto != nil {
 		return
 	}
+	file_api_v1_categories_proto_msgTypes[1].OneofWrappers = []any{}
+	file_api_v1_categories_proto_msgTypes[12].OneofWrappers = []any{}
+	file_api_v1_categories_proto_msgTypes[14].OneofWrappers = []any{}
+	file_api_v1_categories_proto_msgTypes[20].OneofWrappers = []any{}
+	file_api_v1_categories_proto_msgTypes[21].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_categories_proto_rawDesc), len(file_api_v1_categories_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   22,
+			NumMessages:   26,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -0,0 +1,934 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: api/v1/csv_templates.proto
+
+package apiv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CsvTemplate struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name               string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Signature          string                 `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	Delimiter          string                 `protobuf:"bytes,4,opt,name=delimiter,proto3" json:"delimiter,omitempty"`
+	DateColumn         string                 `protobuf:"bytes,5,opt,name=date_column,json=dateColumn,proto3" json:"date_column,omitempty"`
+	DateFormat         string                 `protobuf:"bytes,6,opt,name=date_format,json=dateFormat,proto3" json:"date_format,omitempty"`
+	DecimalSeparator   string                 `protobuf:"bytes,7,opt,name=decimal_separator,json=decimalSeparator,proto3" json:"decimal_separator,omitempty"`
+	DescriptionColumns []string               `protobuf:"bytes,8,rep,name=description_columns,json=descriptionColumns,proto3" json:"description_columns,omitempty"`
+	AmountColumn       string                 `protobuf:"bytes,9,opt,name=amount_column,json=amountColumn,proto3" json:"amount_column,omitempty"`
+	DebitColumn        string                 `protobuf:"bytes,10,opt,name=debit_column,json=debitColumn,proto3" json:"debit_column,omitempty"`
+	CreditColumn       string                 `protobuf:"bytes,11,opt,name=credit_column,json=creditColumn,proto3" json:"credit_column,omitempty"`
+	CurrencyColumn     string                 `protobuf:"bytes,12,opt,name=currency_column,json=currencyColumn,proto3" json:"currency_column,omitempty"`
+	AccountColumn      string                 `protobuf:"bytes,13,opt,name=account_column,json=accountColumn,proto3" json:"account_column,omitempty"`
+	DefaultCurrency    string                 `protobuf:"bytes,14,opt,name=default_currency,json=defaultCurrency,proto3" json:"default_currency,omitempty"`
+	CreatedAt          string                 `protobuf:"bytes,15,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *CsvTemplate) Reset() {
+	*x = CsvTemplate{}
+	mi := &file_api_v1_csv_templates_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CsvTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CsvTemplate) ProtoMessage() {}
+
+func (x *CsvTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_csv_templates_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CsvTemplate.ProtoReflect.Descriptor instead.
+func (*CsvTemplate) Descriptor() ([]byte, []int) {
+	return file_api_v1_csv_templates_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CsvTemplate) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CsvTemplate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CsvTemplate) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *CsvTemplate) GetDelimiter() string {
+	if x != nil {
+		return x.Delimiter
+	}
+	return ""
+}
+
+func (x *CsvTemplate) GetDateColumn() string {
+	if x != nil {
+		return x.DateColumn
+	}
+	return ""
+}
+
+func (x *CsvTemplate) GetDateFormat() string {
+	if x != nil {
+		return x.DateFormat
+	}
+	return ""
+}
+
+func (x *CsvTemplate) GetDecimalSeparator() string {
+	if x != nil {
+		return x.DecimalSeparator
+	}
+	return ""
+}
+
+func (x *CsvTemplate) GetDescriptionColumns() []string {
+	if x != nil {
+		return x.DescriptionColumns
+	}
+	return nil
+}
+
+func (x *CsvTemplate) GetAmountColumn() string {
+	if x != nil {
+		return x.AmountColumn
+	}
+	return ""
+}
+
+func (x *CsvTemplate) GetDebitColumn() string {
+	if x != nil {
+		return x.DebitColumn
+	}
+	return ""
+}
+
+func (x *CsvTemplate) GetCreditColumn() string {
+	if x != nil {
+		return x.CreditColumn
+	}
+	return ""
+}
+
+func (x *CsvTemplate) GetCurrencyColumn() string {
+	if x != nil {
+		return x.CurrencyColumn
+	}
+	return ""
+}
+
+func (x *CsvTemplate) GetAccountColumn() string {
+	if x != nil {
+		return x.AccountColumn
+	}
+	return ""
+}
+
+func (x *CsvTemplate) GetDefaultCurrency() string {
+	if x != nil {
+		return x.DefaultCurrency
+	}
+	return ""
+}
+
+func (x *CsvTemplate) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type CsvTemplateRow struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Row           int32                  `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	PostedDate    string                 `protobuf:"bytes,2,opt,name=posted_date,json=postedDate,proto3" json:"posted_date,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Amount        int64                  `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency      string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	EntryType     string                 `protobuf:"bytes,6,opt,name=entry_type,json=entryType,proto3" json:"entry_type,omitempty"`
+	AccountNumber string                 `protobuf:"bytes,7,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	TransactionId string                 `protobuf:"bytes,8,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CsvTemplateRow) Reset() {
+	*x = CsvTemplateRow{}
+	mi := &file_api_v1_csv_templates_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CsvTemplateRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CsvTemplateRow) ProtoMessage() {}
+
+func (x *CsvTemplateRow) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_csv_templates_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CsvTemplateRow.ProtoReflect.Descriptor instead.
+func (*CsvTemplateRow) Descriptor() ([]byte, []int) {
+	return file_api_v1_csv_templates_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CsvTemplateRow) GetRow() int32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *CsvTemplateRow) GetPostedDate() string {
+	if x != nil {
+		return x.PostedDate
+	}
+	return ""
+}
+
+func (x *CsvTemplateRow) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CsvTemplateRow) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *CsvTemplateRow) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *CsvTemplateRow) GetEntryType() string {
+	if x != nil {
+		return x.EntryType
+	}
+	return ""
+}
+
+func (x *CsvTemplateRow) GetAccountNumber() string {
+	if x != nil {
+		return x.AccountNumber
+	}
+	return ""
+}
+
+func (x *CsvTemplateRow) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+type CsvTemplateRowError struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Row           int32                  `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CsvTemplateRowError) Reset() {
+	*x = CsvTemplateRowError{}
+	mi := &file_api_v1_csv_templates_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CsvTemplateRowError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CsvTemplateRowError) ProtoMessage() {}
+
+func (x *CsvTemplateRowError) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_csv_templates_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CsvTemplateRowError.ProtoReflect.Descriptor instead.
+func (*CsvTemplateRowError) Descriptor() ([]byte, []int) {
+	return file_api_v1_csv_templates_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CsvTemplateRowError) GetRow() int32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *CsvTemplateRowError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ListCsvTemplatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCsvTemplatesRequest) Reset() {
+	*x = ListCsvTemplatesRequest{}
+	mi := &file_api_v1_csv_templates_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCsvTemplatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCsvTemplatesRequest) ProtoMessage() {}
+
+func (x *ListCsvTemplatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_csv_templates_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCsvTemplatesRequest.ProtoReflect.Descriptor instead.
+func (*ListCsvTemplatesRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_csv_templates_proto_rawDescGZIP(), []int{3}
+}
+
+type ListCsvTemplatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Templates     []*CsvTemplate         `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCsvTemplatesResponse) Reset() {
+	*x = ListCsvTemplatesResponse{}
+	mi := &file_api_v1_csv_templates_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCsvTemplatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCsvTemplatesResponse) ProtoMessage() {}
+
+func (x *ListCsvTemplatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_csv_templates_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCsvTemplatesResponse.ProtoReflect.Descriptor instead.
+func (*ListCsvTemplatesResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_csv_templates_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListCsvTemplatesResponse) GetTemplates() []*CsvTemplate {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+type CreateCsvTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *CsvTemplate           `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCsvTemplateRequest) Reset() {
+	*x = CreateCsvTemplateRequest{}
+	mi := &file_api_v1_csv_templates_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCsvTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCsvTemplateRequest) ProtoMessage() {}
+
+func (x *CreateCsvTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_csv_templates_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCsvTemplateRequest.ProtoReflect.Descriptor instead.
+func (*CreateCsvTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_csv_templates_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateCsvTemplateRequest) GetTemplate() *CsvTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type CreateCsvTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *CsvTemplate           `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCsvTemplateResponse) Reset() {
+	*x = CreateCsvTemplateResponse{}
+	mi := &file_api_v1_csv_templates_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCsvTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCsvTemplateResponse) ProtoMessage() {}
+
+func (x *CreateCsvTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_csv_templates_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCsvTemplateResponse.ProtoReflect.Descriptor instead.
+func (*CreateCsvTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_csv_templates_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreateCsvTemplateResponse) GetTemplate() *CsvTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type UpdateCsvTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *CsvTemplate           `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCsvTemplateRequest) Reset() {
+	*x = UpdateCsvTemplateRequest{}
+	mi := &file_api_v1_csv_templates_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCsvTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCsvTemplateRequest) ProtoMessage() {}
+
+func (x *UpdateCsvTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_csv_templates_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCsvTemplateRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCsvTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_csv_templates_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdateCsvTemplateRequest) GetTemplate() *CsvTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type UpdateCsvTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCsvTemplateResponse) Reset() {
+	*x = UpdateCsvTemplateResponse{}
+	mi := &file_api_v1_csv_templates_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCsvTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCsvTemplateResponse) ProtoMessage() {}
+
+func (x *UpdateCsvTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_csv_templates_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCsvTemplateResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCsvTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_csv_templates_proto_rawDescGZIP(), []int{8}
+}
+
+type DeleteCsvTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCsvTemplateRequest) Reset() {
+	*x = DeleteCsvTemplateRequest{}
+	mi := &file_api_v1_csv_templates_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCsvTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCsvTemplateRequest) ProtoMessage() {}
+
+func (x *DeleteCsvTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_csv_templates_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCsvTemplateRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCsvTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_csv_templates_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeleteCsvTemplateRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteCsvTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCsvTemplateResponse) Reset() {
+	*x = DeleteCsvTemplateResponse{}
+	mi := &file_api_v1_csv_templates_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCsvTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCsvTemplateResponse) ProtoMessage() {}
+
+func (x *DeleteCsvTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_csv_templates_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCsvTemplateResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCsvTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_csv_templates_proto_rawDescGZIP(), []int{10}
+}
+
+type TestCsvTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *CsvTemplate           `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	ReportId      int32                  `protobuf:"varint,2,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TestCsvTemplateRequest) Reset() {
+	*x = TestCsvTemplateRequest{}
+	mi := &file_api_v1_csv_templates_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestCsvTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestCsvTemplateRequest) ProtoMessage() {}
+
+func (x *TestCsvTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_csv_templates_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestCsvTemplateRequest.ProtoReflect.Descriptor instead.
+func (*TestCsvTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_csv_templates_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *TestCsvTemplateRequest) GetTemplate() *CsvTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+func (x *TestCsvTemplateRequest) GetReportId() int32 {
+	if x != nil {
+		return x.ReportId
+	}
+	return 0
+}
+
+func (x *TestCsvTemplateRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type TestCsvTemplateResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	SignatureMatches bool                   `protobuf:"varint,1,opt,name=signature_matches,json=signatureMatches,proto3" json:"signature_matches,omitempty"`
+	Rows             []*CsvTemplateRow      `protobuf:"bytes,2,rep,name=rows,proto3" json:"rows,omitempty"`
+	Errors           []*CsvTemplateRowError `protobuf:"bytes,3,rep,name=errors,proto3" json:"errors,omitempty"`
+	TotalRows        int32                  `protobuf:"varint,4,opt,name=total_rows,json=totalRows,proto3" json:"total_rows,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *TestCsvTemplateResponse) Reset() {
+	*x = TestCsvTemplateResponse{}
+	mi := &file_api_v1_csv_templates_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestCsvTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestCsvTemplateResponse) ProtoMessage() {}
+
+func (x *TestCsvTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_csv_templates_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestCsvTemplateResponse.ProtoReflect.Descriptor instead.
+func (*TestCsvTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_csv_templates_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *TestCsvTemplateResponse) GetSignatureMatches() bool {
+	if x != nil {
+		return x.SignatureMatches
+	}
+	return false
+}
+
+func (x *TestCsvTemplateResponse) GetRows() []*CsvTemplateRow {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+func (x *TestCsvTemplateResponse) GetErrors() []*CsvTemplateRowError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *TestCsvTemplateResponse) GetTotalRows() int32 {
+	if x != nil {
+		return x.TotalRows
+	}
+	return 0
+}
+
+var File_api_v1_csv_templates_proto protoreflect.FileDescriptor
+
+const file_api_v1_csv_templates_proto_rawDesc = "" +
+	"\n" +
+	"\x1aapi/v1/csv_templates.proto\x12\x06api.v1\"\x94\x04\n" +
+	"\vCsvTemplate\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1c\n" +
+	"\tsignature\x18\x03 \x01(\tR\tsignature\x12\x1c\n" +
+	"\tdelimiter\x18\x04 \x01(\tR\tdelimiter\x12\x1f\n" +
+	"\vdate_column\x18\x05 \x01(\tR\n" +
+	"dateColumn\x12\x1f\n" +
+	"\vdate_format\x18\x06 \x01(\tR\n" +
+	"dateFormat\x12+\n" +
+	"\x11decimal_separator\x18\a \x01(\tR\x10decimalSeparator\x12/\n" +
+	"\x13description_columns\x18\b \x03(\tR\x12descriptionColumns\x12#\n" +
+	"\ramount_column\x18\t \x01(\tR\famountColumn\x12!\n" +
+	"\fdebit_column\x18\n" +
+	" \x01(\tR\vdebitColumn\x12#\n" +
+	"\rcredit_column\x18\v \x01(\tR\fcreditColumn\x12'\n" +
+	"\x0fcurrency_column\x18\f \x01(\tR\x0ecurrencyColumn\x12%\n" +
+	"\x0eaccount_column\x18\r \x01(\tR\raccountColumn\x12)\n" +
+	"\x10default_currency\x18\x0e \x01(\tR\x0fdefaultCurrency\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x0f \x01(\tR\tcreatedAt\"\x86\x02\n" +
+	"\x0eCsvTemplateRow\x12\x10\n" +
+	"\x03row\x18\x01 \x01(\x05R\x03row\x12\x1f\n" +
+	"\vposted_date\x18\x02 \x01(\tR\n" +
+	"postedDate\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x03R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\x05 \x01(\tR\bcurrency\x12\x1d\n" +
+	"\n" +
+	"entry_type\x18\x06 \x01(\tR\tentryType\x12%\n" +
+	"\x0eaccount_number\x18\a \x01(\tR\raccountNumber\x12%\n" +
+	"\x0etransaction_id\x18\b \x01(\tR\rtransactionId\"A\n" +
+	"\x13CsvTemplateRowError\x12\x10\n" +
+	"\x03row\x18\x01 \x01(\x05R\x03row\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x19\n" +
+	"\x17ListCsvTemplatesRequest\"M\n" +
+	"\x18ListCsvTemplatesResponse\x121\n" +
+	"\ttemplates\x18\x01 \x03(\v2\x13.api.v1.CsvTemplateR\ttemplates\"K\n" +
+	"\x18CreateCsvTemplateRequest\x12/\n" +
+	"\btemplate\x18\x01 \x01(\v2\x13.api.v1.CsvTemplateR\btemplate\"L\n" +
+	"\x19CreateCsvTemplateResponse\x12/\n" +
+	"\btemplate\x18\x01 \x01(\v2\x13.api.v1.CsvTemplateR\btemplate\"K\n" +
+	"\x18UpdateCsvTemplateRequest\x12/\n" +
+	"\btemplate\x18\x01 \x01(\v2\x13.api.v1.CsvTemplateR\btemplate\"\x1b\n" +
+	"\x19UpdateCsvTemplateResponse\"*\n" +
+	"\x18DeleteCsvTemplateRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"\x1b\n" +
+	"\x19DeleteCsvTemplateResponse\"|\n" +
+	"\x16TestCsvTemplateRequest\x12/\n" +
+	"\btemplate\x18\x01 \x01(\v2\x13.api.v1.CsvTemplateR\btemplate\x12\x1b\n" +
+	"\treport_id\x18\x02 \x01(\x05R\breportId\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"\xc6\x01\n" +
+	"\x17TestCsvTemplateResponse\x12+\n" +
+	"\x11signature_matches\x18\x01 \x01(\bR\x10signatureMatches\x12*\n" +
+	"\x04rows\x18\x02 \x03(\v2\x16.api.v1.CsvTemplateRowR\x04rows\x123\n" +
+	"\x06errors\x18\x03 \x03(\v2\x1b.api.v1.CsvTemplateRowErrorR\x06errors\x12\x1d\n" +
+	"\n" +
+	"total_rows\x18\x04 \x01(\x05R\ttotalRows2\xdd\x03\n" +
+	"\x12CsvTemplateService\x12Z\n" +
+	"\x10ListCsvTemplates\x12\x1f.api.v1.ListCsvTemplatesRequest\x1a .api.v1.ListCsvTemplatesResponse\"\x03\x90\x02\x01\x12Z\n" +
+	"\x11CreateCsvTemplate\x12 .api.v1.CreateCsvTemplateRequest\x1a!.api.v1.CreateCsvTemplateResponse\"\x00\x12Z\n" +
+	"\x11UpdateCsvTemplate\x12 .api.v1.UpdateCsvTemplateRequest\x1a!.api.v1.UpdateCsvTemplateResponse\"\x00\x12Z\n" +
+	"\x11DeleteCsvTemplate\x12 .api.v1.DeleteCsvTemplateRequest\x1a!.api.v1.DeleteCsvTemplateResponse\"\x00\x12W\n" +
+	"\x0fTestCsvTemplate\x12\x1e.api.v1.TestCsvTemplateRequest\x1a\x1f.api.v1.TestCsvTemplateResponse\"\x03\x90\x02\x01B|\n" +
+	"\n" +
+	"com.api.v1B\x11CsvTemplatesProtoP\x01Z\"cashtrack/backend/gen/api/v1;apiv1\xa2\x02\x03AXX\xaa\x02\x06Api.V1\xca\x02\x06Api\\V1\xe2\x02\x12Api\\V1\\GPBMetadata\xea\x02\aApi::V1b\x06proto3"
+
+var (
+	file_api_v1_csv_templates_proto_rawDescOnce sync.Once
+	file_api_v1_csv_templates_proto_rawDescData []byte
+)
+
+func file_api_v1_csv_templates_proto_rawDescGZIP() []byte {
+	file_api_v1_csv_templates_proto_rawDescOnce.Do(func() {
+		file_api_v1_csv_templates_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_v1_csv_templates_proto_rawDesc), len(file_api_v1_csv_templates_proto_rawDesc)))
+	})
+	return file_api_v1_csv_templates_proto_rawDescData
+}
+
+var file_api_v1_csv_templates_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_api_v1_csv_templates_proto_goTypes = []any{
+	(*CsvTemplate)(nil),               // 0: api.v1.CsvTemplate
+	(*CsvTemplateRow)(nil),            // 1: api.v1.CsvTemplateRow
+	(*CsvTemplateRowError)(nil),       // 2: api.v1.CsvTemplateRowError
+	(*ListCsvTemplatesRequest)(nil),   // 3: api.v1.ListCsvTemplatesRequest
+	(*ListCsvTemplatesResponse)(nil),  // 4: api.v1.ListCsvTemplatesResponse
+	(*CreateCsvTemplateRequest)(nil),  // 5: api.v1.CreateCsvTemplateRequest
+	(*CreateCsvTemplateResponse)(nil), // 6: api.v1.CreateCsvTemplateResponse
+	(*UpdateCsvTemplateRequest)(nil),  // 7: api.v1.UpdateCsvTemplateRequest
+	(*UpdateCsvTemplateResponse)(nil), // 8: api.v1.UpdateCsvTemplateResponse
+	(*DeleteCsvTemplateRequest)(nil),  // 9: api.v1.DeleteCsvTemplateRequest
+	(*DeleteCsvTemplateResponse)(nil), // 10: api.v1.DeleteCsvTemplateResponse
+	(*TestCsvTemplateRequest)(nil),    // 11: api.v1.TestCsvTemplateRequest
+	(*TestCsvTemplateResponse)(nil),   // 12: api.v1.TestCsvTemplateResponse
+}
+var file_api_v1_csv_templates_proto_depIdxs = []int32{
+	0,  // 0: api.v1.ListCsvTemplatesResponse.templates:type_name -> api.v1.CsvTemplate
+	0,  // 1: api.v1.CreateCsvTemplateRequest.template:type_name -> api.v1.CsvTemplate
+	0,  // 2: api.v1.CreateCsvTemplateResponse.template:type_name -> api.v1.CsvTemplate
+	0,  // 3: api.v1.UpdateCsvTemplateRequest.template:type_name -> api.v1.CsvTemplate
+	0,  // 4: api.v1.TestCsvTemplateRequest.template:type_name -> api.v1.CsvTemplate
+	1,  // 5: api.v1.TestCsvTemplateResponse.rows:type_name -> api.v1.CsvTemplateRow
+	2,  // 6: api.v1.TestCsvTemplateResponse.errors:type_name -> api.v1.CsvTemplateRowError
+	3,  // 7: api.v1.CsvTemplateService.ListCsvTemplates:input_type -> api.v1.ListCsvTemplatesRequest
+	5,  // 8: api.v1.CsvTemplateService.CreateCsvTemplate:input_type -> api.v1.CreateCsvTemplateRequest
+	7,  // 9: api.v1.CsvTemplateService.UpdateCsvTemplate:input_type -> api.v1.UpdateCsvTemplateRequest
+	9,  // 10: api.v1.CsvTemplateService.DeleteCsvTemplate:input_type -> api.v1.DeleteCsvTemplateRequest
+	11, // 11: api.v1.CsvTemplateService.TestCsvTemplate:input_type -> api.v1.TestCsvTemplateRequest
+	4,  // 12: api.v1.CsvTemplateService.ListCsvTemplates:output_type -> api.v1.ListCsvTemplatesResponse
+	6,  // 13: api.v1.CsvTemplateService.CreateCsvTemplate:output_type -> api.v1.CreateCsvTemplateResponse
+	8,  // 14: api.v1.CsvTemplateService.UpdateCsvTemplate:output_type -> api.v1.UpdateCsvTemplateResponse
+	10, // 15: api.v1.CsvTemplateService.DeleteCsvTemplate:output_type -> api.v1.DeleteCsvTemplateResponse
+	12, // 16: api.v1.CsvTemplateService.TestCsvTemplate:output_type -> api.v1.TestCsvTemplateResponse
+	12, // [12:17] is the sub-list for method output_type
+	7,  // [7:12] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_api_v1_csv_templates_proto_init() }
+func file_api_v1_csv_templates_proto_init() {
+	if File_api_v1_csv_templates_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_csv_templates_proto_rawDesc), len(file_api_v1_csv_templates_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   13,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_v1_csv_templates_proto_goTypes,
+		DependencyIndexes: file_api_v1_csv_templates_proto_depIdxs,
+		MessageInfos:      file_api_v1_csv_templates_proto_msgTypes,
+	}.Build()
+	File_api_v1_csv_templates_proto = out.File
+	file_api_v1_csv_templates_proto_goTypes = nil
+	file_api_v1_csv_templates_proto_depIdxs = nil
+}
@@ -0,0 +1,1056 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: api/v1/households.proto
+
+package apiv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Household struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	BaseCurrency  string                 `protobuf:"bytes,4,opt,name=base_currency,json=baseCurrency,proto3" json:"base_currency,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Household) Reset() {
+	*x = Household{}
+	mi := &file_api_v1_households_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Household) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Household) ProtoMessage() {}
+
+func (x *Household) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_households_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Household.ProtoReflect.Descriptor instead.
+func (*Household) Descriptor() ([]byte, []int) {
+	return file_api_v1_households_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Household) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Household) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Household) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *Household) GetBaseCurrency() string {
+	if x != nil {
+		return x.BaseCurrency
+	}
+	return ""
+}
+
+func (x *Household) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type HouseholdMember struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	JoinedAt      string                 `protobuf:"bytes,4,opt,name=joined_at,json=joinedAt,proto3" json:"joined_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HouseholdMember) Reset() {
+	*x = HouseholdMember{}
+	mi := &file_api_v1_households_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HouseholdMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HouseholdMember) ProtoMessage() {}
+
+func (x *HouseholdMember) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_households_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HouseholdMember.ProtoReflect.Descriptor instead.
+func (*HouseholdMember) Descriptor() ([]byte, []int) {
+	return file_api_v1_households_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *HouseholdMember) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *HouseholdMember) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *HouseholdMember) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *HouseholdMember) GetJoinedAt() string {
+	if x != nil {
+		return x.JoinedAt
+	}
+	return ""
+}
+
+type ListHouseholdsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListHouseholdsRequest) Reset() {
+	*x = ListHouseholdsRequest{}
+	mi := &file_api_v1_households_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListHouseholdsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHouseholdsRequest) ProtoMessage() {}
+
+func (x *ListHouseholdsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_households_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHouseholdsRequest.ProtoReflect.Descriptor instead.
+func (*ListHouseholdsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_households_proto_rawDescGZIP(), []int{2}
+}
+
+type ListHouseholdsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Households    []*Household           `protobuf:"bytes,1,rep,name=households,proto3" json:"households,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListHouseholdsResponse) Reset() {
+	*x = ListHouseholdsResponse{}
+	mi := &file_api_v1_households_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListHouseholdsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHouseholdsResponse) ProtoMessage() {}
+
+func (x *ListHouseholdsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_households_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHouseholdsResponse.ProtoReflect.Descriptor instead.
+func (*ListHouseholdsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_households_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListHouseholdsResponse) GetHouseholds() []*Household {
+	if x != nil {
+		return x.Households
+	}
+	return nil
+}
+
+type CreateHouseholdRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateHouseholdRequest) Reset() {
+	*x = CreateHouseholdRequest{}
+	mi := &file_api_v1_households_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateHouseholdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateHouseholdRequest) ProtoMessage() {}
+
+func (x *CreateHouseholdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_households_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateHouseholdRequest.ProtoReflect.Descriptor instead.
+func (*CreateHouseholdRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_households_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateHouseholdRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CreateHouseholdResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Household     *Household             `protobuf:"bytes,1,opt,name=household,proto3" json:"household,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateHouseholdResponse) Reset() {
+	*x = CreateHouseholdResponse{}
+	mi := &file_api_v1_households_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateHouseholdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateHouseholdResponse) ProtoMessage() {}
+
+func (x *CreateHouseholdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_households_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateHouseholdResponse.ProtoReflect.Descriptor instead.
+func (*CreateHouseholdResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_households_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateHouseholdResponse) GetHousehold() *Household {
+	if x != nil {
+		return x.Household
+	}
+	return nil
+}
+
+type UpdateHouseholdRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HouseholdId   int32                  `protobuf:"varint,1,opt,name=household_id,json=householdId,proto3" json:"household_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	BaseCurrency  string                 `protobuf:"bytes,3,opt,name=base_currency,json=baseCurrency,proto3" json:"base_currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateHouseholdRequest) Reset() {
+	*x = UpdateHouseholdRequest{}
+	mi := &file_api_v1_households_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateHouseholdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateHouseholdRequest) ProtoMessage() {}
+
+func (x *UpdateHouseholdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_households_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateHouseholdRequest.ProtoReflect.Descriptor instead.
+func (*UpdateHouseholdRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_households_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdateHouseholdRequest) GetHouseholdId() int32 {
+	if x != nil {
+		return x.HouseholdId
+	}
+	return 0
+}
+
+func (x *UpdateHouseholdRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateHouseholdRequest) GetBaseCurrency() string {
+	if x != nil {
+		return x.BaseCurrency
+	}
+	return ""
+}
+
+type UpdateHouseholdResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Household     *Household             `protobuf:"bytes,1,opt,name=household,proto3" json:"household,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateHouseholdResponse) Reset() {
+	*x = UpdateHouseholdResponse{}
+	mi := &file_api_v1_households_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateHouseholdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateHouseholdResponse) ProtoMessage() {}
+
+func (x *UpdateHouseholdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_households_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateHouseholdResponse.ProtoReflect.Descriptor instead.
+func (*UpdateHouseholdResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_households_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdateHouseholdResponse) GetHousehold() *Household {
+	if x != nil {
+		return x.Household
+	}
+	return nil
+}
+
+type ListHouseholdMembersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HouseholdId   int32                  `protobuf:"varint,1,opt,name=household_id,json=householdId,proto3" json:"household_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListHouseholdMembersRequest) Reset() {
+	*x = ListHouseholdMembersRequest{}
+	mi := &file_api_v1_households_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListHouseholdMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHouseholdMembersRequest) ProtoMessage() {}
+
+func (x *ListHouseholdMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_households_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHouseholdMembersRequest.ProtoReflect.Descriptor instead.
+func (*ListHouseholdMembersRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_households_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListHouseholdMembersRequest) GetHouseholdId() int32 {
+	if x != nil {
+		return x.HouseholdId
+	}
+	return 0
+}
+
+type ListHouseholdMembersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Members       []*HouseholdMember     `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListHouseholdMembersResponse) Reset() {
+	*x = ListHouseholdMembersResponse{}
+	mi := &file_api_v1_households_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListHouseholdMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHouseholdMembersResponse) ProtoMessage() {}
+
+func (x *ListHouseholdMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_households_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHouseholdMembersResponse.ProtoReflect.Descriptor instead.
+func (*ListHouseholdMembersResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_households_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListHouseholdMembersResponse) GetMembers() []*HouseholdMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type UpdateHouseholdMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HouseholdId   int32                  `protobuf:"varint,1,opt,name=household_id,json=householdId,proto3" json:"household_id,omitempty"`
+	UserId        int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateHouseholdMemberRequest) Reset() {
+	*x = UpdateHouseholdMemberRequest{}
+	mi := &file_api_v1_households_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateHouseholdMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateHouseholdMemberRequest) ProtoMessage() {}
+
+func (x *UpdateHouseholdMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_households_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateHouseholdMemberRequest.ProtoReflect.Descriptor instead.
+func (*UpdateHouseholdMemberRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_households_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UpdateHouseholdMemberRequest) GetHouseholdId() int32 {
+	if x != nil {
+		return x.HouseholdId
+	}
+	return 0
+}
+
+func (x *UpdateHouseholdMemberRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UpdateHouseholdMemberRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type UpdateHouseholdMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateHouseholdMemberResponse) Reset() {
+	*x = UpdateHouseholdMemberResponse{}
+	mi := &file_api_v1_households_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateHouseholdMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateHouseholdMemberResponse) ProtoMessage() {}
+
+func (x *UpdateHouseholdMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_households_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateHouseholdMemberResponse.ProtoReflect.Descriptor instead.
+func (*UpdateHouseholdMemberResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_households_proto_rawDescGZIP(), []int{11}
+}
+
+type RemoveHouseholdMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HouseholdId   int32                  `protobuf:"varint,1,opt,name=household_id,json=householdId,proto3" json:"household_id,omitempty"`
+	UserId        int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveHouseholdMemberRequest) Reset() {
+	*x = RemoveHouseholdMemberRequest{}
+	mi := &file_api_v1_households_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveHouseholdMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveHouseholdMemberRequest) ProtoMessage() {}
+
+func (x *RemoveHouseholdMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_households_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveHouseholdMemberRequest.ProtoReflect.Descriptor instead.
+func (*RemoveHouseholdMemberRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_households_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RemoveHouseholdMemberRequest) GetHouseholdId() int32 {
+	if x != nil {
+		return x.HouseholdId
+	}
+	return 0
+}
+
+func (x *RemoveHouseholdMemberRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type RemoveHouseholdMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveHouseholdMemberResponse) Reset() {
+	*x = RemoveHouseholdMemberResponse{}
+	mi := &file_api_v1_households_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveHouseholdMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveHouseholdMemberResponse) ProtoMessage() {}
+
+func (x *RemoveHouseholdMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_households_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveHouseholdMemberResponse.ProtoReflect.Descriptor instead.
+func (*RemoveHouseholdMemberResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_households_proto_rawDescGZIP(), []int{13}
+}
+
+type CreateHouseholdInvitationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HouseholdId   int32                  `protobuf:"varint,1,opt,name=household_id,json=householdId,proto3" json:"household_id,omitempty"`
+	Role          string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateHouseholdInvitationRequest) Reset() {
+	*x = CreateHouseholdInvitationRequest{}
+	mi := &file_api_v1_households_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateHouseholdInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateHouseholdInvitationRequest) ProtoMessage() {}
+
+func (x *CreateHouseholdInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_households_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateHouseholdInvitationRequest.ProtoReflect.Descriptor instead.
+func (*CreateHouseholdInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_households_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CreateHouseholdInvitationRequest) GetHouseholdId() int32 {
+	if x != nil {
+		return x.HouseholdId
+	}
+	return 0
+}
+
+func (x *CreateHouseholdInvitationRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type CreateHouseholdInvitationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	ExpiresAt     string                 `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateHouseholdInvitationResponse) Reset() {
+	*x = CreateHouseholdInvitationResponse{}
+	mi := &file_api_v1_households_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateHouseholdInvitationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateHouseholdInvitationResponse) ProtoMessage() {}
+
+func (x *CreateHouseholdInvitationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_households_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateHouseholdInvitationResponse.ProtoReflect.Descriptor instead.
+func (*CreateHouseholdInvitationResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_households_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CreateHouseholdInvitationResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *CreateHouseholdInvitationResponse) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+type AcceptHouseholdInvitationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptHouseholdInvitationRequest) Reset() {
+	*x = AcceptHouseholdInvitationRequest{}
+	mi := &file_api_v1_households_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptHouseholdInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptHouseholdInvitationRequest) ProtoMessage() {}
+
+func (x *AcceptHouseholdInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_households_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptHouseholdInvitationRequest.ProtoReflect.Descriptor instead.
+func (*AcceptHouseholdInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_households_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *AcceptHouseholdInvitationRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type AcceptHouseholdInvitationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Household     *Household             `protobuf:"bytes,1,opt,name=household,proto3" json:"household,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptHouseholdInvitationResponse) Reset() {
+	*x = AcceptHouseholdInvitationResponse{}
+	mi := &file_api_v1_households_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptHouseholdInvitationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptHouseholdInvitationResponse) ProtoMessage() {}
+
+func (x *AcceptHouseholdInvitationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_households_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptHouseholdInvitationResponse.ProtoReflect.Descriptor instead.
+func (*AcceptHouseholdInvitationResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_households_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *AcceptHouseholdInvitationResponse) GetHousehold() *Household {
+	if x != nil {
+		return x.Household
+	}
+	return nil
+}
+
+var File_api_v1_households_proto protoreflect.FileDescriptor
+
+const file_api_v1_households_proto_rawDesc = "" +
+	"\n" +
+	"\x17api/v1/households.proto\x12\x06api.v1\"\x87\x01\n" +
+	"\tHousehold\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\x12#\n" +
+	"\rbase_currency\x18\x04 \x01(\tR\fbaseCurrency\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\tR\tcreatedAt\"w\n" +
+	"\x0fHouseholdMember\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\x12\x1b\n" +
+	"\tjoined_at\x18\x04 \x01(\tR\bjoinedAt\"\x17\n" +
+	"\x15ListHouseholdsRequest\"K\n" +
+	"\x16ListHouseholdsResponse\x121\n" +
+	"\n" +
+	"households\x18\x01 \x03(\v2\x11.api.v1.HouseholdR\n" +
+	"households\",\n" +
+	"\x16CreateHouseholdRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"J\n" +
+	"\x17CreateHouseholdResponse\x12/\n" +
+	"\thousehold\x18\x01 \x01(\v2\x11.api.v1.HouseholdR\thousehold\"t\n" +
+	"\x16UpdateHouseholdRequest\x12!\n" +
+	"\fhousehold_id\x18\x01 \x01(\x05R\vhouseholdId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12#\n" +
+	"\rbase_currency\x18\x03 \x01(\tR\fbaseCurrency\"J\n" +
+	"\x17UpdateHouseholdResponse\x12/\n" +
+	"\thousehold\x18\x01 \x01(\v2\x11.api.v1.HouseholdR\thousehold\"@\n" +
+	"\x1bListHouseholdMembersRequest\x12!\n" +
+	"\fhousehold_id\x18\x01 \x01(\x05R\vhouseholdId\"Q\n" +
+	"\x1cListHouseholdMembersResponse\x121\n" +
+	"\amembers\x18\x01 \x03(\v2\x17.api.v1.HouseholdMemberR\amembers\"n\n" +
+	"\x1cUpdateHouseholdMemberRequest\x12!\n" +
+	"\fhousehold_id\x18\x01 \x01(\x05R\vhouseholdId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\"\x1f\n" +
+	"\x1dUpdateHouseholdMemberResponse\"Z\n" +
+	"\x1cRemoveHouseholdMemberRequest\x12!\n" +
+	"\fhousehold_id\x18\x01 \x01(\x05R\vhouseholdId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\"\x1f\n" +
+	"\x1dRemoveHouseholdMemberResponse\"Y\n" +
+	" CreateHouseholdInvitationRequest\x12!\n" +
+	"\fhousehold_id\x18\x01 \x01(\x05R\vhouseholdId\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\"X\n" +
+	"!CreateHouseholdInvitationResponse\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\tR\texpiresAt\"8\n" +
+	" AcceptHouseholdInvitationRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"T\n" +
+	"!AcceptHouseholdInvitationResponse\x12/\n" +
+	"\thousehold\x18\x01 \x01(\v2\x11.api.v1.HouseholdR\thousehold2\xb4\x06\n" +
+	"\x10HouseholdService\x12T\n" +
+	"\x0eListHouseholds\x12\x1d.api.v1.ListHouseholdsRequest\x1a\x1e.api.v1.ListHouseholdsResponse\"\x03\x90\x02\x01\x12T\n" +
+	"\x0fCreateHousehold\x12\x1e.api.v1.CreateHouseholdRequest\x1a\x1f.api.v1.CreateHouseholdResponse\"\x00\x12T\n" +
+	"\x0fUpdateHousehold\x12\x1e.api.v1.UpdateHouseholdRequest\x1a\x1f.api.v1.UpdateHouseholdResponse\"\x00\x12f\n" +
+	"\x14ListHouseholdMembers\x12#.api.v1.ListHouseholdMembersRequest\x1a$.api.v1.ListHouseholdMembersResponse\"\x03\x90\x02\x01\x12f\n" +
+	"\x15UpdateHouseholdMember\x12$.api.v1.UpdateHouseholdMemberRequest\x1a%.api.v1.UpdateHouseholdMemberResponse\"\x00\x12f\n" +
+	"\x15RemoveHouseholdMember\x12$.api.v1.RemoveHouseholdMemberRequest\x1a%.api.v1.RemoveHouseholdMemberResponse\"\x00\x12r\n" +
+	"\x19CreateHouseholdInvitation\x12(.api.v1.CreateHouseholdInvitationRequest\x1a).api.v1.CreateHouseholdInvitationResponse\"\x00\x12r\n" +
+	"\x19AcceptHouseholdInvitation\x12(.api.v1.AcceptHouseholdInvitationRequest\x1a).api.v1.AcceptHouseholdInvitationResponse\"\x00Bz\n" +
+	"\n" +
+	"com.api.v1B\x0fHouseholdsProtoP\x01Z\"cashtrack/backend/gen/api/v1;apiv1\xa2\x02\x03AXX\xaa\x02\x06Api.V1\xca\x02\x06Api\\V1\xe2\x02\x12Api\\V1\\GPBMetadata\xea\x02\aApi::V1b\x06proto3"
+
+var (
+	file_api_v1_households_proto_rawDescOnce sync.Once
+	file_api_v1_households_proto_rawDescData []byte
+)
+
+func file_api_v1_households_proto_rawDescGZIP() []byte {
+	file_api_v1_households_proto_rawDescOnce.Do(func() {
+		file_api_v1_households_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_v1_households_proto_rawDesc), len(file_api_v1_households_proto_rawDesc)))
+	})
+	return file_api_v1_households_proto_rawDescData
+}
+
+var file_api_v1_households_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_api_v1_households_proto_goTypes = []any{
+	(*Household)(nil),                         // 0: api.v1.Household
+	(*HouseholdMember)(nil),                   // 1: api.v1.HouseholdMember
+	(*ListHouseholdsRequest)(nil),             // 2: api.v1.ListHouseholdsRequest
+	(*ListHouseholdsResponse)(nil),            // 3: api.v1.ListHouseholdsResponse
+	(*CreateHouseholdRequest)(nil),            // 4: api.v1.CreateHouseholdRequest
+	(*CreateHouseholdResponse)(nil),           // 5: api.v1.CreateHouseholdResponse
+	(*UpdateHouseholdRequest)(nil),            // 6: api.v1.UpdateHouseholdRequest
+	(*UpdateHouseholdResponse)(nil),           // 7: api.v1.UpdateHouseholdResponse
+	(*ListHouseholdMembersRequest)(nil),       // 8: api.v1.ListHouseholdMembersRequest
+	(*ListHouseholdMembersResponse)(nil),      // 9: api.v1.ListHouseholdMembersResponse
+	(*UpdateHouseholdMemberRequest)(nil),      // 10: api.v1.UpdateHouseholdMemberRequest
+	(*UpdateHouseholdMemberResponse)(nil),     // 11: api.v1.UpdateHouseholdMemberResponse
+	(*RemoveHouseholdMemberRequest)(nil),      // 12: api.v1.RemoveHouseholdMemberRequest
+	(*RemoveHouseholdMemberResponse)(nil),     // 13: api.v1.RemoveHouseholdMemberResponse
+	(*CreateHouseholdInvitationRequest)(nil),  // 14: api.v1.CreateHouseholdInvitationRequest
+	(*CreateHouseholdInvitationResponse)(nil), // 15: api.v1.CreateHouseholdInvitationResponse
+	(*AcceptHouseholdInvitationRequest)(nil),  // 16: api.v1.AcceptHouseholdInvitationRequest
+	(*AcceptHouseholdInvitationResponse)(nil), // 17: api.v1.AcceptHouseholdInvitationResponse
+}
+var file_api_v1_households_proto_depIdxs = []int32{
+	0,  // 0: api.v1.ListHouseholdsResponse.households:type_name -> api.v1.Household
+	0,  // 1: api.v1.CreateHouseholdResponse.household:type_name -> api.v1.Household
+	0,  // 2: api.v1.UpdateHouseholdResponse.household:type_name -> api.v1.Household
+	1,  // 3: api.v1.ListHouseholdMembersResponse.members:type_name -> api.v1.HouseholdMember
+	0,  // 4: api.v1.AcceptHouseholdInvitationResponse.household:type_name -> api.v1.Household
+	2,  // 5: api.v1.HouseholdService.ListHouseholds:input_type -> api.v1.ListHouseholdsRequest
+	4,  // 6: api.v1.HouseholdService.CreateHousehold:input_type -> api.v1.CreateHouseholdRequest
+	6,  // 7: api.v1.HouseholdService.UpdateHousehold:input_type -> api.v1.UpdateHouseholdRequest
+	8,  // 8: api.v1.HouseholdService.ListHouseholdMembers:input_type -> api.v1.ListHouseholdMembersRequest
+	10, // 9: api.v1.HouseholdService.UpdateHouseholdMember:input_type -> api.v1.UpdateHouseholdMemberRequest
+	12, // 10: api.v1.HouseholdService.RemoveHouseholdMember:input_type -> api.v1.RemoveHouseholdMemberRequest
+	14, // 11: api.v1.HouseholdService.CreateHouseholdInvitation:input_type -> api.v1.CreateHouseholdInvitationRequest
+	16, // 12: api.v1.HouseholdService.AcceptHouseholdInvitation:input_type -> api.v1.AcceptHouseholdInvitationRequest
+	3,  // 13: api.v1.HouseholdService.ListHouseholds:output_type -> api.v1.ListHouseholdsResponse
+	5,  // 14: api.v1.HouseholdService.CreateHousehold:output_type -> api.v1.CreateHouseholdResponse
+	7,  // 15: api.v1.HouseholdService.UpdateHousehold:output_type -> api.v1.UpdateHouseholdResponse
+	9,  // 16: api.v1.HouseholdService.ListHouseholdMembers:output_type -> api.v1.ListHouseholdMembersResponse
+	11, // 17: api.v1.HouseholdService.UpdateHouseholdMember:output_type -> api.v1.UpdateHouseholdMemberResponse
+	13, // 18: api.v1.HouseholdService.RemoveHouseholdMember:output_type -> api.v1.RemoveHouseholdMemberResponse
+	15, // 19: api.v1.HouseholdService.CreateHouseholdInvitation:output_type -> api.v1.CreateHouseholdInvitationResponse
+	17, // 20: api.v1.HouseholdService.AcceptHouseholdInvitation:output_type -> api.v1.AcceptHouseholdInvitationResponse
+	13, // [13:21] is the sub-list for method output_type
+	5,  // [5:13] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_api_v1_households_proto_init() }
+func file_api_v1_households_proto_init() {
+	if File_api_v1_households_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_households_proto_rawDesc), len(file_api_v1_households_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   18,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_v1_households_proto_goTypes,
+		DependencyIndexes: file_api_v1_households_proto_depIdxs,
+		MessageInfos:      file_api_v1_households_proto_msgTypes,
+	}.Build()
+	File_api_v1_households_proto = out.File
+	file_api_v1_households_proto_goTypes = nil
+	file_api_v1_households_proto_depIdxs = nil
+}
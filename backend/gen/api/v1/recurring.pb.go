@@ -0,0 +1,720 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: api/v1/recurring.proto
+
+package apiv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type RecurringSeries struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Merchant         string                 `protobuf:"bytes,3,opt,name=merchant,proto3" json:"merchant,omitempty"`
+	Cadence          string                 `protobuf:"bytes,4,opt,name=cadence,proto3" json:"cadence,omitempty"`
+	Amount           int64                  `protobuf:"varint,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency         string                 `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency,omitempty"`
+	Status           string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	ChargeCount      int32                  `protobuf:"varint,8,opt,name=charge_count,json=chargeCount,proto3" json:"charge_count,omitempty"`
+	LastChargeDate   string                 `protobuf:"bytes,9,opt,name=last_charge_date,json=lastChargeDate,proto3" json:"last_charge_date,omitempty"`
+	LastChargeAmount int64                  `protobuf:"varint,10,opt,name=last_charge_amount,json=lastChargeAmount,proto3" json:"last_charge_amount,omitempty"`
+	NextChargeDate   string                 `protobuf:"bytes,11,opt,name=next_charge_date,json=nextChargeDate,proto3" json:"next_charge_date,omitempty"`
+	NextChargeAmount int64                  `protobuf:"varint,12,opt,name=next_charge_amount,json=nextChargeAmount,proto3" json:"next_charge_amount,omitempty"`
+	PriceChanged     bool                   `protobuf:"varint,13,opt,name=price_changed,json=priceChanged,proto3" json:"price_changed,omitempty"`
+	Missed           bool                   `protobuf:"varint,14,opt,name=missed,proto3" json:"missed,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *RecurringSeries) Reset() {
+	*x = RecurringSeries{}
+	mi := &file_api_v1_recurring_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecurringSeries) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecurringSeries) ProtoMessage() {}
+
+func (x *RecurringSeries) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_recurring_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecurringSeries.ProtoReflect.Descriptor instead.
+func (*RecurringSeries) Descriptor() ([]byte, []int) {
+	return file_api_v1_recurring_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RecurringSeries) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RecurringSeries) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RecurringSeries) GetMerchant() string {
+	if x != nil {
+		return x.Merchant
+	}
+	return ""
+}
+
+func (x *RecurringSeries) GetCadence() string {
+	if x != nil {
+		return x.Cadence
+	}
+	return ""
+}
+
+func (x *RecurringSeries) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *RecurringSeries) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *RecurringSeries) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *RecurringSeries) GetChargeCount() int32 {
+	if x != nil {
+		return x.ChargeCount
+	}
+	return 0
+}
+
+func (x *RecurringSeries) GetLastChargeDate() string {
+	if x != nil {
+		return x.LastChargeDate
+	}
+	return ""
+}
+
+func (x *RecurringSeries) GetLastChargeAmount() int64 {
+	if x != nil {
+		return x.LastChargeAmount
+	}
+	return 0
+}
+
+func (x *RecurringSeries) GetNextChargeDate() string {
+	if x != nil {
+		return x.NextChargeDate
+	}
+	return ""
+}
+
+func (x *RecurringSeries) GetNextChargeAmount() int64 {
+	if x != nil {
+		return x.NextChargeAmount
+	}
+	return 0
+}
+
+func (x *RecurringSeries) GetPriceChanged() bool {
+	if x != nil {
+		return x.PriceChanged
+	}
+	return false
+}
+
+func (x *RecurringSeries) GetMissed() bool {
+	if x != nil {
+		return x.Missed
+	}
+	return false
+}
+
+type DetectRecurringRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DetectRecurringRequest) Reset() {
+	*x = DetectRecurringRequest{}
+	mi := &file_api_v1_recurring_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetectRecurringRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetectRecurringRequest) ProtoMessage() {}
+
+func (x *DetectRecurringRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_recurring_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetectRecurringRequest.ProtoReflect.Descriptor instead.
+func (*DetectRecurringRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_recurring_proto_rawDescGZIP(), []int{1}
+}
+
+type DetectRecurringResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Created       int32                  `protobuf:"varint,1,opt,name=created,proto3" json:"created,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DetectRecurringResponse) Reset() {
+	*x = DetectRecurringResponse{}
+	mi := &file_api_v1_recurring_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetectRecurringResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetectRecurringResponse) ProtoMessage() {}
+
+func (x *DetectRecurringResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_recurring_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetectRecurringResponse.ProtoReflect.Descriptor instead.
+func (*DetectRecurringResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_recurring_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DetectRecurringResponse) GetCreated() int32 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+type ListRecurringRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	IncludeIgnored bool                   `protobuf:"varint,1,opt,name=include_ignored,json=includeIgnored,proto3" json:"include_ignored,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListRecurringRequest) Reset() {
+	*x = ListRecurringRequest{}
+	mi := &file_api_v1_recurring_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRecurringRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRecurringRequest) ProtoMessage() {}
+
+func (x *ListRecurringRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_recurring_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRecurringRequest.ProtoReflect.Descriptor instead.
+func (*ListRecurringRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_recurring_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListRecurringRequest) GetIncludeIgnored() bool {
+	if x != nil {
+		return x.IncludeIgnored
+	}
+	return false
+}
+
+type ListRecurringResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Series        []*RecurringSeries     `protobuf:"bytes,1,rep,name=series,proto3" json:"series,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRecurringResponse) Reset() {
+	*x = ListRecurringResponse{}
+	mi := &file_api_v1_recurring_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRecurringResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRecurringResponse) ProtoMessage() {}
+
+func (x *ListRecurringResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_recurring_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRecurringResponse.ProtoReflect.Descriptor instead.
+func (*ListRecurringResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_recurring_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListRecurringResponse) GetSeries() []*RecurringSeries {
+	if x != nil {
+		return x.Series
+	}
+	return nil
+}
+
+type ConfirmRecurringRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmRecurringRequest) Reset() {
+	*x = ConfirmRecurringRequest{}
+	mi := &file_api_v1_recurring_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmRecurringRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmRecurringRequest) ProtoMessage() {}
+
+func (x *ConfirmRecurringRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_recurring_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmRecurringRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmRecurringRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_recurring_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ConfirmRecurringRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ConfirmRecurringResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmRecurringResponse) Reset() {
+	*x = ConfirmRecurringResponse{}
+	mi := &file_api_v1_recurring_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmRecurringResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmRecurringResponse) ProtoMessage() {}
+
+func (x *ConfirmRecurringResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_recurring_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmRecurringResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmRecurringResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_recurring_proto_rawDescGZIP(), []int{6}
+}
+
+type IgnoreRecurringRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IgnoreRecurringRequest) Reset() {
+	*x = IgnoreRecurringRequest{}
+	mi := &file_api_v1_recurring_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IgnoreRecurringRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IgnoreRecurringRequest) ProtoMessage() {}
+
+func (x *IgnoreRecurringRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_recurring_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IgnoreRecurringRequest.ProtoReflect.Descriptor instead.
+func (*IgnoreRecurringRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_recurring_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *IgnoreRecurringRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type IgnoreRecurringResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IgnoreRecurringResponse) Reset() {
+	*x = IgnoreRecurringResponse{}
+	mi := &file_api_v1_recurring_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IgnoreRecurringResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IgnoreRecurringResponse) ProtoMessage() {}
+
+func (x *IgnoreRecurringResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_recurring_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IgnoreRecurringResponse.ProtoReflect.Descriptor instead.
+func (*IgnoreRecurringResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_recurring_proto_rawDescGZIP(), []int{8}
+}
+
+type UpdateRecurringRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Cadence       string                 `protobuf:"bytes,3,opt,name=cadence,proto3" json:"cadence,omitempty"`
+	Amount        int64                  `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRecurringRequest) Reset() {
+	*x = UpdateRecurringRequest{}
+	mi := &file_api_v1_recurring_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRecurringRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRecurringRequest) ProtoMessage() {}
+
+func (x *UpdateRecurringRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_recurring_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRecurringRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRecurringRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_recurring_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UpdateRecurringRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateRecurringRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateRecurringRequest) GetCadence() string {
+	if x != nil {
+		return x.Cadence
+	}
+	return ""
+}
+
+func (x *UpdateRecurringRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type UpdateRecurringResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRecurringResponse) Reset() {
+	*x = UpdateRecurringResponse{}
+	mi := &file_api_v1_recurring_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRecurringResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRecurringResponse) ProtoMessage() {}
+
+func (x *UpdateRecurringResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_recurring_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRecurringResponse.ProtoReflect.Descriptor instead.
+func (*UpdateRecurringResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_recurring_proto_rawDescGZIP(), []int{10}
+}
+
+var File_api_v1_recurring_proto protoreflect.FileDescriptor
+
+const file_api_v1_recurring_proto_rawDesc = "" +
+	"\n" +
+	"\x16api/v1/recurring.proto\x12\x06api.v1\"\xc7\x03\n" +
+	"\x0fRecurringSeries\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\bmerchant\x18\x03 \x01(\tR\bmerchant\x12\x18\n" +
+	"\acadence\x18\x04 \x01(\tR\acadence\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\x03R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\x06 \x01(\tR\bcurrency\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12!\n" +
+	"\fcharge_count\x18\b \x01(\x05R\vchargeCount\x12(\n" +
+	"\x10last_charge_date\x18\t \x01(\tR\x0elastChargeDate\x12,\n" +
+	"\x12last_charge_amount\x18\n" +
+	" \x01(\x03R\x10lastChargeAmount\x12(\n" +
+	"\x10next_charge_date\x18\v \x01(\tR\x0enextChargeDate\x12,\n" +
+	"\x12next_charge_amount\x18\f \x01(\x03R\x10nextChargeAmount\x12#\n" +
+	"\rprice_changed\x18\r \x01(\bR\fpriceChanged\x12\x16\n" +
+	"\x06missed\x18\x0e \x01(\bR\x06missed\"\x18\n" +
+	"\x16DetectRecurringRequest\"3\n" +
+	"\x17DetectRecurringResponse\x12\x18\n" +
+	"\acreated\x18\x01 \x01(\x05R\acreated\"?\n" +
+	"\x14ListRecurringRequest\x12'\n" +
+	"\x0finclude_ignored\x18\x01 \x01(\bR\x0eincludeIgnored\"H\n" +
+	"\x15ListRecurringResponse\x12/\n" +
+	"\x06series\x18\x01 \x03(\v2\x17.api.v1.RecurringSeriesR\x06series\")\n" +
+	"\x17ConfirmRecurringRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"\x1a\n" +
+	"\x18ConfirmRecurringResponse\"(\n" +
+	"\x16IgnoreRecurringRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"\x19\n" +
+	"\x17IgnoreRecurringResponse\"n\n" +
+	"\x16UpdateRecurringRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\acadence\x18\x03 \x01(\tR\acadence\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x03R\x06amount\"\x19\n" +
+	"\x17UpdateRecurringResponse2\xc0\x03\n" +
+	"\x10RecurringService\x12T\n" +
+	"\x0fDetectRecurring\x12\x1e.api.v1.DetectRecurringRequest\x1a\x1f.api.v1.DetectRecurringResponse\"\x00\x12Q\n" +
+	"\rListRecurring\x12\x1c.api.v1.ListRecurringRequest\x1a\x1d.api.v1.ListRecurringResponse\"\x03\x90\x02\x01\x12W\n" +
+	"\x10ConfirmRecurring\x12\x1f.api.v1.ConfirmRecurringRequest\x1a .api.v1.ConfirmRecurringResponse\"\x00\x12T\n" +
+	"\x0fIgnoreRecurring\x12\x1e.api.v1.IgnoreRecurringRequest\x1a\x1f.api.v1.IgnoreRecurringResponse\"\x00\x12T\n" +
+	"\x0fUpdateRecurring\x12\x1e.api.v1.UpdateRecurringRequest\x1a\x1f.api.v1.UpdateRecurringResponse\"\x00By\n" +
+	"\n" +
+	"com.api.v1B\x0eRecurringProtoP\x01Z\"cashtrack/backend/gen/api/v1;apiv1\xa2\x02\x03AXX\xaa\x02\x06Api.V1\xca\x02\x06Api\\V1\xe2\x02\x12Api\\V1\\GPBMetadata\xea\x02\aApi::V1b\x06proto3"
+
+var (
+	file_api_v1_recurring_proto_rawDescOnce sync.Once
+	file_api_v1_recurring_proto_rawDescData []byte
+)
+
+func file_api_v1_recurring_proto_rawDescGZIP() []byte {
+	file_api_v1_recurring_proto_rawDescOnce.Do(func() {
+		file_api_v1_recurring_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_v1_recurring_proto_rawDesc), len(file_api_v1_recurring_proto_rawDesc)))
+	})
+	return file_api_v1_recurring_proto_rawDescData
+}
+
+var file_api_v1_recurring_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_api_v1_recurring_proto_goTypes = []any{
+	(*RecurringSeries)(nil),          // 0: api.v1.RecurringSeries
+	(*DetectRecurringRequest)(nil),   // 1: api.v1.DetectRecurringRequest
+	(*DetectRecurringResponse)(nil),  // 2: api.v1.DetectRecurringResponse
+	(*ListRecurringRequest)(nil),     // 3: api.v1.ListRecurringRequest
+	(*ListRecurringResponse)(nil),    // 4: api.v1.ListRecurringResponse
+	(*ConfirmRecurringRequest)(nil),  // 5: api.v1.ConfirmRecurringRequest
+	(*ConfirmRecurringResponse)(nil), // 6: api.v1.ConfirmRecurringResponse
+	(*IgnoreRecurringRequest)(nil),   // 7: api.v1.IgnoreRecurringRequest
+	(*IgnoreRecurringResponse)(nil),  // 8: api.v1.IgnoreRecurringResponse
+	(*UpdateRecurringRequest)(nil),   // 9: api.v1.UpdateRecurringRequest
+	(*UpdateRecurringResponse)(nil),  // 10: api.v1.UpdateRecurringResponse
+}
+var file_api_v1_recurring_proto_depIdxs = []int32{
+	0,  // 0: api.v1.ListRecurringResponse.series:type_name -> api.v1.RecurringSeries
+	1,  // 1: api.v1.RecurringService.DetectRecurring:input_type -> api.v1.DetectRecurringRequest
+	3,  // 2: api.v1.RecurringService.ListRecurring:input_type -> api.v1.ListRecurringRequest
+	5,  // 3: api.v1.RecurringService.ConfirmRecurring:input_type -> api.v1.ConfirmRecurringRequest
+	7,  // 4: api.v1.RecurringService.IgnoreRecurring:input_type -> api.v1.IgnoreRecurringRequest
+	9,  // 5: api.v1.RecurringService.UpdateRecurring:input_type -> api.v1.UpdateRecurringRequest
+	2,  // 6: api.v1.RecurringService.DetectRecurring:output_type -> api.v1.DetectRecurringResponse
+	4,  // 7: api.v1.RecurringService.ListRecurring:output_type -> api.v1.ListRecurringResponse
+	6,  // 8: api.v1.RecurringService.ConfirmRecurring:output_type -> api.v1.ConfirmRecurringResponse
+	8,  // 9: api.v1.RecurringService.IgnoreRecurring:output_type -> api.v1.IgnoreRecurringResponse
+	10, // 10: api.v1.RecurringService.UpdateRecurring:output_type -> api.v1.UpdateRecurringResponse
+	6,  // [6:11] is the sub-list for method output_type
+	1,  // [1:6] is the sub-list for method input_type
+	1,  // [1:1] is the sub-list for extension type_name
+	1,  // [1:1] is the sub-list for extension extendee
+	0,  // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_api_v1_recurring_proto_init() }
+func file_api_v1_recurring_proto_init() {
+	if File_api_v1_recurring_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_recurring_proto_rawDesc), len(file_api_v1_recurring_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_v1_recurring_proto_goTypes,
+		DependencyIndexes: file_api_v1_recurring_proto_depIdxs,
+		MessageInfos:      file_api_v1_recurring_proto_msgTypes,
+	}.Build()
+	File_api_v1_recurring_proto = out.File
+	file_api_v1_recurring_proto_goTypes = nil
+	file_api_v1_recurring_proto_depIdxs = nil
+}
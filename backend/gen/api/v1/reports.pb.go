@@ -29,6 +29,8 @@ type ReportInfo struct {
 	Status            string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
 	UploadedAt        string                 `protobuf:"bytes,5,opt,name=uploaded_at,json=uploadedAt,proto3" json:"uploaded_at,omitempty"`
 	StatusDescription string                 `protobuf:"bytes,6,opt,name=status_description,json=statusDescription,proto3" json:"status_description,omitempty"`
+	TransactionCount  int32                  `protobuf:"varint,7,opt,name=transaction_count,json=transactionCount,proto3" json:"transaction_count,omitempty"`
+	DiagnosticCount   int32                  `protobuf:"varint,8,opt,name=diagnostic_count,json=diagnosticCount,proto3" json:"diagnostic_count,omitempty"`
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
@@ -105,6 +107,88 @@ func (x *ReportInfo) GetStatusDescription() string {
 	return ""
 }
 
+func (x *ReportInfo) GetTransactionCount() int32 {
+	if x != nil {
+		return x.TransactionCount
+	}
+	return 0
+}
+
+func (x *ReportInfo) GetDiagnosticCount() int32 {
+	if x != nil {
+		return x.DiagnosticCount
+	}
+	return 0
+}
+
+type ReportDiagnostic struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Row           int32                  `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	Severity      string                 `protobuf:"bytes,2,opt,name=severity,proto3" json:"severity,omitempty"`
+	Record        string                 `protobuf:"bytes,3,opt,name=record,proto3" json:"record,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportDiagnostic) Reset() {
+	*x = ReportDiagnostic{}
+	mi := &file_api_v1_reports_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportDiagnostic) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportDiagnostic) ProtoMessage() {}
+
+func (x *ReportDiagnostic) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_reports_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportDiagnostic.ProtoReflect.Descriptor instead.
+func (*ReportDiagnostic) Descriptor() ([]byte, []int) {
+	return file_api_v1_reports_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ReportDiagnostic) GetRow() int32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *ReportDiagnostic) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+func (x *ReportDiagnostic) GetRecord() string {
+	if x != nil {
+		return x.Record
+	}
+	return ""
+}
+
+func (x *ReportDiagnostic) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
 type UploadReportRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Filename      string                 `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
@@ -116,7 +200,7 @@ type UploadReportRequest struct {
 
 func (x *UploadReportRequest) Reset() {
 	*x = UploadReportRequest{}
-	mi := &file_api_v1_reports_proto_msgTypes[1]
+	mi := &file_api_v1_reports_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -128,7 +212,7 @@ func (x *UploadReportRequest) String() string {
 func (*UploadReportRequest) ProtoMessage() {}
 
 func (x *UploadReportRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_reports_proto_msgTypes[1]
+	mi := &file_api_v1_reports_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -141,7 +225,7 @@ func (x *UploadReportRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UploadReportRequest.ProtoReflect.Descriptor instead.
 func (*UploadReportRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_reports_proto_rawDescGZIP(), []int{1}
+	return file_api_v1_reports_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *UploadReportRequest) GetFilename() string {
@@ -173,7 +257,7 @@ type UploadReportResponse struct {
 
 func (x *UploadReportResponse) Reset() {
 	*x = UploadReportResponse{}
-	mi := &file_api_v1_reports_proto_msgTypes[2]
+	mi := &file_api_v1_reports_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -185,7 +269,7 @@ func (x *UploadReportResponse) String() string {
 func (*UploadReportResponse) ProtoMessage() {}
 
 func (x *UploadReportResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_reports_proto_msgTypes[2]
+	mi := &file_api_v1_reports_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -198,7 +282,7 @@ func (x *UploadReportResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UploadReportResponse.ProtoReflect.Descriptor instead.
 func (*UploadReportResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_reports_proto_rawDescGZIP(), []int{2}
+	return file_api_v1_reports_proto_rawDescGZIP(), []int{3}
 }
 
 type ListReportsRequest struct {
@@ -209,7 +293,7 @@ type ListReportsRequest struct {
 
 func (x *ListReportsRequest) Reset() {
 	*x = ListReportsRequest{}
-	mi := &file_api_v1_reports_proto_msgTypes[3]
+	mi := &file_api_v1_reports_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -221,7 +305,7 @@ func (x *ListReportsRequest) String() string {
 func (*ListReportsRequest) ProtoMessage() {}
 
 func (x *ListReportsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_reports_proto_msgTypes[3]
+	mi := &file_api_v1_reports_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -234,7 +318,7 @@ func (x *ListReportsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListReportsRequest.ProtoReflect.Descriptor instead.
 func (*ListReportsRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_reports_proto_rawDescGZIP(), []int{3}
+	return file_api_v1_reports_proto_rawDescGZIP(), []int{4}
 }
 
 type ListReportsResponse struct {
@@ -246,7 +330,7 @@ type ListReportsResponse struct {
 
 func (x *ListReportsResponse) Reset() {
 	*x = ListReportsResponse{}
-	mi := &file_api_v1_reports_proto_msgTypes[4]
+	mi := &file_api_v1_reports_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -258,7 +342,7 @@ func (x *ListReportsResponse) String() string {
 func (*ListReportsResponse) ProtoMessage() {}
 
 func (x *ListReportsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_reports_proto_msgTypes[4]
+	mi := &file_api_v1_reports_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -271,7 +355,7 @@ func (x *ListReportsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListReportsResponse.ProtoReflect.Descriptor instead.
 func (*ListReportsResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_reports_proto_rawDescGZIP(), []int{4}
+	return file_api_v1_reports_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ListReportsResponse) GetReports() []*ReportInfo {
@@ -290,7 +374,7 @@ type DownloadReportRequest struct {
 
 func (x *DownloadReportRequest) Reset() {
 	*x = DownloadReportRequest{}
-	mi := &file_api_v1_reports_proto_msgTypes[5]
+	mi := &file_api_v1_reports_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -302,7 +386,7 @@ func (x *DownloadReportRequest) String() string {
 func (*DownloadReportRequest) ProtoMessage() {}
 
 func (x *DownloadReportRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_reports_proto_msgTypes[5]
+	mi := &file_api_v1_reports_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -315,7 +399,7 @@ func (x *DownloadReportRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DownloadReportRequest.ProtoReflect.Descriptor instead.
 func (*DownloadReportRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_reports_proto_rawDescGZIP(), []int{5}
+	return file_api_v1_reports_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *DownloadReportRequest) GetId() int32 {
@@ -336,7 +420,7 @@ type DownloadReportResponse struct {
 
 func (x *DownloadReportResponse) Reset() {
 	*x = DownloadReportResponse{}
-	mi := &file_api_v1_reports_proto_msgTypes[6]
+	mi := &file_api_v1_reports_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -348,7 +432,7 @@ func (x *DownloadReportResponse) String() string {
 func (*DownloadReportResponse) ProtoMessage() {}
 
 func (x *DownloadReportResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_reports_proto_msgTypes[6]
+	mi := &file_api_v1_reports_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -361,7 +445,7 @@ func (x *DownloadReportResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DownloadReportResponse.ProtoReflect.Descriptor instead.
 func (*DownloadReportResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_reports_proto_rawDescGZIP(), []int{6}
+	return file_api_v1_reports_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *DownloadReportResponse) GetData() []byte {
@@ -394,7 +478,7 @@ type DeleteReportRequest struct {
 
 func (x *DeleteReportRequest) Reset() {
 	*x = DeleteReportRequest{}
-	mi := &file_api_v1_reports_proto_msgTypes[7]
+	mi := &file_api_v1_reports_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -406,7 +490,7 @@ func (x *DeleteReportRequest) String() string {
 func (*DeleteReportRequest) ProtoMessage() {}
 
 func (x *DeleteReportRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_reports_proto_msgTypes[7]
+	mi := &file_api_v1_reports_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -419,7 +503,7 @@ func (x *DeleteReportRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteReportRequest.ProtoReflect.Descriptor instead.
 func (*DeleteReportRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_reports_proto_rawDescGZIP(), []int{7}
+	return file_api_v1_reports_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *DeleteReportRequest) GetId() int32 {
@@ -437,7 +521,7 @@ type DeleteReportResponse struct {
 
 func (x *DeleteReportResponse) Reset() {
 	*x = DeleteReportResponse{}
-	mi := &file_api_v1_reports_proto_msgTypes[8]
+	mi := &file_api_v1_reports_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -449,7 +533,7 @@ func (x *DeleteReportResponse) String() string {
 func (*DeleteReportResponse) ProtoMessage() {}
 
 func (x *DeleteReportResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_reports_proto_msgTypes[8]
+	mi := &file_api_v1_reports_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -462,14 +546,366 @@ func (x *DeleteReportResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteReportResponse.ProtoReflect.Descriptor instead.
 func (*DeleteReportResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_reports_proto_rawDescGZIP(), []int{8}
+	return file_api_v1_reports_proto_rawDescGZIP(), []int{9}
+}
+
+type WatchReportsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchReportsRequest) Reset() {
+	*x = WatchReportsRequest{}
+	mi := &file_api_v1_reports_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchReportsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchReportsRequest) ProtoMessage() {}
+
+func (x *WatchReportsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_reports_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchReportsRequest.ProtoReflect.Descriptor instead.
+func (*WatchReportsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_reports_proto_rawDescGZIP(), []int{10}
+}
+
+type WatchReportsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Report        *ReportInfo            `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
+	Deleted       bool                   `protobuf:"varint,2,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchReportsResponse) Reset() {
+	*x = WatchReportsResponse{}
+	mi := &file_api_v1_reports_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchReportsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchReportsResponse) ProtoMessage() {}
+
+func (x *WatchReportsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_reports_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchReportsResponse.ProtoReflect.Descriptor instead.
+func (*WatchReportsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_reports_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *WatchReportsResponse) GetReport() *ReportInfo {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+func (x *WatchReportsResponse) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+type GetReportDiagnosticsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReportDiagnosticsRequest) Reset() {
+	*x = GetReportDiagnosticsRequest{}
+	mi := &file_api_v1_reports_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReportDiagnosticsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReportDiagnosticsRequest) ProtoMessage() {}
+
+func (x *GetReportDiagnosticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_reports_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReportDiagnosticsRequest.ProtoReflect.Descriptor instead.
+func (*GetReportDiagnosticsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_reports_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetReportDiagnosticsRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetReportDiagnosticsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Diagnostics   []*ReportDiagnostic    `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReportDiagnosticsResponse) Reset() {
+	*x = GetReportDiagnosticsResponse{}
+	mi := &file_api_v1_reports_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReportDiagnosticsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReportDiagnosticsResponse) ProtoMessage() {}
+
+func (x *GetReportDiagnosticsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_reports_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReportDiagnosticsResponse.ProtoReflect.Descriptor instead.
+func (*GetReportDiagnosticsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_reports_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetReportDiagnosticsResponse) GetDiagnostics() []*ReportDiagnostic {
+	if x != nil {
+		return x.Diagnostics
+	}
+	return nil
+}
+
+type ReprocessReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReprocessReportRequest) Reset() {
+	*x = ReprocessReportRequest{}
+	mi := &file_api_v1_reports_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReprocessReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReprocessReportRequest) ProtoMessage() {}
+
+func (x *ReprocessReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_reports_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReprocessReportRequest.ProtoReflect.Descriptor instead.
+func (*ReprocessReportRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_reports_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ReprocessReportRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ReprocessReportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReprocessReportResponse) Reset() {
+	*x = ReprocessReportResponse{}
+	mi := &file_api_v1_reports_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReprocessReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReprocessReportResponse) ProtoMessage() {}
+
+func (x *ReprocessReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_reports_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReprocessReportResponse.ProtoReflect.Descriptor instead.
+func (*ReprocessReportResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_reports_proto_rawDescGZIP(), []int{15}
+}
+
+type ReprocessReportsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []int32                `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	Outdated      bool                   `protobuf:"varint,2,opt,name=outdated,proto3" json:"outdated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReprocessReportsRequest) Reset() {
+	*x = ReprocessReportsRequest{}
+	mi := &file_api_v1_reports_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReprocessReportsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReprocessReportsRequest) ProtoMessage() {}
+
+func (x *ReprocessReportsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_reports_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReprocessReportsRequest.ProtoReflect.Descriptor instead.
+func (*ReprocessReportsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_reports_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ReprocessReportsRequest) GetIds() []int32 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *ReprocessReportsRequest) GetOutdated() bool {
+	if x != nil {
+		return x.Outdated
+	}
+	return false
+}
+
+type ReprocessReportsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []int32                `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReprocessReportsResponse) Reset() {
+	*x = ReprocessReportsResponse{}
+	mi := &file_api_v1_reports_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReprocessReportsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReprocessReportsResponse) ProtoMessage() {}
+
+func (x *ReprocessReportsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_reports_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReprocessReportsResponse.ProtoReflect.Descriptor instead.
+func (*ReprocessReportsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_reports_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ReprocessReportsResponse) GetIds() []int32 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
 }
 
 var File_api_v1_reports_proto protoreflect.FileDescriptor
 
 const file_api_v1_reports_proto_rawDesc = "" +
 	"\n" +
-	"\x14api/v1/reports.proto\x12\x06api.v1\"\xbf\x01\n" +
+	"\x14api/v1/reports.proto\x12\x06api.v1\"\x97\x02\n" +
 	"\n" +
 	"ReportInfo\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1a\n" +
@@ -479,7 +915,14 @@ const file_api_v1_reports_proto_rawDesc = "" +
 	"\x06status\x18\x04 \x01(\tR\x06status\x12\x1f\n" +
 	"\vuploaded_at\x18\x05 \x01(\tR\n" +
 	"uploadedAt\x12-\n" +
-	"\x12status_description\x18\x06 \x01(\tR\x11statusDescription\"h\n" +
+	"\x12status_description\x18\x06 \x01(\tR\x11statusDescription\x12+\n" +
+	"\x11transaction_count\x18\a \x01(\x05R\x10transactionCount\x12)\n" +
+	"\x10diagnostic_count\x18\b \x01(\x05R\x0fdiagnosticCount\"p\n" +
+	"\x10ReportDiagnostic\x12\x10\n" +
+	"\x03row\x18\x01 \x01(\x05R\x03row\x12\x1a\n" +
+	"\bseverity\x18\x02 \x01(\tR\bseverity\x12\x16\n" +
+	"\x06record\x18\x03 \x01(\tR\x06record\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"h\n" +
 	"\x13UploadReportRequest\x12\x1a\n" +
 	"\bfilename\x18\x01 \x01(\tR\bfilename\x12\x12\n" +
 	"\x04data\x18\x02 \x01(\fR\x04data\x12!\n" +
@@ -496,12 +939,32 @@ const file_api_v1_reports_proto_rawDesc = "" +
 	"\fcontent_type\x18\x03 \x01(\tR\vcontentType\"%\n" +
 	"\x13DeleteReportRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\"\x16\n" +
-	"\x14DeleteReportResponse2\xc6\x02\n" +
+	"\x14DeleteReportResponse\"\x15\n" +
+	"\x13WatchReportsRequest\"\\\n" +
+	"\x14WatchReportsResponse\x12*\n" +
+	"\x06report\x18\x01 \x01(\v2\x12.api.v1.ReportInfoR\x06report\x12\x18\n" +
+	"\adeleted\x18\x02 \x01(\bR\adeleted\"-\n" +
+	"\x1bGetReportDiagnosticsRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"Z\n" +
+	"\x1cGetReportDiagnosticsResponse\x12:\n" +
+	"\vdiagnostics\x18\x01 \x03(\v2\x18.api.v1.ReportDiagnosticR\vdiagnostics\"(\n" +
+	"\x16ReprocessReportRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"\x19\n" +
+	"\x17ReprocessReportResponse\"G\n" +
+	"\x17ReprocessReportsRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\x05R\x03ids\x12\x1a\n" +
+	"\boutdated\x18\x02 \x01(\bR\boutdated\",\n" +
+	"\x18ReprocessReportsResponse\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\x05R\x03ids2\xb5\x05\n" +
 	"\rReportService\x12K\n" +
-	"\fUploadReport\x12\x1b.api.v1.UploadReportRequest\x1a\x1c.api.v1.UploadReportResponse\"\x00\x12H\n" +
-	"\vListReports\x12\x1a.api.v1.ListReportsRequest\x1a\x1b.api.v1.ListReportsResponse\"\x00\x12Q\n" +
-	"\x0eDownloadReport\x12\x1d.api.v1.DownloadReportRequest\x1a\x1e.api.v1.DownloadReportResponse\"\x00\x12K\n" +
-	"\fDeleteReport\x12\x1b.api.v1.DeleteReportRequest\x1a\x1c.api.v1.DeleteReportResponse\"\x00Bw\n" +
+	"\fUploadReport\x12\x1b.api.v1.UploadReportRequest\x1a\x1c.api.v1.UploadReportResponse\"\x00\x12K\n" +
+	"\vListReports\x12\x1a.api.v1.ListReportsRequest\x1a\x1b.api.v1.ListReportsResponse\"\x03\x90\x02\x01\x12T\n" +
+	"\x0eDownloadReport\x12\x1d.api.v1.DownloadReportRequest\x1a\x1e.api.v1.DownloadReportResponse\"\x03\x90\x02\x01\x12K\n" +
+	"\fDeleteReport\x12\x1b.api.v1.DeleteReportRequest\x1a\x1c.api.v1.DeleteReportResponse\"\x00\x12P\n" +
+	"\fWatchReports\x12\x1b.api.v1.WatchReportsRequest\x1a\x1c.api.v1.WatchReportsResponse\"\x03\x90\x02\x010\x01\x12f\n" +
+	"\x14GetReportDiagnostics\x12#.api.v1.GetReportDiagnosticsRequest\x1a$.api.v1.GetReportDiagnosticsResponse\"\x03\x90\x02\x01\x12T\n" +
+	"\x0fReprocessReport\x12\x1e.api.v1.ReprocessReportRequest\x1a\x1f.api.v1.ReprocessReportResponse\"\x00\x12W\n" +
+	"\x10ReprocessReports\x12\x1f.api.v1.ReprocessReportsRequest\x1a .api.v1.ReprocessReportsResponse\"\x00Bw\n" +
 	"\n" +
 	"com.api.v1B\fReportsProtoP\x01Z\"cashtrack/backend/gen/api/v1;apiv1\xa2\x02\x03AXX\xaa\x02\x06Api.V1\xca\x02\x06Api\\V1\xe2\x02\x12Api\\V1\\GPBMetadata\xea\x02\aApi::V1b\x06proto3"
 
@@ -517,33 +980,52 @@ func file_api_v1_reports_proto_rawDescGZIP() []byte {
 	return file_api_v1_reports_proto_rawDescData
 }
 
-var file_api_v1_reports_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_api_v1_reports_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
 var file_api_v1_reports_proto_goTypes = []any{
-	(*ReportInfo)(nil),             // 0: api.v1.ReportInfo
-	(*UploadReportRequest)(nil),    // 1: api.v1.UploadReportRequest
-	(*UploadReportResponse)(nil),   // 2: api.v1.UploadReportResponse
-	(*ListReportsRequest)(nil),     // 3: api.v1.ListReportsRequest
-	(*ListReportsResponse)(nil),    // 4: api.v1.ListReportsResponse
-	(*DownloadReportRequest)(nil),  // 5: api.v1.DownloadReportRequest
-	(*DownloadReportResponse)(nil), // 6: api.v1.DownloadReportResponse
-	(*DeleteReportRequest)(nil),    // 7: api.v1.DeleteReportRequest
-	(*DeleteReportResponse)(nil),   // 8: api.v1.DeleteReportResponse
+	(*ReportInfo)(nil),                   // 0: api.v1.ReportInfo
+	(*ReportDiagnostic)(nil),             // 1: api.v1.ReportDiagnostic
+	(*UploadReportRequest)(nil),          // 2: api.v1.UploadReportRequest
+	(*UploadReportResponse)(nil),         // 3: api.v1.UploadReportResponse
+	(*ListReportsRequest)(nil),           // 4: api.v1.ListReportsRequest
+	(*ListReportsResponse)(nil),          // 5: api.v1.ListReportsResponse
+	(*DownloadReportRequest)(nil),        // 6: api.v1.DownloadReportRequest
+	(*DownloadReportResponse)(nil),       // 7: api.v1.DownloadReportResponse
+	(*DeleteReportRequest)(nil),          // 8: api.v1.DeleteReportRequest
+	(*DeleteReportResponse)(nil),         // 9: api.v1.DeleteReportResponse
+	(*WatchReportsRequest)(nil),          // 10: api.v1.WatchReportsRequest
+	(*WatchReportsResponse)(nil),         // 11: api.v1.WatchReportsResponse
+	(*GetReportDiagnosticsRequest)(nil),  // 12: api.v1.GetReportDiagnosticsRequest
+	(*GetReportDiagnosticsResponse)(nil), // 13: api.v1.GetReportDiagnosticsResponse
+	(*ReprocessReportRequest)(nil),       // 14: api.v1.ReprocessReportRequest
+	(*ReprocessReportResponse)(nil),      // 15: api.v1.ReprocessReportResponse
+	(*ReprocessReportsRequest)(nil),      // 16: api.v1.ReprocessReportsRequest
+	(*ReprocessReportsResponse)(nil),     // 17: api.v1.ReprocessReportsResponse
 }
 var file_api_v1_reports_proto_depIdxs = []int32{
-	0, // 0: api.v1.ListReportsResponse.reports:type_name -> api.v1.ReportInfo
-	1, // 1: api.v1.ReportService.UploadReport:input_type -> api.v1.UploadReportRequest
-	3, // 2: api.v1.ReportService.ListReports:input_type -> api.v1.ListReportsRequest
-	5, // 3: api.v1.ReportService.DownloadReport:input_type -> api.v1.DownloadReportRequest
-	7, // 4: api.v1.ReportService.DeleteReport:input_type -> api.v1.DeleteReportRequest
-	2, // 5: api.v1.ReportService.UploadReport:output_type -> api.v1.UploadReportResponse
-	4, // 6: api.v1.ReportService.ListReports:output_type -> api.v1.ListReportsResponse
-	6, // 7: api.v1.ReportService.DownloadReport:output_type -> api.v1.DownloadReportResponse
-	8, // 8: api.v1.ReportService.DeleteReport:output_type -> api.v1.DeleteReportResponse
-	5, // [5:9] is the sub-list for method output_type
-	1, // [1:5] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	0,  // 0: api.v1.ListReportsResponse.reports:type_name -> api.v1.ReportInfo
+	0,  // 1: api.v1.WatchReportsResponse.report:type_name -> api.v1.ReportInfo
+	1,  // 2: api.v1.GetReportDiagnosticsResponse.diagnostics:type_name -> api.v1.ReportDiagnostic
+	2,  // 3: api.v1.ReportService.UploadReport:input_type -> api.v1.UploadReportRequest
+	4,  // 4: api.v1.ReportService.ListReports:input_type -> api.v1.ListReportsRequest
+	6,  // 5: api.v1.ReportService.DownloadReport:input_type -> api.v1.DownloadReportRequest
+	8,  // 6: api.v1.ReportService.DeleteReport:input_type -> api.v1.DeleteReportRequest
+	10, // 7: api.v1.ReportService.WatchReports:input_type -> api.v1.WatchReportsRequest
+	12, // 8: api.v1.ReportService.GetReportDiagnostics:input_type -> api.v1.GetReportDiagnosticsRequest
+	14, // 9: api.v1.ReportService.ReprocessReport:input_type -> api.v1.ReprocessReportRequest
+	16, // 10: api.v1.ReportService.ReprocessReports:input_type -> api.v1.ReprocessReportsRequest
+	3,  // 11: api.v1.ReportService.UploadReport:output_type -> api.v1.UploadReportResponse
+	5,  // 12: api.v1.ReportService.ListReports:output_type -> api.v1.ListReportsResponse
+	7,  // 13: api.v1.ReportService.DownloadReport:output_type -> api.v1.DownloadReportResponse
+	9,  // 14: api.v1.ReportService.DeleteReport:output_type -> api.v1.DeleteReportResponse
+	11, // 15: api.v1.ReportService.WatchReports:output_type -> api.v1.WatchReportsResponse
+	13, // 16: api.v1.ReportService.GetReportDiagnostics:output_type -> api.v1.GetReportDiagnosticsResponse
+	15, // 17: api.v1.ReportService.ReprocessReport:output_type -> api.v1.ReprocessReportResponse
+	17, // 18: api.v1.ReportService.ReprocessReports:output_type -> api.v1.ReprocessReportsResponse
+	11, // [11:19] is the sub-list for method output_type
+	3,  // [3:11] is the sub-list for method input_type
+	3,  // [3:3] is the sub-list for extension type_name
+	3,  // [3:3] is the sub-list for extension extendee
+	0,  // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_api_v1_reports_proto_init() }
@@ -557,7 +1039,7 @@ func file_api_v1_reports_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_reports_proto_rawDesc), len(file_api_v1_reports_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   9,
+			NumMessages:   18,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -37,6 +37,7 @@ type Transaction struct {
 	SourceCardNumber    string                 `protobuf:"bytes,12,opt,name=source_card_number,json=sourceCardNumber,proto3" json:"source_card_number,omitempty"`
 	CreatedAt           string                 `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	CategoryId          *int32                 `protobuf:"varint,14,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
+	Splits              []*TransactionSplit    `protobuf:"bytes,15,rep,name=splits,proto3" json:"splits,omitempty"`
 	unknownFields       protoimpl.UnknownFields
 	sizeCache           protoimpl.SizeCache
 }
@@ -169,6 +170,73 @@ func (x *Transaction) GetCategoryId() int32 {
 	return 0
 }
 
+func (x *Transaction) GetSplits() []*TransactionSplit {
+	if x != nil {
+		return x.Splits
+	}
+	return nil
+}
+
+type TransactionSplit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CategoryId    *int32                 `protobuf:"varint,2,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
+	Amount        int64                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransactionSplit) Reset() {
+	*x = TransactionSplit{}
+	mi := &file_api_v1_transactions_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactionSplit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionSplit) ProtoMessage() {}
+
+func (x *TransactionSplit) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_transactions_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionSplit.ProtoReflect.Descriptor instead.
+func (*TransactionSplit) Descriptor() ([]byte, []int) {
+	return file_api_v1_transactions_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TransactionSplit) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TransactionSplit) GetCategoryId() int32 {
+	if x != nil && x.CategoryId != nil {
+		return *x.CategoryId
+	}
+	return 0
+}
+
+func (x *TransactionSplit) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
 type TransactionSummary struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	Count          int32                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
@@ -185,7 +253,7 @@ type TransactionSummary struct {
 
 func (x *TransactionSummary) Reset() {
 	*x = TransactionSummary{}
-	mi := &file_api_v1_transactions_proto_msgTypes[1]
+	mi := &file_api_v1_transactions_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -197,7 +265,7 @@ func (x *TransactionSummary) String() string {
 func (*TransactionSummary) ProtoMessage() {}
 
 func (x *TransactionSummary) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_transactions_proto_msgTypes[1]
+	mi := &file_api_v1_transactions_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -210,7 +278,7 @@ func (x *TransactionSummary) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TransactionSummary.ProtoReflect.Descriptor instead.
 func (*TransactionSummary) Descriptor() ([]byte, []int) {
-	return file_api_v1_transactions_proto_rawDescGZIP(), []int{1}
+	return file_api_v1_transactions_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *TransactionSummary) GetCount() int32 {
@@ -281,13 +349,16 @@ type ListTransactionsRequest struct {
 	CardNumber    string                 `protobuf:"bytes,8,opt,name=card_number,json=cardNumber,proto3" json:"card_number,omitempty"`
 	Limit         int32                  `protobuf:"varint,9,opt,name=limit,proto3" json:"limit,omitempty"`
 	Offset        int32                  `protobuf:"varint,10,opt,name=offset,proto3" json:"offset,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// Transfers between own accounts are always listed. When unset, the summary leaves them out and
+	// ExportTransactions keeps them.
+	IncludeTransfers *bool `protobuf:"varint,11,opt,name=include_transfers,json=includeTransfers,proto3,oneof" json:"include_transfers,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *ListTransactionsRequest) Reset() {
 	*x = ListTransactionsRequest{}
-	mi := &file_api_v1_transactions_proto_msgTypes[2]
+	mi := &file_api_v1_transactions_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -299,7 +370,7 @@ func (x *ListTransactionsRequest) String() string {
 func (*ListTransactionsRequest) ProtoMessage() {}
 
 func (x *ListTransactionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_transactions_proto_msgTypes[2]
+	mi := &file_api_v1_transactions_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -312,7 +383,7 @@ func (x *ListTransactionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListTransactionsRequest.ProtoReflect.Descriptor instead.
 func (*ListTransactionsRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_transactions_proto_rawDescGZIP(), []int{2}
+	return file_api_v1_transactions_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *ListTransactionsRequest) GetFromDate() string {
@@ -385,6 +456,13 @@ func (x *ListTransactionsRequest) GetOffset() int32 {
 	return 0
 }
 
+func (x *ListTransactionsRequest) GetIncludeTransfers() bool {
+	if x != nil && x.IncludeTransfers != nil {
+		return *x.IncludeTransfers
+	}
+	return false
+}
+
 type ListTransactionsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Items         []*Transaction         `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
@@ -395,7 +473,7 @@ type ListTransactionsResponse struct {
 
 func (x *ListTransactionsResponse) Reset() {
 	*x = ListTransactionsResponse{}
-	mi := &file_api_v1_transactions_proto_msgTypes[3]
+	mi := &file_api_v1_transactions_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -407,7 +485,7 @@ func (x *ListTransactionsResponse) String() string {
 func (*ListTransactionsResponse) ProtoMessage() {}
 
 func (x *ListTransactionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_transactions_proto_msgTypes[3]
+	mi := &file_api_v1_transactions_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -420,7 +498,7 @@ func (x *ListTransactionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListTransactionsResponse.ProtoReflect.Descriptor instead.
 func (*ListTransactionsResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_transactions_proto_rawDescGZIP(), []int{3}
+	return file_api_v1_transactions_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ListTransactionsResponse) GetItems() []*Transaction {
@@ -447,7 +525,7 @@ type UpdateTransactionCategoryRequest struct {
 
 func (x *UpdateTransactionCategoryRequest) Reset() {
 	*x = UpdateTransactionCategoryRequest{}
-	mi := &file_api_v1_transactions_proto_msgTypes[4]
+	mi := &file_api_v1_transactions_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -459,7 +537,7 @@ func (x *UpdateTransactionCategoryRequest) String() string {
 func (*UpdateTransactionCategoryRequest) ProtoMessage() {}
 
 func (x *UpdateTransactionCategoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_transactions_proto_msgTypes[4]
+	mi := &file_api_v1_transactions_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -472,7 +550,7 @@ func (x *UpdateTransactionCategoryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateTransactionCategoryRequest.ProtoReflect.Descriptor instead.
 func (*UpdateTransactionCategoryRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_transactions_proto_rawDescGZIP(), []int{4}
+	return file_api_v1_transactions_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *UpdateTransactionCategoryRequest) GetTransactionId() int32 {
@@ -497,7 +575,7 @@ type UpdateTransactionCategoryResponse struct {
 
 func (x *UpdateTransactionCategoryResponse) Reset() {
 	*x = UpdateTransactionCategoryResponse{}
-	mi := &file_api_v1_transactions_proto_msgTypes[5]
+	mi := &file_api_v1_transactions_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -509,7 +587,7 @@ func (x *UpdateTransactionCategoryResponse) String() string {
 func (*UpdateTransactionCategoryResponse) ProtoMessage() {}
 
 func (x *UpdateTransactionCategoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_transactions_proto_msgTypes[5]
+	mi := &file_api_v1_transactions_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -522,73 +600,1479 @@ func (x *UpdateTransactionCategoryResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use UpdateTransactionCategoryResponse.ProtoReflect.Descriptor instead.
 func (*UpdateTransactionCategoryResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_transactions_proto_rawDescGZIP(), []int{5}
+	return file_api_v1_transactions_proto_rawDescGZIP(), []int{6}
+}
+
+type GetTransactionAnalyticsRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	FromDate         string                 `protobuf:"bytes,1,opt,name=from_date,json=fromDate,proto3" json:"from_date,omitempty"`
+	ToDate           string                 `protobuf:"bytes,2,opt,name=to_date,json=toDate,proto3" json:"to_date,omitempty"`
+	SourceFileId     int32                  `protobuf:"varint,3,opt,name=source_file_id,json=sourceFileId,proto3" json:"source_file_id,omitempty"`
+	EntryType        string                 `protobuf:"bytes,4,opt,name=entry_type,json=entryType,proto3" json:"entry_type,omitempty"`
+	SearchText       string                 `protobuf:"bytes,5,opt,name=search_text,json=searchText,proto3" json:"search_text,omitempty"`
+	CategoryId       int32                  `protobuf:"varint,6,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	AccountNumber    string                 `protobuf:"bytes,7,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	CardNumber       string                 `protobuf:"bytes,8,opt,name=card_number,json=cardNumber,proto3" json:"card_number,omitempty"`
+	Interval         string                 `protobuf:"bytes,9,opt,name=interval,proto3" json:"interval,omitempty"`
+	GroupBy          string                 `protobuf:"bytes,10,opt,name=group_by,json=groupBy,proto3" json:"group_by,omitempty"`
+	IncludeTransfers bool                   `protobuf:"varint,11,opt,name=include_transfers,json=includeTransfers,proto3" json:"include_transfers,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetTransactionAnalyticsRequest) Reset() {
+	*x = GetTransactionAnalyticsRequest{}
+	mi := &file_api_v1_transactions_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-var File_api_v1_transactions_proto protoreflect.FileDescriptor
+func (x *GetTransactionAnalyticsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-const file_api_v1_transactions_proto_rawDesc = "" +
-	"\n" +
-	"\x19api/v1/transactions.proto\x12\x06api.v1\"\x80\x04\n" +
-	"\vTransaction\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id\x12$\n" +
-	"\x0esource_file_id\x18\x02 \x01(\x05R\fsourceFileId\x12&\n" +
-	"\x0fsource_file_row\x18\x03 \x01(\x05R\rsourceFileRow\x12\x1f\n" +
-	"\vparser_name\x18\x04 \x01(\tR\n" +
-	"parserName\x12\x1f\n" +
-	"\vposted_date\x18\x05 \x01(\tR\n" +
-	"postedDate\x12 \n" +
-	"\vdescription\x18\x06 \x01(\tR\vdescription\x12\x16\n" +
-	"\x06amount\x18\a \x01(\x03R\x06amount\x12\x1a\n" +
-	"\bcurrency\x18\b \x01(\tR\bcurrency\x12%\n" +
-	"\x0etransaction_id\x18\t \x01(\tR\rtransactionId\x12\x1d\n" +
-	"\n" +
-	"entry_type\x18\n" +
-	" \x01(\tR\tentryType\x122\n" +
-	"\x15source_account_number\x18\v \x01(\tR\x13sourceAccountNumber\x12,\n" +
-	"\x12source_card_number\x18\f \x01(\tR\x10sourceCardNumber\x12\x1d\n" +
-	"\n" +
-	"created_at\x18\r \x01(\tR\tcreatedAt\x12$\n" +
-	"\vcategory_id\x18\x0e \x01(\x05H\x00R\n" +
-	"categoryId\x88\x01\x01B\x0e\n" +
-	"\f_category_id\"\x87\x02\n" +
-	"\x12TransactionSummary\x12\x14\n" +
-	"\x05count\x18\x01 \x01(\x05R\x05count\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x03R\x05total\x12\x18\n" +
-	"\aaverage\x18\x03 \x01(\x03R\aaverage\x12\x16\n" +
-	"\x06median\x18\x04 \x01(\x03R\x06median\x12\x1a\n" +
-	"\bcurrency\x18\x05 \x01(\tR\bcurrency\x12'\n" +
-	"\x0funique_accounts\x18\x06 \x01(\x05R\x0euniqueAccounts\x12(\n" +
-	"\x10date_range_start\x18\a \x01(\tR\x0edateRangeStart\x12$\n" +
-	"\x0edate_range_end\x18\b \x01(\tR\fdateRangeEnd\"\xcc\x02\n" +
-	"\x17ListTransactionsRequest\x12\x1b\n" +
-	"\tfrom_date\x18\x01 \x01(\tR\bfromDate\x12\x17\n" +
-	"\ato_date\x18\x02 \x01(\tR\x06toDate\x12$\n" +
-	"\x0esource_file_id\x18\x03 \x01(\x05R\fsourceFileId\x12\x1d\n" +
-	"\n" +
-	"entry_type\x18\x04 \x01(\tR\tentryType\x12\x1f\n" +
-	"\vsearch_text\x18\x05 \x01(\tR\n" +
-	"searchText\x12\x1f\n" +
-	"\vcategory_id\x18\x06 \x01(\x05R\n" +
-	"categoryId\x12%\n" +
-	"\x0eaccount_number\x18\a \x01(\tR\raccountNumber\x12\x1f\n" +
-	"\vcard_number\x18\b \x01(\tR\n" +
-	"cardNumber\x12\x14\n" +
-	"\x05limit\x18\t \x01(\x05R\x05limit\x12\x16\n" +
-	"\x06offset\x18\n" +
-	" \x01(\x05R\x06offset\"{\n" +
-	"\x18ListTransactionsResponse\x12)\n" +
-	"\x05items\x18\x01 \x03(\v2\x13.api.v1.TransactionR\x05items\x124\n" +
-	"\asummary\x18\x02 \x01(\v2\x1a.api.v1.TransactionSummaryR\asummary\"\x7f\n" +
-	" UpdateTransactionCategoryRequest\x12%\n" +
-	"\x0etransaction_id\x18\x01 \x01(\x05R\rtransactionId\x12$\n" +
-	"\vcategory_id\x18\x02 \x01(\x05H\x00R\n" +
-	"categoryId\x88\x01\x01B\x0e\n" +
-	"\f_category_id\"#\n" +
-	"!UpdateTransactionCategoryResponse2\xe1\x01\n" +
-	"\x12TransactionService\x12W\n" +
-	"\x10ListTransactions\x12\x1f.api.v1.ListTransactionsRequest\x1a .api.v1.ListTransactionsResponse\"\x00\x12r\n" +
-	"\x19UpdateTransactionCategory\x12(.api.v1.UpdateTransactionCategoryRequest\x1a).api.v1.UpdateTransactionCategoryResponse\"\x00B|\n" +
+func (*GetTransactionAnalyticsRequest) ProtoMessage() {}
+
+func (x *GetTransactionAnalyticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_transactions_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionAnalyticsRequest.ProtoReflect.Descriptor instead.
+func (*GetTransactionAnalyticsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_transactions_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetTransactionAnalyticsRequest) GetFromDate() string {
+	if x != nil {
+		return x.FromDate
+	}
+	return ""
+}
+
+func (x *GetTransactionAnalyticsRequest) GetToDate() string {
+	if x != nil {
+		return x.ToDate
+	}
+	return ""
+}
+
+func (x *GetTransactionAnalyticsRequest) GetSourceFileId() int32 {
+	if x != nil {
+		return x.SourceFileId
+	}
+	return 0
+}
+
+func (x *GetTransactionAnalyticsRequest) GetEntryType() string {
+	if x != nil {
+		return x.EntryType
+	}
+	return ""
+}
+
+func (x *GetTransactionAnalyticsRequest) GetSearchText() string {
+	if x != nil {
+		return x.SearchText
+	}
+	return ""
+}
+
+func (x *GetTransactionAnalyticsRequest) GetCategoryId() int32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *GetTransactionAnalyticsRequest) GetAccountNumber() string {
+	if x != nil {
+		return x.AccountNumber
+	}
+	return ""
+}
+
+func (x *GetTransactionAnalyticsRequest) GetCardNumber() string {
+	if x != nil {
+		return x.CardNumber
+	}
+	return ""
+}
+
+func (x *GetTransactionAnalyticsRequest) GetInterval() string {
+	if x != nil {
+		return x.Interval
+	}
+	return ""
+}
+
+func (x *GetTransactionAnalyticsRequest) GetGroupBy() string {
+	if x != nil {
+		return x.GroupBy
+	}
+	return ""
+}
+
+func (x *GetTransactionAnalyticsRequest) GetIncludeTransfers() bool {
+	if x != nil {
+		return x.IncludeTransfers
+	}
+	return false
+}
+
+type TransactionAnalyticsPoint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PeriodStart   string                 `protobuf:"bytes,1,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`
+	CategoryId    *int32                 `protobuf:"varint,2,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
+	Count         int32                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Income        int64                  `protobuf:"varint,4,opt,name=income,proto3" json:"income,omitempty"`
+	Expense       int64                  `protobuf:"varint,5,opt,name=expense,proto3" json:"expense,omitempty"`
+	Total         int64                  `protobuf:"varint,6,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransactionAnalyticsPoint) Reset() {
+	*x = TransactionAnalyticsPoint{}
+	mi := &file_api_v1_transactions_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactionAnalyticsPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionAnalyticsPoint) ProtoMessage() {}
+
+func (x *TransactionAnalyticsPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_transactions_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionAnalyticsPoint.ProtoReflect.Descriptor instead.
+func (*TransactionAnalyticsPoint) Descriptor() ([]byte, []int) {
+	return file_api_v1_transactions_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *TransactionAnalyticsPoint) GetPeriodStart() string {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return ""
+}
+
+func (x *TransactionAnalyticsPoint) GetCategoryId() int32 {
+	if x != nil && x.CategoryId != nil {
+		return *x.CategoryId
+	}
+	return 0
+}
+
+func (x *TransactionAnalyticsPoint) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *TransactionAnalyticsPoint) GetIncome() int64 {
+	if x != nil {
+		return x.Income
+	}
+	return 0
+}
+
+func (x *TransactionAnalyticsPoint) GetExpense() int64 {
+	if x != nil {
+		return x.Expense
+	}
+	return 0
+}
+
+func (x *TransactionAnalyticsPoint) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type GetTransactionAnalyticsResponse struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Points        []*TransactionAnalyticsPoint `protobuf:"bytes,1,rep,name=points,proto3" json:"points,omitempty"`
+	Currency      string                       `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	Interval      string                       `protobuf:"bytes,3,opt,name=interval,proto3" json:"interval,omitempty"`
+	GroupBy       string                       `protobuf:"bytes,4,opt,name=group_by,json=groupBy,proto3" json:"group_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTransactionAnalyticsResponse) Reset() {
+	*x = GetTransactionAnalyticsResponse{}
+	mi := &file_api_v1_transactions_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransactionAnalyticsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionAnalyticsResponse) ProtoMessage() {}
+
+func (x *GetTransactionAnalyticsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_transactions_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionAnalyticsResponse.ProtoReflect.Descriptor instead.
+func (*GetTransactionAnalyticsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_transactions_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetTransactionAnalyticsResponse) GetPoints() []*TransactionAnalyticsPoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+func (x *GetTransactionAnalyticsResponse) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *GetTransactionAnalyticsResponse) GetInterval() string {
+	if x != nil {
+		return x.Interval
+	}
+	return ""
+}
+
+func (x *GetTransactionAnalyticsResponse) GetGroupBy() string {
+	if x != nil {
+		return x.GroupBy
+	}
+	return ""
+}
+
+type SetTransactionSplitsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransactionId int32                  `protobuf:"varint,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Splits        []*TransactionSplit    `protobuf:"bytes,2,rep,name=splits,proto3" json:"splits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTransactionSplitsRequest) Reset() {
+	*x = SetTransactionSplitsRequest{}
+	mi := &file_api_v1_transactions_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTransactionSplitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTransactionSplitsRequest) ProtoMessage() {}
+
+func (x *SetTransactionSplitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_transactions_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTransactionSplitsRequest.ProtoReflect.Descriptor instead.
+func (*SetTransactionSplitsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_transactions_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SetTransactionSplitsRequest) GetTransactionId() int32 {
+	if x != nil {
+		return x.TransactionId
+	}
+	return 0
+}
+
+func (x *SetTransactionSplitsRequest) GetSplits() []*TransactionSplit {
+	if x != nil {
+		return x.Splits
+	}
+	return nil
+}
+
+type SetTransactionSplitsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Splits        []*TransactionSplit    `protobuf:"bytes,1,rep,name=splits,proto3" json:"splits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTransactionSplitsResponse) Reset() {
+	*x = SetTransactionSplitsResponse{}
+	mi := &file_api_v1_transactions_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTransactionSplitsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTransactionSplitsResponse) ProtoMessage() {}
+
+func (x *SetTransactionSplitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_transactions_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTransactionSplitsResponse.ProtoReflect.Descriptor instead.
+func (*SetTransactionSplitsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_transactions_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SetTransactionSplitsResponse) GetSplits() []*TransactionSplit {
+	if x != nil {
+		return x.Splits
+	}
+	return nil
+}
+
+type DeleteTransactionSplitsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransactionId int32                  `protobuf:"varint,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTransactionSplitsRequest) Reset() {
+	*x = DeleteTransactionSplitsRequest{}
+	mi := &file_api_v1_transactions_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTransactionSplitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTransactionSplitsRequest) ProtoMessage() {}
+
+func (x *DeleteTransactionSplitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_transactions_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTransactionSplitsRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTransactionSplitsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_transactions_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DeleteTransactionSplitsRequest) GetTransactionId() int32 {
+	if x != nil {
+		return x.TransactionId
+	}
+	return 0
+}
+
+type DeleteTransactionSplitsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTransactionSplitsResponse) Reset() {
+	*x = DeleteTransactionSplitsResponse{}
+	mi := &file_api_v1_transactions_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTransactionSplitsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTransactionSplitsResponse) ProtoMessage() {}
+
+func (x *DeleteTransactionSplitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_transactions_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTransactionSplitsResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTransactionSplitsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_transactions_proto_rawDescGZIP(), []int{13}
+}
+
+type TransactionTransfer struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Debit         *Transaction           `protobuf:"bytes,3,opt,name=debit,proto3" json:"debit,omitempty"`
+	Credit        *Transaction           `protobuf:"bytes,4,opt,name=credit,proto3" json:"credit,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransactionTransfer) Reset() {
+	*x = TransactionTransfer{}
+	mi := &file_api_v1_transactions_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactionTransfer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionTransfer) ProtoMessage() {}
+
+func (x *TransactionTransfer) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_transactions_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionTransfer.ProtoReflect.Descriptor instead.
+func (*TransactionTransfer) Descriptor() ([]byte, []int) {
+	return file_api_v1_transactions_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *TransactionTransfer) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TransactionTransfer) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *TransactionTransfer) GetDebit() *Transaction {
+	if x != nil {
+		return x.Debit
+	}
+	return nil
+}
+
+func (x *TransactionTransfer) GetCredit() *Transaction {
+	if x != nil {
+		return x.Credit
+	}
+	return nil
+}
+
+func (x *TransactionTransfer) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type DetectTransfersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DetectTransfersRequest) Reset() {
+	*x = DetectTransfersRequest{}
+	mi := &file_api_v1_transactions_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetectTransfersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetectTransfersRequest) ProtoMessage() {}
+
+func (x *DetectTransfersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_transactions_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetectTransfersRequest.ProtoReflect.Descriptor instead.
+func (*DetectTransfersRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_transactions_proto_rawDescGZIP(), []int{15}
+}
+
+type DetectTransfersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Created       int32                  `protobuf:"varint,1,opt,name=created,proto3" json:"created,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DetectTransfersResponse) Reset() {
+	*x = DetectTransfersResponse{}
+	mi := &file_api_v1_transactions_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetectTransfersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetectTransfersResponse) ProtoMessage() {}
+
+func (x *DetectTransfersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_transactions_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetectTransfersResponse.ProtoReflect.Descriptor instead.
+func (*DetectTransfersResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_transactions_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *DetectTransfersResponse) GetCreated() int32 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+type ListTransfersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTransfersRequest) Reset() {
+	*x = ListTransfersRequest{}
+	mi := &file_api_v1_transactions_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTransfersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTransfersRequest) ProtoMessage() {}
+
+func (x *ListTransfersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_transactions_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTransfersRequest.ProtoReflect.Descriptor instead.
+func (*ListTransfersRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_transactions_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListTransfersRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ListTransfersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*TransactionTransfer `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTransfersResponse) Reset() {
+	*x = ListTransfersResponse{}
+	mi := &file_api_v1_transactions_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTransfersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTransfersResponse) ProtoMessage() {}
+
+func (x *ListTransfersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_transactions_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTransfersResponse.ProtoReflect.Descriptor instead.
+func (*ListTransfersResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_transactions_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListTransfersResponse) GetItems() []*TransactionTransfer {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type ConfirmTransferRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransferId    int32                  `protobuf:"varint,1,opt,name=transfer_id,json=transferId,proto3" json:"transfer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmTransferRequest) Reset() {
+	*x = ConfirmTransferRequest{}
+	mi := &file_api_v1_transactions_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmTransferRequest) ProtoMessage() {}
+
+func (x *ConfirmTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_transactions_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmTransferRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmTransferRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_transactions_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ConfirmTransferRequest) GetTransferId() int32 {
+	if x != nil {
+		return x.TransferId
+	}
+	return 0
+}
+
+type ConfirmTransferResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmTransferResponse) Reset() {
+	*x = ConfirmTransferResponse{}
+	mi := &file_api_v1_transactions_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmTransferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmTransferResponse) ProtoMessage() {}
+
+func (x *ConfirmTransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_transactions_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmTransferResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmTransferResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_transactions_proto_rawDescGZIP(), []int{20}
+}
+
+type UnlinkTransferRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransferId    int32                  `protobuf:"varint,1,opt,name=transfer_id,json=transferId,proto3" json:"transfer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlinkTransferRequest) Reset() {
+	*x = UnlinkTransferRequest{}
+	mi := &file_api_v1_transactions_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlinkTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlinkTransferRequest) ProtoMessage() {}
+
+func (x *UnlinkTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_transactions_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlinkTransferRequest.ProtoReflect.Descriptor instead.
+func (*UnlinkTransferRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_transactions_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *UnlinkTransferRequest) GetTransferId() int32 {
+	if x != nil {
+		return x.TransferId
+	}
+	return 0
+}
+
+type UnlinkTransferResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlinkTransferResponse) Reset() {
+	*x = UnlinkTransferResponse{}
+	mi := &file_api_v1_transactions_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlinkTransferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlinkTransferResponse) ProtoMessage() {}
+
+func (x *UnlinkTransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_transactions_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlinkTransferResponse.ProtoReflect.Descriptor instead.
+func (*UnlinkTransferResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_transactions_proto_rawDescGZIP(), []int{22}
+}
+
+type ExportTransactionsRequest struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Filters       *ListTransactionsRequest `protobuf:"bytes,1,opt,name=filters,proto3" json:"filters,omitempty"`
+	Format        string                   `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportTransactionsRequest) Reset() {
+	*x = ExportTransactionsRequest{}
+	mi := &file_api_v1_transactions_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportTransactionsRequest) ProtoMessage() {}
+
+func (x *ExportTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_transactions_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*ExportTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_transactions_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ExportTransactionsRequest) GetFilters() *ListTransactionsRequest {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+func (x *ExportTransactionsRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+type ExportTransactionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Chunk         []byte                 `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	Filename      string                 `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	ContentType   string                 `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportTransactionsResponse) Reset() {
+	*x = ExportTransactionsResponse{}
+	mi := &file_api_v1_transactions_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportTransactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportTransactionsResponse) ProtoMessage() {}
+
+func (x *ExportTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_transactions_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*ExportTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_transactions_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ExportTransactionsResponse) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+func (x *ExportTransactionsResponse) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *ExportTransactionsResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+type LedgerAccountMapping struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Source        string                 `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	LedgerAccount string                 `protobuf:"bytes,4,opt,name=ledger_account,json=ledgerAccount,proto3" json:"ledger_account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LedgerAccountMapping) Reset() {
+	*x = LedgerAccountMapping{}
+	mi := &file_api_v1_transactions_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LedgerAccountMapping) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LedgerAccountMapping) ProtoMessage() {}
+
+func (x *LedgerAccountMapping) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_transactions_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LedgerAccountMapping.ProtoReflect.Descriptor instead.
+func (*LedgerAccountMapping) Descriptor() ([]byte, []int) {
+	return file_api_v1_transactions_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *LedgerAccountMapping) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *LedgerAccountMapping) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *LedgerAccountMapping) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *LedgerAccountMapping) GetLedgerAccount() string {
+	if x != nil {
+		return x.LedgerAccount
+	}
+	return ""
+}
+
+type ListLedgerAccountMappingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLedgerAccountMappingsRequest) Reset() {
+	*x = ListLedgerAccountMappingsRequest{}
+	mi := &file_api_v1_transactions_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLedgerAccountMappingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLedgerAccountMappingsRequest) ProtoMessage() {}
+
+func (x *ListLedgerAccountMappingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_transactions_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLedgerAccountMappingsRequest.ProtoReflect.Descriptor instead.
+func (*ListLedgerAccountMappingsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_transactions_proto_rawDescGZIP(), []int{26}
+}
+
+type ListLedgerAccountMappingsResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Mappings      []*LedgerAccountMapping `protobuf:"bytes,1,rep,name=mappings,proto3" json:"mappings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLedgerAccountMappingsResponse) Reset() {
+	*x = ListLedgerAccountMappingsResponse{}
+	mi := &file_api_v1_transactions_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLedgerAccountMappingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLedgerAccountMappingsResponse) ProtoMessage() {}
+
+func (x *ListLedgerAccountMappingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_transactions_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLedgerAccountMappingsResponse.ProtoReflect.Descriptor instead.
+func (*ListLedgerAccountMappingsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_transactions_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListLedgerAccountMappingsResponse) GetMappings() []*LedgerAccountMapping {
+	if x != nil {
+		return x.Mappings
+	}
+	return nil
+}
+
+type SetLedgerAccountMappingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Source        string                 `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	LedgerAccount string                 `protobuf:"bytes,3,opt,name=ledger_account,json=ledgerAccount,proto3" json:"ledger_account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetLedgerAccountMappingRequest) Reset() {
+	*x = SetLedgerAccountMappingRequest{}
+	mi := &file_api_v1_transactions_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetLedgerAccountMappingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLedgerAccountMappingRequest) ProtoMessage() {}
+
+func (x *SetLedgerAccountMappingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_transactions_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLedgerAccountMappingRequest.ProtoReflect.Descriptor instead.
+func (*SetLedgerAccountMappingRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_transactions_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SetLedgerAccountMappingRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *SetLedgerAccountMappingRequest) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *SetLedgerAccountMappingRequest) GetLedgerAccount() string {
+	if x != nil {
+		return x.LedgerAccount
+	}
+	return ""
+}
+
+type SetLedgerAccountMappingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mapping       *LedgerAccountMapping  `protobuf:"bytes,1,opt,name=mapping,proto3" json:"mapping,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetLedgerAccountMappingResponse) Reset() {
+	*x = SetLedgerAccountMappingResponse{}
+	mi := &file_api_v1_transactions_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetLedgerAccountMappingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLedgerAccountMappingResponse) ProtoMessage() {}
+
+func (x *SetLedgerAccountMappingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_transactions_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLedgerAccountMappingResponse.ProtoReflect.Descriptor instead.
+func (*SetLedgerAccountMappingResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_transactions_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SetLedgerAccountMappingResponse) GetMapping() *LedgerAccountMapping {
+	if x != nil {
+		return x.Mapping
+	}
+	return nil
+}
+
+type DeleteLedgerAccountMappingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteLedgerAccountMappingRequest) Reset() {
+	*x = DeleteLedgerAccountMappingRequest{}
+	mi := &file_api_v1_transactions_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteLedgerAccountMappingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteLedgerAccountMappingRequest) ProtoMessage() {}
+
+func (x *DeleteLedgerAccountMappingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_transactions_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteLedgerAccountMappingRequest.ProtoReflect.Descriptor instead.
+func (*DeleteLedgerAccountMappingRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_transactions_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *DeleteLedgerAccountMappingRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteLedgerAccountMappingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteLedgerAccountMappingResponse) Reset() {
+	*x = DeleteLedgerAccountMappingResponse{}
+	mi := &file_api_v1_transactions_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteLedgerAccountMappingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteLedgerAccountMappingResponse) ProtoMessage() {}
+
+func (x *DeleteLedgerAccountMappingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_transactions_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteLedgerAccountMappingResponse.ProtoReflect.Descriptor instead.
+func (*DeleteLedgerAccountMappingResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_transactions_proto_rawDescGZIP(), []int{31}
+}
+
+var File_api_v1_transactions_proto protoreflect.FileDescriptor
+
+const file_api_v1_transactions_proto_rawDesc = "" +
+	"\n" +
+	"\x19api/v1/transactions.proto\x12\x06api.v1\"\xb2\x04\n" +
+	"\vTransaction\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12$\n" +
+	"\x0esource_file_id\x18\x02 \x01(\x05R\fsourceFileId\x12&\n" +
+	"\x0fsource_file_row\x18\x03 \x01(\x05R\rsourceFileRow\x12\x1f\n" +
+	"\vparser_name\x18\x04 \x01(\tR\n" +
+	"parserName\x12\x1f\n" +
+	"\vposted_date\x18\x05 \x01(\tR\n" +
+	"postedDate\x12 \n" +
+	"\vdescription\x18\x06 \x01(\tR\vdescription\x12\x16\n" +
+	"\x06amount\x18\a \x01(\x03R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\b \x01(\tR\bcurrency\x12%\n" +
+	"\x0etransaction_id\x18\t \x01(\tR\rtransactionId\x12\x1d\n" +
+	"\n" +
+	"entry_type\x18\n" +
+	" \x01(\tR\tentryType\x122\n" +
+	"\x15source_account_number\x18\v \x01(\tR\x13sourceAccountNumber\x12,\n" +
+	"\x12source_card_number\x18\f \x01(\tR\x10sourceCardNumber\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\r \x01(\tR\tcreatedAt\x12$\n" +
+	"\vcategory_id\x18\x0e \x01(\x05H\x00R\n" +
+	"categoryId\x88\x01\x01\x120\n" +
+	"\x06splits\x18\x0f \x03(\v2\x18.api.v1.TransactionSplitR\x06splitsB\x0e\n" +
+	"\f_category_id\"p\n" +
+	"\x10TransactionSplit\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12$\n" +
+	"\vcategory_id\x18\x02 \x01(\x05H\x00R\n" +
+	"categoryId\x88\x01\x01\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x03R\x06amountB\x0e\n" +
+	"\f_category_id\"\x87\x02\n" +
+	"\x12TransactionSummary\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x05R\x05count\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\x12\x18\n" +
+	"\aaverage\x18\x03 \x01(\x03R\aaverage\x12\x16\n" +
+	"\x06median\x18\x04 \x01(\x03R\x06median\x12\x1a\n" +
+	"\bcurrency\x18\x05 \x01(\tR\bcurrency\x12'\n" +
+	"\x0funique_accounts\x18\x06 \x01(\x05R\x0euniqueAccounts\x12(\n" +
+	"\x10date_range_start\x18\a \x01(\tR\x0edateRangeStart\x12$\n" +
+	"\x0edate_range_end\x18\b \x01(\tR\fdateRangeEnd\"\x94\x03\n" +
+	"\x17ListTransactionsRequest\x12\x1b\n" +
+	"\tfrom_date\x18\x01 \x01(\tR\bfromDate\x12\x17\n" +
+	"\ato_date\x18\x02 \x01(\tR\x06toDate\x12$\n" +
+	"\x0esource_file_id\x18\x03 \x01(\x05R\fsourceFileId\x12\x1d\n" +
+	"\n" +
+	"entry_type\x18\x04 \x01(\tR\tentryType\x12\x1f\n" +
+	"\vsearch_text\x18\x05 \x01(\tR\n" +
+	"searchText\x12\x1f\n" +
+	"\vcategory_id\x18\x06 \x01(\x05R\n" +
+	"categoryId\x12%\n" +
+	"\x0eaccount_number\x18\a \x01(\tR\raccountNumber\x12\x1f\n" +
+	"\vcard_number\x18\b \x01(\tR\n" +
+	"cardNumber\x12\x14\n" +
+	"\x05limit\x18\t \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\n" +
+	" \x01(\x05R\x06offset\x120\n" +
+	"\x11include_transfers\x18\v \x01(\bH\x00R\x10includeTransfers\x88\x01\x01B\x14\n" +
+	"\x12_include_transfers\"{\n" +
+	"\x18ListTransactionsResponse\x12)\n" +
+	"\x05items\x18\x01 \x03(\v2\x13.api.v1.TransactionR\x05items\x124\n" +
+	"\asummary\x18\x02 \x01(\v2\x1a.api.v1.TransactionSummaryR\asummary\"\x7f\n" +
+	" UpdateTransactionCategoryRequest\x12%\n" +
+	"\x0etransaction_id\x18\x01 \x01(\x05R\rtransactionId\x12$\n" +
+	"\vcategory_id\x18\x02 \x01(\x05H\x00R\n" +
+	"categoryId\x88\x01\x01B\x0e\n" +
+	"\f_category_id\"#\n" +
+	"!UpdateTransactionCategoryResponse\"\x89\x03\n" +
+	"\x1eGetTransactionAnalyticsRequest\x12\x1b\n" +
+	"\tfrom_date\x18\x01 \x01(\tR\bfromDate\x12\x17\n" +
+	"\ato_date\x18\x02 \x01(\tR\x06toDate\x12$\n" +
+	"\x0esource_file_id\x18\x03 \x01(\x05R\fsourceFileId\x12\x1d\n" +
+	"\n" +
+	"entry_type\x18\x04 \x01(\tR\tentryType\x12\x1f\n" +
+	"\vsearch_text\x18\x05 \x01(\tR\n" +
+	"searchText\x12\x1f\n" +
+	"\vcategory_id\x18\x06 \x01(\x05R\n" +
+	"categoryId\x12%\n" +
+	"\x0eaccount_number\x18\a \x01(\tR\raccountNumber\x12\x1f\n" +
+	"\vcard_number\x18\b \x01(\tR\n" +
+	"cardNumber\x12\x1a\n" +
+	"\binterval\x18\t \x01(\tR\binterval\x12\x19\n" +
+	"\bgroup_by\x18\n" +
+	" \x01(\tR\agroupBy\x12+\n" +
+	"\x11include_transfers\x18\v \x01(\bR\x10includeTransfers\"\xd2\x01\n" +
+	"\x19TransactionAnalyticsPoint\x12!\n" +
+	"\fperiod_start\x18\x01 \x01(\tR\vperiodStart\x12$\n" +
+	"\vcategory_id\x18\x02 \x01(\x05H\x00R\n" +
+	"categoryId\x88\x01\x01\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x05R\x05count\x12\x16\n" +
+	"\x06income\x18\x04 \x01(\x03R\x06income\x12\x18\n" +
+	"\aexpense\x18\x05 \x01(\x03R\aexpense\x12\x14\n" +
+	"\x05total\x18\x06 \x01(\x03R\x05totalB\x0e\n" +
+	"\f_category_id\"\xaf\x01\n" +
+	"\x1fGetTransactionAnalyticsResponse\x129\n" +
+	"\x06points\x18\x01 \x03(\v2!.api.v1.TransactionAnalyticsPointR\x06points\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x12\x1a\n" +
+	"\binterval\x18\x03 \x01(\tR\binterval\x12\x19\n" +
+	"\bgroup_by\x18\x04 \x01(\tR\agroupBy\"v\n" +
+	"\x1bSetTransactionSplitsRequest\x12%\n" +
+	"\x0etransaction_id\x18\x01 \x01(\x05R\rtransactionId\x120\n" +
+	"\x06splits\x18\x02 \x03(\v2\x18.api.v1.TransactionSplitR\x06splits\"P\n" +
+	"\x1cSetTransactionSplitsResponse\x120\n" +
+	"\x06splits\x18\x01 \x03(\v2\x18.api.v1.TransactionSplitR\x06splits\"G\n" +
+	"\x1eDeleteTransactionSplitsRequest\x12%\n" +
+	"\x0etransaction_id\x18\x01 \x01(\x05R\rtransactionId\"!\n" +
+	"\x1fDeleteTransactionSplitsResponse\"\xb4\x01\n" +
+	"\x13TransactionTransfer\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12)\n" +
+	"\x05debit\x18\x03 \x01(\v2\x13.api.v1.TransactionR\x05debit\x12+\n" +
+	"\x06credit\x18\x04 \x01(\v2\x13.api.v1.TransactionR\x06credit\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\tR\tcreatedAt\"\x18\n" +
+	"\x16DetectTransfersRequest\"3\n" +
+	"\x17DetectTransfersResponse\x12\x18\n" +
+	"\acreated\x18\x01 \x01(\x05R\acreated\".\n" +
+	"\x14ListTransfersRequest\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\"J\n" +
+	"\x15ListTransfersResponse\x121\n" +
+	"\x05items\x18\x01 \x03(\v2\x1b.api.v1.TransactionTransferR\x05items\"9\n" +
+	"\x16ConfirmTransferRequest\x12\x1f\n" +
+	"\vtransfer_id\x18\x01 \x01(\x05R\n" +
+	"transferId\"\x19\n" +
+	"\x17ConfirmTransferResponse\"8\n" +
+	"\x15UnlinkTransferRequest\x12\x1f\n" +
+	"\vtransfer_id\x18\x01 \x01(\x05R\n" +
+	"transferId\"\x18\n" +
+	"\x16UnlinkTransferResponse\"n\n" +
+	"\x19ExportTransactionsRequest\x129\n" +
+	"\afilters\x18\x01 \x01(\v2\x1f.api.v1.ListTransactionsRequestR\afilters\x12\x16\n" +
+	"\x06format\x18\x02 \x01(\tR\x06format\"q\n" +
+	"\x1aExportTransactionsResponse\x12\x14\n" +
+	"\x05chunk\x18\x01 \x01(\fR\x05chunk\x12\x1a\n" +
+	"\bfilename\x18\x02 \x01(\tR\bfilename\x12!\n" +
+	"\fcontent_type\x18\x03 \x01(\tR\vcontentType\"y\n" +
+	"\x14LedgerAccountMapping\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x16\n" +
+	"\x06source\x18\x03 \x01(\tR\x06source\x12%\n" +
+	"\x0eledger_account\x18\x04 \x01(\tR\rledgerAccount\"\"\n" +
+	" ListLedgerAccountMappingsRequest\"]\n" +
+	"!ListLedgerAccountMappingsResponse\x128\n" +
+	"\bmappings\x18\x01 \x03(\v2\x1c.api.v1.LedgerAccountMappingR\bmappings\"s\n" +
+	"\x1eSetLedgerAccountMappingRequest\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x16\n" +
+	"\x06source\x18\x02 \x01(\tR\x06source\x12%\n" +
+	"\x0eledger_account\x18\x03 \x01(\tR\rledgerAccount\"Y\n" +
+	"\x1fSetLedgerAccountMappingResponse\x126\n" +
+	"\amapping\x18\x01 \x01(\v2\x1c.api.v1.LedgerAccountMappingR\amapping\"3\n" +
+	"!DeleteLedgerAccountMappingRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"$\n" +
+	"\"DeleteLedgerAccountMappingResponse2\xba\n" +
+	"\n" +
+	"\x12TransactionService\x12Z\n" +
+	"\x10ListTransactions\x12\x1f.api.v1.ListTransactionsRequest\x1a .api.v1.ListTransactionsResponse\"\x03\x90\x02\x01\x12r\n" +
+	"\x19UpdateTransactionCategory\x12(.api.v1.UpdateTransactionCategoryRequest\x1a).api.v1.UpdateTransactionCategoryResponse\"\x00\x12o\n" +
+	"\x17GetTransactionAnalytics\x12&.api.v1.GetTransactionAnalyticsRequest\x1a'.api.v1.GetTransactionAnalyticsResponse\"\x03\x90\x02\x01\x12c\n" +
+	"\x14SetTransactionSplits\x12#.api.v1.SetTransactionSplitsRequest\x1a$.api.v1.SetTransactionSplitsResponse\"\x00\x12l\n" +
+	"\x17DeleteTransactionSplits\x12&.api.v1.DeleteTransactionSplitsRequest\x1a'.api.v1.DeleteTransactionSplitsResponse\"\x00\x12T\n" +
+	"\x0fDetectTransfers\x12\x1e.api.v1.DetectTransfersRequest\x1a\x1f.api.v1.DetectTransfersResponse\"\x00\x12Q\n" +
+	"\rListTransfers\x12\x1c.api.v1.ListTransfersRequest\x1a\x1d.api.v1.ListTransfersResponse\"\x03\x90\x02\x01\x12T\n" +
+	"\x0fConfirmTransfer\x12\x1e.api.v1.ConfirmTransferRequest\x1a\x1f.api.v1.ConfirmTransferResponse\"\x00\x12Q\n" +
+	"\x0eUnlinkTransfer\x12\x1d.api.v1.UnlinkTransferRequest\x1a\x1e.api.v1.UnlinkTransferResponse\"\x00\x12b\n" +
+	"\x12ExportTransactions\x12!.api.v1.ExportTransactionsRequest\x1a\".api.v1.ExportTransactionsResponse\"\x03\x90\x02\x010\x01\x12u\n" +
+	"\x19ListLedgerAccountMappings\x12(.api.v1.ListLedgerAccountMappingsRequest\x1a).api.v1.ListLedgerAccountMappingsResponse\"\x03\x90\x02\x01\x12l\n" +
+	"\x17SetLedgerAccountMapping\x12&.api.v1.SetLedgerAccountMappingRequest\x1a'.api.v1.SetLedgerAccountMappingResponse\"\x00\x12u\n" +
+	"\x1aDeleteLedgerAccountMapping\x12).api.v1.DeleteLedgerAccountMappingRequest\x1a*.api.v1.DeleteLedgerAccountMappingResponse\"\x00B|\n" +
 	"\n" +
 	"com.api.v1B\x11TransactionsProtoP\x01Z\"cashtrack/backend/gen/api/v1;apiv1\xa2\x02\x03AXX\xaa\x02\x06Api.V1\xca\x02\x06Api\\V1\xe2\x02\x12Api\\V1\\GPBMetadata\xea\x02\aApi::V1b\x06proto3"
 
@@ -604,27 +2088,85 @@ func file_api_v1_transactions_proto_rawDescGZIP() []byte {
 	return file_api_v1_transactions_proto_rawDescData
 }
 
-var file_api_v1_transactions_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_api_v1_transactions_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
 var file_api_v1_transactions_proto_goTypes = []any{
-	(*Transaction)(nil),                       // 0: api.v1.Transaction
-	(*TransactionSummary)(nil),                // 1: api.v1.TransactionSummary
-	(*ListTransactionsRequest)(nil),           // 2: api.v1.ListTransactionsRequest
-	(*ListTransactionsResponse)(nil),          // 3: api.v1.ListTransactionsResponse
-	(*UpdateTransactionCategoryRequest)(nil),  // 4: api.v1.UpdateTransactionCategoryRequest
-	(*UpdateTransactionCategoryResponse)(nil), // 5: api.v1.UpdateTransactionCategoryResponse
+	(*Transaction)(nil),                        // 0: api.v1.Transaction
+	(*TransactionSplit)(nil),                   // 1: api.v1.TransactionSplit
+	(*TransactionSummary)(nil),                 // 2: api.v1.TransactionSummary
+	(*ListTransactionsRequest)(nil),            // 3: api.v1.ListTransactionsRequest
+	(*ListTransactionsResponse)(nil),           // 4: api.v1.ListTransactionsResponse
+	(*UpdateTransactionCategoryRequest)(nil),   // 5: api.v1.UpdateTransactionCategoryRequest
+	(*UpdateTransactionCategoryResponse)(nil),  // 6: api.v1.UpdateTransactionCategoryResponse
+	(*GetTransactionAnalyticsRequest)(nil),     // 7: api.v1.GetTransactionAnalyticsRequest
+	(*TransactionAnalyticsPoint)(nil),          // 8: api.v1.TransactionAnalyticsPoint
+	(*GetTransactionAnalyticsResponse)(nil),    // 9: api.v1.GetTransactionAnalyticsResponse
+	(*SetTransactionSplitsRequest)(nil),        // 10: api.v1.SetTransactionSplitsRequest
+	(*SetTransactionSplitsResponse)(nil),       // 11: api.v1.SetTransactionSplitsResponse
+	(*DeleteTransactionSplitsRequest)(nil),     // 12: api.v1.DeleteTransactionSplitsRequest
+	(*DeleteTransactionSplitsResponse)(nil),    // 13: api.v1.DeleteTransactionSplitsResponse
+	(*TransactionTransfer)(nil),                // 14: api.v1.TransactionTransfer
+	(*DetectTransfersRequest)(nil),             // 15: api.v1.DetectTransfersRequest
+	(*DetectTransfersResponse)(nil),            // 16: api.v1.DetectTransfersResponse
+	(*ListTransfersRequest)(nil),               // 17: api.v1.ListTransfersRequest
+	(*ListTransfersResponse)(nil),              // 18: api.v1.ListTransfersResponse
+	(*ConfirmTransferRequest)(nil),             // 19: api.v1.ConfirmTransferRequest
+	(*ConfirmTransferResponse)(nil),            // 20: api.v1.ConfirmTransferResponse
+	(*UnlinkTransferRequest)(nil),              // 21: api.v1.UnlinkTransferRequest
+	(*UnlinkTransferResponse)(nil),             // 22: api.v1.UnlinkTransferResponse
+	(*ExportTransactionsRequest)(nil),          // 23: api.v1.ExportTransactionsRequest
+	(*ExportTransactionsResponse)(nil),         // 24: api.v1.ExportTransactionsResponse
+	(*LedgerAccountMapping)(nil),               // 25: api.v1.LedgerAccountMapping
+	(*ListLedgerAccountMappingsRequest)(nil),   // 26: api.v1.ListLedgerAccountMappingsRequest
+	(*ListLedgerAccountMappingsResponse)(nil),  // 27: api.v1.ListLedgerAccountMappingsResponse
+	(*SetLedgerAccountMappingRequest)(nil),     // 28: api.v1.SetLedgerAccountMappingRequest
+	(*SetLedgerAccountMappingResponse)(nil),    // 29: api.v1.SetLedgerAccountMappingResponse
+	(*DeleteLedgerAccountMappingRequest)(nil),  // 30: api.v1.DeleteLedgerAccountMappingRequest
+	(*DeleteLedgerAccountMappingResponse)(nil), // 31: api.v1.DeleteLedgerAccountMappingResponse
 }
 var file_api_v1_transactions_proto_depIdxs = []int32{
-	0, // 0: api.v1.ListTransactionsResponse.items:type_name -> api.v1.Transaction
-	1, // 1: api.v1.ListTransactionsResponse.summary:type_name -> api.v1.TransactionSummary
-	2, // 2: api.v1.TransactionService.ListTransactions:input_type -> api.v1.ListTransactionsRequest
-	4, // 3: api.v1.TransactionService.UpdateTransactionCategory:input_type -> api.v1.UpdateTransactionCategoryRequest
-	3, // 4: api.v1.TransactionService.ListTransactions:output_type -> api.v1.ListTransactionsResponse
-	5, // 5: api.v1.TransactionService.UpdateTransactionCategory:output_type -> api.v1.UpdateTransactionCategoryResponse
-	4, // [4:6] is the sub-list for method output_type
-	2, // [2:4] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	1,  // 0: api.v1.Transaction.splits:type_name -> api.v1.TransactionSplit
+	0,  // 1: api.v1.ListTransactionsResponse.items:type_name -> api.v1.Transaction
+	2,  // 2: api.v1.ListTransactionsResponse.summary:type_name -> api.v1.TransactionSummary
+	8,  // 3: api.v1.GetTransactionAnalyticsResponse.points:type_name -> api.v1.TransactionAnalyticsPoint
+	1,  // 4: api.v1.SetTransactionSplitsRequest.splits:type_name -> api.v1.TransactionSplit
+	1,  // 5: api.v1.SetTransactionSplitsResponse.splits:type_name -> api.v1.TransactionSplit
+	0,  // 6: api.v1.TransactionTransfer.debit:type_name -> api.v1.Transaction
+	0,  // 7: api.v1.TransactionTransfer.credit:type_name -> api.v1.Transaction
+	14, // 8: api.v1.ListTransfersResponse.items:type_name -> api.v1.TransactionTransfer
+	3,  // 9: api.v1.ExportTransactionsRequest.filters:type_name -> api.v1.ListTransactionsRequest
+	25, // 10: api.v1.ListLedgerAccountMappingsResponse.mappings:type_name -> api.v1.LedgerAccountMapping
+	25, // 11: api.v1.SetLedgerAccountMappingResponse.mapping:type_name -> api.v1.LedgerAccountMapping
+	3,  // 12: api.v1.TransactionService.ListTransactions:input_type -> api.v1.ListTransactionsRequest
+	5,  // 13: api.v1.TransactionService.UpdateTransactionCategory:input_type -> api.v1.UpdateTransactionCategoryRequest
+	7,  // 14: api.v1.TransactionService.GetTransactionAnalytics:input_type -> api.v1.GetTransactionAnalyticsRequest
+	10, // 15: api.v1.TransactionService.SetTransactionSplits:input_type -> api.v1.SetTransactionSplitsRequest
+	12, // 16: api.v1.TransactionService.DeleteTransactionSplits:input_type -> api.v1.DeleteTransactionSplitsRequest
+	15, // 17: api.v1.TransactionService.DetectTransfers:input_type -> api.v1.DetectTransfersRequest
+	17, // 18: api.v1.TransactionService.ListTransfers:input_type -> api.v1.ListTransfersRequest
+	19, // 19: api.v1.TransactionService.ConfirmTransfer:input_type -> api.v1.ConfirmTransferRequest
+	21, // 20: api.v1.TransactionService.UnlinkTransfer:input_type -> api.v1.UnlinkTransferRequest
+	23, // 21: api.v1.TransactionService.ExportTransactions:input_type -> api.v1.ExportTransactionsRequest
+	26, // 22: api.v1.TransactionService.ListLedgerAccountMappings:input_type -> api.v1.ListLedgerAccountMappingsRequest
+	28, // 23: api.v1.TransactionService.SetLedgerAccountMapping:input_type -> api.v1.SetLedgerAccountMappingRequest
+	30, // 24: api.v1.TransactionService.DeleteLedgerAccountMapping:input_type -> api.v1.DeleteLedgerAccountMappingRequest
+	4,  // 25: api.v1.TransactionService.ListTransactions:output_type -> api.v1.ListTransactionsResponse
+	6,  // 26: api.v1.TransactionService.UpdateTransactionCategory:output_type -> api.v1.UpdateTransactionCategoryResponse
+	9,  // 27: api.v1.TransactionService.GetTransactionAnalytics:output_type -> api.v1.GetTransactionAnalyticsResponse
+	11, // 28: api.v1.TransactionService.SetTransactionSplits:output_type -> api.v1.SetTransactionSplitsResponse
+	13, // 29: api.v1.TransactionService.DeleteTransactionSplits:output_type -> api.v1.DeleteTransactionSplitsResponse
+	16, // 30: api.v1.TransactionService.DetectTransfers:output_type -> api.v1.DetectTransfersResponse
+	18, // 31: api.v1.TransactionService.ListTransfers:output_type -> api.v1.ListTransfersResponse
+	20, // 32: api.v1.TransactionService.ConfirmTransfer:output_type -> api.v1.ConfirmTransferResponse
+	22, // 33: api.v1.TransactionService.UnlinkTransfer:output_type -> api.v1.UnlinkTransferResponse
+	24, // 34: api.v1.TransactionService.ExportTransactions:output_type -> api.v1.ExportTransactionsResponse
+	27, // 35: api.v1.TransactionService.ListLedgerAccountMappings:output_type -> api.v1.ListLedgerAccountMappingsResponse
+	29, // 36: api.v1.TransactionService.SetLedgerAccountMapping:output_type -> api.v1.SetLedgerAccountMappingResponse
+	31, // 37: api.v1.TransactionService.DeleteLedgerAccountMapping:output_type -> api.v1.DeleteLedgerAccountMappingResponse
+	25, // [25:38] is the sub-list for method output_type
+	12, // [12:25] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_api_v1_transactions_proto_init() }
@@ -633,14 +2175,17 @@ func file_api_v1_transactions_proto_init() {
 		return
 	}
 	file_api_v1_transactions_proto_msgTypes[0].OneofWrappers = []any{}
-	file_api_v1_transactions_proto_msgTypes[4].OneofWrappers = []any{}
+	file_api_v1_transactions_proto_msgTypes[1].OneofWrappers = []any{}
+	file_api_v1_transactions_proto_msgTypes[3].OneofWrappers = []any{}
+	file_api_v1_transactions_proto_msgTypes[5].OneofWrappers = []any{}
+	file_api_v1_transactions_proto_msgTypes[8].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_transactions_proto_rawDesc), len(file_api_v1_transactions_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   32,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
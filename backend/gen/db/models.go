@@ -8,23 +8,96 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type AccountBalance struct {
+	ID           int64
+	AccountID    int64
+	WorkspaceID  int32
+	SourceFileID pgtype.Int8
+	BalanceDate  pgtype.Date
+	Balance      pgtype.Numeric
+	CreatedAt    pgtype.Timestamptz
+}
+
+type Account struct {
+	ID           int64
+	WorkspaceID  int32
+	Name         string
+	Type         string
+	Identifier   pgtype.Text
+	Iban         pgtype.Text
+	Currency     pgtype.Text
+	Archived     bool
+	MergedIntoID pgtype.Int8
+	CreatedAt    pgtype.Timestamptz
+}
+
+type ApiToken struct {
+	ID          int64
+	UserID      int32
+	Name        string
+	TokenPrefix string
+	TokenHash   string
+	Scopes      []string
+	CreatedAt   pgtype.Timestamptz
+	LastUsedAt  pgtype.Timestamptz
+	RevokedAt   pgtype.Timestamptz
+}
+
+type Budget struct {
+	ID          int64
+	WorkspaceID int32
+	CategoryID  int64
+	Period      string
+	Amount      pgtype.Numeric
+	Currency    string
+	CreatedAt   pgtype.Timestamptz
+}
+
 type Category struct {
-	ID        int64
-	UserID    int32
-	Name      string
-	CreatedAt pgtype.Timestamptz
-	Color     pgtype.Text
-	ParentID  pgtype.Int8
-	IsGroup   bool
+	ID          int64
+	WorkspaceID int32
+	Name        string
+	CreatedAt   pgtype.Timestamptz
+	Color       pgtype.Text
+	ParentID    pgtype.Int8
+	IsGroup     bool
 }
 
 type CategoryRule struct {
 	ID                  int64
-	UserID              int32
+	WorkspaceID         int32
 	CategoryID          int64
 	DescriptionContains string
 	CreatedAt           pgtype.Timestamptz
 	Position            int32
+	DescriptionRegex    string
+	DescriptionExact    string
+	DescriptionPrefix   string
+	AmountMin           pgtype.Int8
+	AmountMax           pgtype.Int8
+	EntryType           string
+	Currency            string
+	Account             string
+	ParserName          string
+}
+
+type CsvTemplate struct {
+	ID                 int64
+	WorkspaceID        int32
+	Name               string
+	Signature          string
+	Delimiter          string
+	DateColumn         string
+	DateFormat         string
+	DecimalSeparator   string
+	DescriptionColumns []string
+	AmountColumn       string
+	DebitColumn        string
+	CreditColumn       string
+	CurrencyColumn     string
+	AccountColumn      string
+	DefaultCurrency    string
+	CreatedAt          pgtype.Timestamptz
 }
 
 type ExchangeRate struct {
@@ -38,13 +111,68 @@ type ExchangeRate struct {
 
 type FinancialReport struct {
 	ID                int64
-	UserID            int32
+	WorkspaceID       int32
 	Filename          string
 	ContentType       pgtype.Text
 	Data              []byte
 	UploadedAt        pgtype.Timestamptz
 	Status            string
 	StatusDescription pgtype.Text
+	ClaimedAt         pgtype.Timestamptz
+	ClaimedBy         pgtype.Text
+	Attempts          int32
+	NextAttemptAt     pgtype.Timestamptz
+}
+
+type HouseholdInvitation struct {
+	ID          int64
+	HouseholdID int32
+	TokenHash   string
+	Role        string
+	CreatedBy   int32
+	ExpiresAt   pgtype.Timestamptz
+	AcceptedBy  pgtype.Int4
+	AcceptedAt  pgtype.Timestamptz
+	CreatedAt   pgtype.Timestamptz
+}
+
+type HouseholdMember struct {
+	HouseholdID int32
+	UserID      int32
+	Role        string
+	CreatedAt   pgtype.Timestamptz
+}
+
+type LedgerAccountMapping struct {
+	ID            int64
+	WorkspaceID   int32
+	Kind          string
+	Source        string
+	LedgerAccount string
+	CreatedAt     pgtype.Timestamptz
+}
+
+type RecurringSeries struct {
+	ID          int64
+	WorkspaceID int32
+	MerchantKey string
+	Name        string
+	Currency    string
+	Cadence     string
+	Amount      pgtype.Numeric
+	Status      string
+	CreatedAt   pgtype.Timestamptz
+}
+
+type ReportDiagnostic struct {
+	ID          int64
+	ReportID    int64
+	WorkspaceID int32
+	RowNumber   int32
+	Severity    string
+	RawRecord   string
+	Reason      string
+	CreatedAt   pgtype.Timestamptz
 }
 
 type Session struct {
@@ -59,9 +187,27 @@ type Todo struct {
 	UserID int32
 }
 
+type TransactionSplit struct {
+	ID            int64
+	TransactionID int64
+	WorkspaceID   int32
+	CategoryID    pgtype.Int8
+	Amount        pgtype.Numeric
+	CreatedAt     pgtype.Timestamptz
+}
+
+type TransactionTransfer struct {
+	ID                  int64
+	WorkspaceID         int32
+	DebitTransactionID  int64
+	CreditTransactionID int64
+	Status              string
+	CreatedAt           pgtype.Timestamptz
+}
+
 type Transaction struct {
 	ID                  int64
-	UserID              int32
+	WorkspaceID         int32
 	SourceFileID        int64
 	SourceFileRow       int32
 	ParserName          string
@@ -77,6 +223,7 @@ type Transaction struct {
 	CreatedAt           pgtype.Timestamptz
 	CategoryID          pgtype.Int8
 	CategorySource      pgtype.Text
+	AccountID           pgtype.Int8
 }
 
 type User struct {
@@ -85,3 +232,11 @@ type User struct {
 	Password string
 	Language string
 }
+
+type Workspace struct {
+	ID           int32
+	UserID       pgtype.Int4
+	Name         string
+	BaseCurrency string
+	CreatedAt    pgtype.Timestamptz
+}
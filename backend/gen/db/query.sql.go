@@ -11,6 +11,26 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const addHouseholdMember = `-- name: AddHouseholdMember :execrows
+INSERT INTO household_members (household_id, user_id, role)
+VALUES ($1, $2, $3)
+ON CONFLICT (household_id, user_id) DO NOTHING
+`
+
+type AddHouseholdMemberParams struct {
+	HouseholdID int32
+	UserID      int32
+	Role        string
+}
+
+func (q *Queries) AddHouseholdMember(ctx context.Context, arg AddHouseholdMemberParams) (int64, error) {
+	result, err := q.db.Exec(ctx, addHouseholdMember, arg.HouseholdID, arg.UserID, arg.Role)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const addTodo = `-- name: AddTodo :exec
 INSERT INTO todo (title, user_id) VALUES ($1, $2)
 `
@@ -40,38 +60,413 @@ func (q *Queries) AddTodosBatch(ctx context.Context, arg AddTodosBatchParams) er
 	return err
 }
 
+const aggregateTransactionsByDay = `-- name: AggregateTransactionsByDay :many
+WITH lines AS (
+    SELECT transactions.id,
+           transactions.posted_date,
+           transactions.currency,
+           transactions.source_account_number,
+           transactions.source_card_number,
+           CASE WHEN transaction_splits.id IS NULL THEN transactions.category_id ELSE transaction_splits.category_id END AS category_id,
+           COALESCE(transaction_splits.amount, transactions.amount) AS amount
+    FROM transactions
+    LEFT JOIN transaction_splits ON transaction_splits.transaction_id = transactions.id
+    WHERE transactions.workspace_id = $1
+      AND ($2::date IS NULL OR transactions.posted_date >= $2)
+      AND ($3::date IS NULL OR transactions.posted_date <= $3)
+      AND ($4::bigint IS NULL OR transactions.source_file_id = $4)
+      AND ($5::text IS NULL OR transactions.entry_type = $5)
+      AND ($6::text IS NULL OR transactions.source_account_number = $6)
+      AND ($7::text IS NULL OR transactions.source_card_number = $7)
+      AND ($8::text IS NULL OR to_tsvector('simple', transactions.description) @@ plainto_tsquery('simple', $8))
+      AND ($9::boolean OR NOT EXISTS (
+          SELECT 1
+          FROM transaction_transfers
+          WHERE transaction_transfers.status <> 'rejected'
+            AND transactions.id IN (transaction_transfers.debit_transaction_id, transaction_transfers.credit_transaction_id)
+      ))
+)
+SELECT posted_date,
+       currency,
+       category_id,
+       COUNT(DISTINCT id) AS count,
+       COALESCE(SUM(amount) FILTER (WHERE amount > 0), 0)::numeric AS income,
+       COALESCE(SUM(amount) FILTER (WHERE amount < 0), 0)::numeric AS expense
+FROM lines
+WHERE $10::bigint IS NULL OR category_id = $10
+GROUP BY posted_date, currency, category_id
+ORDER BY posted_date, currency, category_id
+`
+
+type AggregateTransactionsByDayParams struct {
+	WorkspaceID         int32
+	FromDate            pgtype.Date
+	ToDate              pgtype.Date
+	SourceFileID        pgtype.Int8
+	EntryType           pgtype.Text
+	SourceAccountNumber pgtype.Text
+	SourceCardNumber    pgtype.Text
+	SearchText          pgtype.Text
+	IncludeTransfers    bool
+	CategoryID          pgtype.Int8
+}
+
+type AggregateTransactionsByDayRow struct {
+	PostedDate pgtype.Date
+	Currency   string
+	CategoryID pgtype.Int8
+	Count      int64
+	Income     pgtype.Numeric
+	Expense    pgtype.Numeric
+}
+
+func (q *Queries) AggregateTransactionsByDay(ctx context.Context, arg AggregateTransactionsByDayParams) ([]AggregateTransactionsByDayRow, error) {
+	rows, err := q.db.Query(ctx, aggregateTransactionsByDay,
+		arg.WorkspaceID,
+		arg.FromDate,
+		arg.ToDate,
+		arg.SourceFileID,
+		arg.EntryType,
+		arg.SourceAccountNumber,
+		arg.SourceCardNumber,
+		arg.SearchText,
+		arg.IncludeTransfers,
+		arg.CategoryID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AggregateTransactionsByDayRow
+	for rows.Next() {
+		var i AggregateTransactionsByDayRow
+		if err := rows.Scan(
+			&i.PostedDate,
+			&i.Currency,
+			&i.CategoryID,
+			&i.Count,
+			&i.Income,
+			&i.Expense,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const assignReportTransactionAccounts = `-- name: AssignReportTransactionAccounts :exec
+UPDATE transactions
+SET account_id = COALESCE(accounts.merged_into_id, accounts.id)
+FROM accounts
+WHERE transactions.source_file_id = $1
+  AND transactions.workspace_id = $2
+  AND accounts.workspace_id = transactions.workspace_id
+  AND accounts.identifier = COALESCE(NULLIF(transactions.source_card_number, ''), transactions.source_account_number)
+`
+
+type AssignReportTransactionAccountsParams struct {
+	SourceFileID int64
+	WorkspaceID  int32
+}
+
+func (q *Queries) AssignReportTransactionAccounts(ctx context.Context, arg AssignReportTransactionAccountsParams) error {
+	_, err := q.db.Exec(ctx, assignReportTransactionAccounts, arg.SourceFileID, arg.WorkspaceID)
+	return err
+}
+
 const categoryExists = `-- name: CategoryExists :one
 SELECT EXISTS(
     SELECT 1
     FROM categories
-    WHERE id = $1 AND user_id = $2
+    WHERE id = $1 AND workspace_id = $2
 )
 `
 
 type CategoryExistsParams struct {
-	ID     int64
-	UserID int32
+	ID          int64
+	WorkspaceID int32
 }
 
 func (q *Queries) CategoryExists(ctx context.Context, arg CategoryExistsParams) (bool, error) {
-	row := q.db.QueryRow(ctx, categoryExists, arg.ID, arg.UserID)
+	row := q.db.QueryRow(ctx, categoryExists, arg.ID, arg.WorkspaceID)
 	var exists bool
 	err := row.Scan(&exists)
 	return exists, err
 }
 
+const claimHouseholdInvitation = `-- name: ClaimHouseholdInvitation :one
+UPDATE household_invitations
+SET accepted_by = $1, accepted_at = now()
+WHERE token_hash = $2 AND accepted_at IS NULL AND expires_at > now()
+RETURNING household_id, role
+`
+
+type ClaimHouseholdInvitationParams struct {
+	AcceptedBy pgtype.Int4
+	TokenHash  string
+}
+
+type ClaimHouseholdInvitationRow struct {
+	HouseholdID int32
+	Role        string
+}
+
+func (q *Queries) ClaimHouseholdInvitation(ctx context.Context, arg ClaimHouseholdInvitationParams) (ClaimHouseholdInvitationRow, error) {
+	row := q.db.QueryRow(ctx, claimHouseholdInvitation, arg.AcceptedBy, arg.TokenHash)
+	var i ClaimHouseholdInvitationRow
+	err := row.Scan(&i.HouseholdID, &i.Role)
+	return i, err
+}
+
+const claimNextReport = `-- name: ClaimNextReport :one
+UPDATE financial_reports
+SET status = 'processing',
+    claimed_at = now(),
+    claimed_by = $1,
+    attempts = attempts + 1
+WHERE id = (
+    SELECT id
+    FROM financial_reports
+    WHERE (status = 'pending' AND (next_attempt_at IS NULL OR next_attempt_at <= now()))
+       OR (status = 'processing' AND claimed_at < now() - make_interval(secs => $2::double precision))
+    ORDER BY uploaded_at ASC, id ASC
+    LIMIT 1
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING id, workspace_id, filename, data, attempts
+`
+
+type ClaimNextReportParams struct {
+	ClaimedBy    pgtype.Text
+	LeaseSeconds float64
+}
+
+type ClaimNextReportRow struct {
+	ID          int64
+	WorkspaceID int32
+	Filename    string
+	Data        []byte
+	Attempts    int32
+}
+
+func (q *Queries) ClaimNextReport(ctx context.Context, arg ClaimNextReportParams) (ClaimNextReportRow, error) {
+	row := q.db.QueryRow(ctx, claimNextReport, arg.ClaimedBy, arg.LeaseSeconds)
+	var i ClaimNextReportRow
+	err := row.Scan(
+		&i.ID,
+		&i.WorkspaceID,
+		&i.Filename,
+		&i.Data,
+		&i.Attempts,
+	)
+	return i, err
+}
+
+const completeReportClaim = `-- name: CompleteReportClaim :execrows
+UPDATE financial_reports
+SET status = $1,
+    status_description = $2,
+    claimed_at = NULL,
+    claimed_by = NULL,
+    next_attempt_at = NULL
+WHERE id = $3
+  AND status = 'processing'
+  AND claimed_by = $4
+`
+
+type CompleteReportClaimParams struct {
+	Status            string
+	StatusDescription pgtype.Text
+	ID                int64
+	ClaimedBy         pgtype.Text
+}
+
+func (q *Queries) CompleteReportClaim(ctx context.Context, arg CompleteReportClaimParams) (int64, error) {
+	result, err := q.db.Exec(ctx, completeReportClaim,
+		arg.Status,
+		arg.StatusDescription,
+		arg.ID,
+		arg.ClaimedBy,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const countHouseholdOwners = `-- name: CountHouseholdOwners :one
+SELECT COUNT(*)
+FROM household_members
+WHERE household_id = $1 AND role = 'owner'
+`
+
+func (q *Queries) CountHouseholdOwners(ctx context.Context, householdID int32) (int64, error) {
+	row := q.db.QueryRow(ctx, countHouseholdOwners, householdID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createAccount = `-- name: CreateAccount :one
+INSERT INTO accounts (workspace_id, name, type, currency)
+VALUES ($1, $2, $3, $4)
+RETURNING id, name, type, identifier, iban, currency, archived, created_at
+`
+
+type CreateAccountParams struct {
+	WorkspaceID int32
+	Name        string
+	Type        string
+	Currency    pgtype.Text
+}
+
+type CreateAccountRow struct {
+	ID         int64
+	Name       string
+	Type       string
+	Identifier pgtype.Text
+	Iban       pgtype.Text
+	Currency   pgtype.Text
+	Archived   bool
+	CreatedAt  pgtype.Timestamptz
+}
+
+func (q *Queries) CreateAccount(ctx context.Context, arg CreateAccountParams) (CreateAccountRow, error) {
+	row := q.db.QueryRow(ctx, createAccount,
+		arg.WorkspaceID,
+		arg.Name,
+		arg.Type,
+		arg.Currency,
+	)
+	var i CreateAccountRow
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Type,
+		&i.Identifier,
+		&i.Iban,
+		&i.Currency,
+		&i.Archived,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createAccountBalance = `-- name: CreateAccountBalance :exec
+INSERT INTO account_balances (account_id, workspace_id, source_file_id, balance_date, balance)
+VALUES ($1, $2, $3, $4, $5)
+`
+
+type CreateAccountBalanceParams struct {
+	AccountID    int64
+	WorkspaceID  int32
+	SourceFileID pgtype.Int8
+	BalanceDate  pgtype.Date
+	Balance      pgtype.Numeric
+}
+
+func (q *Queries) CreateAccountBalance(ctx context.Context, arg CreateAccountBalanceParams) error {
+	_, err := q.db.Exec(ctx, createAccountBalance,
+		arg.AccountID,
+		arg.WorkspaceID,
+		arg.SourceFileID,
+		arg.BalanceDate,
+		arg.Balance,
+	)
+	return err
+}
+
+const createApiToken = `-- name: CreateApiToken :one
+INSERT INTO api_tokens (user_id, name, token_prefix, token_hash, scopes)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, created_at
+`
+
+type CreateApiTokenParams struct {
+	UserID      int32
+	Name        string
+	TokenPrefix string
+	TokenHash   string
+	Scopes      []string
+}
+
+type CreateApiTokenRow struct {
+	ID        int64
+	CreatedAt pgtype.Timestamptz
+}
+
+func (q *Queries) CreateApiToken(ctx context.Context, arg CreateApiTokenParams) (CreateApiTokenRow, error) {
+	row := q.db.QueryRow(ctx, createApiToken,
+		arg.UserID,
+		arg.Name,
+		arg.TokenPrefix,
+		arg.TokenHash,
+		arg.Scopes,
+	)
+	var i CreateApiTokenRow
+	err := row.Scan(&i.ID, &i.CreatedAt)
+	return i, err
+}
+
+const createBudget = `-- name: CreateBudget :one
+INSERT INTO budgets (workspace_id, category_id, period, amount, currency)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, category_id, period, amount, currency, created_at
+`
+
+type CreateBudgetParams struct {
+	WorkspaceID int32
+	CategoryID  int64
+	Period      string
+	Amount      pgtype.Numeric
+	Currency    string
+}
+
+type CreateBudgetRow struct {
+	ID         int64
+	CategoryID int64
+	Period     string
+	Amount     pgtype.Numeric
+	Currency   string
+	CreatedAt  pgtype.Timestamptz
+}
+
+func (q *Queries) CreateBudget(ctx context.Context, arg CreateBudgetParams) (CreateBudgetRow, error) {
+	row := q.db.QueryRow(ctx, createBudget,
+		arg.WorkspaceID,
+		arg.CategoryID,
+		arg.Period,
+		arg.Amount,
+		arg.Currency,
+	)
+	var i CreateBudgetRow
+	err := row.Scan(
+		&i.ID,
+		&i.CategoryID,
+		&i.Period,
+		&i.Amount,
+		&i.Currency,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
 const createCategory = `-- name: CreateCategory :one
-INSERT INTO categories (user_id, name, color, parent_id, is_group)
+INSERT INTO categories (workspace_id, name, color, parent_id, is_group)
 VALUES ($1, $2, $3, $4, $5)
 RETURNING id, name, color, created_at, parent_id, is_group
 `
 
 type CreateCategoryParams struct {
-	UserID   int32
-	Name     string
-	Color    pgtype.Text
-	ParentID pgtype.Int8
-	IsGroup  bool
+	WorkspaceID int32
+	Name        string
+	Color       pgtype.Text
+	ParentID    pgtype.Int8
+	IsGroup     bool
 }
 
 type CreateCategoryRow struct {
@@ -85,7 +480,7 @@ type CreateCategoryRow struct {
 
 func (q *Queries) CreateCategory(ctx context.Context, arg CreateCategoryParams) (CreateCategoryRow, error) {
 	row := q.db.QueryRow(ctx, createCategory,
-		arg.UserID,
+		arg.WorkspaceID,
 		arg.Name,
 		arg.Color,
 		arg.ParentID,
@@ -104,20 +499,43 @@ func (q *Queries) CreateCategory(ctx context.Context, arg CreateCategoryParams)
 }
 
 const createCategoryRule = `-- name: CreateCategoryRule :one
-INSERT INTO category_rules (user_id, category_id, description_contains, position)
+INSERT INTO category_rules (
+    workspace_id, category_id, description_contains, description_regex, description_exact, description_prefix,
+    amount_min, amount_max, entry_type, currency, account, parser_name, position
+)
 VALUES (
     $1,
     $2,
     $3,
-    COALESCE((SELECT MAX(position) FROM category_rules WHERE user_id = $1), 0) + 1
+    $4,
+    $5,
+    $6,
+    $7,
+    $8,
+    $9,
+    $10,
+    $11,
+    $12,
+    COALESCE((SELECT MAX(position) FROM category_rules WHERE workspace_id = $1), 0) + 1
 )
-RETURNING id, category_id, description_contains, position, created_at
+RETURNING id, category_id, description_contains, position, created_at,
+       description_regex, description_exact, description_prefix,
+       amount_min, amount_max, entry_type, currency, account, parser_name
 `
 
 type CreateCategoryRuleParams struct {
-	UserID              int32
+	WorkspaceID         int32
 	CategoryID          int64
 	DescriptionContains string
+	DescriptionRegex    string
+	DescriptionExact    string
+	DescriptionPrefix   string
+	AmountMin           pgtype.Int8
+	AmountMax           pgtype.Int8
+	EntryType           string
+	Currency            string
+	Account             string
+	ParserName          string
 }
 
 type CreateCategoryRuleRow struct {
@@ -126,10 +544,32 @@ type CreateCategoryRuleRow struct {
 	DescriptionContains string
 	Position            int32
 	CreatedAt           pgtype.Timestamptz
+	DescriptionRegex    string
+	DescriptionExact    string
+	DescriptionPrefix   string
+	AmountMin           pgtype.Int8
+	AmountMax           pgtype.Int8
+	EntryType           string
+	Currency            string
+	Account             string
+	ParserName          string
 }
 
 func (q *Queries) CreateCategoryRule(ctx context.Context, arg CreateCategoryRuleParams) (CreateCategoryRuleRow, error) {
-	row := q.db.QueryRow(ctx, createCategoryRule, arg.UserID, arg.CategoryID, arg.DescriptionContains)
+	row := q.db.QueryRow(ctx, createCategoryRule,
+		arg.WorkspaceID,
+		arg.CategoryID,
+		arg.DescriptionContains,
+		arg.DescriptionRegex,
+		arg.DescriptionExact,
+		arg.DescriptionPrefix,
+		arg.AmountMin,
+		arg.AmountMax,
+		arg.EntryType,
+		arg.Currency,
+		arg.Account,
+		arg.ParserName,
+	)
 	var i CreateCategoryRuleRow
 	err := row.Scan(
 		&i.ID,
@@ -137,17 +577,185 @@ func (q *Queries) CreateCategoryRule(ctx context.Context, arg CreateCategoryRule
 		&i.DescriptionContains,
 		&i.Position,
 		&i.CreatedAt,
+		&i.DescriptionRegex,
+		&i.DescriptionExact,
+		&i.DescriptionPrefix,
+		&i.AmountMin,
+		&i.AmountMax,
+		&i.EntryType,
+		&i.Currency,
+		&i.Account,
+		&i.ParserName,
+	)
+	return i, err
+}
+
+const createCsvTemplate = `-- name: CreateCsvTemplate :one
+INSERT INTO csv_templates (
+    workspace_id, name, signature, delimiter, date_column, date_format, decimal_separator, description_columns,
+    amount_column, debit_column, credit_column, currency_column, account_column, default_currency
+)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+RETURNING id, name, signature, delimiter, date_column, date_format, decimal_separator, description_columns,
+       amount_column, debit_column, credit_column, currency_column, account_column, default_currency, created_at
+`
+
+type CreateCsvTemplateParams struct {
+	WorkspaceID        int32
+	Name               string
+	Signature          string
+	Delimiter          string
+	DateColumn         string
+	DateFormat         string
+	DecimalSeparator   string
+	DescriptionColumns []string
+	AmountColumn       string
+	DebitColumn        string
+	CreditColumn       string
+	CurrencyColumn     string
+	AccountColumn      string
+	DefaultCurrency    string
+}
+
+type CreateCsvTemplateRow struct {
+	ID                 int64
+	Name               string
+	Signature          string
+	Delimiter          string
+	DateColumn         string
+	DateFormat         string
+	DecimalSeparator   string
+	DescriptionColumns []string
+	AmountColumn       string
+	DebitColumn        string
+	CreditColumn       string
+	CurrencyColumn     string
+	AccountColumn      string
+	DefaultCurrency    string
+	CreatedAt          pgtype.Timestamptz
+}
+
+func (q *Queries) CreateCsvTemplate(ctx context.Context, arg CreateCsvTemplateParams) (CreateCsvTemplateRow, error) {
+	row := q.db.QueryRow(ctx, createCsvTemplate,
+		arg.WorkspaceID,
+		arg.Name,
+		arg.Signature,
+		arg.Delimiter,
+		arg.DateColumn,
+		arg.DateFormat,
+		arg.DecimalSeparator,
+		arg.DescriptionColumns,
+		arg.AmountColumn,
+		arg.DebitColumn,
+		arg.CreditColumn,
+		arg.CurrencyColumn,
+		arg.AccountColumn,
+		arg.DefaultCurrency,
+	)
+	var i CreateCsvTemplateRow
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Signature,
+		&i.Delimiter,
+		&i.DateColumn,
+		&i.DateFormat,
+		&i.DecimalSeparator,
+		&i.DescriptionColumns,
+		&i.AmountColumn,
+		&i.DebitColumn,
+		&i.CreditColumn,
+		&i.CurrencyColumn,
+		&i.AccountColumn,
+		&i.DefaultCurrency,
+		&i.CreatedAt,
 	)
 	return i, err
 }
 
+const createHousehold = `-- name: CreateHousehold :one
+INSERT INTO workspaces (name, base_currency)
+VALUES ($1, $2)
+RETURNING id, created_at
+`
+
+type CreateHouseholdParams struct {
+	Name         string
+	BaseCurrency string
+}
+
+type CreateHouseholdRow struct {
+	ID        int32
+	CreatedAt pgtype.Timestamptz
+}
+
+func (q *Queries) CreateHousehold(ctx context.Context, arg CreateHouseholdParams) (CreateHouseholdRow, error) {
+	row := q.db.QueryRow(ctx, createHousehold, arg.Name, arg.BaseCurrency)
+	var i CreateHouseholdRow
+	err := row.Scan(&i.ID, &i.CreatedAt)
+	return i, err
+}
+
+const createHouseholdInvitation = `-- name: CreateHouseholdInvitation :exec
+INSERT INTO household_invitations (household_id, token_hash, role, created_by, expires_at)
+VALUES ($1, $2, $3, $4, $5)
+`
+
+type CreateHouseholdInvitationParams struct {
+	HouseholdID int32
+	TokenHash   string
+	Role        string
+	CreatedBy   int32
+	ExpiresAt   pgtype.Timestamptz
+}
+
+func (q *Queries) CreateHouseholdInvitation(ctx context.Context, arg CreateHouseholdInvitationParams) error {
+	_, err := q.db.Exec(ctx, createHouseholdInvitation,
+		arg.HouseholdID,
+		arg.TokenHash,
+		arg.Role,
+		arg.CreatedBy,
+		arg.ExpiresAt,
+	)
+	return err
+}
+
+const createRecurringSeries = `-- name: CreateRecurringSeries :execrows
+INSERT INTO recurring_series (workspace_id, merchant_key, name, currency, cadence, amount)
+VALUES ($1, $2, $3, $4, $5, $6)
+`
+
+type CreateRecurringSeriesParams struct {
+	WorkspaceID int32
+	MerchantKey string
+	Name        string
+	Currency    string
+	Cadence     string
+	Amount      pgtype.Numeric
+}
+
+func (q *Queries) CreateRecurringSeries(ctx context.Context, arg CreateRecurringSeriesParams) (int64, error) {
+	result, err := q.db.Exec(ctx, createRecurringSeries,
+		arg.WorkspaceID,
+		arg.MerchantKey,
+		arg.Name,
+		arg.Currency,
+		arg.Cadence,
+		arg.Amount,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const createReport = `-- name: CreateReport :exec
-INSERT INTO financial_reports (user_id, filename, content_type, data, status)
+INSERT INTO financial_reports (workspace_id, filename, content_type, data, status)
 VALUES ($1, $2, $3, $4, $5)
 `
 
 type CreateReportParams struct {
-	UserID      int32
+	WorkspaceID int32
 	Filename    string
 	ContentType pgtype.Text
 	Data        []byte
@@ -156,7 +764,7 @@ type CreateReportParams struct {
 
 func (q *Queries) CreateReport(ctx context.Context, arg CreateReportParams) error {
 	_, err := q.db.Exec(ctx, createReport,
-		arg.UserID,
+		arg.WorkspaceID,
 		arg.Filename,
 		arg.ContentType,
 		arg.Data,
@@ -165,6 +773,32 @@ func (q *Queries) CreateReport(ctx context.Context, arg CreateReportParams) erro
 	return err
 }
 
+const createReportDiagnostic = `-- name: CreateReportDiagnostic :exec
+INSERT INTO report_diagnostics (report_id, workspace_id, row_number, severity, raw_record, reason)
+VALUES ($1, $2, $3, $4, $5, $6)
+`
+
+type CreateReportDiagnosticParams struct {
+	ReportID    int64
+	WorkspaceID int32
+	RowNumber   int32
+	Severity    string
+	RawRecord   string
+	Reason      string
+}
+
+func (q *Queries) CreateReportDiagnostic(ctx context.Context, arg CreateReportDiagnosticParams) error {
+	_, err := q.db.Exec(ctx, createReportDiagnostic,
+		arg.ReportID,
+		arg.WorkspaceID,
+		arg.RowNumber,
+		arg.Severity,
+		arg.RawRecord,
+		arg.Reason,
+	)
+	return err
+}
+
 const createSession = `-- name: CreateSession :one
 INSERT INTO sessions (user_id, expires)
 VALUES ($1, $2)
@@ -185,7 +819,7 @@ func (q *Queries) CreateSession(ctx context.Context, arg CreateSessionParams) (s
 
 const createTransaction = `-- name: CreateTransaction :exec
 INSERT INTO transactions (
-    user_id,
+    workspace_id,
     source_file_id,
     source_file_row,
     parser_name,
@@ -220,7 +854,7 @@ INSERT INTO transactions (
 `
 
 type CreateTransactionParams struct {
-	UserID              int32
+	WorkspaceID         int32
 	SourceFileID        int64
 	SourceFileRow       int32
 	ParserName          string
@@ -239,7 +873,7 @@ type CreateTransactionParams struct {
 
 func (q *Queries) CreateTransaction(ctx context.Context, arg CreateTransactionParams) error {
 	_, err := q.db.Exec(ctx, createTransaction,
-		arg.UserID,
+		arg.WorkspaceID,
 		arg.SourceFileID,
 		arg.SourceFileRow,
 		arg.ParserName,
@@ -258,10 +892,66 @@ func (q *Queries) CreateTransaction(ctx context.Context, arg CreateTransactionPa
 	return err
 }
 
+const createTransactionSplit = `-- name: CreateTransactionSplit :exec
+INSERT INTO transaction_splits (transaction_id, workspace_id, category_id, amount)
+VALUES ($1, $2, $3, $4)
+`
+
+type CreateTransactionSplitParams struct {
+	TransactionID int64
+	WorkspaceID   int32
+	CategoryID    pgtype.Int8
+	Amount        pgtype.Numeric
+}
+
+func (q *Queries) CreateTransactionSplit(ctx context.Context, arg CreateTransactionSplitParams) error {
+	_, err := q.db.Exec(ctx, createTransactionSplit,
+		arg.TransactionID,
+		arg.WorkspaceID,
+		arg.CategoryID,
+		arg.Amount,
+	)
+	return err
+}
+
+const createTransactionTransfer = `-- name: CreateTransactionTransfer :execrows
+INSERT INTO transaction_transfers (workspace_id, debit_transaction_id, credit_transaction_id, status)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT DO NOTHING
+`
+
+type CreateTransactionTransferParams struct {
+	WorkspaceID         int32
+	DebitTransactionID  int64
+	CreditTransactionID int64
+	Status              string
+}
+
+func (q *Queries) CreateTransactionTransfer(ctx context.Context, arg CreateTransactionTransferParams) (int64, error) {
+	result, err := q.db.Exec(ctx, createTransactionTransfer,
+		arg.WorkspaceID,
+		arg.DebitTransactionID,
+		arg.CreditTransactionID,
+		arg.Status,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const createUser = `-- name: CreateUser :one
-INSERT INTO users (username, password, language)
-VALUES ($1, $2, 'en')
-RETURNING id, username, language
+WITH created AS (
+    INSERT INTO users (username, password, language)
+    VALUES ($1, $2, 'en')
+    RETURNING id, username, language
+), workspace AS (
+    INSERT INTO workspaces (user_id)
+    SELECT id FROM created
+    RETURNING base_currency
+)
+SELECT created.id, created.username, created.language, workspace.base_currency
+FROM created, workspace
 `
 
 type CreateUserParams struct {
@@ -270,30 +960,69 @@ type CreateUserParams struct {
 }
 
 type CreateUserRow struct {
-	ID       int32
-	Username string
-	Language string
+	ID           int32
+	Username     string
+	Language     string
+	BaseCurrency string
 }
 
 func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (CreateUserRow, error) {
 	row := q.db.QueryRow(ctx, createUser, arg.Username, arg.Password)
 	var i CreateUserRow
-	err := row.Scan(&i.ID, &i.Username, &i.Language)
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.Language,
+		&i.BaseCurrency,
+	)
 	return i, err
 }
 
+const deleteAccountBalancesBySource = `-- name: DeleteAccountBalancesBySource :exec
+DELETE FROM account_balances
+WHERE source_file_id = $1 AND workspace_id = $2
+`
+
+type DeleteAccountBalancesBySourceParams struct {
+	SourceFileID pgtype.Int8
+	WorkspaceID  int32
+}
+
+func (q *Queries) DeleteAccountBalancesBySource(ctx context.Context, arg DeleteAccountBalancesBySourceParams) error {
+	_, err := q.db.Exec(ctx, deleteAccountBalancesBySource, arg.SourceFileID, arg.WorkspaceID)
+	return err
+}
+
+const deleteBudget = `-- name: DeleteBudget :execrows
+DELETE FROM budgets
+WHERE id = $1 AND workspace_id = $2
+`
+
+type DeleteBudgetParams struct {
+	ID          int64
+	WorkspaceID int32
+}
+
+func (q *Queries) DeleteBudget(ctx context.Context, arg DeleteBudgetParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteBudget, arg.ID, arg.WorkspaceID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const deleteCategory = `-- name: DeleteCategory :execrows
 DELETE FROM categories
-WHERE id = $1 AND user_id = $2
+WHERE id = $1 AND workspace_id = $2
 `
 
 type DeleteCategoryParams struct {
-	ID     int64
-	UserID int32
+	ID          int64
+	WorkspaceID int32
 }
 
 func (q *Queries) DeleteCategory(ctx context.Context, arg DeleteCategoryParams) (int64, error) {
-	result, err := q.db.Exec(ctx, deleteCategory, arg.ID, arg.UserID)
+	result, err := q.db.Exec(ctx, deleteCategory, arg.ID, arg.WorkspaceID)
 	if err != nil {
 		return 0, err
 	}
@@ -302,16 +1031,70 @@ func (q *Queries) DeleteCategory(ctx context.Context, arg DeleteCategoryParams)
 
 const deleteCategoryRule = `-- name: DeleteCategoryRule :execrows
 DELETE FROM category_rules
-WHERE id = $1 AND user_id = $2
+WHERE id = $1 AND workspace_id = $2
 `
 
 type DeleteCategoryRuleParams struct {
-	ID     int64
-	UserID int32
+	ID          int64
+	WorkspaceID int32
 }
 
 func (q *Queries) DeleteCategoryRule(ctx context.Context, arg DeleteCategoryRuleParams) (int64, error) {
-	result, err := q.db.Exec(ctx, deleteCategoryRule, arg.ID, arg.UserID)
+	result, err := q.db.Exec(ctx, deleteCategoryRule, arg.ID, arg.WorkspaceID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteCsvTemplate = `-- name: DeleteCsvTemplate :execrows
+DELETE FROM csv_templates
+WHERE id = $1 AND workspace_id = $2
+`
+
+type DeleteCsvTemplateParams struct {
+	ID          int64
+	WorkspaceID int32
+}
+
+func (q *Queries) DeleteCsvTemplate(ctx context.Context, arg DeleteCsvTemplateParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteCsvTemplate, arg.ID, arg.WorkspaceID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteHouseholdMember = `-- name: DeleteHouseholdMember :execrows
+DELETE FROM household_members
+WHERE household_id = $1 AND user_id = $2
+`
+
+type DeleteHouseholdMemberParams struct {
+	HouseholdID int32
+	UserID      int32
+}
+
+func (q *Queries) DeleteHouseholdMember(ctx context.Context, arg DeleteHouseholdMemberParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteHouseholdMember, arg.HouseholdID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteLedgerAccountMapping = `-- name: DeleteLedgerAccountMapping :execrows
+DELETE FROM ledger_account_mappings
+WHERE id = $1 AND workspace_id = $2
+`
+
+type DeleteLedgerAccountMappingParams struct {
+	ID          int64
+	WorkspaceID int32
+}
+
+func (q *Queries) DeleteLedgerAccountMapping(ctx context.Context, arg DeleteLedgerAccountMappingParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteLedgerAccountMapping, arg.ID, arg.WorkspaceID)
 	if err != nil {
 		return 0, err
 	}
@@ -320,16 +1103,26 @@ func (q *Queries) DeleteCategoryRule(ctx context.Context, arg DeleteCategoryRule
 
 const deleteReportByID = `-- name: DeleteReportByID :exec
 DELETE FROM financial_reports
-WHERE id = $1 AND user_id = $2
+WHERE id = $1 AND workspace_id = $2
 `
 
 type DeleteReportByIDParams struct {
-	ID     int64
-	UserID int32
+	ID          int64
+	WorkspaceID int32
 }
 
 func (q *Queries) DeleteReportByID(ctx context.Context, arg DeleteReportByIDParams) error {
-	_, err := q.db.Exec(ctx, deleteReportByID, arg.ID, arg.UserID)
+	_, err := q.db.Exec(ctx, deleteReportByID, arg.ID, arg.WorkspaceID)
+	return err
+}
+
+const deleteReportDiagnostics = `-- name: DeleteReportDiagnostics :exec
+DELETE FROM report_diagnostics
+WHERE report_id = $1
+`
+
+func (q *Queries) DeleteReportDiagnostics(ctx context.Context, reportID int64) error {
+	_, err := q.db.Exec(ctx, deleteReportDiagnostics, reportID)
 	return err
 }
 
@@ -346,30 +1139,223 @@ func (q *Queries) DeleteSession(ctx context.Context, id pgtype.UUID) (int64, err
 	return result.RowsAffected(), nil
 }
 
-const deleteTransactionsBySource = `-- name: DeleteTransactionsBySource :exec
-DELETE FROM transactions
-WHERE source_file_id = $1 AND user_id = $2
+const deleteTransactionSplits = `-- name: DeleteTransactionSplits :exec
+DELETE FROM transaction_splits
+WHERE transaction_id = $1 AND workspace_id = $2
 `
 
-type DeleteTransactionsBySourceParams struct {
-	SourceFileID int64
-	UserID       int32
+type DeleteTransactionSplitsParams struct {
+	TransactionID int64
+	WorkspaceID   int32
 }
 
-func (q *Queries) DeleteTransactionsBySource(ctx context.Context, arg DeleteTransactionsBySourceParams) error {
-	_, err := q.db.Exec(ctx, deleteTransactionsBySource, arg.SourceFileID, arg.UserID)
+func (q *Queries) DeleteTransactionSplits(ctx context.Context, arg DeleteTransactionSplitsParams) error {
+	_, err := q.db.Exec(ctx, deleteTransactionSplits, arg.TransactionID, arg.WorkspaceID)
 	return err
 }
 
+const deleteTransactionsByIDs = `-- name: DeleteTransactionsByIDs :execrows
+DELETE FROM transactions
+WHERE workspace_id = $1 AND id = ANY($2::bigint[])
+`
+
+type DeleteTransactionsByIDsParams struct {
+	WorkspaceID int32
+	Ids         []int64
+}
+
+func (q *Queries) DeleteTransactionsByIDs(ctx context.Context, arg DeleteTransactionsByIDsParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteTransactionsByIDs, arg.WorkspaceID, arg.Ids)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const exportTransactions = `-- name: ExportTransactions :many
+SELECT t.id,
+       t.posted_date,
+       t.description,
+       t.amount,
+       t.currency,
+       t.transaction_id,
+       t.entry_type,
+       t.source_account_number,
+       t.source_card_number,
+       t.source_file_row,
+       t.parser_name,
+       t.category_id,
+       a.name AS account_name,
+       a.type AS account_type,
+       f.filename AS source_filename,
+       EXISTS (
+           SELECT 1
+           FROM transaction_transfers
+           WHERE transaction_transfers.status <> 'rejected'
+             AND t.id IN (transaction_transfers.debit_transaction_id, transaction_transfers.credit_transaction_id)
+       ) AS is_transfer
+FROM transactions t
+JOIN financial_reports f ON f.id = t.source_file_id
+LEFT JOIN accounts a ON a.id = t.account_id
+WHERE t.workspace_id = $1
+  AND ($2::date IS NULL OR t.posted_date >= $2)
+  AND ($3::date IS NULL OR t.posted_date <= $3)
+  AND ($4::bigint IS NULL OR t.source_file_id = $4)
+  AND ($5::text IS NULL OR t.entry_type = $5)
+  AND ($6::text IS NULL OR t.source_account_number = $6)
+  AND ($7::text IS NULL OR t.source_card_number = $7)
+  AND ($8::text IS NULL OR to_tsvector('simple', t.description) @@ plainto_tsquery('simple', $8))
+  AND ($9::bigint IS NULL
+       OR (t.category_id = $9
+           AND NOT EXISTS (SELECT 1 FROM transaction_splits WHERE transaction_splits.transaction_id = t.id))
+       OR EXISTS (SELECT 1
+                  FROM transaction_splits
+                  WHERE transaction_splits.transaction_id = t.id
+                    AND transaction_splits.category_id = $9))
+  AND ($10::boolean OR NOT EXISTS (
+      SELECT 1
+      FROM transaction_transfers
+      WHERE transaction_transfers.status <> 'rejected'
+        AND t.id IN (transaction_transfers.debit_transaction_id, transaction_transfers.credit_transaction_id)
+  ))
+  AND ($11::date IS NULL OR (t.posted_date, t.id) > ($11, $12::bigint))
+ORDER BY t.posted_date, t.id
+LIMIT $13
+`
+
+type ExportTransactionsParams struct {
+	WorkspaceID         int32
+	FromDate            pgtype.Date
+	ToDate              pgtype.Date
+	SourceFileID        pgtype.Int8
+	EntryType           pgtype.Text
+	SourceAccountNumber pgtype.Text
+	SourceCardNumber    pgtype.Text
+	SearchText          pgtype.Text
+	CategoryID          pgtype.Int8
+	IncludeTransfers    bool
+	AfterDate           pgtype.Date
+	AfterID             int64
+	LimitCount          int32
+}
+
+type ExportTransactionsRow struct {
+	ID                  int64
+	PostedDate          pgtype.Date
+	Description         string
+	Amount              pgtype.Numeric
+	Currency            string
+	TransactionID       pgtype.Text
+	EntryType           string
+	SourceAccountNumber pgtype.Text
+	SourceCardNumber    pgtype.Text
+	SourceFileRow       int32
+	ParserName          string
+	CategoryID          pgtype.Int8
+	AccountName         pgtype.Text
+	AccountType         pgtype.Text
+	SourceFilename      string
+	IsTransfer          bool
+}
+
+func (q *Queries) ExportTransactions(ctx context.Context, arg ExportTransactionsParams) ([]ExportTransactionsRow, error) {
+	rows, err := q.db.Query(ctx, exportTransactions,
+		arg.WorkspaceID,
+		arg.FromDate,
+		arg.ToDate,
+		arg.SourceFileID,
+		arg.EntryType,
+		arg.SourceAccountNumber,
+		arg.SourceCardNumber,
+		arg.SearchText,
+		arg.CategoryID,
+		arg.IncludeTransfers,
+		arg.AfterDate,
+		arg.AfterID,
+		arg.LimitCount,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ExportTransactionsRow
+	for rows.Next() {
+		var i ExportTransactionsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.PostedDate,
+			&i.Description,
+			&i.Amount,
+			&i.Currency,
+			&i.TransactionID,
+			&i.EntryType,
+			&i.SourceAccountNumber,
+			&i.SourceCardNumber,
+			&i.SourceFileRow,
+			&i.ParserName,
+			&i.CategoryID,
+			&i.AccountName,
+			&i.AccountType,
+			&i.SourceFilename,
+			&i.IsTransfer,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAccount = `-- name: GetAccount :one
+SELECT id, name, type, identifier, iban, currency, archived, created_at
+FROM accounts
+WHERE id = $1 AND workspace_id = $2 AND merged_into_id IS NULL
+`
+
+type GetAccountParams struct {
+	ID          int64
+	WorkspaceID int32
+}
+
+type GetAccountRow struct {
+	ID         int64
+	Name       string
+	Type       string
+	Identifier pgtype.Text
+	Iban       pgtype.Text
+	Currency   pgtype.Text
+	Archived   bool
+	CreatedAt  pgtype.Timestamptz
+}
+
+func (q *Queries) GetAccount(ctx context.Context, arg GetAccountParams) (GetAccountRow, error) {
+	row := q.db.QueryRow(ctx, getAccount, arg.ID, arg.WorkspaceID)
+	var i GetAccountRow
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Type,
+		&i.Identifier,
+		&i.Iban,
+		&i.Currency,
+		&i.Archived,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
 const getCategoryByID = `-- name: GetCategoryByID :one
 SELECT id, name, color, created_at, parent_id, is_group
 FROM categories
-WHERE id = $1 AND user_id = $2
+WHERE id = $1 AND workspace_id = $2
 `
 
 type GetCategoryByIDParams struct {
-	ID     int64
-	UserID int32
+	ID          int64
+	WorkspaceID int32
 }
 
 type GetCategoryByIDRow struct {
@@ -382,7 +1368,7 @@ type GetCategoryByIDRow struct {
 }
 
 func (q *Queries) GetCategoryByID(ctx context.Context, arg GetCategoryByIDParams) (GetCategoryByIDRow, error) {
-	row := q.db.QueryRow(ctx, getCategoryByID, arg.ID, arg.UserID)
+	row := q.db.QueryRow(ctx, getCategoryByID, arg.ID, arg.WorkspaceID)
 	var i GetCategoryByIDRow
 	err := row.Scan(
 		&i.ID,
@@ -416,15 +1402,48 @@ func (q *Queries) GetExchangeRate(ctx context.Context, arg GetExchangeRateParams
 	return rate, err
 }
 
+const getHouseholdMembership = `-- name: GetHouseholdMembership :one
+SELECT w.id, w.name, m.role, w.base_currency, w.created_at
+FROM household_members m
+JOIN workspaces w ON w.id = m.household_id
+WHERE m.household_id = $1 AND m.user_id = $2
+`
+
+type GetHouseholdMembershipParams struct {
+	HouseholdID int32
+	UserID      int32
+}
+
+type GetHouseholdMembershipRow struct {
+	ID           int32
+	Name         string
+	Role         string
+	BaseCurrency string
+	CreatedAt    pgtype.Timestamptz
+}
+
+func (q *Queries) GetHouseholdMembership(ctx context.Context, arg GetHouseholdMembershipParams) (GetHouseholdMembershipRow, error) {
+	row := q.db.QueryRow(ctx, getHouseholdMembership, arg.HouseholdID, arg.UserID)
+	var i GetHouseholdMembershipRow
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Role,
+		&i.BaseCurrency,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
 const getReportByID = `-- name: GetReportByID :one
 SELECT filename, content_type, data
 FROM financial_reports
-WHERE id = $1 AND user_id = $2
+WHERE id = $1 AND workspace_id = $2
 `
 
 type GetReportByIDParams struct {
-	ID     int64
-	UserID int32
+	ID          int64
+	WorkspaceID int32
 }
 
 type GetReportByIDRow struct {
@@ -434,24 +1453,75 @@ type GetReportByIDRow struct {
 }
 
 func (q *Queries) GetReportByID(ctx context.Context, arg GetReportByIDParams) (GetReportByIDRow, error) {
-	row := q.db.QueryRow(ctx, getReportByID, arg.ID, arg.UserID)
+	row := q.db.QueryRow(ctx, getReportByID, arg.ID, arg.WorkspaceID)
 	var i GetReportByIDRow
 	err := row.Scan(&i.Filename, &i.ContentType, &i.Data)
 	return i, err
 }
 
+const getTransactionAmount = `-- name: GetTransactionAmount :one
+SELECT amount
+FROM transactions
+WHERE id = $1 AND workspace_id = $2
+`
+
+type GetTransactionAmountParams struct {
+	ID          int64
+	WorkspaceID int32
+}
+
+func (q *Queries) GetTransactionAmount(ctx context.Context, arg GetTransactionAmountParams) (pgtype.Numeric, error) {
+	row := q.db.QueryRow(ctx, getTransactionAmount, arg.ID, arg.WorkspaceID)
+	var amount pgtype.Numeric
+	err := row.Scan(&amount)
+	return amount, err
+}
+
+const getUserByApiToken = `-- name: GetUserByApiToken :one
+SELECT t.id AS token_id, t.scopes, u.id, u.username, u.language, w.base_currency
+FROM api_tokens t
+JOIN users u ON u.id = t.user_id
+JOIN workspaces w ON w.user_id = u.id
+WHERE t.token_hash = $1 AND t.revoked_at IS NULL
+`
+
+type GetUserByApiTokenRow struct {
+	TokenID      int64
+	Scopes       []string
+	ID           int32
+	Username     string
+	Language     string
+	BaseCurrency string
+}
+
+func (q *Queries) GetUserByApiToken(ctx context.Context, tokenHash string) (GetUserByApiTokenRow, error) {
+	row := q.db.QueryRow(ctx, getUserByApiToken, tokenHash)
+	var i GetUserByApiTokenRow
+	err := row.Scan(
+		&i.TokenID,
+		&i.Scopes,
+		&i.ID,
+		&i.Username,
+		&i.Language,
+		&i.BaseCurrency,
+	)
+	return i, err
+}
+
 const getUserBySession = `-- name: GetUserBySession :one
-SELECT u.id, u.username, u.language, s.expires
+SELECT u.id, u.username, u.language, w.base_currency, s.expires
 FROM sessions s
 JOIN users u ON u.id = s.user_id
+JOIN workspaces w ON w.user_id = u.id
 WHERE s.id = $1
 `
 
 type GetUserBySessionRow struct {
-	ID       int32
-	Username string
-	Language string
-	Expires  pgtype.Timestamptz
+	ID           int32
+	Username     string
+	Language     string
+	BaseCurrency string
+	Expires      pgtype.Timestamptz
 }
 
 func (q *Queries) GetUserBySession(ctx context.Context, id pgtype.UUID) (GetUserBySessionRow, error) {
@@ -461,63 +1531,83 @@ func (q *Queries) GetUserBySession(ctx context.Context, id pgtype.UUID) (GetUser
 		&i.ID,
 		&i.Username,
 		&i.Language,
+		&i.BaseCurrency,
 		&i.Expires,
 	)
 	return i, err
 }
 
 const getUserByUsername = `-- name: GetUserByUsername :one
-SELECT id, username, language
-FROM users
-WHERE username = $1
+SELECT u.id, u.username, u.language, w.base_currency
+FROM users u
+JOIN workspaces w ON w.user_id = u.id
+WHERE u.username = $1
 `
 
 type GetUserByUsernameRow struct {
-	ID       int32
-	Username string
-	Language string
+	ID           int32
+	Username     string
+	Language     string
+	BaseCurrency string
 }
 
 func (q *Queries) GetUserByUsername(ctx context.Context, username string) (GetUserByUsernameRow, error) {
 	row := q.db.QueryRow(ctx, getUserByUsername, username)
 	var i GetUserByUsernameRow
-	err := row.Scan(&i.ID, &i.Username, &i.Language)
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.Language,
+		&i.BaseCurrency,
+	)
 	return i, err
 }
 
-const listCategoriesByUser = `-- name: ListCategoriesByUser :many
-SELECT id, name, color, created_at, parent_id, is_group
-FROM categories
+const getUserWorkspace = `-- name: GetUserWorkspace :one
+SELECT id, base_currency
+FROM workspaces
 WHERE user_id = $1
-ORDER BY name
 `
 
-type ListCategoriesByUserRow struct {
-	ID        int64
-	Name      string
-	Color     pgtype.Text
-	CreatedAt pgtype.Timestamptz
-	ParentID  pgtype.Int8
-	IsGroup   bool
+type GetUserWorkspaceRow struct {
+	ID           int32
+	BaseCurrency string
+}
+
+func (q *Queries) GetUserWorkspace(ctx context.Context, userID pgtype.Int4) (GetUserWorkspaceRow, error) {
+	row := q.db.QueryRow(ctx, getUserWorkspace, userID)
+	var i GetUserWorkspaceRow
+	err := row.Scan(&i.ID, &i.BaseCurrency)
+	return i, err
+}
+
+const listAccountBalanceCheckpoints = `-- name: ListAccountBalanceCheckpoints :many
+SELECT balance_date, balance
+FROM account_balances
+WHERE account_id = $1 AND workspace_id = $2
+ORDER BY balance_date, id
+`
+
+type ListAccountBalanceCheckpointsParams struct {
+	AccountID   int64
+	WorkspaceID int32
+}
+
+type ListAccountBalanceCheckpointsRow struct {
+	BalanceDate pgtype.Date
+	Balance     pgtype.Numeric
 }
 
-func (q *Queries) ListCategoriesByUser(ctx context.Context, userID int32) ([]ListCategoriesByUserRow, error) {
-	rows, err := q.db.Query(ctx, listCategoriesByUser, userID)
+func (q *Queries) ListAccountBalanceCheckpoints(ctx context.Context, arg ListAccountBalanceCheckpointsParams) ([]ListAccountBalanceCheckpointsRow, error) {
+	rows, err := q.db.Query(ctx, listAccountBalanceCheckpoints, arg.AccountID, arg.WorkspaceID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []ListCategoriesByUserRow
+	var items []ListAccountBalanceCheckpointsRow
 	for rows.Next() {
-		var i ListCategoriesByUserRow
-		if err := rows.Scan(
-			&i.ID,
-			&i.Name,
-			&i.Color,
-			&i.CreatedAt,
-			&i.ParentID,
-			&i.IsGroup,
-		); err != nil {
+		var i ListAccountBalanceCheckpointsRow
+		if err := rows.Scan(&i.BalanceDate, &i.Balance); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -528,35 +1618,86 @@ func (q *Queries) ListCategoriesByUser(ctx context.Context, userID int32) ([]Lis
 	return items, nil
 }
 
-const listCategoryRulesByUser = `-- name: ListCategoryRulesByUser :many
-SELECT id, category_id, description_contains, position, created_at
-FROM category_rules
-WHERE user_id = $1
-ORDER BY position, id
+const listAccountDailyTotals = `-- name: ListAccountDailyTotals :many
+SELECT posted_date, SUM(amount)::numeric AS amount
+FROM transactions
+WHERE account_id = $1 AND workspace_id = $2
+GROUP BY posted_date
+ORDER BY posted_date
 `
 
-type ListCategoryRulesByUserRow struct {
-	ID                  int64
-	CategoryID          int64
-	DescriptionContains string
-	Position            int32
-	CreatedAt           pgtype.Timestamptz
+type ListAccountDailyTotalsParams struct {
+	AccountID   pgtype.Int8
+	WorkspaceID int32
+}
+
+type ListAccountDailyTotalsRow struct {
+	PostedDate pgtype.Date
+	Amount     pgtype.Numeric
+}
+
+func (q *Queries) ListAccountDailyTotals(ctx context.Context, arg ListAccountDailyTotalsParams) ([]ListAccountDailyTotalsRow, error) {
+	rows, err := q.db.Query(ctx, listAccountDailyTotals, arg.AccountID, arg.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListAccountDailyTotalsRow
+	for rows.Next() {
+		var i ListAccountDailyTotalsRow
+		if err := rows.Scan(&i.PostedDate, &i.Amount); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAccounts = `-- name: ListAccounts :many
+SELECT id, name, type, identifier, iban, currency, archived, created_at
+FROM accounts
+WHERE workspace_id = $1
+  AND merged_into_id IS NULL
+  AND ($2::boolean OR NOT archived)
+ORDER BY archived, name, id
+`
+
+type ListAccountsParams struct {
+	WorkspaceID     int32
+	IncludeArchived bool
+}
+
+type ListAccountsRow struct {
+	ID         int64
+	Name       string
+	Type       string
+	Identifier pgtype.Text
+	Iban       pgtype.Text
+	Currency   pgtype.Text
+	Archived   bool
+	CreatedAt  pgtype.Timestamptz
 }
 
-func (q *Queries) ListCategoryRulesByUser(ctx context.Context, userID int32) ([]ListCategoryRulesByUserRow, error) {
-	rows, err := q.db.Query(ctx, listCategoryRulesByUser, userID)
+func (q *Queries) ListAccounts(ctx context.Context, arg ListAccountsParams) ([]ListAccountsRow, error) {
+	rows, err := q.db.Query(ctx, listAccounts, arg.WorkspaceID, arg.IncludeArchived)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []ListCategoryRulesByUserRow
+	var items []ListAccountsRow
 	for rows.Next() {
-		var i ListCategoryRulesByUserRow
+		var i ListAccountsRow
 		if err := rows.Scan(
 			&i.ID,
-			&i.CategoryID,
-			&i.DescriptionContains,
-			&i.Position,
+			&i.Name,
+			&i.Type,
+			&i.Identifier,
+			&i.Iban,
+			&i.Currency,
+			&i.Archived,
 			&i.CreatedAt,
 		); err != nil {
 			return nil, err
@@ -569,34 +1710,38 @@ func (q *Queries) ListCategoryRulesByUser(ctx context.Context, userID int32) ([]
 	return items, nil
 }
 
-const listPendingReports = `-- name: ListPendingReports :many
-SELECT id, user_id, filename, data
-FROM financial_reports
-WHERE status = 'pending'
-ORDER BY uploaded_at ASC, id ASC
+const listApiTokens = `-- name: ListApiTokens :many
+SELECT id, name, token_prefix, scopes, created_at, last_used_at
+FROM api_tokens
+WHERE user_id = $1 AND revoked_at IS NULL
+ORDER BY created_at DESC, id DESC
 `
 
-type ListPendingReportsRow struct {
-	ID       int64
-	UserID   int32
-	Filename string
-	Data     []byte
+type ListApiTokensRow struct {
+	ID          int64
+	Name        string
+	TokenPrefix string
+	Scopes      []string
+	CreatedAt   pgtype.Timestamptz
+	LastUsedAt  pgtype.Timestamptz
 }
 
-func (q *Queries) ListPendingReports(ctx context.Context) ([]ListPendingReportsRow, error) {
-	rows, err := q.db.Query(ctx, listPendingReports)
+func (q *Queries) ListApiTokens(ctx context.Context, userID int32) ([]ListApiTokensRow, error) {
+	rows, err := q.db.Query(ctx, listApiTokens, userID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []ListPendingReportsRow
+	var items []ListApiTokensRow
 	for rows.Next() {
-		var i ListPendingReportsRow
+		var i ListApiTokensRow
 		if err := rows.Scan(
 			&i.ID,
-			&i.UserID,
-			&i.Filename,
-			&i.Data,
+			&i.Name,
+			&i.TokenPrefix,
+			&i.Scopes,
+			&i.CreatedAt,
+			&i.LastUsedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -608,43 +1753,38 @@ func (q *Queries) ListPendingReports(ctx context.Context) ([]ListPendingReportsR
 	return items, nil
 }
 
-const listReportsByUser = `-- name: ListReportsByUser :many
-SELECT id,
-       filename,
-       octet_length(data) AS size_bytes,
-       status,
-       uploaded_at,
-       status_description
-FROM financial_reports
-WHERE user_id = $1
-ORDER BY uploaded_at DESC, id DESC
+const listBudgetsByWorkspace = `-- name: ListBudgetsByWorkspace :many
+SELECT id, category_id, period, amount, currency, created_at
+FROM budgets
+WHERE workspace_id = $1
+ORDER BY id
 `
 
-type ListReportsByUserRow struct {
-	ID                int64
-	Filename          string
-	SizeBytes         int32
-	Status            string
-	UploadedAt        pgtype.Timestamptz
-	StatusDescription pgtype.Text
+type ListBudgetsByWorkspaceRow struct {
+	ID         int64
+	CategoryID int64
+	Period     string
+	Amount     pgtype.Numeric
+	Currency   string
+	CreatedAt  pgtype.Timestamptz
 }
 
-func (q *Queries) ListReportsByUser(ctx context.Context, userID int32) ([]ListReportsByUserRow, error) {
-	rows, err := q.db.Query(ctx, listReportsByUser, userID)
+func (q *Queries) ListBudgetsByWorkspace(ctx context.Context, workspaceID int32) ([]ListBudgetsByWorkspaceRow, error) {
+	rows, err := q.db.Query(ctx, listBudgetsByWorkspace, workspaceID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []ListReportsByUserRow
+	var items []ListBudgetsByWorkspaceRow
 	for rows.Next() {
-		var i ListReportsByUserRow
+		var i ListBudgetsByWorkspaceRow
 		if err := rows.Scan(
 			&i.ID,
-			&i.Filename,
-			&i.SizeBytes,
-			&i.Status,
-			&i.UploadedAt,
-			&i.StatusDescription,
+			&i.CategoryID,
+			&i.Period,
+			&i.Amount,
+			&i.Currency,
+			&i.CreatedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -656,25 +1796,39 @@ func (q *Queries) ListReportsByUser(ctx context.Context, userID int32) ([]ListRe
 	return items, nil
 }
 
-const listTodosByUser = `-- name: ListTodosByUser :many
-SELECT id, title FROM todo WHERE user_id = $1 ORDER BY id
+const listCategoriesByWorkspace = `-- name: ListCategoriesByWorkspace :many
+SELECT id, name, color, created_at, parent_id, is_group
+FROM categories
+WHERE workspace_id = $1
+ORDER BY name
 `
 
-type ListTodosByUserRow struct {
-	ID    int32
-	Title string
+type ListCategoriesByWorkspaceRow struct {
+	ID        int64
+	Name      string
+	Color     pgtype.Text
+	CreatedAt pgtype.Timestamptz
+	ParentID  pgtype.Int8
+	IsGroup   bool
 }
 
-func (q *Queries) ListTodosByUser(ctx context.Context, userID int32) ([]ListTodosByUserRow, error) {
-	rows, err := q.db.Query(ctx, listTodosByUser, userID)
+func (q *Queries) ListCategoriesByWorkspace(ctx context.Context, workspaceID int32) ([]ListCategoriesByWorkspaceRow, error) {
+	rows, err := q.db.Query(ctx, listCategoriesByWorkspace, workspaceID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []ListTodosByUserRow
+	var items []ListCategoriesByWorkspaceRow
 	for rows.Next() {
-		var i ListTodosByUserRow
-		if err := rows.Scan(&i.ID, &i.Title); err != nil {
+		var i ListCategoriesByWorkspaceRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Color,
+			&i.CreatedAt,
+			&i.ParentID,
+			&i.IsGroup,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -685,106 +1839,52 @@ func (q *Queries) ListTodosByUser(ctx context.Context, userID int32) ([]ListTodo
 	return items, nil
 }
 
-const listTransactions = `-- name: ListTransactions :many
-SELECT id,
-       source_file_id,
-       source_file_row,
-       parser_name,
-       posted_date,
-       description,
-       amount,
-       currency,
-       transaction_id,
-       entry_type,
-       source_account_number,
-       source_card_number,
-       category_id,
-       parser_meta,
-       created_at
+const listCategorizedTransactions = `-- name: ListCategorizedTransactions :many
+SELECT transactions.posted_date,
+       COALESCE(transaction_splits.amount, transactions.amount)::numeric AS amount,
+       transactions.currency,
+       CASE WHEN transaction_splits.id IS NULL THEN transactions.category_id ELSE transaction_splits.category_id END AS category_id
 FROM transactions
-WHERE user_id = $1
-  AND ($2::date IS NULL OR posted_date >= $2)
-  AND ($3::date IS NULL OR posted_date <= $3)
-  AND ($4::bigint IS NULL OR source_file_id = $4)
-  AND ($5::text IS NULL OR entry_type = $5)
-  AND ($6::text IS NULL OR source_account_number = $6)
-  AND ($7::text IS NULL OR source_card_number = $7)
-  AND ($8::text IS NULL OR to_tsvector('simple', description) @@ plainto_tsquery('simple', $8))
-  AND ($9::bigint IS NULL OR category_id = $9)
-ORDER BY posted_date DESC, id DESC
-LIMIT $11
-OFFSET $10
+LEFT JOIN transaction_splits ON transaction_splits.transaction_id = transactions.id
+WHERE transactions.workspace_id = $1
+  AND transactions.posted_date >= $2
+  AND transactions.posted_date < $3
+  AND CASE WHEN transaction_splits.id IS NULL THEN transactions.category_id ELSE transaction_splits.category_id END IS NOT NULL
+  AND NOT EXISTS (
+      SELECT 1
+      FROM transaction_transfers
+      WHERE transaction_transfers.status <> 'rejected'
+        AND transactions.id IN (transaction_transfers.debit_transaction_id, transaction_transfers.credit_transaction_id)
+  )
 `
 
-type ListTransactionsParams struct {
-	UserID              int32
-	FromDate            pgtype.Date
-	ToDate              pgtype.Date
-	SourceFileID        pgtype.Int8
-	EntryType           pgtype.Text
-	SourceAccountNumber pgtype.Text
-	SourceCardNumber    pgtype.Text
-	SearchText          pgtype.Text
-	CategoryID          pgtype.Int8
-	OffsetCount         int32
-	LimitCount          int32
+type ListCategorizedTransactionsParams struct {
+	WorkspaceID int32
+	FromDate    pgtype.Date
+	ToDate      pgtype.Date
 }
 
-type ListTransactionsRow struct {
-	ID                  int64
-	SourceFileID        int64
-	SourceFileRow       int32
-	ParserName          string
-	PostedDate          pgtype.Date
-	Description         string
-	Amount              pgtype.Numeric
-	Currency            string
-	TransactionID       pgtype.Text
-	EntryType           string
-	SourceAccountNumber pgtype.Text
-	SourceCardNumber    pgtype.Text
-	CategoryID          pgtype.Int8
-	ParserMeta          []byte
-	CreatedAt           pgtype.Timestamptz
+type ListCategorizedTransactionsRow struct {
+	PostedDate pgtype.Date
+	Amount     pgtype.Numeric
+	Currency   string
+	CategoryID pgtype.Int8
 }
 
-func (q *Queries) ListTransactions(ctx context.Context, arg ListTransactionsParams) ([]ListTransactionsRow, error) {
-	rows, err := q.db.Query(ctx, listTransactions,
-		arg.UserID,
-		arg.FromDate,
-		arg.ToDate,
-		arg.SourceFileID,
-		arg.EntryType,
-		arg.SourceAccountNumber,
-		arg.SourceCardNumber,
-		arg.SearchText,
-		arg.CategoryID,
-		arg.OffsetCount,
-		arg.LimitCount,
-	)
+func (q *Queries) ListCategorizedTransactions(ctx context.Context, arg ListCategorizedTransactionsParams) ([]ListCategorizedTransactionsRow, error) {
+	rows, err := q.db.Query(ctx, listCategorizedTransactions, arg.WorkspaceID, arg.FromDate, arg.ToDate)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []ListTransactionsRow
+	var items []ListCategorizedTransactionsRow
 	for rows.Next() {
-		var i ListTransactionsRow
+		var i ListCategorizedTransactionsRow
 		if err := rows.Scan(
-			&i.ID,
-			&i.SourceFileID,
-			&i.SourceFileRow,
-			&i.ParserName,
 			&i.PostedDate,
-			&i.Description,
 			&i.Amount,
 			&i.Currency,
-			&i.TransactionID,
-			&i.EntryType,
-			&i.SourceAccountNumber,
-			&i.SourceCardNumber,
 			&i.CategoryID,
-			&i.ParserMeta,
-			&i.CreatedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -796,42 +1896,118 @@ func (q *Queries) ListTransactions(ctx context.Context, arg ListTransactionsPara
 	return items, nil
 }
 
-const listTransactionsForRuleApply = `-- name: ListTransactionsForRuleApply :many
-SELECT id,
-       description,
-       category_id,
-       category_source
-FROM transactions
-WHERE user_id = $1
-  AND ($2::boolean OR category_source IS DISTINCT FROM 'manual')
+const listCategoryRulesByWorkspace = `-- name: ListCategoryRulesByWorkspace :many
+SELECT id, category_id, description_contains, position, created_at,
+       description_regex, description_exact, description_prefix,
+       amount_min, amount_max, entry_type, currency, account, parser_name
+FROM category_rules
+WHERE workspace_id = $1
+ORDER BY position, id
 `
 
-type ListTransactionsForRuleApplyParams struct {
-	UserID  int32
-	Column2 bool
+type ListCategoryRulesByWorkspaceRow struct {
+	ID                  int64
+	CategoryID          int64
+	DescriptionContains string
+	Position            int32
+	CreatedAt           pgtype.Timestamptz
+	DescriptionRegex    string
+	DescriptionExact    string
+	DescriptionPrefix   string
+	AmountMin           pgtype.Int8
+	AmountMax           pgtype.Int8
+	EntryType           string
+	Currency            string
+	Account             string
+	ParserName          string
 }
 
-type ListTransactionsForRuleApplyRow struct {
-	ID             int64
-	Description    string
-	CategoryID     pgtype.Int8
-	CategorySource pgtype.Text
+func (q *Queries) ListCategoryRulesByWorkspace(ctx context.Context, workspaceID int32) ([]ListCategoryRulesByWorkspaceRow, error) {
+	rows, err := q.db.Query(ctx, listCategoryRulesByWorkspace, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListCategoryRulesByWorkspaceRow
+	for rows.Next() {
+		var i ListCategoryRulesByWorkspaceRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.CategoryID,
+			&i.DescriptionContains,
+			&i.Position,
+			&i.CreatedAt,
+			&i.DescriptionRegex,
+			&i.DescriptionExact,
+			&i.DescriptionPrefix,
+			&i.AmountMin,
+			&i.AmountMax,
+			&i.EntryType,
+			&i.Currency,
+			&i.Account,
+			&i.ParserName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
-func (q *Queries) ListTransactionsForRuleApply(ctx context.Context, arg ListTransactionsForRuleApplyParams) ([]ListTransactionsForRuleApplyRow, error) {
-	rows, err := q.db.Query(ctx, listTransactionsForRuleApply, arg.UserID, arg.Column2)
+const listCsvTemplatesByWorkspace = `-- name: ListCsvTemplatesByWorkspace :many
+SELECT id, name, signature, delimiter, date_column, date_format, decimal_separator, description_columns,
+       amount_column, debit_column, credit_column, currency_column, account_column, default_currency, created_at
+FROM csv_templates
+WHERE workspace_id = $1
+ORDER BY name, id
+`
+
+type ListCsvTemplatesByWorkspaceRow struct {
+	ID                 int64
+	Name               string
+	Signature          string
+	Delimiter          string
+	DateColumn         string
+	DateFormat         string
+	DecimalSeparator   string
+	DescriptionColumns []string
+	AmountColumn       string
+	DebitColumn        string
+	CreditColumn       string
+	CurrencyColumn     string
+	AccountColumn      string
+	DefaultCurrency    string
+	CreatedAt          pgtype.Timestamptz
+}
+
+func (q *Queries) ListCsvTemplatesByWorkspace(ctx context.Context, workspaceID int32) ([]ListCsvTemplatesByWorkspaceRow, error) {
+	rows, err := q.db.Query(ctx, listCsvTemplatesByWorkspace, workspaceID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []ListTransactionsForRuleApplyRow
+	var items []ListCsvTemplatesByWorkspaceRow
 	for rows.Next() {
-		var i ListTransactionsForRuleApplyRow
+		var i ListCsvTemplatesByWorkspaceRow
 		if err := rows.Scan(
 			&i.ID,
-			&i.Description,
-			&i.CategoryID,
-			&i.CategorySource,
+			&i.Name,
+			&i.Signature,
+			&i.Delimiter,
+			&i.DateColumn,
+			&i.DateFormat,
+			&i.DecimalSeparator,
+			&i.DescriptionColumns,
+			&i.AmountColumn,
+			&i.DebitColumn,
+			&i.CreditColumn,
+			&i.CurrencyColumn,
+			&i.AccountColumn,
+			&i.DefaultCurrency,
+			&i.CreatedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -843,69 +2019,1219 @@ func (q *Queries) ListTransactionsForRuleApply(ctx context.Context, arg ListTran
 	return items, nil
 }
 
-const listTransactionsSummaryRows = `-- name: ListTransactionsSummaryRows :many
-SELECT posted_date, amount, currency, source_account_number, source_card_number
+const listDuplicateCandidates = `-- name: ListDuplicateCandidates :many
+SELECT id, posted_date, amount, transaction_id, source_account_number, source_card_number, description
 FROM transactions
-WHERE user_id = $1
-  AND ($2::date IS NULL OR posted_date >= $2)
-  AND ($3::date IS NULL OR posted_date <= $3)
-  AND ($4::bigint IS NULL OR source_file_id = $4)
-  AND ($5::text IS NULL OR entry_type = $5)
-  AND ($6::text IS NULL OR source_account_number = $6)
-  AND ($7::text IS NULL OR source_card_number = $7)
-  AND ($8::text IS NULL OR to_tsvector('simple', description) @@ plainto_tsquery('simple', $8))
-  AND ($9::bigint IS NULL OR category_id = $9)
+WHERE workspace_id = $1
+  AND source_file_id <> $2
+  AND posted_date BETWEEN $3 AND $4
 `
 
-type ListTransactionsSummaryRowsParams struct {
-	UserID              int32
-	FromDate            pgtype.Date
-	ToDate              pgtype.Date
-	SourceFileID        pgtype.Int8
-	EntryType           pgtype.Text
+type ListDuplicateCandidatesParams struct {
+	WorkspaceID  int32
+	SourceFileID int64
+	FromDate     pgtype.Date
+	ToDate       pgtype.Date
+}
+
+type ListDuplicateCandidatesRow struct {
+	ID                  int64
+	PostedDate          pgtype.Date
+	Amount              pgtype.Numeric
+	TransactionID       pgtype.Text
 	SourceAccountNumber pgtype.Text
 	SourceCardNumber    pgtype.Text
-	SearchText          pgtype.Text
+	Description         string
+}
+
+func (q *Queries) ListDuplicateCandidates(ctx context.Context, arg ListDuplicateCandidatesParams) ([]ListDuplicateCandidatesRow, error) {
+	rows, err := q.db.Query(ctx, listDuplicateCandidates,
+		arg.WorkspaceID,
+		arg.SourceFileID,
+		arg.FromDate,
+		arg.ToDate,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListDuplicateCandidatesRow
+	for rows.Next() {
+		var i ListDuplicateCandidatesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.PostedDate,
+			&i.Amount,
+			&i.TransactionID,
+			&i.SourceAccountNumber,
+			&i.SourceCardNumber,
+			&i.Description,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listHouseholdMembers = `-- name: ListHouseholdMembers :many
+SELECT m.user_id, u.username, m.role, m.created_at
+FROM household_members m
+JOIN users u ON u.id = m.user_id
+WHERE m.household_id = $1
+ORDER BY m.created_at, m.user_id
+`
+
+type ListHouseholdMembersRow struct {
+	UserID    int32
+	Username  string
+	Role      string
+	CreatedAt pgtype.Timestamptz
+}
+
+func (q *Queries) ListHouseholdMembers(ctx context.Context, householdID int32) ([]ListHouseholdMembersRow, error) {
+	rows, err := q.db.Query(ctx, listHouseholdMembers, householdID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListHouseholdMembersRow
+	for rows.Next() {
+		var i ListHouseholdMembersRow
+		if err := rows.Scan(
+			&i.UserID,
+			&i.Username,
+			&i.Role,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listHouseholdsByUser = `-- name: ListHouseholdsByUser :many
+SELECT w.id, w.name, m.role, w.base_currency, w.created_at
+FROM household_members m
+JOIN workspaces w ON w.id = m.household_id
+WHERE m.user_id = $1
+ORDER BY w.name, w.id
+`
+
+type ListHouseholdsByUserRow struct {
+	ID           int32
+	Name         string
+	Role         string
+	BaseCurrency string
+	CreatedAt    pgtype.Timestamptz
+}
+
+func (q *Queries) ListHouseholdsByUser(ctx context.Context, userID int32) ([]ListHouseholdsByUserRow, error) {
+	rows, err := q.db.Query(ctx, listHouseholdsByUser, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListHouseholdsByUserRow
+	for rows.Next() {
+		var i ListHouseholdsByUserRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Role,
+			&i.BaseCurrency,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listLedgerAccountMappings = `-- name: ListLedgerAccountMappings :many
+SELECT id, kind, source, ledger_account
+FROM ledger_account_mappings
+WHERE workspace_id = $1
+ORDER BY kind, source
+`
+
+type ListLedgerAccountMappingsRow struct {
+	ID            int64
+	Kind          string
+	Source        string
+	LedgerAccount string
+}
+
+func (q *Queries) ListLedgerAccountMappings(ctx context.Context, workspaceID int32) ([]ListLedgerAccountMappingsRow, error) {
+	rows, err := q.db.Query(ctx, listLedgerAccountMappings, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListLedgerAccountMappingsRow
+	for rows.Next() {
+		var i ListLedgerAccountMappingsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Kind,
+			&i.Source,
+			&i.LedgerAccount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listOutdatedReports = `-- name: ListOutdatedReports :many
+SELECT DISTINCT transactions.source_file_id
+FROM transactions
+JOIN unnest($1::text[], $2::int[]) AS current_parsers(parser_name, parser_version)
+  ON current_parsers.parser_name = transactions.parser_name
+WHERE transactions.workspace_id = $3
+  AND COALESCE((transactions.parser_meta->>'parser_version')::int, 0) < current_parsers.parser_version
+`
+
+type ListOutdatedReportsParams struct {
+	ParserNames    []string
+	ParserVersions []int32
+	WorkspaceID    int32
+}
+
+func (q *Queries) ListOutdatedReports(ctx context.Context, arg ListOutdatedReportsParams) ([]int64, error) {
+	rows, err := q.db.Query(ctx, listOutdatedReports, arg.ParserNames, arg.ParserVersions, arg.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int64
+	for rows.Next() {
+		var sourceFileID int64
+		if err := rows.Scan(&sourceFileID); err != nil {
+			return nil, err
+		}
+		items = append(items, sourceFileID)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRecurringCandidates = `-- name: ListRecurringCandidates :many
+SELECT id, posted_date, description, amount, currency
+FROM transactions
+WHERE workspace_id = $1 AND amount < 0 AND posted_date >= $2
+ORDER BY posted_date, id
+`
+
+type ListRecurringCandidatesParams struct {
+	WorkspaceID int32
+	PostedDate  pgtype.Date
+}
+
+type ListRecurringCandidatesRow struct {
+	ID          int64
+	PostedDate  pgtype.Date
+	Description string
+	Amount      pgtype.Numeric
+	Currency    string
+}
+
+func (q *Queries) ListRecurringCandidates(ctx context.Context, arg ListRecurringCandidatesParams) ([]ListRecurringCandidatesRow, error) {
+	rows, err := q.db.Query(ctx, listRecurringCandidates, arg.WorkspaceID, arg.PostedDate)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListRecurringCandidatesRow
+	for rows.Next() {
+		var i ListRecurringCandidatesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.PostedDate,
+			&i.Description,
+			&i.Amount,
+			&i.Currency,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRecurringSeries = `-- name: ListRecurringSeries :many
+SELECT id, merchant_key, name, currency, cadence, amount, status, created_at
+FROM recurring_series
+WHERE workspace_id = $1
+  AND ($2::boolean OR status <> 'ignored')
+ORDER BY name, id
+`
+
+type ListRecurringSeriesParams struct {
+	WorkspaceID    int32
+	IncludeIgnored bool
+}
+
+type ListRecurringSeriesRow struct {
+	ID          int64
+	MerchantKey string
+	Name        string
+	Currency    string
+	Cadence     string
+	Amount      pgtype.Numeric
+	Status      string
+	CreatedAt   pgtype.Timestamptz
+}
+
+func (q *Queries) ListRecurringSeries(ctx context.Context, arg ListRecurringSeriesParams) ([]ListRecurringSeriesRow, error) {
+	rows, err := q.db.Query(ctx, listRecurringSeries, arg.WorkspaceID, arg.IncludeIgnored)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListRecurringSeriesRow
+	for rows.Next() {
+		var i ListRecurringSeriesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.MerchantKey,
+			&i.Name,
+			&i.Currency,
+			&i.Cadence,
+			&i.Amount,
+			&i.Status,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRejectedTransfers = `-- name: ListRejectedTransfers :many
+SELECT debit_transaction_id, credit_transaction_id
+FROM transaction_transfers
+WHERE workspace_id = $1 AND status = 'rejected'
+`
+
+type ListRejectedTransfersRow struct {
+	DebitTransactionID  int64
+	CreditTransactionID int64
+}
+
+func (q *Queries) ListRejectedTransfers(ctx context.Context, workspaceID int32) ([]ListRejectedTransfersRow, error) {
+	rows, err := q.db.Query(ctx, listRejectedTransfers, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListRejectedTransfersRow
+	for rows.Next() {
+		var i ListRejectedTransfersRow
+		if err := rows.Scan(&i.DebitTransactionID, &i.CreditTransactionID); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listReportDiagnostics = `-- name: ListReportDiagnostics :many
+SELECT row_number, severity, raw_record, reason
+FROM report_diagnostics
+WHERE report_id = $1 AND workspace_id = $2
+ORDER BY row_number, id
+`
+
+type ListReportDiagnosticsParams struct {
+	ReportID    int64
+	WorkspaceID int32
+}
+
+type ListReportDiagnosticsRow struct {
+	RowNumber int32
+	Severity  string
+	RawRecord string
+	Reason    string
+}
+
+func (q *Queries) ListReportDiagnostics(ctx context.Context, arg ListReportDiagnosticsParams) ([]ListReportDiagnosticsRow, error) {
+	rows, err := q.db.Query(ctx, listReportDiagnostics, arg.ReportID, arg.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListReportDiagnosticsRow
+	for rows.Next() {
+		var i ListReportDiagnosticsRow
+		if err := rows.Scan(
+			&i.RowNumber,
+			&i.Severity,
+			&i.RawRecord,
+			&i.Reason,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listReportsByWorkspace = `-- name: ListReportsByWorkspace :many
+SELECT id,
+       filename,
+       octet_length(data) AS size_bytes,
+       status,
+       uploaded_at,
+       status_description,
+       (SELECT count(*)
+        FROM transactions
+        WHERE transactions.source_file_id = financial_reports.id)::int AS transaction_count,
+       (SELECT count(*)
+        FROM report_diagnostics
+        WHERE report_diagnostics.report_id = financial_reports.id)::int AS diagnostic_count
+FROM financial_reports
+WHERE workspace_id = $1
+ORDER BY uploaded_at DESC, id DESC
+`
+
+type ListReportsByWorkspaceRow struct {
+	ID                int64
+	Filename          string
+	SizeBytes         int32
+	Status            string
+	UploadedAt        pgtype.Timestamptz
+	StatusDescription pgtype.Text
+	TransactionCount  int32
+	DiagnosticCount   int32
+}
+
+func (q *Queries) ListReportsByWorkspace(ctx context.Context, workspaceID int32) ([]ListReportsByWorkspaceRow, error) {
+	rows, err := q.db.Query(ctx, listReportsByWorkspace, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListReportsByWorkspaceRow
+	for rows.Next() {
+		var i ListReportsByWorkspaceRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Filename,
+			&i.SizeBytes,
+			&i.Status,
+			&i.UploadedAt,
+			&i.StatusDescription,
+			&i.TransactionCount,
+			&i.DiagnosticCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTodosByUser = `-- name: ListTodosByUser :many
+SELECT id, title FROM todo WHERE user_id = $1 ORDER BY id
+`
+
+type ListTodosByUserRow struct {
+	ID    int32
+	Title string
+}
+
+func (q *Queries) ListTodosByUser(ctx context.Context, userID int32) ([]ListTodosByUserRow, error) {
+	rows, err := q.db.Query(ctx, listTodosByUser, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListTodosByUserRow
+	for rows.Next() {
+		var i ListTodosByUserRow
+		if err := rows.Scan(&i.ID, &i.Title); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTransactionSplits = `-- name: ListTransactionSplits :many
+SELECT id, transaction_id, category_id, amount
+FROM transaction_splits
+WHERE workspace_id = $1 AND transaction_id = ANY($2::bigint[])
+ORDER BY transaction_id, id
+`
+
+type ListTransactionSplitsParams struct {
+	WorkspaceID    int32
+	TransactionIds []int64
+}
+
+type ListTransactionSplitsRow struct {
+	ID            int64
+	TransactionID int64
+	CategoryID    pgtype.Int8
+	Amount        pgtype.Numeric
+}
+
+func (q *Queries) ListTransactionSplits(ctx context.Context, arg ListTransactionSplitsParams) ([]ListTransactionSplitsRow, error) {
+	rows, err := q.db.Query(ctx, listTransactionSplits, arg.WorkspaceID, arg.TransactionIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListTransactionSplitsRow
+	for rows.Next() {
+		var i ListTransactionSplitsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.TransactionID,
+			&i.CategoryID,
+			&i.Amount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTransactionTransfers = `-- name: ListTransactionTransfers :many
+SELECT transaction_transfers.id,
+       transaction_transfers.status,
+       transaction_transfers.created_at,
+       debit.id AS debit_id,
+       debit.posted_date AS debit_posted_date,
+       debit.description AS debit_description,
+       debit.amount AS debit_amount,
+       debit.currency AS debit_currency,
+       debit.source_account_number AS debit_source_account_number,
+       debit.source_card_number AS debit_source_card_number,
+       credit.id AS credit_id,
+       credit.posted_date AS credit_posted_date,
+       credit.description AS credit_description,
+       credit.amount AS credit_amount,
+       credit.currency AS credit_currency,
+       credit.source_account_number AS credit_source_account_number,
+       credit.source_card_number AS credit_source_card_number
+FROM transaction_transfers
+JOIN transactions AS debit ON debit.id = transaction_transfers.debit_transaction_id
+JOIN transactions AS credit ON credit.id = transaction_transfers.credit_transaction_id
+WHERE transaction_transfers.workspace_id = $1
+  AND ($2::text IS NULL OR transaction_transfers.status = $2)
+ORDER BY debit.posted_date DESC, transaction_transfers.id DESC
+`
+
+type ListTransactionTransfersParams struct {
+	WorkspaceID int32
+	Status      pgtype.Text
+}
+
+type ListTransactionTransfersRow struct {
+	ID                        int64
+	Status                    string
+	CreatedAt                 pgtype.Timestamptz
+	DebitID                   int64
+	DebitPostedDate           pgtype.Date
+	DebitDescription          string
+	DebitAmount               pgtype.Numeric
+	DebitCurrency             string
+	DebitSourceAccountNumber  pgtype.Text
+	DebitSourceCardNumber     pgtype.Text
+	CreditID                  int64
+	CreditPostedDate          pgtype.Date
+	CreditDescription         string
+	CreditAmount              pgtype.Numeric
+	CreditCurrency            string
+	CreditSourceAccountNumber pgtype.Text
+	CreditSourceCardNumber    pgtype.Text
+}
+
+func (q *Queries) ListTransactionTransfers(ctx context.Context, arg ListTransactionTransfersParams) ([]ListTransactionTransfersRow, error) {
+	rows, err := q.db.Query(ctx, listTransactionTransfers, arg.WorkspaceID, arg.Status)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListTransactionTransfersRow
+	for rows.Next() {
+		var i ListTransactionTransfersRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Status,
+			&i.CreatedAt,
+			&i.DebitID,
+			&i.DebitPostedDate,
+			&i.DebitDescription,
+			&i.DebitAmount,
+			&i.DebitCurrency,
+			&i.DebitSourceAccountNumber,
+			&i.DebitSourceCardNumber,
+			&i.CreditID,
+			&i.CreditPostedDate,
+			&i.CreditDescription,
+			&i.CreditAmount,
+			&i.CreditCurrency,
+			&i.CreditSourceAccountNumber,
+			&i.CreditSourceCardNumber,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTransactions = `-- name: ListTransactions :many
+SELECT id,
+       source_file_id,
+       source_file_row,
+       parser_name,
+       posted_date,
+       description,
+       amount,
+       currency,
+       transaction_id,
+       entry_type,
+       source_account_number,
+       source_card_number,
+       category_id,
+       parser_meta,
+       created_at
+FROM transactions
+WHERE workspace_id = $1
+  AND ($2::date IS NULL OR posted_date >= $2)
+  AND ($3::date IS NULL OR posted_date <= $3)
+  AND ($4::bigint IS NULL OR source_file_id = $4)
+  AND ($5::text IS NULL OR entry_type = $5)
+  AND ($6::text IS NULL OR source_account_number = $6)
+  AND ($7::text IS NULL OR source_card_number = $7)
+  AND ($8::text IS NULL OR to_tsvector('simple', description) @@ plainto_tsquery('simple', $8))
+  AND ($9::bigint IS NULL
+       OR (category_id = $9
+           AND NOT EXISTS (SELECT 1 FROM transaction_splits WHERE transaction_splits.transaction_id = transactions.id))
+       OR EXISTS (SELECT 1
+                  FROM transaction_splits
+                  WHERE transaction_splits.transaction_id = transactions.id
+                    AND transaction_splits.category_id = $9))
+ORDER BY posted_date DESC, id DESC
+LIMIT $11
+OFFSET $10
+`
+
+type ListTransactionsParams struct {
+	WorkspaceID         int32
+	FromDate            pgtype.Date
+	ToDate              pgtype.Date
+	SourceFileID        pgtype.Int8
+	EntryType           pgtype.Text
+	SourceAccountNumber pgtype.Text
+	SourceCardNumber    pgtype.Text
+	SearchText          pgtype.Text
+	CategoryID          pgtype.Int8
+	OffsetCount         int32
+	LimitCount          int32
+}
+
+type ListTransactionsRow struct {
+	ID                  int64
+	SourceFileID        int64
+	SourceFileRow       int32
+	ParserName          string
+	PostedDate          pgtype.Date
+	Description         string
+	Amount              pgtype.Numeric
+	Currency            string
+	TransactionID       pgtype.Text
+	EntryType           string
+	SourceAccountNumber pgtype.Text
+	SourceCardNumber    pgtype.Text
+	CategoryID          pgtype.Int8
+	ParserMeta          []byte
+	CreatedAt           pgtype.Timestamptz
+}
+
+func (q *Queries) ListTransactions(ctx context.Context, arg ListTransactionsParams) ([]ListTransactionsRow, error) {
+	rows, err := q.db.Query(ctx, listTransactions,
+		arg.WorkspaceID,
+		arg.FromDate,
+		arg.ToDate,
+		arg.SourceFileID,
+		arg.EntryType,
+		arg.SourceAccountNumber,
+		arg.SourceCardNumber,
+		arg.SearchText,
+		arg.CategoryID,
+		arg.OffsetCount,
+		arg.LimitCount,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListTransactionsRow
+	for rows.Next() {
+		var i ListTransactionsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.SourceFileID,
+			&i.SourceFileRow,
+			&i.ParserName,
+			&i.PostedDate,
+			&i.Description,
+			&i.Amount,
+			&i.Currency,
+			&i.TransactionID,
+			&i.EntryType,
+			&i.SourceAccountNumber,
+			&i.SourceCardNumber,
+			&i.CategoryID,
+			&i.ParserMeta,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTransactionsBySource = `-- name: ListTransactionsBySource :many
+SELECT id,
+       source_file_row,
+       parser_name,
+       posted_date,
+       description,
+       amount,
+       currency,
+       transaction_id,
+       entry_type,
+       source_account_number,
+       source_card_number,
+       category_id,
+       category_source,
+       parser_meta
+FROM transactions
+WHERE source_file_id = $1 AND workspace_id = $2
+ORDER BY source_file_row, id
+`
+
+type ListTransactionsBySourceParams struct {
+	SourceFileID int64
+	WorkspaceID  int32
+}
+
+type ListTransactionsBySourceRow struct {
+	ID                  int64
+	SourceFileRow       int32
+	ParserName          string
+	PostedDate          pgtype.Date
+	Description         string
+	Amount              pgtype.Numeric
+	Currency            string
+	TransactionID       pgtype.Text
+	EntryType           string
+	SourceAccountNumber pgtype.Text
+	SourceCardNumber    pgtype.Text
+	CategoryID          pgtype.Int8
+	CategorySource      pgtype.Text
+	ParserMeta          []byte
+}
+
+func (q *Queries) ListTransactionsBySource(ctx context.Context, arg ListTransactionsBySourceParams) ([]ListTransactionsBySourceRow, error) {
+	rows, err := q.db.Query(ctx, listTransactionsBySource, arg.SourceFileID, arg.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListTransactionsBySourceRow
+	for rows.Next() {
+		var i ListTransactionsBySourceRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.SourceFileRow,
+			&i.ParserName,
+			&i.PostedDate,
+			&i.Description,
+			&i.Amount,
+			&i.Currency,
+			&i.TransactionID,
+			&i.EntryType,
+			&i.SourceAccountNumber,
+			&i.SourceCardNumber,
+			&i.CategoryID,
+			&i.CategorySource,
+			&i.ParserMeta,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTransactionsForRuleApply = `-- name: ListTransactionsForRuleApply :many
+SELECT id,
+       posted_date,
+       description,
+       amount,
+       currency,
+       entry_type,
+       source_account_number,
+       source_card_number,
+       parser_name,
+       category_id,
+       category_source
+FROM transactions
+WHERE workspace_id = $1
+  AND ($2::boolean OR category_source IS DISTINCT FROM 'manual')
+ORDER BY posted_date DESC, id DESC
+`
+
+type ListTransactionsForRuleApplyParams struct {
+	WorkspaceID int32
+	Column2     bool
+}
+
+type ListTransactionsForRuleApplyRow struct {
+	ID                  int64
+	PostedDate          pgtype.Date
+	Description         string
+	Amount              pgtype.Numeric
+	Currency            string
+	EntryType           string
+	SourceAccountNumber pgtype.Text
+	SourceCardNumber    pgtype.Text
+	ParserName          string
+	CategoryID          pgtype.Int8
+	CategorySource      pgtype.Text
+}
+
+func (q *Queries) ListTransactionsForRuleApply(ctx context.Context, arg ListTransactionsForRuleApplyParams) ([]ListTransactionsForRuleApplyRow, error) {
+	rows, err := q.db.Query(ctx, listTransactionsForRuleApply, arg.WorkspaceID, arg.Column2)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListTransactionsForRuleApplyRow
+	for rows.Next() {
+		var i ListTransactionsForRuleApplyRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.PostedDate,
+			&i.Description,
+			&i.Amount,
+			&i.Currency,
+			&i.EntryType,
+			&i.SourceAccountNumber,
+			&i.SourceCardNumber,
+			&i.ParserName,
+			&i.CategoryID,
+			&i.CategorySource,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTransactionsSummaryRows = `-- name: ListTransactionsSummaryRows :many
+WITH lines AS (
+    SELECT transactions.id,
+           transactions.posted_date,
+           transactions.currency,
+           transactions.source_account_number,
+           transactions.source_card_number,
+           CASE WHEN transaction_splits.id IS NULL THEN transactions.category_id ELSE transaction_splits.category_id END AS category_id,
+           COALESCE(transaction_splits.amount, transactions.amount) AS amount
+    FROM transactions
+    LEFT JOIN transaction_splits ON transaction_splits.transaction_id = transactions.id
+    WHERE transactions.workspace_id = $1
+      AND ($2::date IS NULL OR transactions.posted_date >= $2)
+      AND ($3::date IS NULL OR transactions.posted_date <= $3)
+      AND ($4::bigint IS NULL OR transactions.source_file_id = $4)
+      AND ($5::text IS NULL OR transactions.entry_type = $5)
+      AND ($6::text IS NULL OR transactions.source_account_number = $6)
+      AND ($7::text IS NULL OR transactions.source_card_number = $7)
+      AND ($8::text IS NULL OR to_tsvector('simple', transactions.description) @@ plainto_tsquery('simple', $8))
+      AND ($9::boolean OR NOT EXISTS (
+          SELECT 1
+          FROM transaction_transfers
+          WHERE transaction_transfers.status <> 'rejected'
+            AND transactions.id IN (transaction_transfers.debit_transaction_id, transaction_transfers.credit_transaction_id)
+      ))
+)
+SELECT posted_date, SUM(amount)::numeric AS amount, currency, source_account_number, source_card_number
+FROM lines
+WHERE $10::bigint IS NULL OR category_id = $10
+GROUP BY id, posted_date, currency, source_account_number, source_card_number
+`
+
+type ListTransactionsSummaryRowsParams struct {
+	WorkspaceID         int32
+	FromDate            pgtype.Date
+	ToDate              pgtype.Date
+	SourceFileID        pgtype.Int8
+	EntryType           pgtype.Text
+	SourceAccountNumber pgtype.Text
+	SourceCardNumber    pgtype.Text
+	SearchText          pgtype.Text
+	IncludeTransfers    bool
 	CategoryID          pgtype.Int8
 }
 
-type ListTransactionsSummaryRowsRow struct {
-	PostedDate          pgtype.Date
-	Amount              pgtype.Numeric
-	Currency            string
-	SourceAccountNumber pgtype.Text
-	SourceCardNumber    pgtype.Text
+type ListTransactionsSummaryRowsRow struct {
+	PostedDate          pgtype.Date
+	Amount              pgtype.Numeric
+	Currency            string
+	SourceAccountNumber pgtype.Text
+	SourceCardNumber    pgtype.Text
+}
+
+func (q *Queries) ListTransactionsSummaryRows(ctx context.Context, arg ListTransactionsSummaryRowsParams) ([]ListTransactionsSummaryRowsRow, error) {
+	rows, err := q.db.Query(ctx, listTransactionsSummaryRows,
+		arg.WorkspaceID,
+		arg.FromDate,
+		arg.ToDate,
+		arg.SourceFileID,
+		arg.EntryType,
+		arg.SourceAccountNumber,
+		arg.SourceCardNumber,
+		arg.SearchText,
+		arg.IncludeTransfers,
+		arg.CategoryID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListTransactionsSummaryRowsRow
+	for rows.Next() {
+		var i ListTransactionsSummaryRowsRow
+		if err := rows.Scan(
+			&i.PostedDate,
+			&i.Amount,
+			&i.Currency,
+			&i.SourceAccountNumber,
+			&i.SourceCardNumber,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTransferCandidates = `-- name: ListTransferCandidates :many
+SELECT id, source_file_id, posted_date, amount, currency, source_account_number, source_card_number
+FROM transactions
+WHERE workspace_id = $1
+  AND NOT EXISTS (
+      SELECT 1
+      FROM transaction_transfers
+      WHERE transaction_transfers.status <> 'rejected'
+        AND transactions.id IN (transaction_transfers.debit_transaction_id, transaction_transfers.credit_transaction_id)
+  )
+ORDER BY posted_date, id
+`
+
+type ListTransferCandidatesRow struct {
+	ID                  int64
+	SourceFileID        int64
+	PostedDate          pgtype.Date
+	Amount              pgtype.Numeric
+	Currency            string
+	SourceAccountNumber pgtype.Text
+	SourceCardNumber    pgtype.Text
+}
+
+func (q *Queries) ListTransferCandidates(ctx context.Context, workspaceID int32) ([]ListTransferCandidatesRow, error) {
+	rows, err := q.db.Query(ctx, listTransferCandidates, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListTransferCandidatesRow
+	for rows.Next() {
+		var i ListTransferCandidatesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.SourceFileID,
+			&i.PostedDate,
+			&i.Amount,
+			&i.Currency,
+			&i.SourceAccountNumber,
+			&i.SourceCardNumber,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const lockHousehold = `-- name: LockHousehold :exec
+SELECT id FROM workspaces WHERE id = $1 FOR UPDATE
+`
+
+func (q *Queries) LockHousehold(ctx context.Context, id int32) error {
+	_, err := q.db.Exec(ctx, lockHousehold, id)
+	return err
+}
+
+const lockWorkspaceTransactions = `-- name: LockWorkspaceTransactions :exec
+SELECT pg_advisory_xact_lock($1::integer, $2::integer)
+`
+
+type LockWorkspaceTransactionsParams struct {
+	Namespace   int32
+	WorkspaceID int32
+}
+
+func (q *Queries) LockWorkspaceTransactions(ctx context.Context, arg LockWorkspaceTransactionsParams) error {
+	_, err := q.db.Exec(ctx, lockWorkspaceTransactions, arg.Namespace, arg.WorkspaceID)
+	return err
+}
+
+const mergeAccountInto = `-- name: MergeAccountInto :exec
+UPDATE accounts
+SET merged_into_id = $1, archived = true
+WHERE workspace_id = $2
+  AND (id = $3 OR merged_into_id = $3)
+`
+
+type MergeAccountIntoParams struct {
+	TargetID    pgtype.Int8
+	WorkspaceID int32
+	SourceID    int64
+}
+
+func (q *Queries) MergeAccountInto(ctx context.Context, arg MergeAccountIntoParams) error {
+	_, err := q.db.Exec(ctx, mergeAccountInto, arg.TargetID, arg.WorkspaceID, arg.SourceID)
+	return err
+}
+
+const moveAccountBalances = `-- name: MoveAccountBalances :exec
+UPDATE account_balances
+SET account_id = $1
+WHERE account_id = $2 AND workspace_id = $3
+`
+
+type MoveAccountBalancesParams struct {
+	TargetID    int64
+	SourceID    int64
+	WorkspaceID int32
+}
+
+func (q *Queries) MoveAccountBalances(ctx context.Context, arg MoveAccountBalancesParams) error {
+	_, err := q.db.Exec(ctx, moveAccountBalances, arg.TargetID, arg.SourceID, arg.WorkspaceID)
+	return err
+}
+
+const moveAccountTransactions = `-- name: MoveAccountTransactions :exec
+UPDATE transactions
+SET account_id = $1
+WHERE account_id = $2 AND workspace_id = $3
+`
+
+type MoveAccountTransactionsParams struct {
+	TargetID    pgtype.Int8
+	SourceID    pgtype.Int8
+	WorkspaceID int32
+}
+
+func (q *Queries) MoveAccountTransactions(ctx context.Context, arg MoveAccountTransactionsParams) error {
+	_, err := q.db.Exec(ctx, moveAccountTransactions, arg.TargetID, arg.SourceID, arg.WorkspaceID)
+	return err
+}
+
+const notifyReportStatus = `-- name: NotifyReportStatus :exec
+SELECT pg_notify('report_status', $1::text)
+`
+
+func (q *Queries) NotifyReportStatus(ctx context.Context, payload string) error {
+	_, err := q.db.Exec(ctx, notifyReportStatus, payload)
+	return err
+}
+
+const notifyReportUploaded = `-- name: NotifyReportUploaded :exec
+SELECT pg_notify('report_uploaded', $1::text)
+`
+
+func (q *Queries) NotifyReportUploaded(ctx context.Context, payload string) error {
+	_, err := q.db.Exec(ctx, notifyReportUploaded, payload)
+	return err
+}
+
+const releaseReportClaim = `-- name: ReleaseReportClaim :execrows
+UPDATE financial_reports
+SET status = 'pending',
+    claimed_at = NULL,
+    claimed_by = NULL,
+    attempts = GREATEST(attempts - 1, 0)
+WHERE id = $1
+  AND status = 'processing'
+  AND claimed_by = $2
+`
+
+type ReleaseReportClaimParams struct {
+	ID        int64
+	ClaimedBy pgtype.Text
+}
+
+func (q *Queries) ReleaseReportClaim(ctx context.Context, arg ReleaseReportClaimParams) (int64, error) {
+	result, err := q.db.Exec(ctx, releaseReportClaim, arg.ID, arg.ClaimedBy)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const removeTodo = `-- name: RemoveTodo :exec
+DELETE FROM todo WHERE id = $1 AND user_id = $2
+`
+
+type RemoveTodoParams struct {
+	ID     int32
+	UserID int32
+}
+
+func (q *Queries) RemoveTodo(ctx context.Context, arg RemoveTodoParams) error {
+	_, err := q.db.Exec(ctx, removeTodo, arg.ID, arg.UserID)
+	return err
+}
+
+const renameAccount = `-- name: RenameAccount :execrows
+UPDATE accounts
+SET name = $1
+WHERE id = $2 AND workspace_id = $3 AND merged_into_id IS NULL
+`
+
+type RenameAccountParams struct {
+	Name        string
+	ID          int64
+	WorkspaceID int32
+}
+
+func (q *Queries) RenameAccount(ctx context.Context, arg RenameAccountParams) (int64, error) {
+	result, err := q.db.Exec(ctx, renameAccount, arg.Name, arg.ID, arg.WorkspaceID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const reportExists = `-- name: ReportExists :one
+SELECT EXISTS(
+    SELECT 1
+    FROM financial_reports
+    WHERE id = $1 AND workspace_id = $2
+)
+`
+
+type ReportExistsParams struct {
+	ID          int64
+	WorkspaceID int32
+}
+
+func (q *Queries) ReportExists(ctx context.Context, arg ReportExistsParams) (bool, error) {
+	row := q.db.QueryRow(ctx, reportExists, arg.ID, arg.WorkspaceID)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
 }
 
-func (q *Queries) ListTransactionsSummaryRows(ctx context.Context, arg ListTransactionsSummaryRowsParams) ([]ListTransactionsSummaryRowsRow, error) {
-	rows, err := q.db.Query(ctx, listTransactionsSummaryRows,
-		arg.UserID,
-		arg.FromDate,
-		arg.ToDate,
-		arg.SourceFileID,
-		arg.EntryType,
-		arg.SourceAccountNumber,
-		arg.SourceCardNumber,
-		arg.SearchText,
-		arg.CategoryID,
-	)
+const requeueReports = `-- name: RequeueReports :many
+UPDATE financial_reports
+SET status = 'pending',
+    status_description = NULL,
+    attempts = 0,
+    next_attempt_at = NULL
+WHERE workspace_id = $1
+  AND id = ANY($2::bigint[])
+  AND status NOT IN ('pending', 'processing')
+RETURNING id
+`
+
+type RequeueReportsParams struct {
+	WorkspaceID int32
+	Ids         []int64
+}
+
+func (q *Queries) RequeueReports(ctx context.Context, arg RequeueReportsParams) ([]int64, error) {
+	rows, err := q.db.Query(ctx, requeueReports, arg.WorkspaceID, arg.Ids)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []ListTransactionsSummaryRowsRow
+	var items []int64
 	for rows.Next() {
-		var i ListTransactionsSummaryRowsRow
-		if err := rows.Scan(
-			&i.PostedDate,
-			&i.Amount,
-			&i.Currency,
-			&i.SourceAccountNumber,
-			&i.SourceCardNumber,
-		); err != nil {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
 			return nil, err
 		}
-		items = append(items, i)
+		items = append(items, id)
 	}
 	if err := rows.Err(); err != nil {
 		return nil, err
@@ -913,40 +3239,119 @@ func (q *Queries) ListTransactionsSummaryRows(ctx context.Context, arg ListTrans
 	return items, nil
 }
 
-const removeTodo = `-- name: RemoveTodo :exec
-DELETE FROM todo WHERE id = $1 AND user_id = $2
+const retryReportClaim = `-- name: RetryReportClaim :execrows
+UPDATE financial_reports
+SET status = 'pending',
+    status_description = $1,
+    claimed_at = NULL,
+    claimed_by = NULL,
+    next_attempt_at = now() + make_interval(secs => $2::double precision)
+WHERE id = $3
+  AND status = 'processing'
+  AND claimed_by = $4
 `
 
-type RemoveTodoParams struct {
-	ID     int32
+type RetryReportClaimParams struct {
+	StatusDescription pgtype.Text
+	DelaySeconds      float64
+	ID                int64
+	ClaimedBy         pgtype.Text
+}
+
+func (q *Queries) RetryReportClaim(ctx context.Context, arg RetryReportClaimParams) (int64, error) {
+	result, err := q.db.Exec(ctx, retryReportClaim,
+		arg.StatusDescription,
+		arg.DelaySeconds,
+		arg.ID,
+		arg.ClaimedBy,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const revokeApiToken = `-- name: RevokeApiToken :execrows
+UPDATE api_tokens
+SET revoked_at = now()
+WHERE id = $1 AND user_id = $2 AND revoked_at IS NULL
+`
+
+type RevokeApiTokenParams struct {
+	ID     int64
 	UserID int32
 }
 
-func (q *Queries) RemoveTodo(ctx context.Context, arg RemoveTodoParams) error {
-	_, err := q.db.Exec(ctx, removeTodo, arg.ID, arg.UserID)
-	return err
+func (q *Queries) RevokeApiToken(ctx context.Context, arg RevokeApiTokenParams) (int64, error) {
+	result, err := q.db.Exec(ctx, revokeApiToken, arg.ID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const setAccountArchived = `-- name: SetAccountArchived :execrows
+UPDATE accounts
+SET archived = $1
+WHERE id = $2 AND workspace_id = $3 AND merged_into_id IS NULL
+`
+
+type SetAccountArchivedParams struct {
+	Archived    bool
+	ID          int64
+	WorkspaceID int32
+}
+
+func (q *Queries) SetAccountArchived(ctx context.Context, arg SetAccountArchivedParams) (int64, error) {
+	result, err := q.db.Exec(ctx, setAccountArchived, arg.Archived, arg.ID, arg.WorkspaceID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
 }
 
 const summaryTransactions = `-- name: SummaryTransactions :one
+WITH lines AS (
+    SELECT transactions.id,
+           transactions.posted_date,
+           transactions.currency,
+           transactions.source_account_number,
+           transactions.source_card_number,
+           CASE WHEN transaction_splits.id IS NULL THEN transactions.category_id ELSE transaction_splits.category_id END AS category_id,
+           COALESCE(transaction_splits.amount, transactions.amount) AS amount
+    FROM transactions
+    LEFT JOIN transaction_splits ON transaction_splits.transaction_id = transactions.id
+    WHERE transactions.workspace_id = $1
+      AND ($2::date IS NULL OR transactions.posted_date >= $2)
+      AND ($3::date IS NULL OR transactions.posted_date <= $3)
+      AND ($4::bigint IS NULL OR transactions.source_file_id = $4)
+      AND ($5::text IS NULL OR transactions.entry_type = $5)
+      AND ($6::text IS NULL OR transactions.source_account_number = $6)
+      AND ($7::text IS NULL OR transactions.source_card_number = $7)
+      AND ($8::text IS NULL OR to_tsvector('simple', transactions.description) @@ plainto_tsquery('simple', $8))
+      AND ($9::boolean OR NOT EXISTS (
+          SELECT 1
+          FROM transaction_transfers
+          WHERE transaction_transfers.status <> 'rejected'
+            AND transactions.id IN (transaction_transfers.debit_transaction_id, transaction_transfers.credit_transaction_id)
+      ))
+),
+scoped AS (
+    SELECT id, SUM(amount) AS amount
+    FROM lines
+    WHERE $10::bigint IS NULL OR category_id = $10
+    GROUP BY id
+)
 SELECT
     COUNT(*) AS count,
     COALESCE(SUM(amount), 0::numeric)::text AS total_amount,
     COALESCE(AVG(amount), 0::numeric)::text AS average_amount,
     COALESCE(PERCENTILE_CONT(0.5) WITHIN GROUP (ORDER BY amount), 0::numeric)::text AS median_amount
-FROM transactions
-WHERE user_id = $1
-  AND ($2::date IS NULL OR posted_date >= $2)
-  AND ($3::date IS NULL OR posted_date <= $3)
-  AND ($4::bigint IS NULL OR source_file_id = $4)
-  AND ($5::text IS NULL OR entry_type = $5)
-  AND ($6::text IS NULL OR source_account_number = $6)
-  AND ($7::text IS NULL OR source_card_number = $7)
-  AND ($8::text IS NULL OR to_tsvector('simple', description) @@ plainto_tsquery('simple', $8))
-  AND ($9::bigint IS NULL OR category_id = $9)
+FROM scoped
 `
 
 type SummaryTransactionsParams struct {
-	UserID              int32
+	WorkspaceID         int32
 	FromDate            pgtype.Date
 	ToDate              pgtype.Date
 	SourceFileID        pgtype.Int8
@@ -954,6 +3359,7 @@ type SummaryTransactionsParams struct {
 	SourceAccountNumber pgtype.Text
 	SourceCardNumber    pgtype.Text
 	SearchText          pgtype.Text
+	IncludeTransfers    bool
 	CategoryID          pgtype.Int8
 }
 
@@ -966,7 +3372,7 @@ type SummaryTransactionsRow struct {
 
 func (q *Queries) SummaryTransactions(ctx context.Context, arg SummaryTransactionsParams) (SummaryTransactionsRow, error) {
 	row := q.db.QueryRow(ctx, summaryTransactions,
-		arg.UserID,
+		arg.WorkspaceID,
 		arg.FromDate,
 		arg.ToDate,
 		arg.SourceFileID,
@@ -974,6 +3380,7 @@ func (q *Queries) SummaryTransactions(ctx context.Context, arg SummaryTransactio
 		arg.SourceAccountNumber,
 		arg.SourceCardNumber,
 		arg.SearchText,
+		arg.IncludeTransfers,
 		arg.CategoryID,
 	)
 	var i SummaryTransactionsRow
@@ -986,22 +3393,66 @@ func (q *Queries) SummaryTransactions(ctx context.Context, arg SummaryTransactio
 	return i, err
 }
 
+const touchApiToken = `-- name: TouchApiToken :exec
+UPDATE api_tokens
+SET last_used_at = now()
+WHERE id = $1 AND (last_used_at IS NULL OR last_used_at < now() - interval '1 minute')
+`
+
+func (q *Queries) TouchApiToken(ctx context.Context, id int64) error {
+	_, err := q.db.Exec(ctx, touchApiToken, id)
+	return err
+}
+
+const updateBudget = `-- name: UpdateBudget :execrows
+UPDATE budgets
+SET category_id = $1,
+    period = $2,
+    amount = $3,
+    currency = $4
+WHERE id = $5 AND workspace_id = $6
+`
+
+type UpdateBudgetParams struct {
+	CategoryID  int64
+	Period      string
+	Amount      pgtype.Numeric
+	Currency    string
+	ID          int64
+	WorkspaceID int32
+}
+
+func (q *Queries) UpdateBudget(ctx context.Context, arg UpdateBudgetParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateBudget,
+		arg.CategoryID,
+		arg.Period,
+		arg.Amount,
+		arg.Currency,
+		arg.ID,
+		arg.WorkspaceID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const updateCategory = `-- name: UpdateCategory :execrows
 UPDATE categories
 SET name = $1,
     color = $2,
     parent_id = $3,
     is_group = $4
-WHERE id = $5 AND user_id = $6
+WHERE id = $5 AND workspace_id = $6
 `
 
 type UpdateCategoryParams struct {
-	Name     string
-	Color    pgtype.Text
-	ParentID pgtype.Int8
-	IsGroup  bool
-	ID       int64
-	UserID   int32
+	Name        string
+	Color       pgtype.Text
+	ParentID    pgtype.Int8
+	IsGroup     bool
+	ID          int64
+	WorkspaceID int32
 }
 
 func (q *Queries) UpdateCategory(ctx context.Context, arg UpdateCategoryParams) (int64, error) {
@@ -1011,7 +3462,7 @@ func (q *Queries) UpdateCategory(ctx context.Context, arg UpdateCategoryParams)
 		arg.ParentID,
 		arg.IsGroup,
 		arg.ID,
-		arg.UserID,
+		arg.WorkspaceID,
 	)
 	if err != nil {
 		return 0, err
@@ -1022,23 +3473,50 @@ func (q *Queries) UpdateCategory(ctx context.Context, arg UpdateCategoryParams)
 const updateCategoryRule = `-- name: UpdateCategoryRule :execrows
 UPDATE category_rules
 SET category_id = $1,
-    description_contains = $2
-WHERE id = $3 AND user_id = $4
+    description_contains = $2,
+    description_regex = $3,
+    description_exact = $4,
+    description_prefix = $5,
+    amount_min = $6,
+    amount_max = $7,
+    entry_type = $8,
+    currency = $9,
+    account = $10,
+    parser_name = $11
+WHERE id = $12 AND workspace_id = $13
 `
 
 type UpdateCategoryRuleParams struct {
 	CategoryID          int64
 	DescriptionContains string
+	DescriptionRegex    string
+	DescriptionExact    string
+	DescriptionPrefix   string
+	AmountMin           pgtype.Int8
+	AmountMax           pgtype.Int8
+	EntryType           string
+	Currency            string
+	Account             string
+	ParserName          string
 	ID                  int64
-	UserID              int32
+	WorkspaceID         int32
 }
 
 func (q *Queries) UpdateCategoryRule(ctx context.Context, arg UpdateCategoryRuleParams) (int64, error) {
 	result, err := q.db.Exec(ctx, updateCategoryRule,
 		arg.CategoryID,
 		arg.DescriptionContains,
+		arg.DescriptionRegex,
+		arg.DescriptionExact,
+		arg.DescriptionPrefix,
+		arg.AmountMin,
+		arg.AmountMax,
+		arg.EntryType,
+		arg.Currency,
+		arg.Account,
+		arg.ParserName,
 		arg.ID,
-		arg.UserID,
+		arg.WorkspaceID,
 	)
 	if err != nil {
 		return 0, err
@@ -1049,17 +3527,165 @@ func (q *Queries) UpdateCategoryRule(ctx context.Context, arg UpdateCategoryRule
 const updateCategoryRulePosition = `-- name: UpdateCategoryRulePosition :execrows
 UPDATE category_rules
 SET position = $1
-WHERE id = $2 AND user_id = $3
+WHERE id = $2 AND workspace_id = $3
 `
 
 type UpdateCategoryRulePositionParams struct {
-	Position int32
-	ID       int64
-	UserID   int32
+	Position    int32
+	ID          int64
+	WorkspaceID int32
 }
 
 func (q *Queries) UpdateCategoryRulePosition(ctx context.Context, arg UpdateCategoryRulePositionParams) (int64, error) {
-	result, err := q.db.Exec(ctx, updateCategoryRulePosition, arg.Position, arg.ID, arg.UserID)
+	result, err := q.db.Exec(ctx, updateCategoryRulePosition, arg.Position, arg.ID, arg.WorkspaceID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateCsvTemplate = `-- name: UpdateCsvTemplate :execrows
+UPDATE csv_templates
+SET name = $1,
+    signature = $2,
+    delimiter = $3,
+    date_column = $4,
+    date_format = $5,
+    decimal_separator = $6,
+    description_columns = $7,
+    amount_column = $8,
+    debit_column = $9,
+    credit_column = $10,
+    currency_column = $11,
+    account_column = $12,
+    default_currency = $13
+WHERE id = $14 AND workspace_id = $15
+`
+
+type UpdateCsvTemplateParams struct {
+	Name               string
+	Signature          string
+	Delimiter          string
+	DateColumn         string
+	DateFormat         string
+	DecimalSeparator   string
+	DescriptionColumns []string
+	AmountColumn       string
+	DebitColumn        string
+	CreditColumn       string
+	CurrencyColumn     string
+	AccountColumn      string
+	DefaultCurrency    string
+	ID                 int64
+	WorkspaceID        int32
+}
+
+func (q *Queries) UpdateCsvTemplate(ctx context.Context, arg UpdateCsvTemplateParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateCsvTemplate,
+		arg.Name,
+		arg.Signature,
+		arg.Delimiter,
+		arg.DateColumn,
+		arg.DateFormat,
+		arg.DecimalSeparator,
+		arg.DescriptionColumns,
+		arg.AmountColumn,
+		arg.DebitColumn,
+		arg.CreditColumn,
+		arg.CurrencyColumn,
+		arg.AccountColumn,
+		arg.DefaultCurrency,
+		arg.ID,
+		arg.WorkspaceID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateHousehold = `-- name: UpdateHousehold :execrows
+UPDATE workspaces
+SET name = $1, base_currency = $2
+WHERE id = $3 AND user_id IS NULL
+`
+
+type UpdateHouseholdParams struct {
+	Name         string
+	BaseCurrency string
+	ID           int32
+}
+
+func (q *Queries) UpdateHousehold(ctx context.Context, arg UpdateHouseholdParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateHousehold, arg.Name, arg.BaseCurrency, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateHouseholdMemberRole = `-- name: UpdateHouseholdMemberRole :execrows
+UPDATE household_members
+SET role = $1
+WHERE household_id = $2 AND user_id = $3
+`
+
+type UpdateHouseholdMemberRoleParams struct {
+	Role        string
+	HouseholdID int32
+	UserID      int32
+}
+
+func (q *Queries) UpdateHouseholdMemberRole(ctx context.Context, arg UpdateHouseholdMemberRoleParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateHouseholdMemberRole, arg.Role, arg.HouseholdID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateRecurringSeries = `-- name: UpdateRecurringSeries :execrows
+UPDATE recurring_series
+SET name = $1, cadence = $2, amount = $3, status = 'confirmed'
+WHERE id = $4 AND workspace_id = $5
+`
+
+type UpdateRecurringSeriesParams struct {
+	Name        string
+	Cadence     string
+	Amount      pgtype.Numeric
+	ID          int64
+	WorkspaceID int32
+}
+
+func (q *Queries) UpdateRecurringSeries(ctx context.Context, arg UpdateRecurringSeriesParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateRecurringSeries,
+		arg.Name,
+		arg.Cadence,
+		arg.Amount,
+		arg.ID,
+		arg.WorkspaceID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateRecurringSeriesStatus = `-- name: UpdateRecurringSeriesStatus :execrows
+UPDATE recurring_series
+SET status = $1
+WHERE id = $2 AND workspace_id = $3
+`
+
+type UpdateRecurringSeriesStatusParams struct {
+	Status      string
+	ID          int64
+	WorkspaceID int32
+}
+
+func (q *Queries) UpdateRecurringSeriesStatus(ctx context.Context, arg UpdateRecurringSeriesStatusParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateRecurringSeriesStatus, arg.Status, arg.ID, arg.WorkspaceID)
 	if err != nil {
 		return 0, err
 	}
@@ -1069,17 +3695,17 @@ func (q *Queries) UpdateCategoryRulePosition(ctx context.Context, arg UpdateCate
 const updateReportStatus = `-- name: UpdateReportStatus :exec
 UPDATE financial_reports
 SET status = $1
-WHERE id = $2 AND user_id = $3
+WHERE id = $2 AND workspace_id = $3
 `
 
 type UpdateReportStatusParams struct {
-	Status string
-	ID     int64
-	UserID int32
+	Status      string
+	ID          int64
+	WorkspaceID int32
 }
 
 func (q *Queries) UpdateReportStatus(ctx context.Context, arg UpdateReportStatusParams) error {
-	_, err := q.db.Exec(ctx, updateReportStatus, arg.Status, arg.ID, arg.UserID)
+	_, err := q.db.Exec(ctx, updateReportStatus, arg.Status, arg.ID, arg.WorkspaceID)
 	return err
 }
 
@@ -1087,14 +3713,14 @@ const updateReportStatusWithError = `-- name: UpdateReportStatusWithError :exec
 UPDATE financial_reports
 SET status = $1,
     status_description = $2
-WHERE id = $3 AND user_id = $4
+WHERE id = $3 AND workspace_id = $4
 `
 
 type UpdateReportStatusWithErrorParams struct {
 	Status            string
 	StatusDescription pgtype.Text
 	ID                int64
-	UserID            int32
+	WorkspaceID       int32
 }
 
 func (q *Queries) UpdateReportStatusWithError(ctx context.Context, arg UpdateReportStatusWithErrorParams) error {
@@ -1102,7 +3728,7 @@ func (q *Queries) UpdateReportStatusWithError(ctx context.Context, arg UpdateRep
 		arg.Status,
 		arg.StatusDescription,
 		arg.ID,
-		arg.UserID,
+		arg.WorkspaceID,
 	)
 	return err
 }
@@ -1111,14 +3737,14 @@ const updateTransactionCategory = `-- name: UpdateTransactionCategory :execrows
 UPDATE transactions
 SET category_id = $1,
     category_source = $2
-WHERE id = $3 AND user_id = $4
+WHERE id = $3 AND workspace_id = $4
 `
 
 type UpdateTransactionCategoryParams struct {
 	CategoryID     pgtype.Int8
 	CategorySource pgtype.Text
 	ID             int64
-	UserID         int32
+	WorkspaceID    int32
 }
 
 func (q *Queries) UpdateTransactionCategory(ctx context.Context, arg UpdateTransactionCategoryParams) (int64, error) {
@@ -1126,14 +3752,107 @@ func (q *Queries) UpdateTransactionCategory(ctx context.Context, arg UpdateTrans
 		arg.CategoryID,
 		arg.CategorySource,
 		arg.ID,
-		arg.UserID,
+		arg.WorkspaceID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateTransactionFromSource = `-- name: UpdateTransactionFromSource :exec
+UPDATE transactions
+SET source_file_row = $1,
+    parser_name = $2,
+    posted_date = $3,
+    description = $4,
+    amount = $5,
+    currency = $6,
+    transaction_id = $7,
+    entry_type = $8,
+    source_account_number = $9,
+    source_card_number = $10,
+    category_id = $11,
+    category_source = $12,
+    parser_meta = $13
+WHERE id = $14 AND workspace_id = $15
+`
+
+type UpdateTransactionFromSourceParams struct {
+	SourceFileRow       int32
+	ParserName          string
+	PostedDate          pgtype.Date
+	Description         string
+	Amount              pgtype.Numeric
+	Currency            string
+	TransactionID       pgtype.Text
+	EntryType           string
+	SourceAccountNumber pgtype.Text
+	SourceCardNumber    pgtype.Text
+	CategoryID          pgtype.Int8
+	CategorySource      pgtype.Text
+	ParserMeta          []byte
+	ID                  int64
+	WorkspaceID         int32
+}
+
+func (q *Queries) UpdateTransactionFromSource(ctx context.Context, arg UpdateTransactionFromSourceParams) error {
+	_, err := q.db.Exec(ctx, updateTransactionFromSource,
+		arg.SourceFileRow,
+		arg.ParserName,
+		arg.PostedDate,
+		arg.Description,
+		arg.Amount,
+		arg.Currency,
+		arg.TransactionID,
+		arg.EntryType,
+		arg.SourceAccountNumber,
+		arg.SourceCardNumber,
+		arg.CategoryID,
+		arg.CategorySource,
+		arg.ParserMeta,
+		arg.ID,
+		arg.WorkspaceID,
 	)
+	return err
+}
+
+const updateTransactionTransferStatus = `-- name: UpdateTransactionTransferStatus :execrows
+UPDATE transaction_transfers
+SET status = $1
+WHERE id = $2 AND workspace_id = $3
+`
+
+type UpdateTransactionTransferStatusParams struct {
+	Status      string
+	ID          int64
+	WorkspaceID int32
+}
+
+func (q *Queries) UpdateTransactionTransferStatus(ctx context.Context, arg UpdateTransactionTransferStatusParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateTransactionTransferStatus, arg.Status, arg.ID, arg.WorkspaceID)
 	if err != nil {
 		return 0, err
 	}
 	return result.RowsAffected(), nil
 }
 
+const updateUserBaseCurrency = `-- name: UpdateUserBaseCurrency :exec
+UPDATE workspaces
+SET base_currency = $1
+WHERE user_id = $2
+`
+
+type UpdateUserBaseCurrencyParams struct {
+	BaseCurrency string
+	UserID       pgtype.Int4
+}
+
+func (q *Queries) UpdateUserBaseCurrency(ctx context.Context, arg UpdateUserBaseCurrencyParams) error {
+	_, err := q.db.Exec(ctx, updateUserBaseCurrency, arg.BaseCurrency, arg.UserID)
+	return err
+}
+
 const updateUserLanguage = `-- name: UpdateUserLanguage :exec
 UPDATE users
 SET language = $1
@@ -1150,6 +3869,38 @@ func (q *Queries) UpdateUserLanguage(ctx context.Context, arg UpdateUserLanguage
 	return err
 }
 
+const upsertAccount = `-- name: UpsertAccount :one
+INSERT INTO accounts (workspace_id, name, type, identifier, iban, currency)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (workspace_id, identifier) DO UPDATE
+SET iban = COALESCE(accounts.iban, EXCLUDED.iban),
+    currency = COALESCE(accounts.currency, EXCLUDED.currency)
+RETURNING COALESCE(merged_into_id, id)::bigint AS id
+`
+
+type UpsertAccountParams struct {
+	WorkspaceID int32
+	Name        string
+	Type        string
+	Identifier  pgtype.Text
+	Iban        pgtype.Text
+	Currency    pgtype.Text
+}
+
+func (q *Queries) UpsertAccount(ctx context.Context, arg UpsertAccountParams) (int64, error) {
+	row := q.db.QueryRow(ctx, upsertAccount,
+		arg.WorkspaceID,
+		arg.Name,
+		arg.Type,
+		arg.Identifier,
+		arg.Iban,
+		arg.Currency,
+	)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
 const upsertExchangeRate = `-- name: UpsertExchangeRate :exec
 INSERT INTO exchange_rates (rate_date, base_currency, target_currency, rate)
 VALUES ($1, $2, $3, $4)
@@ -1173,3 +3924,30 @@ func (q *Queries) UpsertExchangeRate(ctx context.Context, arg UpsertExchangeRate
 	)
 	return err
 }
+
+const upsertLedgerAccountMapping = `-- name: UpsertLedgerAccountMapping :one
+INSERT INTO ledger_account_mappings (workspace_id, kind, source, ledger_account)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (workspace_id, kind, source) DO UPDATE
+SET ledger_account = EXCLUDED.ledger_account
+RETURNING id
+`
+
+type UpsertLedgerAccountMappingParams struct {
+	WorkspaceID   int32
+	Kind          string
+	Source        string
+	LedgerAccount string
+}
+
+func (q *Queries) UpsertLedgerAccountMapping(ctx context.Context, arg UpsertLedgerAccountMappingParams) (int64, error) {
+	row := q.db.QueryRow(ctx, upsertLedgerAccountMapping,
+		arg.WorkspaceID,
+		arg.Kind,
+		arg.Source,
+		arg.LedgerAccount,
+	)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
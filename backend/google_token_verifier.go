@@ -0,0 +1,307 @@
+package cashtrack
+
+import (
+	"context"
+	"crypto"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultJWKSCacheTTL    = time.Hour
+	minJWKSRefreshInterval = time.Minute
+	idTokenClockSkew       = 5 * time.Minute
+)
+
+var googleIssuers = []string{"accounts.google.com", "https://accounts.google.com"}
+
+var errUnknownSigningKey = errors.New("unknown signing key")
+
+// GoogleKeySource provides the RSA public keys used to sign Google ID tokens, indexed by key id.
+// When forceRefresh is set the source must bypass its cache.
+type GoogleKeySource interface {
+	Keys(ctx context.Context, forceRefresh bool) (map[string]*rsa.PublicKey, error)
+}
+
+type JWKSKeySource struct {
+	url        string
+	httpClient *http.Client
+	now        func() time.Time
+
+	lock        sync.Mutex
+	keys        map[string]*rsa.PublicKey
+	expiresAt   time.Time
+	lastFetched time.Time
+}
+
+func NewJWKSKeySource(url string, httpClient *http.Client) *JWKSKeySource {
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 10 * time.Second}
+	}
+	return &JWKSKeySource{
+		url:        url,
+		httpClient: httpClient,
+		now:        time.Now,
+	}
+}
+
+func (s *JWKSKeySource) Keys(ctx context.Context, forceRefresh bool) (map[string]*rsa.PublicKey, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	now := s.now()
+	if s.keys != nil && now.Before(s.expiresAt) {
+		// Unknown key ids trigger a refresh, but don't let forged tokens hammer the endpoint.
+		if !forceRefresh || now.Sub(s.lastFetched) < minJWKSRefreshInterval {
+			return s.keys, nil
+		}
+	}
+
+	keys, ttl, err := s.fetch(ctx)
+	if err != nil {
+		if s.keys != nil {
+			log.Warn().Err(err).Str("url", s.url).Msg("failed to refresh jwks, using cached keys")
+			return s.keys, nil
+		}
+		return nil, err
+	}
+	s.keys = keys
+	s.lastFetched = now
+	s.expiresAt = now.Add(ttl)
+	return keys, nil
+}
+
+type jsonWebKey struct {
+	Kid string `json:"kid"`
+	Kty string `json:"kty"`
+	Alg string `json:"alg"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+func (s *JWKSKeySource) fetch(ctx context.Context) (map[string]*rsa.PublicKey, time.Duration, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.url, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, 0, fmt.Errorf("jwks request failed: %s", resp.Status)
+	}
+
+	var payload struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&payload); err != nil {
+		return nil, 0, fmt.Errorf("decode jwks: %w", err)
+	}
+
+	keys := make(map[string]*rsa.PublicKey, len(payload.Keys))
+	for _, key := range payload.Keys {
+		if key.Kty != "RSA" || key.Kid == "" {
+			continue
+		}
+		if key.Use != "" && key.Use != "sig" {
+			continue
+		}
+		publicKey, err := rsaPublicKeyFromJWK(key)
+		if err != nil {
+			log.Warn().Err(err).Str("kid", key.Kid).Msg("skipping invalid jwk")
+			continue
+		}
+		keys[key.Kid] = publicKey
+	}
+	if len(keys) == 0 {
+		return nil, 0, errors.New("jwks contains no usable keys")
+	}
+	return keys, cacheMaxAge(resp.Header.Get("Cache-Control")), nil
+}
+
+func rsaPublicKeyFromJWK(key jsonWebKey) (*rsa.PublicKey, error) {
+	modulus, err := base64.RawURLEncoding.DecodeString(key.N)
+	if err != nil {
+		return nil, fmt.Errorf("decode modulus: %w", err)
+	}
+	exponent, err := base64.RawURLEncoding.DecodeString(key.E)
+	if err != nil {
+		return nil, fmt.Errorf("decode exponent: %w", err)
+	}
+	e := new(big.Int).SetBytes(exponent)
+	if len(modulus) == 0 || !e.IsInt64() || e.Int64() < 3 || e.Int64() > 1<<31-1 {
+		return nil, errors.New("invalid rsa key")
+	}
+	return &rsa.PublicKey{N: new(big.Int).SetBytes(modulus), E: int(e.Int64())}, nil
+}
+
+func cacheMaxAge(cacheControl string) time.Duration {
+	for _, directive := range strings.Split(cacheControl, ",") {
+		name, value, ok := strings.Cut(strings.TrimSpace(directive), "=")
+		if !ok || !strings.EqualFold(name, "max-age") {
+			continue
+		}
+		seconds, err := strconv.Atoi(strings.Trim(value, `"`))
+		if err != nil || seconds <= 0 {
+			break
+		}
+		return time.Duration(seconds) * time.Second
+	}
+	return defaultJWKSCacheTTL
+}
+
+type GoogleTokenVerifier struct {
+	clientID string
+	keys     GoogleKeySource
+	now      func() time.Time
+}
+
+func NewGoogleTokenVerifier(config GoogleConfig) *GoogleTokenVerifier {
+	return NewGoogleTokenVerifierWithKeys(config.ClientID, NewJWKSKeySource(config.JWKSURL, nil))
+}
+
+func NewGoogleTokenVerifierWithKeys(clientID string, keys GoogleKeySource) *GoogleTokenVerifier {
+	return &GoogleTokenVerifier{
+		clientID: clientID,
+		keys:     keys,
+		now:      time.Now,
+	}
+}
+
+type idTokenHeader struct {
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+	Typ string `json:"typ"`
+}
+
+// Verify checks the signature and standard claims of a Google ID token and returns its claims.
+func (v *GoogleTokenVerifier) Verify(ctx context.Context, credential string) (idTokenClaims, error) {
+	parts := strings.Split(credential, ".")
+	if len(parts) != 3 {
+		return idTokenClaims{}, errors.New("invalid token")
+	}
+
+	var header idTokenHeader
+	if err := decodeTokenSegment(parts[0], &header); err != nil {
+		return idTokenClaims{}, fmt.Errorf("decode header: %w", err)
+	}
+	if header.Alg != "RS256" {
+		return idTokenClaims{}, fmt.Errorf("unsupported signing algorithm %q", header.Alg)
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return idTokenClaims{}, fmt.Errorf("decode signature: %w", err)
+	}
+	if err := v.verifySignature(ctx, header.Kid, parts[0]+"."+parts[1], signature); err != nil {
+		return idTokenClaims{}, err
+	}
+
+	var claims idTokenClaims
+	if err := decodeTokenSegment(parts[1], &claims); err != nil {
+		return idTokenClaims{}, fmt.Errorf("decode claims: %w", err)
+	}
+	if err := v.validateClaims(claims); err != nil {
+		return idTokenClaims{}, err
+	}
+	return claims, nil
+}
+
+func (v *GoogleTokenVerifier) verifySignature(ctx context.Context, kid string, signed string, signature []byte) error {
+	keys, err := v.keys.Keys(ctx, false)
+	if err != nil {
+		return fmt.Errorf("load signing keys: %w", err)
+	}
+	key, ok := keys[kid]
+	if !ok {
+		// Google rotates keys regularly, so a new kid means the cache is stale.
+		keys, err = v.keys.Keys(ctx, true)
+		if err != nil {
+			return fmt.Errorf("load signing keys: %w", err)
+		}
+		if key, ok = keys[kid]; !ok {
+			return errUnknownSigningKey
+		}
+	}
+
+	digest := sha256.Sum256([]byte(signed))
+	if err := rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signature); err != nil {
+		return fmt.Errorf("verify signature: %w", err)
+	}
+	return nil
+}
+
+func (v *GoogleTokenVerifier) validateClaims(claims idTokenClaims) error {
+	if !isGoogleIssuer(claims.Iss) {
+		return fmt.Errorf("unexpected issuer %q", claims.Iss)
+	}
+	if v.clientID == "" || claims.Aud != v.clientID {
+		return fmt.Errorf("unexpected audience %q", claims.Aud)
+	}
+
+	now := v.now()
+	if claims.Exp == 0 || now.After(time.Unix(claims.Exp, 0).Add(idTokenClockSkew)) {
+		return errors.New("token expired")
+	}
+	if claims.Iat == 0 || time.Unix(claims.Iat, 0).After(now.Add(idTokenClockSkew)) {
+		return errors.New("token issued in the future")
+	}
+	if claims.Sub == "" {
+		return errors.New("missing subject")
+	}
+	if claims.Email != "" && !claims.EmailVerified {
+		return errors.New("email is not verified")
+	}
+	return nil
+}
+
+func isGoogleIssuer(issuer string) bool {
+	for _, candidate := range googleIssuers {
+		if issuer == candidate {
+			return true
+		}
+	}
+	return false
+}
+
+func decodeTokenSegment(segment string, target any) error {
+	payload, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(payload, target)
+}
+
+// flexibleBool accepts both JSON booleans and the "true"/"false" strings some Google tokens carry.
+type flexibleBool bool
+
+func (b *flexibleBool) UnmarshalJSON(data []byte) error {
+	var value bool
+	if err := json.Unmarshal(data, &value); err == nil {
+		*b = flexibleBool(value)
+		return nil
+	}
+	var raw string
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	parsed, err := strconv.ParseBool(raw)
+	if err != nil {
+		return err
+	}
+	*b = flexibleBool(parsed)
+	return nil
+}
@@ -0,0 +1,229 @@
+package cashtrack
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+const testGoogleClientID = "test-client.apps.googleusercontent.com"
+
+type testGoogleIssuer struct {
+	t        *testing.T
+	key      *rsa.PrivateKey
+	kid      string
+	server   *httptest.Server
+	requests atomic.Int32
+}
+
+func newTestGoogleIssuer(t *testing.T) *testGoogleIssuer {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	issuer := &testGoogleIssuer{t: t, key: key, kid: "test-kid"}
+	issuer.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		issuer.requests.Add(1)
+		key := issuer.key
+		w.Header().Set("Cache-Control", "public, max-age=600")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"keys": []map[string]string{{
+				"kid": issuer.kid,
+				"kty": "RSA",
+				"alg": "RS256",
+				"use": "sig",
+				"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+			}},
+		})
+	}))
+	t.Cleanup(issuer.server.Close)
+	return issuer
+}
+
+func (i *testGoogleIssuer) verifier() *GoogleTokenVerifier {
+	return NewGoogleTokenVerifier(GoogleConfig{ClientID: testGoogleClientID, JWKSURL: i.server.URL})
+}
+
+func (i *testGoogleIssuer) validClaims() idTokenClaims {
+	now := time.Now()
+	return idTokenClaims{
+		Iss:           "https://accounts.google.com",
+		Aud:           testGoogleClientID,
+		Exp:           now.Add(time.Hour).Unix(),
+		Iat:           now.Unix(),
+		Sub:           "sub-123",
+		Email:         "test@example.com",
+		EmailVerified: true,
+		Name:          "Test User",
+	}
+}
+
+func (i *testGoogleIssuer) sign(claims idTokenClaims) string {
+	return i.signWith(i.key, i.kid, claims)
+}
+
+func (i *testGoogleIssuer) signWith(key *rsa.PrivateKey, kid string, claims idTokenClaims) string {
+	i.t.Helper()
+	header, err := json.Marshal(idTokenHeader{Alg: "RS256", Kid: kid, Typ: "JWT"})
+	if err != nil {
+		i.t.Fatalf("marshal header: %v", err)
+	}
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		i.t.Fatalf("marshal claims: %v", err)
+	}
+	signed := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	digest := sha256.Sum256([]byte(signed))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	if err != nil {
+		i.t.Fatalf("sign token: %v", err)
+	}
+	return signed + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func TestGoogleTokenVerifierAcceptsValidToken(t *testing.T) {
+	issuer := newTestGoogleIssuer(t)
+	verifier := issuer.verifier()
+
+	claims, err := verifier.Verify(context.Background(), issuer.sign(issuer.validClaims()))
+	if err != nil {
+		t.Fatalf("verify: %v", err)
+	}
+	if claims.Email != "test@example.com" || claims.Sub != "sub-123" {
+		t.Fatalf("unexpected claims: %+v", claims)
+	}
+
+	if _, err := verifier.Verify(context.Background(), issuer.sign(issuer.validClaims())); err != nil {
+		t.Fatalf("verify second token: %v", err)
+	}
+	if got := issuer.requests.Load(); got != 1 {
+		t.Fatalf("expected jwks to be fetched once, got %d", got)
+	}
+}
+
+func TestGoogleTokenVerifierRefreshesKeysOnRotation(t *testing.T) {
+	issuer := newTestGoogleIssuer(t)
+	now := time.Now()
+	keys := NewJWKSKeySource(issuer.server.URL, nil)
+	keys.now = func() time.Time { return now }
+	verifier := NewGoogleTokenVerifierWithKeys(testGoogleClientID, keys)
+
+	if _, err := verifier.Verify(context.Background(), issuer.sign(issuer.validClaims())); err != nil {
+		t.Fatalf("verify: %v", err)
+	}
+
+	rotated, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	issuer.key = rotated
+	issuer.kid = "rotated-kid"
+	token := issuer.sign(issuer.validClaims())
+
+	if _, err := verifier.Verify(context.Background(), token); err == nil {
+		t.Fatalf("expected refresh to be rate limited")
+	}
+
+	now = now.Add(2 * minJWKSRefreshInterval)
+	if _, err := verifier.Verify(context.Background(), token); err != nil {
+		t.Fatalf("verify after rotation: %v", err)
+	}
+	if got := issuer.requests.Load(); got != 2 {
+		t.Fatalf("expected jwks to be fetched twice, got %d", got)
+	}
+}
+
+func TestGoogleTokenVerifierRejectsInvalidTokens(t *testing.T) {
+	issuer := newTestGoogleIssuer(t)
+	verifier := issuer.verifier()
+
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+
+	unsigned := func(claims idTokenClaims) string {
+		payload, _ := json.Marshal(claims)
+		header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"none"}`))
+		return strings.Join([]string{header, base64.RawURLEncoding.EncodeToString(payload), ""}, ".")
+	}
+	tamper := func(token string) string {
+		parts := strings.Split(token, ".")
+		claims := issuer.validClaims()
+		claims.Email = "attacker@example.com"
+		payload, _ := json.Marshal(claims)
+		parts[1] = base64.RawURLEncoding.EncodeToString(payload)
+		return strings.Join(parts, ".")
+	}
+	withClaims := func(mutate func(*idTokenClaims)) string {
+		claims := issuer.validClaims()
+		mutate(&claims)
+		return issuer.sign(claims)
+	}
+
+	cases := map[string]string{
+		"malformed":          "not-a-token",
+		"alg none":           unsigned(issuer.validClaims()),
+		"tampered payload":   tamper(issuer.sign(issuer.validClaims())),
+		"foreign key":        issuer.signWith(otherKey, issuer.kid, issuer.validClaims()),
+		"unknown kid":        issuer.signWith(otherKey, "other-kid", issuer.validClaims()),
+		"wrong audience":     withClaims(func(c *idTokenClaims) { c.Aud = "someone-else" }),
+		"wrong issuer":       withClaims(func(c *idTokenClaims) { c.Iss = "https://evil.example.com" }),
+		"expired":            withClaims(func(c *idTokenClaims) { c.Exp = time.Now().Add(-time.Hour).Unix() }),
+		"issued in future":   withClaims(func(c *idTokenClaims) { c.Iat = time.Now().Add(time.Hour).Unix() }),
+		"unverified email":   withClaims(func(c *idTokenClaims) { c.EmailVerified = false }),
+		"missing expiration": withClaims(func(c *idTokenClaims) { c.Exp = 0 }),
+	}
+	for name, token := range cases {
+		t.Run(name, func(t *testing.T) {
+			if _, err := verifier.Verify(context.Background(), token); err == nil {
+				t.Fatalf("expected token to be rejected")
+			}
+		})
+	}
+}
+
+func TestGoogleTokenVerifierAcceptsStringEmailVerified(t *testing.T) {
+	issuer := newTestGoogleIssuer(t)
+	claims := issuer.validClaims()
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		t.Fatalf("marshal claims: %v", err)
+	}
+	var raw map[string]any
+	if err := json.Unmarshal(payload, &raw); err != nil {
+		t.Fatalf("unmarshal claims: %v", err)
+	}
+	raw["email_verified"] = "true"
+
+	var decoded idTokenClaims
+	encoded, _ := json.Marshal(raw)
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		t.Fatalf("decode claims: %v", err)
+	}
+	if !decoded.EmailVerified {
+		t.Fatalf("expected email_verified string to decode as true")
+	}
+}
+
+func TestCacheMaxAge(t *testing.T) {
+	if got := cacheMaxAge("public, max-age=19432, must-revalidate, no-transform"); got != 19432*time.Second {
+		t.Fatalf("unexpected max-age: %v", got)
+	}
+	if got := cacheMaxAge("no-store"); got != defaultJWKSCacheTTL {
+		t.Fatalf("expected default ttl, got %v", got)
+	}
+}
@@ -8,6 +8,11 @@ func Handlers(
 	reportService *ReportServiceHandler,
 	transactionService *TransactionServiceHandler,
 	categoryService *CategoryServiceHandler,
+	budgetService *BudgetServiceHandler,
+	csvTemplateService *CsvTemplateServiceHandler,
+	accountService *AccountServiceHandler,
+	recurringService *RecurringServiceHandler,
+	householdService *HouseholdServiceHandler,
 ) []*Handler {
 	return []*Handler{
 		(*Handler)(todo),
@@ -17,5 +22,10 @@ func Handlers(
 		(*Handler)(reportService),
 		(*Handler)(transactionService),
 		(*Handler)(categoryService),
+		(*Handler)(budgetService),
+		(*Handler)(csvTemplateService),
+		(*Handler)(accountService),
+		(*Handler)(recurringService),
+		(*Handler)(householdService),
 	}
 }
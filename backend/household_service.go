@@ -0,0 +1,473 @@
+package cashtrack
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	apiv1 "cashtrack/backend/gen/api/v1"
+	"cashtrack/backend/gen/api/v1/apiv1connect"
+	dbgen "cashtrack/backend/gen/db"
+	"connectrpc.com/connect"
+	"connectrpc.com/validate"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const (
+	HouseholdRoleOwner  = "owner"
+	HouseholdRoleEditor = "editor"
+	HouseholdRoleViewer = "viewer"
+)
+
+const householdInvitationTTL = 7 * 24 * time.Hour
+
+var errLastHouseholdOwner = errors.New("a household needs at least one owner")
+
+type HouseholdService struct {
+	db *Db
+}
+
+type HouseholdServiceHandler Handler
+
+func NewHouseholdServiceHandler(db *Db) *HouseholdServiceHandler {
+	service := &HouseholdService{db: db}
+	path, handler := apiv1connect.NewHouseholdServiceHandler(
+		service,
+		connect.WithInterceptors(validate.NewInterceptor(), NewAuthInterceptor(db)),
+	)
+	return &HouseholdServiceHandler{Path: path, Handler: handler}
+}
+
+func normalizeHouseholdName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", connect.NewError(connect.CodeInvalidArgument, errors.New("name is required"))
+	}
+	if utf8.RuneCountInString(name) > 255 {
+		return "", connect.NewError(connect.CodeInvalidArgument, errors.New("name must be at most 255 characters"))
+	}
+	return name, nil
+}
+
+func isHouseholdRole(role string) bool {
+	switch role {
+	case HouseholdRoleOwner, HouseholdRoleEditor, HouseholdRoleViewer:
+		return true
+	}
+	return false
+}
+
+// householdReadProcedures are the calls on a household's workspace that only read it, the only ones
+// open to viewers. New read calls have to be added here.
+var householdReadProcedures = map[string]bool{
+	apiv1connect.AccountServiceListAccountsProcedure:                  true,
+	apiv1connect.AccountServiceGetAccountBalancesProcedure:            true,
+	apiv1connect.BudgetServiceListBudgetsProcedure:                    true,
+	apiv1connect.BudgetServiceGetBudgetStatusProcedure:                true,
+	apiv1connect.CategoryServiceListCategoriesProcedure:               true,
+	apiv1connect.CategoryServiceListCategoryRulesProcedure:            true,
+	apiv1connect.CategoryServicePreviewCategoryRulesProcedure:         true,
+	apiv1connect.CsvTemplateServiceListCsvTemplatesProcedure:          true,
+	apiv1connect.CsvTemplateServiceTestCsvTemplateProcedure:           true,
+	apiv1connect.RecurringServiceListRecurringProcedure:               true,
+	apiv1connect.ReportServiceListReportsProcedure:                    true,
+	apiv1connect.ReportServiceDownloadReportProcedure:                 true,
+	apiv1connect.ReportServiceWatchReportsProcedure:                   true,
+	apiv1connect.ReportServiceGetReportDiagnosticsProcedure:           true,
+	apiv1connect.TransactionServiceListTransactionsProcedure:          true,
+	apiv1connect.TransactionServiceGetTransactionAnalyticsProcedure:   true,
+	apiv1connect.TransactionServiceListTransfersProcedure:             true,
+	apiv1connect.TransactionServiceExportTransactionsProcedure:        true,
+	apiv1connect.TransactionServiceListLedgerAccountMappingsProcedure: true,
+}
+
+// householdRoleAllows reports whether a member with the given role may make a call on the household's
+// workspace. Viewers are limited to householdReadProcedures.
+func householdRoleAllows(role string, spec connect.Spec) bool {
+	switch role {
+	case HouseholdRoleOwner, HouseholdRoleEditor:
+		return true
+	case HouseholdRoleViewer:
+		return householdReadProcedures[spec.Procedure]
+	}
+	return false
+}
+
+func (s *HouseholdService) ListHouseholds(ctx context.Context, req *apiv1.ListHouseholdsRequest) (*apiv1.ListHouseholdsResponse, error) {
+	user, err := requireUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.db.Queries.ListHouseholdsByUser(ctx, user.Id)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	households := make([]*apiv1.Household, 0, len(rows))
+	for _, row := range rows {
+		households = append(households, householdToProto(row.ID, row.Name, row.Role, row.BaseCurrency, row.CreatedAt))
+	}
+	return &apiv1.ListHouseholdsResponse{Households: households}, nil
+}
+
+func (s *HouseholdService) CreateHousehold(ctx context.Context, req *apiv1.CreateHouseholdRequest) (*apiv1.CreateHouseholdResponse, error) {
+	user, err := requireUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	name, err := normalizeHouseholdName(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	household, err := s.createHousehold(ctx, user, name)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return &apiv1.CreateHouseholdResponse{Household: household}, nil
+}
+
+// createHousehold creates the household's workspace, copying the creator's base currency, and makes
+// the creator its owner.
+func (s *HouseholdService) createHousehold(ctx context.Context, user *apiv1.User, name string) (*apiv1.Household, error) {
+	baseCurrency := normalizeBaseCurrency(user.BaseCurrency)
+
+	tx, err := s.db.conn.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	txQueries := s.db.Queries.WithTx(tx)
+	created, err := txQueries.CreateHousehold(ctx, dbgen.CreateHouseholdParams{Name: name, BaseCurrency: baseCurrency})
+	if err != nil {
+		return nil, fmt.Errorf("create household: %w", err)
+	}
+	if _, err := txQueries.AddHouseholdMember(ctx, dbgen.AddHouseholdMemberParams{
+		HouseholdID: created.ID,
+		UserID:      user.Id,
+		Role:        HouseholdRoleOwner,
+	}); err != nil {
+		return nil, fmt.Errorf("add owner: %w", err)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("commit household: %w", err)
+	}
+	return householdToProto(created.ID, name, HouseholdRoleOwner, baseCurrency, created.CreatedAt), nil
+}
+
+// UpdateHousehold changes the settings of a household's workspace. Only owners may change them.
+func (s *HouseholdService) UpdateHousehold(ctx context.Context, req *apiv1.UpdateHouseholdRequest) (*apiv1.UpdateHouseholdResponse, error) {
+	user, err := requireUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	name, err := normalizeHouseholdName(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	currency := normalizeCurrency(req.BaseCurrency)
+	if currency == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("base currency is required"))
+	}
+	if !isCurrencyCode(currency) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("base currency must be a 3-letter ISO code"))
+	}
+	if err := s.requireOwner(ctx, req.HouseholdId, user.Id); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.db.Queries.UpdateHousehold(ctx, dbgen.UpdateHouseholdParams{
+		Name:         name,
+		BaseCurrency: currency,
+		ID:           req.HouseholdId,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if updated == 0 {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("household not found"))
+	}
+	membership, err := s.membership(ctx, req.HouseholdId, user.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &apiv1.UpdateHouseholdResponse{
+		Household: householdToProto(membership.ID, membership.Name, membership.Role, membership.BaseCurrency, membership.CreatedAt),
+	}, nil
+}
+
+func (s *HouseholdService) ListHouseholdMembers(ctx context.Context, req *apiv1.ListHouseholdMembersRequest) (*apiv1.ListHouseholdMembersResponse, error) {
+	user, err := requireUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.membership(ctx, req.HouseholdId, user.Id); err != nil {
+		return nil, err
+	}
+
+	rows, err := s.db.Queries.ListHouseholdMembers(ctx, req.HouseholdId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	members := make([]*apiv1.HouseholdMember, 0, len(rows))
+	for _, row := range rows {
+		members = append(members, &apiv1.HouseholdMember{
+			UserId:   row.UserID,
+			Username: row.Username,
+			Role:     row.Role,
+			JoinedAt: row.CreatedAt.Time.Format(time.RFC3339Nano),
+		})
+	}
+	return &apiv1.ListHouseholdMembersResponse{Members: members}, nil
+}
+
+func (s *HouseholdService) UpdateHouseholdMember(ctx context.Context, req *apiv1.UpdateHouseholdMemberRequest) (*apiv1.UpdateHouseholdMemberResponse, error) {
+	user, err := requireUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !isHouseholdRole(req.Role) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("role must be owner, editor or viewer"))
+	}
+	if err := s.requireOwner(ctx, req.HouseholdId, user.Id); err != nil {
+		return nil, err
+	}
+
+	if err := s.changeMember(ctx, req.HouseholdId, req.UserId, req.Role); err != nil {
+		return nil, memberChangeError(err)
+	}
+	return &apiv1.UpdateHouseholdMemberResponse{}, nil
+}
+
+func (s *HouseholdService) RemoveHouseholdMember(ctx context.Context, req *apiv1.RemoveHouseholdMemberRequest) (*apiv1.RemoveHouseholdMemberResponse, error) {
+	user, err := requireUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Any member may leave; only owners may remove someone else.
+	if req.UserId == user.Id {
+		_, err = s.membership(ctx, req.HouseholdId, user.Id)
+	} else {
+		err = s.requireOwner(ctx, req.HouseholdId, user.Id)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.changeMember(ctx, req.HouseholdId, req.UserId, ""); err != nil {
+		return nil, memberChangeError(err)
+	}
+	return &apiv1.RemoveHouseholdMemberResponse{}, nil
+}
+
+// changeMember sets the role of a member, or removes them when role is empty, without leaving the
+// household without an owner.
+func (s *HouseholdService) changeMember(ctx context.Context, householdID int32, userID int32, role string) error {
+	tx, err := s.db.conn.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	txQueries := s.db.Queries.WithTx(tx)
+	if err := txQueries.LockHousehold(ctx, householdID); err != nil {
+		return fmt.Errorf("lock household: %w", err)
+	}
+	member, err := txQueries.GetHouseholdMembership(ctx, dbgen.GetHouseholdMembershipParams{
+		HouseholdID: householdID,
+		UserID:      userID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return errNotFound
+		}
+		return fmt.Errorf("load member: %w", err)
+	}
+	if member.Role == HouseholdRoleOwner && role != HouseholdRoleOwner {
+		owners, err := txQueries.CountHouseholdOwners(ctx, householdID)
+		if err != nil {
+			return fmt.Errorf("count owners: %w", err)
+		}
+		if owners <= 1 {
+			return errLastHouseholdOwner
+		}
+	}
+
+	if role == "" {
+		_, err = txQueries.DeleteHouseholdMember(ctx, dbgen.DeleteHouseholdMemberParams{HouseholdID: householdID, UserID: userID})
+	} else {
+		_, err = txQueries.UpdateHouseholdMemberRole(ctx, dbgen.UpdateHouseholdMemberRoleParams{
+			Role:        role,
+			HouseholdID: householdID,
+			UserID:      userID,
+		})
+	}
+	if err != nil {
+		return fmt.Errorf("update member: %w", err)
+	}
+	return tx.Commit(ctx)
+}
+
+func memberChangeError(err error) error {
+	switch {
+	case errors.Is(err, errNotFound):
+		return connect.NewError(connect.CodeNotFound, errors.New("member not found"))
+	case errors.Is(err, errLastHouseholdOwner):
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+	return connect.NewError(connect.CodeInternal, err)
+}
+
+func (s *HouseholdService) CreateHouseholdInvitation(ctx context.Context, req *apiv1.CreateHouseholdInvitationRequest) (*apiv1.CreateHouseholdInvitationResponse, error) {
+	user, err := requireUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !isHouseholdRole(req.Role) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("role must be owner, editor or viewer"))
+	}
+	if err := s.requireOwner(ctx, req.HouseholdId, user.Id); err != nil {
+		return nil, err
+	}
+
+	token, err := randomToken(32)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	expiresAt := time.Now().Add(householdInvitationTTL)
+	err = s.db.Queries.CreateHouseholdInvitation(ctx, dbgen.CreateHouseholdInvitationParams{
+		HouseholdID: req.HouseholdId,
+		TokenHash:   hashToken(token),
+		Role:        req.Role,
+		CreatedBy:   user.Id,
+		ExpiresAt:   pgtype.Timestamptz{Time: expiresAt, Valid: true},
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return &apiv1.CreateHouseholdInvitationResponse{
+		Token:     token,
+		ExpiresAt: expiresAt.UTC().Format(time.RFC3339Nano),
+	}, nil
+}
+
+func (s *HouseholdService) AcceptHouseholdInvitation(ctx context.Context, req *apiv1.AcceptHouseholdInvitationRequest) (*apiv1.AcceptHouseholdInvitationResponse, error) {
+	user, err := requireUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	token := strings.TrimSpace(req.Token)
+	if token == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("token is required"))
+	}
+
+	household, err := s.acceptInvitation(ctx, user.Id, token)
+	if err != nil {
+		if errors.Is(err, errNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, errors.New("invitation not found or expired"))
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return &apiv1.AcceptHouseholdInvitationResponse{Household: household}, nil
+}
+
+// acceptInvitation uses up an invitation and adds the user to its household. Members who accept another
+// invitation keep the role they already have.
+func (s *HouseholdService) acceptInvitation(ctx context.Context, userID int32, token string) (*apiv1.Household, error) {
+	tx, err := s.db.conn.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	txQueries := s.db.Queries.WithTx(tx)
+	invitation, err := txQueries.ClaimHouseholdInvitation(ctx, dbgen.ClaimHouseholdInvitationParams{
+		AcceptedBy: pgtype.Int4{Int32: userID, Valid: true},
+		TokenHash:  hashToken(token),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errNotFound
+		}
+		return nil, fmt.Errorf("claim invitation: %w", err)
+	}
+	if _, err := txQueries.AddHouseholdMember(ctx, dbgen.AddHouseholdMemberParams{
+		HouseholdID: invitation.HouseholdID,
+		UserID:      userID,
+		Role:        invitation.Role,
+	}); err != nil {
+		return nil, fmt.Errorf("add member: %w", err)
+	}
+	membership, err := txQueries.GetHouseholdMembership(ctx, dbgen.GetHouseholdMembershipParams{
+		HouseholdID: invitation.HouseholdID,
+		UserID:      userID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("load membership: %w", err)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("commit invitation: %w", err)
+	}
+	return householdToProto(membership.ID, membership.Name, membership.Role, membership.BaseCurrency, membership.CreatedAt), nil
+}
+
+// membership loads the user's membership, reporting households they don't belong to as not found.
+func (s *HouseholdService) membership(ctx context.Context, householdID int32, userID int32) (dbgen.GetHouseholdMembershipRow, error) {
+	row, err := s.db.Queries.GetHouseholdMembership(ctx, dbgen.GetHouseholdMembershipParams{
+		HouseholdID: householdID,
+		UserID:      userID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return row, connect.NewError(connect.CodeNotFound, errors.New("household not found"))
+		}
+		return row, connect.NewError(connect.CodeInternal, err)
+	}
+	return row, nil
+}
+
+func (s *HouseholdService) requireOwner(ctx context.Context, householdID int32, userID int32) error {
+	membership, err := s.membership(ctx, householdID, userID)
+	if err != nil {
+		return err
+	}
+	if membership.Role != HouseholdRoleOwner {
+		return connect.NewError(connect.CodePermissionDenied, errors.New("only owners can manage the household"))
+	}
+	return nil
+}
+
+func householdToProto(id int32, name string, role string, baseCurrency string, createdAt pgtype.Timestamptz) *apiv1.Household {
+	return &apiv1.Household{
+		Id:           id,
+		Name:         name,
+		Role:         role,
+		BaseCurrency: baseCurrency,
+		CreatedAt:    createdAt.Time.Format(time.RFC3339Nano),
+	}
+}
+
+// randomToken returns size random bytes encoded for use in URLs.
+func randomToken(size int) (string, error) {
+	buf := make([]byte, size)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("generate token: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// hashToken is how secrets handed out to users are stored, so a database leak does not leak them.
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
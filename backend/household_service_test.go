@@ -0,0 +1,198 @@
+package cashtrack
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	apiv1 "cashtrack/backend/gen/api/v1"
+	"cashtrack/backend/gen/api/v1/apiv1connect"
+	"connectrpc.com/connect"
+)
+
+func TestHouseholdRoleAllows(t *testing.T) {
+	read := connect.Spec{Procedure: apiv1connect.TransactionServiceListTransactionsProcedure}
+	write := connect.Spec{Procedure: apiv1connect.TransactionServiceUpdateTransactionCategoryProcedure}
+
+	tests := []struct {
+		role  string
+		spec  connect.Spec
+		allow bool
+	}{
+		{HouseholdRoleOwner, write, true},
+		{HouseholdRoleEditor, write, true},
+		{HouseholdRoleViewer, read, true},
+		{HouseholdRoleViewer, write, false},
+		{HouseholdRoleViewer, connect.Spec{Procedure: apiv1connect.TransactionServiceDetectTransfersProcedure, IdempotencyLevel: connect.IdempotencyNoSideEffects}, false},
+		{"", read, false},
+	}
+	for _, test := range tests {
+		if got := householdRoleAllows(test.role, test.spec); got != test.allow {
+			t.Fatalf("householdRoleAllows(%q, %s) = %v, expected %v", test.role, test.spec.Procedure, got, test.allow)
+		}
+	}
+}
+
+func TestHouseholdInvitationGrantsLedgerAccess(t *testing.T) {
+	db, cleanup := openTestDB(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	createHouseholdTables(t, db)
+	ownerID := createUser(t, db, "owner@example.com")
+	partnerID := createUser(t, db, "partner@example.com")
+	strangerID := createUser(t, db, "stranger@example.com")
+
+	service := &HouseholdService{db: db}
+	ownerCtx := contextWithUser(ctx, &apiv1.User{Id: ownerID, Language: "en", BaseCurrency: "EUR"})
+	partnerCtx := contextWithUser(ctx, &apiv1.User{Id: partnerID})
+
+	created, err := service.CreateHousehold(ownerCtx, &apiv1.CreateHouseholdRequest{Name: " Home "})
+	if err != nil {
+		t.Fatalf("create household: %v", err)
+	}
+	household := created.Household
+	if household.Name != "Home" || household.Role != HouseholdRoleOwner || household.BaseCurrency != "EUR" {
+		t.Fatalf("unexpected household %+v", household)
+	}
+
+	if _, err := service.CreateHouseholdInvitation(partnerCtx, &apiv1.CreateHouseholdInvitationRequest{HouseholdId: household.Id, Role: HouseholdRoleViewer}); connect.CodeOf(err) != connect.CodeNotFound {
+		t.Fatalf("expected not found for a non-member, got %v", err)
+	}
+	invitation, err := service.CreateHouseholdInvitation(ownerCtx, &apiv1.CreateHouseholdInvitationRequest{HouseholdId: household.Id, Role: HouseholdRoleViewer})
+	if err != nil {
+		t.Fatalf("create invitation: %v", err)
+	}
+	accepted, err := service.AcceptHouseholdInvitation(partnerCtx, &apiv1.AcceptHouseholdInvitationRequest{Token: invitation.Token})
+	if err != nil {
+		t.Fatalf("accept invitation: %v", err)
+	}
+	if accepted.Household.Id != household.Id || accepted.Household.Role != HouseholdRoleViewer {
+		t.Fatalf("unexpected household %+v", accepted.Household)
+	}
+	strangerCtx := contextWithUser(ctx, &apiv1.User{Id: strangerID})
+	if _, err := service.AcceptHouseholdInvitation(strangerCtx, &apiv1.AcceptHouseholdInvitationRequest{Token: invitation.Token}); connect.CodeOf(err) != connect.CodeNotFound {
+		t.Fatalf("expected a used invitation to be rejected, got %v", err)
+	}
+
+	interceptor := &authInterceptor{db: db}
+	header := func(userID int32) http.Header {
+		header := http.Header{}
+		header.Set("Cookie", fmt.Sprintf("%s=%s", sessionCookieName, createSessionForUser(t, db, userID)))
+		header.Set(householdHeader, fmt.Sprint(household.Id))
+		return header
+	}
+	read := connect.Spec{Procedure: apiv1connect.TransactionServiceListTransactionsProcedure}
+	write := connect.Spec{Procedure: apiv1connect.TransactionServiceUpdateTransactionCategoryProcedure}
+
+	callCtx, err := interceptor.authorize(ctx, read, header(partnerID))
+	if err != nil {
+		t.Fatalf("authorize viewer read: %v", err)
+	}
+	workspace, err := requireWorkspace(callCtx)
+	if err != nil || workspace.ID != household.Id || workspace.BaseCurrency != "EUR" {
+		t.Fatalf("expected the household workspace, got %+v, %v", workspace, err)
+	}
+	if user, _ := requireUser(callCtx); user.Id != partnerID {
+		t.Fatalf("expected the signed-in user to stay the partner, got %+v", user)
+	}
+	if _, err := interceptor.authorize(ctx, write, header(partnerID)); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Fatalf("expected viewers to be denied changes, got %v", err)
+	}
+	if _, err := interceptor.authorize(ctx, read, header(strangerID)); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Fatalf("expected non-members to be denied, got %v", err)
+	}
+	personal := connect.Spec{Procedure: apiv1connect.AuthServiceUpdateLanguageProcedure}
+	callCtx, err = interceptor.authorize(ctx, personal, header(partnerID))
+	if err != nil {
+		t.Fatalf("authorize personal call: %v", err)
+	}
+	if workspace, err := requireWorkspace(callCtx); err == nil {
+		t.Fatalf("expected personal calls to ignore the household, got %+v", workspace)
+	}
+	personalHeader := header(partnerID)
+	personalHeader.Del(householdHeader)
+	callCtx, err = interceptor.authorize(ctx, read, personalHeader)
+	if err != nil {
+		t.Fatalf("authorize personal read: %v", err)
+	}
+	if workspace, err := requireWorkspace(callCtx); err != nil || workspace.ID != partnerID {
+		t.Fatalf("expected the partner's personal workspace, got %+v, %v", workspace, err)
+	}
+
+	if _, err := service.UpdateHousehold(partnerCtx, &apiv1.UpdateHouseholdRequest{HouseholdId: household.Id, Name: "Mine", BaseCurrency: "USD"}); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Fatalf("expected viewers not to change settings, got %v", err)
+	}
+	if _, err := service.UpdateHousehold(ownerCtx, &apiv1.UpdateHouseholdRequest{HouseholdId: household.Id, Name: "Home", BaseCurrency: "dollars"}); connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Fatalf("expected an invalid currency to be rejected, got %v", err)
+	}
+	updated, err := service.UpdateHousehold(ownerCtx, &apiv1.UpdateHouseholdRequest{HouseholdId: household.Id, Name: " Family ", BaseCurrency: "usd"})
+	if err != nil {
+		t.Fatalf("update household: %v", err)
+	}
+	if updated.Household.Name != "Family" || updated.Household.BaseCurrency != "USD" || updated.Household.Role != HouseholdRoleOwner {
+		t.Fatalf("unexpected household %+v", updated.Household)
+	}
+	callCtx, err = interceptor.authorize(ctx, read, header(partnerID))
+	if err != nil {
+		t.Fatalf("authorize viewer read: %v", err)
+	}
+	if workspace, _ := requireWorkspace(callCtx); workspace.BaseCurrency != "USD" {
+		t.Fatalf("expected the household's new base currency, got %+v", workspace)
+	}
+
+	if _, err := service.RemoveHouseholdMember(ownerCtx, &apiv1.RemoveHouseholdMemberRequest{HouseholdId: household.Id, UserId: ownerID}); connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Fatalf("expected the last owner to stay, got %v", err)
+	}
+	if _, err := service.UpdateHouseholdMember(partnerCtx, &apiv1.UpdateHouseholdMemberRequest{HouseholdId: household.Id, UserId: partnerID, Role: HouseholdRoleOwner}); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Fatalf("expected viewers not to manage members, got %v", err)
+	}
+	if _, err := service.UpdateHouseholdMember(ownerCtx, &apiv1.UpdateHouseholdMemberRequest{HouseholdId: household.Id, UserId: partnerID, Role: HouseholdRoleEditor}); err != nil {
+		t.Fatalf("promote partner: %v", err)
+	}
+	if _, err := interceptor.authorize(ctx, write, header(partnerID)); err != nil {
+		t.Fatalf("expected editors to make changes, got %v", err)
+	}
+
+	if _, err := service.RemoveHouseholdMember(partnerCtx, &apiv1.RemoveHouseholdMemberRequest{HouseholdId: household.Id, UserId: partnerID}); err != nil {
+		t.Fatalf("leave household: %v", err)
+	}
+	listed, err := service.ListHouseholds(partnerCtx, &apiv1.ListHouseholdsRequest{})
+	if err != nil {
+		t.Fatalf("list households: %v", err)
+	}
+	if len(listed.Households) != 0 {
+		t.Fatalf("expected the partner to have left, got %+v", listed.Households)
+	}
+	if _, err := interceptor.authorize(ctx, read, header(partnerID)); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Fatalf("expected former members to be denied, got %v", err)
+	}
+}
+
+func createHouseholdTables(t *testing.T, db *Db) {
+	t.Helper()
+	_, err := db.conn.Exec(context.Background(), `
+		CREATE TABLE household_members (
+			household_id integer NOT NULL REFERENCES workspaces(id) ON DELETE CASCADE,
+			user_id integer NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+			role varchar(16) NOT NULL,
+			created_at timestamptz NOT NULL DEFAULT now(),
+			PRIMARY KEY (household_id, user_id)
+		);
+		CREATE TABLE household_invitations (
+			id bigserial PRIMARY KEY,
+			household_id integer NOT NULL REFERENCES workspaces(id) ON DELETE CASCADE,
+			token_hash varchar(64) NOT NULL UNIQUE,
+			role varchar(16) NOT NULL,
+			created_by integer NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+			expires_at timestamptz NOT NULL,
+			accepted_by integer REFERENCES users(id) ON DELETE SET NULL,
+			accepted_at timestamptz,
+			created_at timestamptz NOT NULL DEFAULT now()
+		);
+	`)
+	if err != nil {
+		t.Fatalf("create household tables: %v", err)
+	}
+}
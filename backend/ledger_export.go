@@ -0,0 +1,352 @@
+package cashtrack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	apiv1 "cashtrack/backend/gen/api/v1"
+	dbgen "cashtrack/backend/gen/db"
+	"connectrpc.com/connect"
+)
+
+const (
+	LedgerMappingAccount  = "account"
+	LedgerMappingCard     = "card"
+	LedgerMappingCategory = "category"
+)
+
+// ledgerTransferAccount balances transfers between own accounts, so both legs net to zero in it.
+const ledgerTransferAccount = "Assets:Transfers"
+
+// ledgerAmountColumn is where posting amounts end, so they line up and journals stay easy to diff.
+const ledgerAmountColumn = 60
+
+var ledgerRootAccounts = []string{"Assets", "Liabilities", "Equity", "Income", "Expenses"}
+
+// ledgerAccounts names the ledger accounts of exported lines, preferring the workspace's mappings over the
+// names derived from account numbers and categories.
+type ledgerAccounts map[string]string
+
+func newLedgerAccounts(rows []dbgen.ListLedgerAccountMappingsRow) ledgerAccounts {
+	accounts := make(ledgerAccounts, len(rows))
+	for _, row := range rows {
+		accounts[ledgerMappingKey(row.Kind, row.Source)] = row.LedgerAccount
+	}
+	return accounts
+}
+
+func ledgerMappingKey(kind string, source string) string {
+	return kind + ":" + source
+}
+
+// source is the account the money moved in or out of. A card mapping wins over an account mapping as
+// the more specific of the two. Without a mapping the account's type picks the root: cards are
+// liabilities, bank accounts and cash are assets. Lines not linked to an account go by their
+// account or card number.
+func (a ledgerAccounts) source(line exportLine) string {
+	if name, ok := a[ledgerMappingKey(LedgerMappingCard, line.CardNumber)]; ok && line.CardNumber != "" {
+		return name
+	}
+	if name, ok := a[ledgerMappingKey(LedgerMappingAccount, line.AccountNumber)]; ok && line.AccountNumber != "" {
+		return name
+	}
+	accountType, name := line.AccountType, line.Account
+	if accountType == "" || name == "" {
+		switch {
+		case line.AccountNumber != "":
+			accountType, name = AccountTypeBank, line.AccountNumber
+		case line.CardNumber != "":
+			accountType, name = AccountTypeCard, line.CardNumber
+		default:
+			return "Assets:Unknown"
+		}
+	}
+	switch accountType {
+	case AccountTypeCard:
+		return "Liabilities:Card:" + ledgerAccountComponent(name)
+	case AccountTypeCash:
+		return "Assets:Cash:" + ledgerAccountComponent(name)
+	}
+	return "Assets:Bank:" + ledgerAccountComponent(name)
+}
+
+// counterpart is the account that balances the source. A category mapping also covers the
+// category's descendants, which book below it, so mapping the income group to Income:Work puts
+// salary under Income:Work:Salary. Categories outside a mapping book below Expenses, refunds
+// included. Lines without a category go by their own sign.
+func (a ledgerAccounts) counterpart(line exportLine) string {
+	for i := len(line.CategoryPathIDs) - 1; i >= 0; i-- {
+		name, ok := a[ledgerMappingKey(LedgerMappingCategory, strconv.FormatInt(line.CategoryPathIDs[i], 10))]
+		if !ok {
+			continue
+		}
+		components := []string{name}
+		for _, child := range line.CategoryPath[i+1:] {
+			components = append(components, ledgerAccountComponent(child))
+		}
+		return strings.Join(components, ":")
+	}
+	if line.Transfer {
+		return ledgerTransferAccount
+	}
+	if len(line.CategoryPath) == 0 {
+		if line.AmountCents > 0 {
+			return "Income:Uncategorized"
+		}
+		return "Expenses:Uncategorized"
+	}
+	components := []string{"Expenses"}
+	for _, name := range line.CategoryPath {
+		components = append(components, ledgerAccountComponent(name))
+	}
+	return strings.Join(components, ":")
+}
+
+// ledgerAccountComponent turns a name into an account component Beancount accepts: words of letters
+// and digits joined by dashes, starting with an upper-case letter or a digit.
+func ledgerAccountComponent(name string) string {
+	words := strings.FieldsFunc(name, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for i, word := range words {
+		first, size := utf8.DecodeRuneInString(word)
+		words[i] = string(unicode.ToUpper(first)) + word[size:]
+	}
+	component := strings.Join(words, "-")
+	if component == "" {
+		return "Unknown"
+	}
+	if first, _ := utf8.DecodeRuneInString(component); !unicode.IsUpper(first) && !unicode.IsDigit(first) {
+		component = "X" + component
+	}
+	return component
+}
+
+func validLedgerAccount(name string) bool {
+	components := strings.Split(name, ":")
+	if len(components) < 2 {
+		return false
+	}
+	root := false
+	for _, candidate := range ledgerRootAccounts {
+		root = root || components[0] == candidate
+	}
+	if !root {
+		return false
+	}
+	for _, component := range components[1:] {
+		if component == "" || ledgerAccountComponent(component) != component {
+			return false
+		}
+	}
+	return true
+}
+
+// ledgerExportWriter writes a Beancount or Ledger journal with one transaction per exported
+// transaction. Postings keep the original currency and carry the exchange rate to the base currency
+// as a price. Lines of a split transaction arrive together and are collected before writing.
+type ledgerExportWriter struct {
+	w         io.Writer
+	beancount bool
+	accounts  ledgerAccounts
+	opened    map[string]bool
+	pending   []exportLine
+}
+
+func newLedgerExportWriter(format string, w io.Writer, accounts ledgerAccounts) *ledgerExportWriter {
+	return &ledgerExportWriter{
+		w:         w,
+		beancount: format == ExportFormatBeancount,
+		accounts:  accounts,
+		opened:    map[string]bool{},
+	}
+}
+
+func (w *ledgerExportWriter) Write(line exportLine) error {
+	if len(w.pending) > 0 && w.pending[0].TransactionID != line.TransactionID {
+		if err := w.flush(); err != nil {
+			return err
+		}
+	}
+	w.pending = append(w.pending, line)
+	return nil
+}
+
+func (w *ledgerExportWriter) Close() error {
+	return w.flush()
+}
+
+type ledgerPosting struct {
+	account string
+	cents   int64
+}
+
+func (w *ledgerExportWriter) flush() error {
+	if len(w.pending) == 0 {
+		return nil
+	}
+	lines := w.pending
+	w.pending = nil
+	return w.writeTransaction(lines)
+}
+
+// writeTransaction writes the postings of one transaction: a counterpart per line, balanced by a
+// single posting on the source account.
+func (w *ledgerExportWriter) writeTransaction(lines []exportLine) error {
+	first := lines[0]
+	currency := normalizeCurrency(first.Currency)
+	if currency == "" {
+		currency = defaultCurrency
+	}
+	date := first.PostedDate.Format("2006-01-02")
+
+	source := w.accounts.source(first)
+	postings := make([]ledgerPosting, 0, len(lines)+1)
+	var total int64
+	for _, line := range lines {
+		postings = append(postings, ledgerPosting{account: w.accounts.counterpart(line), cents: -line.AmountCents})
+		total += line.AmountCents
+	}
+	postings = append(postings, ledgerPosting{account: source, cents: total})
+
+	var b strings.Builder
+	if w.beancount {
+		for _, posting := range postings {
+			if !w.opened[posting.account] {
+				w.opened[posting.account] = true
+				fmt.Fprintf(&b, "%s open %s\n", date, posting.account)
+			}
+		}
+		fmt.Fprintf(&b, "%s * %s\n", date, beancountString(first.Description))
+		fmt.Fprintf(&b, "  cashtrack_id: %s\n", beancountString(strconv.FormatInt(first.TransactionID, 10)))
+	} else {
+		fmt.Fprintf(&b, "%s * %s\n", date, ledgerPayee(first.Description))
+		fmt.Fprintf(&b, "    ; cashtrack_id: %d\n", first.TransactionID)
+	}
+
+	price := ""
+	baseCurrency := normalizeCurrency(first.BaseCurrency)
+	if baseCurrency != "" && baseCurrency != currency {
+		price = fmt.Sprintf(" @ %s %s", formatFloat(first.Rate), baseCurrency)
+	}
+	indent := "    "
+	if w.beancount {
+		indent = "  "
+	}
+	for _, posting := range postings {
+		account := indent + posting.account
+		amount := formatCents(posting.cents)
+		width := max(len(amount)+2, ledgerAmountColumn-utf8.RuneCountInString(account))
+		fmt.Fprintf(&b, "%s%*s %s%s\n", account, width, amount, currency, price)
+	}
+	b.WriteString("\n")
+	_, err := io.WriteString(w.w, b.String())
+	return err
+}
+
+// beancountString quotes a value as a Beancount string on a single line.
+func beancountString(value string) string {
+	value = strings.Join(strings.Fields(value), " ")
+	value = strings.ReplaceAll(value, `\`, `\\`)
+	return `"` + strings.ReplaceAll(value, `"`, `\"`) + `"`
+}
+
+// ledgerPayee keeps a description on one line and drops semicolons, which would start a comment.
+func ledgerPayee(value string) string {
+	value = strings.ReplaceAll(value, ";", ",")
+	return strings.Join(strings.Fields(value), " ")
+}
+
+func (s *TransactionService) ListLedgerAccountMappings(ctx context.Context, req *apiv1.ListLedgerAccountMappingsRequest) (*apiv1.ListLedgerAccountMappingsResponse, error) {
+	workspace, err := requireWorkspace(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.db.Queries.ListLedgerAccountMappings(ctx, workspace.ID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	mappings := make([]*apiv1.LedgerAccountMapping, 0, len(rows))
+	for _, row := range rows {
+		mappings = append(mappings, &apiv1.LedgerAccountMapping{
+			Id:            int32(row.ID),
+			Kind:          row.Kind,
+			Source:        row.Source,
+			LedgerAccount: row.LedgerAccount,
+		})
+	}
+	return &apiv1.ListLedgerAccountMappingsResponse{Mappings: mappings}, nil
+}
+
+func (s *TransactionService) SetLedgerAccountMapping(ctx context.Context, req *apiv1.SetLedgerAccountMappingRequest) (*apiv1.SetLedgerAccountMappingResponse, error) {
+	workspace, err := requireWorkspace(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	kind := strings.TrimSpace(req.Kind)
+	source := strings.TrimSpace(req.Source)
+	if source == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("source is required"))
+	}
+	if utf8.RuneCountInString(source) > 255 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("source must be at most 255 characters"))
+	}
+	switch kind {
+	case LedgerMappingAccount, LedgerMappingCard:
+	case LedgerMappingCategory:
+		categoryID, err := strconv.ParseInt(source, 10, 32)
+		if err != nil || categoryID <= 0 {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("source must be a category id"))
+		}
+		if _, err := getCategory(ctx, s.db, workspace.ID, int32(categoryID)); err != nil {
+			if errors.Is(err, errNotFound) {
+				return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("category not found"))
+			}
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		source = strconv.FormatInt(categoryID, 10)
+	default:
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("kind must be account, card or category"))
+	}
+	ledgerAccount := strings.TrimSpace(req.LedgerAccount)
+	if utf8.RuneCountInString(ledgerAccount) > 255 || !validLedgerAccount(ledgerAccount) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("ledger_account must look like Expenses:Food, below Assets, Liabilities, Equity, Income or Expenses"))
+	}
+
+	id, err := s.db.Queries.UpsertLedgerAccountMapping(ctx, dbgen.UpsertLedgerAccountMappingParams{
+		WorkspaceID:   workspace.ID,
+		Kind:          kind,
+		Source:        source,
+		LedgerAccount: ledgerAccount,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return &apiv1.SetLedgerAccountMappingResponse{
+		Mapping: &apiv1.LedgerAccountMapping{Id: int32(id), Kind: kind, Source: source, LedgerAccount: ledgerAccount},
+	}, nil
+}
+
+func (s *TransactionService) DeleteLedgerAccountMapping(ctx context.Context, req *apiv1.DeleteLedgerAccountMappingRequest) (*apiv1.DeleteLedgerAccountMappingResponse, error) {
+	workspace, err := requireWorkspace(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	deleted, err := s.db.Queries.DeleteLedgerAccountMapping(ctx, dbgen.DeleteLedgerAccountMappingParams{ID: int64(req.Id), WorkspaceID: workspace.ID})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if deleted == 0 {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("ledger account mapping not found"))
+	}
+	return &apiv1.DeleteLedgerAccountMappingResponse{}, nil
+}
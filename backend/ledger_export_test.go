@@ -0,0 +1,247 @@
+package cashtrack
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	apiv1 "cashtrack/backend/gen/api/v1"
+	dbgen "cashtrack/backend/gen/db"
+	"connectrpc.com/connect"
+)
+
+func testLedgerLines() []exportLine {
+	groceries, salary := int64(3), int64(5)
+	date := time.Date(2024, time.March, 5, 0, 0, 0, 0, time.UTC)
+	return []exportLine{
+		{TransactionID: 7, PostedDate: date, Description: "Coop \"Basel\"", AmountCents: -1250, Currency: "EUR", BaseCurrency: "CHF", Rate: 0.94,
+			CategoryID: &groceries, CategoryPath: []string{"Living", "food & drink", "Groceries"}, CategoryPathIDs: []int64{1, 2, 3}, AccountNumber: "CH93 0076", Split: true},
+		{TransactionID: 7, PostedDate: date, Description: "Coop \"Basel\"", AmountCents: -750, Currency: "EUR", BaseCurrency: "CHF", Rate: 0.94,
+			AccountNumber: "CH93 0076", Split: true},
+		{TransactionID: 8, PostedDate: date, Description: "Salary; March", AmountCents: 500000, Currency: "CHF", BaseCurrency: "CHF", Rate: 1,
+			CategoryID: &salary, CategoryPath: []string{"Income", "Salary"}, CategoryPathIDs: []int64{6, 5}, AccountNumber: "CH93 0076"},
+		{TransactionID: 9, PostedDate: date.AddDate(0, 0, 1), Description: "To savings", AmountCents: -10000, Currency: "CHF", BaseCurrency: "CHF", Rate: 1,
+			Account: "UBS checking", AccountType: AccountTypeBank, AccountNumber: "CH93 0076", Transfer: true},
+		{TransactionID: 10, PostedDate: date.AddDate(0, 0, 2), Description: "Coop refund", AmountCents: 350, Currency: "CHF", BaseCurrency: "CHF", Rate: 1,
+			CategoryID: &groceries, CategoryPath: []string{"Living", "food & drink", "Groceries"}, CategoryPathIDs: []int64{1, 2, 3}, AccountNumber: "CH93 0076"},
+	}
+}
+
+func writeLedger(t *testing.T, format string, accounts ledgerAccounts) string {
+	t.Helper()
+	var buf bytes.Buffer
+	writer, err := newExportWriter(format, &buf, accounts)
+	if err != nil {
+		t.Fatalf("new writer: %v", err)
+	}
+	for _, line := range testLedgerLines() {
+		if err := writer.Write(line); err != nil {
+			t.Fatalf("write: %v", err)
+		}
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatalf("close: %v", err)
+	}
+	return buf.String()
+}
+
+func TestBeancountExport(t *testing.T) {
+	accounts := newLedgerAccounts([]dbgen.ListLedgerAccountMappingsRow{
+		{Kind: LedgerMappingCategory, Source: "6", LedgerAccount: "Income:Work"},
+		{Kind: LedgerMappingCategory, Source: "5", LedgerAccount: "Income:Job"},
+	})
+	expected := `2024-03-05 open Expenses:Living:Food-Drink:Groceries
+2024-03-05 open Expenses:Uncategorized
+2024-03-05 open Assets:Bank:CH93-0076
+2024-03-05 * "Coop \"Basel\""
+  cashtrack_id: "7"
+  Expenses:Living:Food-Drink:Groceries                 12.50 EUR @ 0.94 CHF
+  Expenses:Uncategorized                                7.50 EUR @ 0.94 CHF
+  Assets:Bank:CH93-0076                               -20.00 EUR @ 0.94 CHF
+
+2024-03-05 open Income:Job
+2024-03-05 * "Salary; March"
+  cashtrack_id: "8"
+  Income:Job                                        -5000.00 CHF
+  Assets:Bank:CH93-0076                              5000.00 CHF
+
+2024-03-06 open Assets:Transfers
+2024-03-06 open Assets:Bank:UBS-Checking
+2024-03-06 * "To savings"
+  cashtrack_id: "9"
+  Assets:Transfers                                    100.00 CHF
+  Assets:Bank:UBS-Checking                           -100.00 CHF
+
+2024-03-07 * "Coop refund"
+  cashtrack_id: "10"
+  Expenses:Living:Food-Drink:Groceries                 -3.50 CHF
+  Assets:Bank:CH93-0076                                 3.50 CHF
+
+`
+	got := writeLedger(t, ExportFormatBeancount, accounts)
+	if got != expected {
+		t.Fatalf("unexpected journal:\n%s", got)
+	}
+	if again := writeLedger(t, ExportFormatBeancount, accounts); again != got {
+		t.Fatalf("expected the export to be deterministic")
+	}
+}
+
+func TestLedgerExport(t *testing.T) {
+	accounts := newLedgerAccounts([]dbgen.ListLedgerAccountMappingsRow{
+		{Kind: LedgerMappingAccount, Source: "CH93 0076", LedgerAccount: "Assets:Checking"},
+		{Kind: LedgerMappingCategory, Source: "6", LedgerAccount: "Income:Work"},
+	})
+	expected := `2024-03-05 * Coop "Basel"
+    ; cashtrack_id: 7
+    Expenses:Living:Food-Drink:Groceries               12.50 EUR @ 0.94 CHF
+    Expenses:Uncategorized                              7.50 EUR @ 0.94 CHF
+    Assets:Checking                                   -20.00 EUR @ 0.94 CHF
+
+2024-03-05 * Salary, March
+    ; cashtrack_id: 8
+    Income:Work:Salary                              -5000.00 CHF
+    Assets:Checking                                  5000.00 CHF
+
+2024-03-06 * To savings
+    ; cashtrack_id: 9
+    Assets:Transfers                                  100.00 CHF
+    Assets:Checking                                  -100.00 CHF
+
+2024-03-07 * Coop refund
+    ; cashtrack_id: 10
+    Expenses:Living:Food-Drink:Groceries               -3.50 CHF
+    Assets:Checking                                     3.50 CHF
+
+`
+	if got := writeLedger(t, ExportFormatLedger, accounts); got != expected {
+		t.Fatalf("unexpected journal:\n%s", got)
+	}
+}
+
+func TestLedgerSourceAccount(t *testing.T) {
+	accounts := newLedgerAccounts([]dbgen.ListLedgerAccountMappingsRow{
+		{Kind: LedgerMappingAccount, Source: "CH93", LedgerAccount: "Assets:Checking"},
+		{Kind: LedgerMappingCard, Source: "4111", LedgerAccount: "Liabilities:Visa"},
+	})
+	tests := []struct {
+		line     exportLine
+		expected string
+	}{
+		{exportLine{AccountNumber: "CH93", CardNumber: "4111"}, "Liabilities:Visa"},
+		{exportLine{AccountNumber: "CH93", CardNumber: "5500"}, "Assets:Checking"},
+		{exportLine{AccountNumber: "CH12", Account: "savings", AccountType: AccountTypeBank}, "Assets:Bank:Savings"},
+		{exportLine{AccountNumber: "CH12", CardNumber: "5500 1234", Account: "Visa gold", AccountType: AccountTypeCard}, "Liabilities:Card:Visa-Gold"},
+		{exportLine{Account: "wallet", AccountType: AccountTypeCash}, "Assets:Cash:Wallet"},
+		{exportLine{AccountNumber: "CH12"}, "Assets:Bank:CH12"},
+		{exportLine{CardNumber: "5500 xxxx"}, "Liabilities:Card:5500-Xxxx"},
+		{exportLine{}, "Assets:Unknown"},
+	}
+	for _, test := range tests {
+		if got := accounts.source(test.line); got != test.expected {
+			t.Fatalf("source(%+v) = %q, expected %q", test.line, got, test.expected)
+		}
+	}
+}
+
+func TestLedgerAccountComponent(t *testing.T) {
+	tests := map[string]string{
+		"eating out":    "Eating-Out",
+		"Café & Bar":    "Café-Bar",
+		"2nd car":       "2nd-Car",
+		"  ":            "Unknown",
+		"ünterwegs/ÖV":  "Ünterwegs-ÖV",
+		"Kids: school!": "Kids-School",
+	}
+	for name, expected := range tests {
+		if got := ledgerAccountComponent(name); got != expected {
+			t.Fatalf("ledgerAccountComponent(%q) = %q, expected %q", name, got, expected)
+		}
+	}
+}
+
+func TestValidLedgerAccount(t *testing.T) {
+	tests := map[string]bool{
+		"Expenses:Food":           true,
+		"Assets:Bank:CH93-0076":   true,
+		"Liabilities:Visa":        true,
+		"Expenses":                false,
+		"Spending:Food":           false,
+		"Expenses:food":           false,
+		"Expenses:Eating Out":     false,
+		"Expenses::Food":          false,
+		"Income:Job:":             false,
+		"Equity:Opening-Balances": true,
+	}
+	for name, expected := range tests {
+		if got := validLedgerAccount(name); got != expected {
+			t.Fatalf("validLedgerAccount(%q) = %v, expected %v", name, got, expected)
+		}
+	}
+}
+
+func TestLedgerAccountMappings(t *testing.T) {
+	db, cleanup := openTestDB(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	createReportTables(t, db)
+	_, err := db.conn.Exec(ctx, `
+		CREATE TABLE ledger_account_mappings (
+			id bigserial PRIMARY KEY,
+			workspace_id integer NOT NULL REFERENCES workspaces(id) ON DELETE CASCADE,
+			kind varchar(16) NOT NULL,
+			source varchar(255) NOT NULL,
+			ledger_account varchar(255) NOT NULL,
+			created_at timestamptz NOT NULL DEFAULT now(),
+			UNIQUE (workspace_id, kind, source)
+		);
+	`)
+	if err != nil {
+		t.Fatalf("create ledger account mapping table: %v", err)
+	}
+	userID := createUser(t, db, "books@example.com")
+	otherID := createUser(t, db, "other@example.com")
+	var categoryID int64
+	if err := db.conn.QueryRow(ctx, `INSERT INTO categories (workspace_id, name) VALUES ($1, 'Rent') RETURNING id`, otherID).Scan(&categoryID); err != nil {
+		t.Fatalf("create category: %v", err)
+	}
+	service := &TransactionService{db: db}
+	userCtx := contextWithPersonalWorkspace(ctx, userID)
+
+	if _, err := service.SetLedgerAccountMapping(userCtx, &apiv1.SetLedgerAccountMappingRequest{Kind: LedgerMappingCategory, Source: fmt.Sprint(categoryID), LedgerAccount: "Expenses:Rent"}); connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Fatalf("expected another user's category to be rejected, got %v", err)
+	}
+	if _, err := service.SetLedgerAccountMapping(userCtx, &apiv1.SetLedgerAccountMappingRequest{Kind: LedgerMappingAccount, Source: "CH93", LedgerAccount: "Checking"}); connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Fatalf("expected an account without a root to be rejected, got %v", err)
+	}
+	first, err := service.SetLedgerAccountMapping(userCtx, &apiv1.SetLedgerAccountMappingRequest{Kind: LedgerMappingAccount, Source: " CH93 ", LedgerAccount: "Assets:Checking"})
+	if err != nil {
+		t.Fatalf("set mapping: %v", err)
+	}
+	second, err := service.SetLedgerAccountMapping(userCtx, &apiv1.SetLedgerAccountMappingRequest{Kind: LedgerMappingAccount, Source: "CH93", LedgerAccount: "Assets:UBS:Checking"})
+	if err != nil {
+		t.Fatalf("replace mapping: %v", err)
+	}
+	if second.Mapping.Id != first.Mapping.Id {
+		t.Fatalf("expected the mapping to be replaced, got %+v and %+v", first.Mapping, second.Mapping)
+	}
+
+	listed, err := service.ListLedgerAccountMappings(userCtx, &apiv1.ListLedgerAccountMappingsRequest{})
+	if err != nil {
+		t.Fatalf("list mappings: %v", err)
+	}
+	if len(listed.Mappings) != 1 || listed.Mappings[0].LedgerAccount != "Assets:UBS:Checking" {
+		t.Fatalf("unexpected mappings %+v", listed.Mappings)
+	}
+
+	otherCtx := contextWithPersonalWorkspace(ctx, otherID)
+	if _, err := service.DeleteLedgerAccountMapping(otherCtx, &apiv1.DeleteLedgerAccountMappingRequest{Id: first.Mapping.Id}); connect.CodeOf(err) != connect.CodeNotFound {
+		t.Fatalf("expected not found for another user's mapping, got %v", err)
+	}
+	if _, err := service.DeleteLedgerAccountMapping(userCtx, &apiv1.DeleteLedgerAccountMappingRequest{Id: first.Mapping.Id}); err != nil {
+		t.Fatalf("delete mapping: %v", err)
+	}
+}
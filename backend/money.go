@@ -102,3 +102,17 @@ func formatFloat(value float64) string {
 func centsFromFloat(value float64) int64 {
 	return int64(math.Round(value * 100))
 }
+
+func numericFromCents(cents int64) (pgtype.Numeric, error) {
+	return numericFromString(formatCents(cents))
+}
+
+// formatCents renders cents as a decimal with two fraction digits, e.g. -1234 as "-12.34".
+func formatCents(cents int64) string {
+	sign := ""
+	if cents < 0 {
+		sign = "-"
+		cents = -cents
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, cents/100, cents%100)
+}
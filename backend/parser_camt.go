@@ -0,0 +1,287 @@
+package cashtrack
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// CAMTParser reads ISO 20022 camt.053 statements and camt.054 notifications. Entries that are
+// not booked yet are skipped, since their amounts and references may still change.
+type CAMTParser struct{}
+
+func NewCAMTParser() *CAMTParser {
+	return &CAMTParser{}
+}
+
+func (p *CAMTParser) Name() string {
+	return "camt"
+}
+
+func (p *CAMTParser) Version() int {
+	return 1
+}
+
+func (p *CAMTParser) CanParse(sample string, filename string) bool {
+	trimmed := strings.TrimSpace(stripBOM(sample))
+	if strings.Contains(trimmed, "camt.053") || strings.Contains(trimmed, "camt.054") {
+		return true
+	}
+	return strings.EqualFold(filepath.Ext(filename), ".xml") &&
+		(strings.HasPrefix(trimmed, "<?xml") || strings.HasPrefix(trimmed, "<Document"))
+}
+
+type camtDocument struct {
+	Statements    []camtStatement `xml:"BkToCstmrStmt>Stmt"`
+	Notifications []camtStatement `xml:"BkToCstmrDbtCdtNtfctn>Ntfctn"`
+}
+
+type camtStatement struct {
+	ID      string      `xml:"Id"`
+	IBAN    string      `xml:"Acct>Id>IBAN"`
+	Other   string      `xml:"Acct>Id>Othr>Id"`
+	Entries []camtEntry `xml:"Ntry"`
+}
+
+type camtEntry struct {
+	Reference         string         `xml:"NtryRef"`
+	Amount            camtAmount     `xml:"Amt"`
+	CreditDebit       string         `xml:"CdtDbtInd"`
+	Status            camtStatus     `xml:"Sts"`
+	BookingDate       camtDate       `xml:"BookgDt"`
+	ValueDate         camtDate       `xml:"ValDt"`
+	ServicerReference string         `xml:"AcctSvcrRef"`
+	BankCode          string         `xml:"BkTxCd>Domn>Fmly>SubFmlyCd"`
+	AdditionalInfo    string         `xml:"AddtlNtryInf"`
+	Details           []camtTxDetail `xml:"NtryDtls>TxDtls"`
+}
+
+type camtAmount struct {
+	Value    string `xml:",chardata"`
+	Currency string `xml:"Ccy,attr"`
+}
+
+// camtStatus is plain text up to camt.053.001.04 and wrapped in Cd since.
+type camtStatus struct {
+	Value string `xml:",chardata"`
+	Code  string `xml:"Cd"`
+}
+
+func (s camtStatus) code() string {
+	if code := strings.TrimSpace(s.Code); code != "" {
+		return strings.ToUpper(code)
+	}
+	return strings.ToUpper(strings.TrimSpace(s.Value))
+}
+
+type camtDate struct {
+	Date     string `xml:"Dt"`
+	DateTime string `xml:"DtTm"`
+}
+
+func (d camtDate) parse() (time.Time, error) {
+	if value := strings.TrimSpace(d.Date); value != "" {
+		return time.Parse("2006-01-02", value)
+	}
+	if value := strings.TrimSpace(d.DateTime); value != "" {
+		if len(value) >= len("2006-01-02") {
+			return time.Parse("2006-01-02", value[:len("2006-01-02")])
+		}
+	}
+	return time.Time{}, errors.New("date is missing")
+}
+
+type camtTxDetail struct {
+	EndToEndID        string   `xml:"Refs>EndToEndId"`
+	ServicerReference string   `xml:"Refs>AcctSvcrRef"`
+	CreditorName      string   `xml:"RltdPties>Cdtr>Nm"`
+	CreditorPartyName string   `xml:"RltdPties>Cdtr>Pty>Nm"`
+	DebtorName        string   `xml:"RltdPties>Dbtr>Nm"`
+	DebtorPartyName   string   `xml:"RltdPties>Dbtr>Pty>Nm"`
+	Unstructured      []string `xml:"RmtInf>Ustrd"`
+	StructuredRefs    []string `xml:"RmtInf>Strd>CdtrRefInf>Ref"`
+	AdditionalInfo    string   `xml:"AddtlTxInf"`
+}
+
+func (p *CAMTParser) Parse(data []byte) (ParsedReport, error) {
+	var document camtDocument
+	if err := xml.Unmarshal(bytes.TrimPrefix(data, []byte("\ufeff")), &document); err != nil {
+		return ParsedReport{}, fmt.Errorf("read xml: %w", err)
+	}
+	statements := append(document.Statements, document.Notifications...)
+	if len(statements) == 0 {
+		return ParsedReport{}, errors.New("no camt.053 statements or camt.054 notifications found")
+	}
+
+	rowNumber := 0
+	transactions := make([]ParsedTransaction, 0)
+	var diagnostics []ParseDiagnostic
+	for _, statement := range statements {
+		accountNumber := strings.TrimSpace(statement.IBAN)
+		if accountNumber == "" {
+			accountNumber = strings.TrimSpace(statement.Other)
+		}
+		for _, entry := range statement.Entries {
+			rowNumber++
+			if status := entry.Status.code(); status != "" && status != "BOOK" {
+				continue
+			}
+
+			postedDate, err := entry.BookingDate.parse()
+			if err != nil {
+				postedDate, err = entry.ValueDate.parse()
+			}
+			if err != nil {
+				diagnostics = append(diagnostics, rowError(rowNumber, camtEntryRecord(entry), "invalid booking date: %v", err))
+				continue
+			}
+
+			var entryType string
+			switch strings.ToUpper(strings.TrimSpace(entry.CreditDebit)) {
+			case "DBIT":
+				entryType = EntryTypeDebit
+			case "CRDT":
+				entryType = EntryTypeCredit
+			default:
+				diagnostics = append(diagnostics, rowError(rowNumber, camtEntryRecord(entry), "unknown credit/debit indicator %q", entry.CreditDebit))
+				continue
+			}
+			amount, err := normalizeAmount(entry.Amount.Value, entryType)
+			if err != nil || amount == "" {
+				diagnostics = append(diagnostics, rowError(rowNumber, camtEntryRecord(entry), "invalid amount %q", entry.Amount.Value))
+				continue
+			}
+
+			meta := camtEntryMeta(entry, entryType)
+			description := camtDescription(entry, meta)
+			transactionID := strings.TrimSpace(entry.Reference)
+			if transactionID == "" {
+				transactionID = strings.TrimSpace(entry.ServicerReference)
+			}
+			if transactionID == "" {
+				transactionID = buildCAMTTransactionID(accountNumber, postedDate, description, amount, rowNumber)
+			}
+
+			transactions = append(transactions, ParsedTransaction{
+				PostedDate:          postedDate,
+				Description:         description,
+				Amount:              amount,
+				Currency:            strings.ToUpper(strings.TrimSpace(entry.Amount.Currency)),
+				TransactionID:       transactionID,
+				EntryType:           entryType,
+				SourceAccountNumber: accountNumber,
+				SourceFileRow:       rowNumber,
+				ParserName:          p.Name(),
+				ParserMeta:          meta,
+			})
+		}
+	}
+
+	return ParsedReport{ParserName: p.Name(), Transactions: transactions, Diagnostics: diagnostics}, nil
+}
+
+// camtEntryMeta collects counterparty and remittance information of all transaction details.
+// Batch entries carry several details, so values are lists.
+func camtEntryMeta(entry camtEntry, entryType string) map[string]any {
+	var counterparties, remittance, references, endToEndIDs []string
+	for _, detail := range entry.Details {
+		counterparty := detail.CreditorName + detail.CreditorPartyName
+		if entryType == EntryTypeCredit {
+			counterparty = detail.DebtorName + detail.DebtorPartyName
+		}
+		counterparties = appendTrimmed(counterparties, counterparty)
+		for _, line := range detail.Unstructured {
+			remittance = appendTrimmed(remittance, line)
+		}
+		remittance = appendTrimmed(remittance, detail.AdditionalInfo)
+		for _, reference := range detail.StructuredRefs {
+			references = appendTrimmed(references, reference)
+		}
+		if value := strings.TrimSpace(detail.EndToEndID); value != "" && value != "NOTPROVIDED" {
+			endToEndIDs = append(endToEndIDs, value)
+		}
+	}
+
+	meta := make(map[string]any)
+	if len(counterparties) > 0 {
+		meta["counterparties"] = counterparties
+	}
+	if len(remittance) > 0 {
+		meta["remittance_info"] = remittance
+	}
+	if len(references) > 0 {
+		meta["creditor_references"] = references
+	}
+	if len(endToEndIDs) > 0 {
+		meta["end_to_end_ids"] = endToEndIDs
+	}
+	if value := strings.TrimSpace(entry.ServicerReference); value != "" {
+		meta["account_servicer_reference"] = value
+	}
+	if value := strings.TrimSpace(entry.BankCode); value != "" {
+		meta["bank_transaction_code"] = value
+	}
+	if valueDate, err := entry.ValueDate.parse(); err == nil {
+		meta["value_date"] = valueDate.Format("2006-01-02")
+	}
+	if len(meta) == 0 {
+		return nil
+	}
+	return meta
+}
+
+func camtDescription(entry camtEntry, meta map[string]any) string {
+	var parts []string
+	if counterparties, ok := meta["counterparties"].([]string); ok && len(counterparties) == 1 {
+		parts = append(parts, counterparties[0])
+	}
+	parts = append(parts, entry.AdditionalInfo)
+	if remittance, ok := meta["remittance_info"].([]string); ok && len(remittance) > 0 {
+		parts = append(parts, remittance[0])
+	}
+	return joinNonEmpty(parts...)
+}
+
+// camtEntryRecord summarizes an entry for diagnostics, since there is no raw line to show.
+func camtEntryRecord(entry camtEntry) string {
+	return joinNonEmpty(
+		entry.Reference,
+		entry.BookingDate.Date+entry.BookingDate.DateTime,
+		entry.CreditDebit,
+		strings.TrimSpace(entry.Amount.Value+" "+entry.Amount.Currency),
+		entry.AdditionalInfo,
+	)
+}
+
+func appendTrimmed(items []string, value string) []string {
+	value = strings.Join(strings.Fields(value), " ")
+	if value == "" {
+		return items
+	}
+	for _, item := range items {
+		if item == value {
+			return items
+		}
+	}
+	return append(items, value)
+}
+
+func buildCAMTTransactionID(accountNumber string, postedDate time.Time, description string, amount string, rowNumber int) string {
+	hash := sha1.New()
+	hash.Write([]byte(accountNumber))
+	hash.Write([]byte("|"))
+	hash.Write([]byte(postedDate.Format("2006-01-02")))
+	hash.Write([]byte("|"))
+	hash.Write([]byte(description))
+	hash.Write([]byte("|"))
+	hash.Write([]byte(amount))
+	hash.Write([]byte("|"))
+	hash.Write([]byte(fmt.Sprint(rowNumber)))
+	return "camt-" + hex.EncodeToString(hash.Sum(nil))
+}
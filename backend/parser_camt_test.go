@@ -0,0 +1,79 @@
+package cashtrack
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCAMTParser_Parse(t *testing.T) {
+	parser := NewCAMTParser()
+	data := mustReadTestFile(t, "camt053_statement.xml")
+
+	if !parser.CanParse(reportSample(data), "statement.xml") {
+		t.Fatalf("expected camt parser to accept the statement")
+	}
+	report, err := parser.Parse(data)
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+
+	if report.ParserName != parser.Name() {
+		t.Fatalf("expected parser name %q, got %q", parser.Name(), report.ParserName)
+	}
+	if got := len(report.Transactions); got != 2 {
+		t.Fatalf("expected 2 booked transactions, got %d", got)
+	}
+
+	rent := report.Transactions[0]
+	if rent.EntryType != EntryTypeDebit || rent.Amount != "-1500.00" || rent.Currency != "CHF" {
+		t.Fatalf("unexpected rent entry %+v", rent)
+	}
+	if rent.TransactionID != "20260105000123" {
+		t.Fatalf("expected entry reference as transaction id, got %q", rent.TransactionID)
+	}
+	if rent.SourceAccountNumber != "CH9300762011623852957" {
+		t.Fatalf("expected IBAN as account number, got %q", rent.SourceAccountNumber)
+	}
+	if !sameDate(rent.PostedDate, time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("expected booking date, got %v", rent.PostedDate)
+	}
+	if rent.Description != "Immo Verwaltung AG; Miete Januar 2026" {
+		t.Fatalf("unexpected description %q", rent.Description)
+	}
+	remittance, ok := rent.ParserMeta["remittance_info"].([]string)
+	if !ok || len(remittance) != 1 || remittance[0] != "Miete Januar 2026" {
+		t.Fatalf("expected remittance info in parser meta, got %+v", rent.ParserMeta)
+	}
+
+	salary := report.Transactions[1]
+	if salary.EntryType != EntryTypeCredit || salary.Amount != "6200.50" {
+		t.Fatalf("unexpected salary entry %+v", salary)
+	}
+	if salary.TransactionID != "ZKB-20260125-12" {
+		t.Fatalf("expected servicer reference as fallback transaction id, got %q", salary.TransactionID)
+	}
+	if !sameDate(salary.PostedDate, time.Date(2026, 1, 25, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("expected booking date time to be used, got %v", salary.PostedDate)
+	}
+	if salary.SourceFileRow != 2 {
+		t.Fatalf("expected source row 2, got %d", salary.SourceFileRow)
+	}
+}
+
+func TestReportParsingServiceKeepsCSVParsersFirst(t *testing.T) {
+	service := NewReportParsingService()
+	report, err := service.Parse(mustReadTestFile(t, "ubs_account_transactions.csv"), "transactions.csv")
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if report.ParserName != NewUBSAccountParser().Name() {
+		t.Fatalf("expected ubs parser, got %q", report.ParserName)
+	}
+	report, err = service.Parse(mustReadTestFile(t, "camt053_statement.xml"), "export.XML")
+	if err != nil {
+		t.Fatalf("parse camt: %v", err)
+	}
+	if report.ParserName != NewCAMTParser().Name() {
+		t.Fatalf("expected camt parser, got %q", report.ParserName)
+	}
+}
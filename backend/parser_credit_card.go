@@ -20,6 +20,10 @@ func (p *CreditCardParser) Name() string {
 	return "credit_card_transactions"
 }
 
+func (p *CreditCardParser) Version() int {
+	return 1
+}
+
 func (p *CreditCardParser) CanParse(sample string, filename string) bool {
 	trimmed := strings.TrimSpace(sample)
 	return strings.HasPrefix(trimmed, "sep=") || strings.HasPrefix(trimmed, "Account number;")
@@ -45,6 +49,7 @@ func (p *CreditCardParser) Parse(data []byte) (ParsedReport, error) {
 	headers := headerIndex(record)
 	rowNumber := 0
 	transactions := make([]ParsedTransaction, 0)
+	var diagnostics []ParseDiagnostic
 	for {
 		record, err := reader.Read()
 		if err != nil {
@@ -65,7 +70,8 @@ func (p *CreditCardParser) Parse(data []byte) (ParsedReport, error) {
 		}
 		postedDate, err := time.Parse("02.01.2006", purchaseDateRaw)
 		if err != nil {
-			return ParsedReport{}, fmt.Errorf("parse purchase date %q: %w", purchaseDateRaw, err)
+			diagnostics = append(diagnostics, rowError(rowNumber, strings.Join(record, ";"), "invalid purchase date %q", purchaseDateRaw))
+			continue
 		}
 
 		debitRaw := fieldByHeader(headers, record, "Debit")
@@ -78,8 +84,9 @@ func (p *CreditCardParser) Parse(data []byte) (ParsedReport, error) {
 			}
 		}
 		amount, err := normalizeAmount(amountRaw, entryType)
-		if err != nil {
-			return ParsedReport{}, fmt.Errorf("parse amount %q: %w", amountRaw, err)
+		if err != nil || amount == "" {
+			diagnostics = append(diagnostics, rowError(rowNumber, strings.Join(record, ";"), "invalid amount %q", amountRaw))
+			continue
 		}
 
 		description := fieldByHeader(headers, record, "Booking text")
@@ -101,7 +108,7 @@ func (p *CreditCardParser) Parse(data []byte) (ParsedReport, error) {
 		})
 	}
 
-	return ParsedReport{ParserName: p.Name(), Transactions: transactions}, nil
+	return ParsedReport{ParserName: p.Name(), Transactions: transactions, Diagnostics: diagnostics}, nil
 }
 
 func buildCardTransactionID(accountNumber string, cardNumber string, purchaseDate string, description string, amount string) string {
@@ -51,3 +51,23 @@ func TestCreditCardParser_Parse(t *testing.T) {
 		t.Fatalf("expected description to be present")
 	}
 }
+
+func TestCreditCardParser_CollectsRowDiagnostics(t *testing.T) {
+	parser := NewCreditCardParser()
+	data := strings.Replace(string(mustReadTestFile(t, "credit_card_transactions.csv")), "25.01.2026", "2026-01-25", 1)
+
+	report, err := parser.Parse([]byte(data))
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if got := len(report.Transactions); got != 46 {
+		t.Fatalf("expected 46 transactions, got %d", got)
+	}
+	if len(report.Diagnostics) != 1 {
+		t.Fatalf("expected 1 diagnostic, got %+v", report.Diagnostics)
+	}
+	diagnostic := report.Diagnostics[0]
+	if diagnostic.Row != 1 || diagnostic.Severity != DiagnosticSeverityError || !strings.Contains(diagnostic.Record, "2026-01-25") {
+		t.Fatalf("unexpected diagnostic %+v", diagnostic)
+	}
+}
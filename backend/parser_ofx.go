@@ -0,0 +1,282 @@
+package cashtrack
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"html"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// OFXParser reads OFX 1.x (SGML) and 2.x (XML) bank and credit card statements, including
+// the QFX flavour. Both versions go through the same lenient tokenizer, since 1.x leaves
+// elements unclosed.
+type OFXParser struct{}
+
+func NewOFXParser() *OFXParser {
+	return &OFXParser{}
+}
+
+func (p *OFXParser) Name() string {
+	return "ofx"
+}
+
+func (p *OFXParser) Version() int {
+	return 1
+}
+
+func (p *OFXParser) CanParse(sample string, filename string) bool {
+	trimmed := strings.TrimSpace(stripBOM(sample))
+	if strings.HasPrefix(trimmed, "OFXHEADER:") || strings.Contains(trimmed, "<?OFX") || strings.Contains(trimmed, "<OFX>") {
+		return true
+	}
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case ".ofx", ".qfx":
+		return true
+	}
+	return false
+}
+
+func (p *OFXParser) Parse(data []byte) (ParsedReport, error) {
+	root, err := parseOFXTree(stripBOM(string(data)))
+	if err != nil {
+		return ParsedReport{}, err
+	}
+	statements := append(root.findAll("STMTRS"), root.findAll("CCSTMTRS")...)
+	if len(statements) == 0 {
+		return ParsedReport{}, errors.New("no bank or credit card statements found")
+	}
+
+	rowNumber := 0
+	transactions := make([]ParsedTransaction, 0)
+	var diagnostics []ParseDiagnostic
+	for _, statement := range statements {
+		defaultCurrency := strings.ToUpper(statement.value("CURDEF"))
+		accountNumber := statement.value("BANKACCTFROM", "ACCTID")
+		if accountNumber == "" {
+			accountNumber = statement.value("CCACCTFROM", "ACCTID")
+		}
+		list := statement.child("BANKTRANLIST")
+		if list == nil {
+			continue
+		}
+		for _, entry := range list.children("STMTTRN") {
+			rowNumber++
+
+			postedRaw := entry.value("DTPOSTED")
+			postedDate, err := parseOFXDate(postedRaw)
+			if err != nil {
+				diagnostics = append(diagnostics, rowError(rowNumber, ofxEntryRecord(entry), "invalid posted date %q", postedRaw))
+				continue
+			}
+			amountRaw := entry.value("TRNAMT")
+			trnType := strings.ToUpper(entry.value("TRNTYPE"))
+			// The sign of TRNAMT is authoritative: a POS refund is positive, a reversed CREDIT negative.
+			// TRNTYPE is kept in the parser metadata.
+			amount, err := normalizeAmount(amountRaw, "")
+			if err != nil || amount == "" {
+				diagnostics = append(diagnostics, rowError(rowNumber, ofxEntryRecord(entry), "invalid amount %q", amountRaw))
+				continue
+			}
+			entryType := EntryTypeCredit
+			if strings.HasPrefix(amount, "-") {
+				entryType = EntryTypeDebit
+			}
+
+			currency := strings.ToUpper(entry.value("CURRENCY", "CURSYM"))
+			if currency == "" {
+				currency = defaultCurrency
+			}
+			payee := entry.value("NAME")
+			if payee == "" {
+				payee = entry.value("PAYEE", "NAME")
+			}
+			memo := entry.value("MEMO")
+			description := payee
+			if memo != "" && !strings.EqualFold(memo, payee) {
+				description = joinNonEmpty(payee, memo)
+			}
+
+			transactionID := entry.value("FITID")
+			if transactionID == "" {
+				transactionID = buildOFXTransactionID(accountNumber, postedRaw, description, amount)
+			}
+
+			meta := map[string]any{"trntype": trnType}
+			for key, value := range map[string]string{
+				"payee":        payee,
+				"memo":         memo,
+				"check_number": entry.value("CHECKNUM"),
+				"ref_number":   entry.value("REFNUM"),
+			} {
+				if value != "" {
+					meta[key] = value
+				}
+			}
+
+			transactions = append(transactions, ParsedTransaction{
+				PostedDate:          postedDate,
+				Description:         description,
+				Amount:              amount,
+				Currency:            currency,
+				TransactionID:       transactionID,
+				EntryType:           entryType,
+				SourceAccountNumber: accountNumber,
+				SourceFileRow:       rowNumber,
+				ParserName:          p.Name(),
+				ParserMeta:          meta,
+			})
+		}
+	}
+
+	return ParsedReport{ParserName: p.Name(), Transactions: transactions, Diagnostics: diagnostics}, nil
+}
+
+// ofxEntryRecord renders the leaf elements of a STMTTRN back as SGML for diagnostics.
+func ofxEntryRecord(entry *ofxNode) string {
+	parts := make([]string, 0, len(entry.elements))
+	for _, element := range entry.elements {
+		if element.text != "" {
+			parts = append(parts, "<"+element.name+">"+element.text)
+		}
+	}
+	return strings.Join(parts, "")
+}
+
+// parseOFXDate reads the date part of YYYYMMDD[HHMMSS[.XXX][[offset:TZ]]].
+func parseOFXDate(value string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if len(value) < len("20060102") {
+		return time.Time{}, errors.New("date is too short")
+	}
+	return time.Parse("20060102", value[:len("20060102")])
+}
+
+func buildOFXTransactionID(accountNumber string, postedDate string, description string, amount string) string {
+	hash := sha1.New()
+	hash.Write([]byte(accountNumber))
+	hash.Write([]byte("|"))
+	hash.Write([]byte(postedDate))
+	hash.Write([]byte("|"))
+	hash.Write([]byte(description))
+	hash.Write([]byte("|"))
+	hash.Write([]byte(amount))
+	return "ofx-" + hex.EncodeToString(hash.Sum(nil))
+}
+
+type ofxNode struct {
+	name     string
+	text     string
+	elements []*ofxNode
+}
+
+func (n *ofxNode) child(name string) *ofxNode {
+	for _, element := range n.elements {
+		if element.name == name {
+			return element
+		}
+	}
+	return nil
+}
+
+func (n *ofxNode) children(name string) []*ofxNode {
+	var result []*ofxNode
+	for _, element := range n.elements {
+		if element.name == name {
+			result = append(result, element)
+		}
+	}
+	return result
+}
+
+// value follows the path of element names and returns the text of the last one.
+func (n *ofxNode) value(path ...string) string {
+	current := n
+	for _, name := range path {
+		current = current.child(name)
+		if current == nil {
+			return ""
+		}
+	}
+	return current.text
+}
+
+func (n *ofxNode) findAll(name string) []*ofxNode {
+	var result []*ofxNode
+	for _, element := range n.elements {
+		if element.name == name {
+			result = append(result, element)
+			continue
+		}
+		result = append(result, element.findAll(name)...)
+	}
+	return result
+}
+
+// parseOFXTree builds an element tree from the <OFX> body. An element followed by text is a
+// leaf, whether or not it is closed; a closing tag pops everything up to its opening element.
+func parseOFXTree(data string) (*ofxNode, error) {
+	start := strings.Index(data, "<OFX>")
+	if start < 0 {
+		return nil, errors.New("missing <OFX> element")
+	}
+	root := &ofxNode{}
+	stack := []*ofxNode{root}
+	rest := data[start:]
+	for {
+		open := strings.IndexByte(rest, '<')
+		if open < 0 {
+			break
+		}
+		end := strings.IndexByte(rest[open:], '>')
+		if end < 0 {
+			return nil, errors.New("unterminated tag")
+		}
+		tag := strings.TrimSpace(rest[open+1 : open+end])
+		rest = rest[open+end+1:]
+		if tag == "" || strings.HasPrefix(tag, "?") || strings.HasPrefix(tag, "!") {
+			continue
+		}
+
+		if strings.HasPrefix(tag, "/") {
+			name := strings.ToUpper(strings.TrimSpace(tag[1:]))
+			for i := len(stack) - 1; i > 0; i-- {
+				if stack[i].name == name {
+					stack = stack[:i]
+					break
+				}
+			}
+			continue
+		}
+
+		selfClosing := strings.HasSuffix(tag, "/")
+		name := strings.ToUpper(strings.TrimSpace(strings.TrimSuffix(tag, "/")))
+		if fields := strings.Fields(name); len(fields) > 0 {
+			name = fields[0]
+		}
+		node := &ofxNode{name: name}
+		parent := stack[len(stack)-1]
+		parent.elements = append(parent.elements, node)
+		if selfClosing {
+			continue
+		}
+
+		next := strings.IndexByte(rest, '<')
+		if next < 0 {
+			next = len(rest)
+		}
+		text := strings.TrimSpace(rest[:next])
+		if text == "" {
+			stack = append(stack, node)
+			continue
+		}
+		node.text = html.UnescapeString(text)
+		rest = rest[next:]
+		if closing := "</" + name + ">"; len(rest) >= len(closing) && strings.EqualFold(rest[:len(closing)], closing) {
+			rest = rest[len(closing):]
+		}
+	}
+	return root, nil
+}
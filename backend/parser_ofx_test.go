@@ -0,0 +1,107 @@
+package cashtrack
+
+import (
+	"testing"
+	"time"
+)
+
+func TestOFXParser_ParseSGML(t *testing.T) {
+	parser := NewOFXParser()
+	data := mustReadTestFile(t, "ofx1_statement.ofx")
+
+	if !parser.CanParse(reportSample(data), "export.txt") {
+		t.Fatalf("expected ofx parser to recognize the OFX 1.x header")
+	}
+	report, err := parser.Parse(data)
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if got := len(report.Transactions); got != 3 {
+		t.Fatalf("expected 3 transactions, got %d", got)
+	}
+
+	first := report.Transactions[0]
+	if first.EntryType != EntryTypeDebit || first.Amount != "-54.20" || first.Currency != "USD" {
+		t.Fatalf("unexpected first transaction %+v", first)
+	}
+	if first.TransactionID != "2026010301" {
+		t.Fatalf("expected FITID as transaction id, got %q", first.TransactionID)
+	}
+	if first.SourceAccountNumber != "000123456789" {
+		t.Fatalf("expected ACCTID as account number, got %q", first.SourceAccountNumber)
+	}
+	if first.Description != "WHOLE FOODS MARKET; Groceries & household" {
+		t.Fatalf("unexpected description %q", first.Description)
+	}
+	if first.ParserMeta["memo"] != "Groceries & household" || first.ParserMeta["trntype"] != "POS" {
+		t.Fatalf("unexpected parser meta %+v", first.ParserMeta)
+	}
+	if !sameDate(first.PostedDate, time.Date(2026, 1, 3, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("unexpected posted date %v", first.PostedDate)
+	}
+
+	if salary := report.Transactions[1]; salary.EntryType != EntryTypeCredit || salary.Amount != "3200.00" {
+		t.Fatalf("unexpected direct deposit %+v", salary)
+	}
+	transfer := report.Transactions[2]
+	if transfer.EntryType != EntryTypeDebit || transfer.Description != "TRANSFER TO SAVINGS" {
+		t.Fatalf("expected transfer direction from amount sign, got %+v", transfer)
+	}
+}
+
+func TestOFXParser_ParseXML(t *testing.T) {
+	parser := NewOFXParser()
+	data := mustReadTestFile(t, "ofx2_statement.xml")
+
+	if !parser.CanParse(reportSample(data), "export.xml") {
+		t.Fatalf("expected ofx parser to recognize the OFX 2.x header")
+	}
+	report, err := NewReportParsingService().Parse(data, "export.xml")
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if report.ParserName != parser.Name() {
+		t.Fatalf("expected ofx parser to win over camt, got %q", report.ParserName)
+	}
+	if got := len(report.Transactions); got != 2 {
+		t.Fatalf("expected 2 transactions, got %d", got)
+	}
+
+	purchase := report.Transactions[0]
+	if purchase.Description != "Cafe Central" || purchase.Currency != "EUR" || purchase.SourceAccountNumber != "4111XXXXXXXX1111" {
+		t.Fatalf("unexpected purchase %+v", purchase)
+	}
+	if _, ok := purchase.ParserMeta["memo"]; ok {
+		t.Fatalf("expected empty memo to be left out, got %+v", purchase.ParserMeta)
+	}
+	refund := report.Transactions[1]
+	if refund.EntryType != EntryTypeCredit || refund.Amount != "25.00" || refund.Currency != "CHF" {
+		t.Fatalf("unexpected refund %+v", refund)
+	}
+}
+
+func TestOFXParser_KeepsAmountSign(t *testing.T) {
+	report, err := NewOFXParser().Parse(mustReadTestFile(t, "ofx1_reversals.ofx"))
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if got := len(report.Transactions); got != 3 {
+		t.Fatalf("expected 3 transactions, got %d", got)
+	}
+
+	expected := []struct {
+		trnType   string
+		entryType string
+		amount    string
+	}{
+		{"POS", EntryTypeCredit, "19.90"},
+		{"CREDIT", EntryTypeDebit, "-25.00"},
+		{"PAYMENT", EntryTypeDebit, "-80.00"},
+	}
+	for i, want := range expected {
+		got := report.Transactions[i]
+		if got.Amount != want.amount || got.EntryType != want.entryType || got.ParserMeta["trntype"] != want.trnType {
+			t.Fatalf("transaction %d: expected %s %s, got %+v", i, want.trnType, want.amount, got)
+		}
+	}
+}
@@ -18,6 +18,11 @@ func (p *UBSAccountParser) Name() string {
 	return "ubs_account_transactions"
 }
 
+// Version 2 reads the opening and closing balances from the statement header.
+func (p *UBSAccountParser) Version() int {
+	return 2
+}
+
 func (p *UBSAccountParser) CanParse(sample string, filename string) bool {
 	trimmed := strings.TrimSpace(sample)
 	return strings.HasPrefix(trimmed, "Account number:") || strings.HasPrefix(trimmed, "\ufeffAccount number:")
@@ -30,11 +35,13 @@ func (p *UBSAccountParser) Parse(data []byte) (ParsedReport, error) {
 	reader.LazyQuotes = true
 
 	var accountNumber string
+	var header ubsStatementHeader
 	var headers map[string]int
 	inDataSection := false
 	rowNumber := 0
 
 	transactions := make([]ParsedTransaction, 0)
+	var diagnostics []ParseDiagnostic
 	for {
 		record, err := reader.Read()
 		if err != nil {
@@ -54,6 +61,9 @@ func (p *UBSAccountParser) Parse(data []byte) (ParsedReport, error) {
 				accountNumber = strings.TrimSpace(record[1])
 				continue
 			}
+			if len(record) > 1 {
+				header.set(first, strings.TrimSpace(record[1]))
+			}
 
 			if strings.EqualFold(first, "Trade date") {
 				headers = headerIndex(record)
@@ -66,7 +76,8 @@ func (p *UBSAccountParser) Parse(data []byte) (ParsedReport, error) {
 		postedDateRaw := fieldByHeader(headers, record, "Booking date")
 		postedDate, err := time.Parse("2006-01-02", postedDateRaw)
 		if err != nil {
-			return ParsedReport{}, fmt.Errorf("parse booking date %q: %w", postedDateRaw, err)
+			diagnostics = append(diagnostics, rowError(rowNumber, strings.Join(record, ";"), "invalid booking date %q", postedDateRaw))
+			continue
 		}
 
 		debitRaw := fieldByHeader(headers, record, "Debit")
@@ -77,7 +88,8 @@ func (p *UBSAccountParser) Parse(data []byte) (ParsedReport, error) {
 		}
 		amount, err := normalizeAmount(amountRaw, entryType)
 		if err != nil {
-			return ParsedReport{}, fmt.Errorf("parse amount %q: %w", amountRaw, err)
+			diagnostics = append(diagnostics, rowError(rowNumber, strings.Join(record, ";"), "invalid amount %q", amountRaw))
+			continue
 		}
 
 		description := joinNonEmpty(
@@ -99,7 +111,55 @@ func (p *UBSAccountParser) Parse(data []byte) (ParsedReport, error) {
 		})
 	}
 
-	return ParsedReport{ParserName: p.Name(), Transactions: transactions}, nil
+	report := ParsedReport{ParserName: p.Name(), Transactions: transactions, Diagnostics: diagnostics}
+	if accountNumber != "" {
+		report.Accounts = []ParsedAccount{header.account(accountNumber)}
+	}
+	return report, nil
+}
+
+// ubsStatementHeader holds the key-value lines UBS puts above the column headers.
+type ubsStatementHeader struct {
+	iban           string
+	from           string
+	until          string
+	openingBalance string
+	closingBalance string
+	currency       string
+}
+
+func (h *ubsStatementHeader) set(key string, value string) {
+	switch strings.ToLower(key) {
+	case "iban:":
+		h.iban = value
+	case "from:":
+		h.from = value
+	case "until:":
+		h.until = value
+	case "opening balance:":
+		h.openingBalance = value
+	case "closing balance:":
+		h.closingBalance = value
+	case "valued in:":
+		h.currency = value
+	}
+}
+
+// account turns the header into balance checkpoints. The opening balance is what the account
+// held before the first day of the period, so it is dated the day before.
+func (h ubsStatementHeader) account(number string) ParsedAccount {
+	account := ParsedAccount{Number: number, IBAN: h.iban, Currency: h.currency}
+	if from, err := time.Parse("2006-01-02", h.from); err == nil {
+		if amount, err := normalizeAmount(h.openingBalance, ""); err == nil && amount != "" {
+			account.Balances = append(account.Balances, ParsedBalance{Date: from.AddDate(0, 0, -1), Amount: amount})
+		}
+	}
+	if until, err := time.Parse("2006-01-02", h.until); err == nil {
+		if amount, err := normalizeAmount(h.closingBalance, ""); err == nil && amount != "" {
+			account.Balances = append(account.Balances, ParsedBalance{Date: until, Amount: amount})
+		}
+	}
+	return account
 }
 
 func headerIndex(headers []string) map[string]int {
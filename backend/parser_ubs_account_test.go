@@ -50,6 +50,30 @@ func TestUBSAccountParser_Parse(t *testing.T) {
 	}
 }
 
+func TestUBSAccountParser_ReadsStatementBalances(t *testing.T) {
+	report, err := NewUBSAccountParser().Parse(mustReadTestFile(t, "ubs_account_transactions.csv"))
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if len(report.Accounts) != 1 {
+		t.Fatalf("expected 1 account, got %d", len(report.Accounts))
+	}
+	account := report.Accounts[0]
+	if account.Number != "0230 00826810.40" || account.IBAN != "CH44 0023 0230 8268 1040 J" || account.Currency != "CHF" {
+		t.Fatalf("unexpected account %+v", account)
+	}
+	if len(account.Balances) != 2 {
+		t.Fatalf("expected opening and closing balances, got %+v", account.Balances)
+	}
+	opening, closing := account.Balances[0], account.Balances[1]
+	if !sameDate(opening.Date, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)) || opening.Amount != "-1449.03" {
+		t.Fatalf("unexpected opening balance %+v", opening)
+	}
+	if !sameDate(closing.Date, time.Date(2026, 1, 23, 0, 0, 0, 0, time.UTC)) || closing.Amount != "52924.96" {
+		t.Fatalf("unexpected closing balance %+v", closing)
+	}
+}
+
 func mustReadTestFile(t *testing.T, name string) []byte {
 	t.Helper()
 	path := filepath.Join("testdata", name)
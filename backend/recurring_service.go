@@ -0,0 +1,586 @@
+package cashtrack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	apiv1 "cashtrack/backend/gen/api/v1"
+	"cashtrack/backend/gen/api/v1/apiv1connect"
+	dbgen "cashtrack/backend/gen/db"
+	"connectrpc.com/connect"
+	"connectrpc.com/validate"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const (
+	RecurringCadenceWeekly  = "weekly"
+	RecurringCadenceMonthly = "monthly"
+	RecurringCadenceYearly  = "yearly"
+
+	RecurringStatusDetected  = "detected"
+	RecurringStatusConfirmed = "confirmed"
+	RecurringStatusIgnored   = "ignored"
+
+	// recurringLookbackMonths covers two yearly charges.
+	recurringLookbackMonths = 25
+	// recurringMerchantWords is how many words of a description identify the merchant. Card
+	// statements pad descriptions with the city and country, which vary less than the rest.
+	recurringMerchantWords = 3
+	// recurringAmountTolerance is how much, in percent, consecutive charges may differ and still
+	// count as the same price.
+	recurringAmountTolerance = 30
+)
+
+type recurringCadence struct {
+	name       string
+	minDays    int
+	maxDays    int
+	minCharges int
+	graceDays  int
+	nextCharge func(time.Time) time.Time
+}
+
+var recurringCadences = []recurringCadence{
+	{name: RecurringCadenceWeekly, minDays: 6, maxDays: 8, minCharges: 3, graceDays: 3, nextCharge: func(t time.Time) time.Time { return t.AddDate(0, 0, 7) }},
+	{name: RecurringCadenceMonthly, minDays: 26, maxDays: 35, minCharges: 3, graceDays: 7, nextCharge: func(t time.Time) time.Time { return t.AddDate(0, 1, 0) }},
+	{name: RecurringCadenceYearly, minDays: 350, maxDays: 380, minCharges: 2, graceDays: 30, nextCharge: func(t time.Time) time.Time { return t.AddDate(1, 0, 0) }},
+}
+
+func findRecurringCadence(name string) (recurringCadence, bool) {
+	for _, cadence := range recurringCadences {
+		if cadence.name == name {
+			return cadence, true
+		}
+	}
+	return recurringCadence{}, false
+}
+
+type RecurringService struct {
+	db *Db
+}
+
+type RecurringServiceHandler Handler
+
+func NewRecurringServiceHandler(db *Db) *RecurringServiceHandler {
+	service := &RecurringService{db: db}
+	path, handler := apiv1connect.NewRecurringServiceHandler(
+		service,
+		connect.WithInterceptors(validate.NewInterceptor(), NewAuthInterceptor(db)),
+	)
+	return &RecurringServiceHandler{Path: path, Handler: handler}
+}
+
+func (s *RecurringService) DetectRecurring(ctx context.Context, req *apiv1.DetectRecurringRequest) (*apiv1.DetectRecurringResponse, error) {
+	workspace, err := requireWorkspace(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := s.detectRecurring(ctx, workspace.ID, time.Now().UTC())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return &apiv1.DetectRecurringResponse{Created: int32(created)}, nil
+}
+
+// detectRecurring runs a detection under the import lock, so it doesn't race an import storing
+// the same series.
+func (s *RecurringService) detectRecurring(ctx context.Context, workspaceID int32, now time.Time) (int, error) {
+	tx, err := s.db.conn.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	txQueries := s.db.Queries.WithTx(tx)
+	if err := txQueries.LockWorkspaceTransactions(ctx, dbgen.LockWorkspaceTransactionsParams{Namespace: transactionsLockNamespace, WorkspaceID: workspaceID}); err != nil {
+		return 0, fmt.Errorf("lock workspace transactions: %w", err)
+	}
+	created, err := detectRecurring(ctx, txQueries, workspaceID, now)
+	if err != nil {
+		return 0, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("commit transaction: %w", err)
+	}
+	return created, nil
+}
+
+// ListRecurring returns the stored series with their charges analyzed against the cadence and
+// amount the series expects, so edits take effect without another detection run.
+func (s *RecurringService) ListRecurring(ctx context.Context, req *apiv1.ListRecurringRequest) (*apiv1.ListRecurringResponse, error) {
+	workspace, err := requireWorkspace(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	series, err := s.listRecurring(ctx, workspace.ID, req.IncludeIgnored, time.Now().UTC())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return &apiv1.ListRecurringResponse{Series: series}, nil
+}
+
+func (s *RecurringService) listRecurring(ctx context.Context, workspaceID int32, includeIgnored bool, now time.Time) ([]*apiv1.RecurringSeries, error) {
+	rows, err := s.db.Queries.ListRecurringSeries(ctx, dbgen.ListRecurringSeriesParams{
+		WorkspaceID:    workspaceID,
+		IncludeIgnored: includeIgnored,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("query recurring series: %w", err)
+	}
+	charges, err := loadRecurringCharges(ctx, s.db.Queries, workspaceID, now)
+	if err != nil {
+		return nil, err
+	}
+	groups := groupRecurringCharges(charges)
+
+	result := make([]*apiv1.RecurringSeries, 0, len(rows))
+	for _, row := range rows {
+		amount, err := numericToCents(row.Amount)
+		if err != nil {
+			return nil, fmt.Errorf("convert amount: %w", err)
+		}
+		series := &apiv1.RecurringSeries{
+			Id:       int32(row.ID),
+			Name:     row.Name,
+			Merchant: row.MerchantKey,
+			Cadence:  row.Cadence,
+			Amount:   amount,
+			Currency: row.Currency,
+			Status:   row.Status,
+		}
+		cadence, ok := findRecurringCadence(row.Cadence)
+		group := matchRecurringCluster(groups[recurringGroupKey{merchant: row.MerchantKey, currency: row.Currency}], amount)
+		if ok && len(group) > 0 {
+			analysis := analyzeRecurringSeries(group, cadence, amount, now)
+			series.ChargeCount = int32(len(group))
+			series.LastChargeDate = analysis.LastDate.Format("2006-01-02")
+			series.LastChargeAmount = analysis.LastAmount
+			series.NextChargeDate = analysis.NextDate.Format("2006-01-02")
+			series.NextChargeAmount = amount
+			series.PriceChanged = analysis.PriceChanged
+			series.Missed = analysis.Missed
+		}
+		result = append(result, series)
+	}
+	return result, nil
+}
+
+func (s *RecurringService) ConfirmRecurring(ctx context.Context, req *apiv1.ConfirmRecurringRequest) (*apiv1.ConfirmRecurringResponse, error) {
+	if err := s.setRecurringStatus(ctx, req.Id, RecurringStatusConfirmed); err != nil {
+		return nil, err
+	}
+	return &apiv1.ConfirmRecurringResponse{}, nil
+}
+
+// IgnoreRecurring hides a series. It stays stored so detection doesn't bring it back.
+func (s *RecurringService) IgnoreRecurring(ctx context.Context, req *apiv1.IgnoreRecurringRequest) (*apiv1.IgnoreRecurringResponse, error) {
+	if err := s.setRecurringStatus(ctx, req.Id, RecurringStatusIgnored); err != nil {
+		return nil, err
+	}
+	return &apiv1.IgnoreRecurringResponse{}, nil
+}
+
+func (s *RecurringService) setRecurringStatus(ctx context.Context, id int32, status string) error {
+	workspace, err := requireWorkspace(ctx)
+	if err != nil {
+		return err
+	}
+	if id == 0 {
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("id is required"))
+	}
+
+	affected, err := s.db.Queries.UpdateRecurringSeriesStatus(ctx, dbgen.UpdateRecurringSeriesStatusParams{
+		Status:      status,
+		ID:          int64(id),
+		WorkspaceID: workspace.ID,
+	})
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	if affected == 0 {
+		return connect.NewError(connect.CodeNotFound, errNotFound)
+	}
+	return nil
+}
+
+// UpdateRecurring corrects a series and confirms it. The amount is the expected charge in cents;
+// series are payments, so it is stored as a debit whatever its sign.
+func (s *RecurringService) UpdateRecurring(ctx context.Context, req *apiv1.UpdateRecurringRequest) (*apiv1.UpdateRecurringResponse, error) {
+	workspace, err := requireWorkspace(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Id == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("id is required"))
+	}
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("name is required"))
+	}
+	cadence := strings.ToLower(strings.TrimSpace(req.Cadence))
+	if _, ok := findRecurringCadence(cadence); !ok {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("cadence must be weekly, monthly or yearly"))
+	}
+	if req.Amount == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("amount is required"))
+	}
+	amountCents := req.Amount
+	if amountCents > 0 {
+		amountCents = -amountCents
+	}
+	amount, err := numericFromCents(amountCents)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	affected, err := s.db.Queries.UpdateRecurringSeries(ctx, dbgen.UpdateRecurringSeriesParams{
+		Name:        name,
+		Cadence:     cadence,
+		Amount:      amount,
+		ID:          int64(req.Id),
+		WorkspaceID: workspace.ID,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if affected == 0 {
+		return nil, connect.NewError(connect.CodeNotFound, errNotFound)
+	}
+	return &apiv1.UpdateRecurringResponse{}, nil
+}
+
+type recurringCharge struct {
+	ID          int64
+	Date        time.Time
+	Description string
+	AmountCents int64
+	Currency    string
+}
+
+type recurringGroupKey struct {
+	merchant string
+	currency string
+}
+
+type detectedRecurring struct {
+	Merchant    string
+	Name        string
+	Currency    string
+	Cadence     string
+	AmountCents int64
+	Charges     []recurringCharge
+}
+
+type recurringAnalysis struct {
+	LastDate     time.Time
+	LastAmount   int64
+	NextDate     time.Time
+	PriceChanged bool
+	Missed       bool
+}
+
+// recurringMerchant reduces a description to the words that identify the merchant: letters only,
+// lowercased, without one-letter words, so "APPLE.COM/BILL ITUNES.COM IRL" and reference numbers
+// in descriptions don't split a series.
+func recurringMerchant(description string) string {
+	words := strings.FieldsFunc(strings.ToLower(description), func(r rune) bool {
+		return !unicode.IsLetter(r)
+	})
+	merchant := make([]string, 0, recurringMerchantWords)
+	for _, word := range words {
+		if len([]rune(word)) < 2 {
+			continue
+		}
+		merchant = append(merchant, word)
+		if len(merchant) == recurringMerchantWords {
+			break
+		}
+	}
+	return strings.Join(merchant, " ")
+}
+
+// groupRecurringCharges groups the charges by merchant and currency, and each merchant's charges
+// by amount with clusterRecurringCharges.
+func groupRecurringCharges(charges []recurringCharge) map[recurringGroupKey][][]recurringCharge {
+	byMerchant := make(map[recurringGroupKey][]recurringCharge)
+	for _, charge := range charges {
+		merchant := recurringMerchant(charge.Description)
+		if merchant == "" {
+			continue
+		}
+		key := recurringGroupKey{merchant: merchant, currency: charge.Currency}
+		byMerchant[key] = append(byMerchant[key], charge)
+	}
+	groups := make(map[recurringGroupKey][][]recurringCharge, len(byMerchant))
+	for key, group := range byMerchant {
+		sort.SliceStable(group, func(i, j int) bool {
+			if !group[i].Date.Equal(group[j].Date) {
+				return group[i].Date.Before(group[j].Date)
+			}
+			return group[i].ID < group[j].ID
+		})
+		groups[key] = clusterRecurringCharges(group)
+	}
+	return groups
+}
+
+// clusterRecurringCharges splits a merchant's charges, sorted by date, by amount, so two
+// subscriptions billed under one merchant become two series. Each charge joins the cluster whose
+// latest charge is closest in amount within recurringAmountTolerance. A cluster that starts after
+// another cluster of at least two charges has ended continues it at a new price, once.
+func clusterRecurringCharges(group []recurringCharge) [][]recurringCharge {
+	var clusters [][]recurringCharge
+	for _, charge := range group {
+		closest := -1
+		var closestDiff int64
+		for i, cluster := range clusters {
+			previous := cluster[len(cluster)-1].AmountCents
+			if !recurringSamePrice(previous, charge.AmountCents) {
+				continue
+			}
+			if diff := absCents(charge.AmountCents - previous); closest < 0 || diff < closestDiff {
+				closest, closestDiff = i, diff
+			}
+		}
+		if closest < 0 {
+			clusters = append(clusters, []recurringCharge{charge})
+			continue
+		}
+		clusters[closest] = append(clusters[closest], charge)
+	}
+
+	merged := make([][]recurringCharge, 0, len(clusters))
+	changed := make([]bool, 0, len(clusters))
+	for _, cluster := range clusters {
+		previous := -1
+		for i, candidate := range merged {
+			last := candidate[len(candidate)-1].Date
+			if changed[i] || len(candidate) < 2 || !cluster[0].Date.After(last) {
+				continue
+			}
+			if previous < 0 || last.After(merged[previous][len(merged[previous])-1].Date) {
+				previous = i
+			}
+		}
+		if previous < 0 {
+			merged = append(merged, cluster)
+			changed = append(changed, false)
+			continue
+		}
+		merged[previous] = append(merged[previous], cluster...)
+		changed[previous] = true
+	}
+	return merged
+}
+
+// matchRecurringCluster returns the cluster a stored series follows: the one with a charge
+// closest to the amount the series expects.
+func matchRecurringCluster(clusters [][]recurringCharge, amountCents int64) []recurringCharge {
+	var match []recurringCharge
+	var matchDiff int64
+	for _, cluster := range clusters {
+		for _, charge := range cluster {
+			if diff := absCents(charge.AmountCents - amountCents); match == nil || diff < matchDiff {
+				match, matchDiff = cluster, diff
+			}
+		}
+	}
+	return match
+}
+
+// detectRecurringSeries finds charges repeating on a regular cadence. A cluster qualifies when at
+// least three quarters of the gaps between charges fit one cadence and its amounts keep to at most
+// two price levels, which lets a price change through but not the varying amounts of a shop visited
+// every week. Clusters must be sorted by date.
+func detectRecurringSeries(groups map[recurringGroupKey][][]recurringCharge) []detectedRecurring {
+	detected := make([]detectedRecurring, 0)
+	for key, clusters := range groups {
+		for _, group := range clusters {
+			if series, ok := detectRecurringCluster(key, group); ok {
+				detected = append(detected, series)
+			}
+		}
+	}
+	sort.Slice(detected, func(i, j int) bool {
+		if detected[i].Merchant != detected[j].Merchant {
+			return detected[i].Merchant < detected[j].Merchant
+		}
+		if detected[i].Currency != detected[j].Currency {
+			return detected[i].Currency < detected[j].Currency
+		}
+		return detected[i].Charges[0].ID < detected[j].Charges[0].ID
+	})
+	return detected
+}
+
+func detectRecurringCluster(key recurringGroupKey, group []recurringCharge) (detectedRecurring, bool) {
+	if _, ok := recurringPriceChange(group); !ok {
+		return detectedRecurring{}, false
+	}
+	for _, cadence := range recurringCadences {
+		if len(group) < cadence.minCharges {
+			continue
+		}
+		fitting := 0
+		for i := 1; i < len(group); i++ {
+			gap := daysApart(group[i].Date, group[i-1].Date)
+			if gap >= cadence.minDays && gap <= cadence.maxDays {
+				fitting++
+			}
+		}
+		if fitting*4 < (len(group)-1)*3 {
+			continue
+		}
+		last := group[len(group)-1]
+		return detectedRecurring{
+			Merchant:    key.merchant,
+			Name:        recurringName(last.Description),
+			Currency:    key.currency,
+			Cadence:     cadence.name,
+			AmountCents: last.AmountCents,
+			Charges:     group,
+		}, true
+	}
+	return detectedRecurring{}, false
+}
+
+// recurringName is the default series name: the latest description with its padding collapsed.
+func recurringName(description string) string {
+	name := []rune(strings.Join(strings.Fields(description), " "))
+	if len(name) > 255 {
+		name = name[:255]
+	}
+	return string(name)
+}
+
+// recurringPriceChange returns the index of the charge that moved the series to a new price, or 0
+// when it kept one price. Each charge within recurringAmountTolerance of the one before stays at the
+// current price. A single step beyond it is a price change, but only once the old price was charged
+// twice; ok is false for any other group.
+func recurringPriceChange(group []recurringCharge) (index int, ok bool) {
+	for i := 1; i < len(group); i++ {
+		if recurringSamePrice(group[i-1].AmountCents, group[i].AmountCents) {
+			continue
+		}
+		if index > 0 || i < 2 {
+			return 0, false
+		}
+		index = i
+	}
+	return index, true
+}
+
+// recurringSamePrice reports whether current is within recurringAmountTolerance of previous.
+func recurringSamePrice(previous, current int64) bool {
+	return absCents(current-previous)*100 <= absCents(previous)*recurringAmountTolerance
+}
+
+func absCents(cents int64) int64 {
+	if cents < 0 {
+		return -cents
+	}
+	return cents
+}
+
+// analyzeRecurringSeries predicts the next charge of a series from its last one. The price
+// changed when the last charge differs from the amount the series expects or is the one that moved
+// the series to a new price, and a charge is missed once the predicted date is more than the
+// cadence's grace period in the past.
+func analyzeRecurringSeries(group []recurringCharge, cadence recurringCadence, expectedCents int64, now time.Time) recurringAnalysis {
+	last := group[len(group)-1]
+	next := cadence.nextCharge(last.Date)
+	change, _ := recurringPriceChange(group)
+	return recurringAnalysis{
+		LastDate:     last.Date,
+		LastAmount:   last.AmountCents,
+		NextDate:     next,
+		PriceChanged: last.AmountCents != expectedCents || (change > 0 && change == len(group)-1),
+		Missed:       now.After(next.AddDate(0, 0, cadence.graceDays)),
+	}
+}
+
+func loadRecurringCharges(ctx context.Context, queries *dbgen.Queries, workspaceID int32, now time.Time) ([]recurringCharge, error) {
+	since := now.AddDate(0, -recurringLookbackMonths, 0)
+	rows, err := queries.ListRecurringCandidates(ctx, dbgen.ListRecurringCandidatesParams{
+		WorkspaceID: workspaceID,
+		PostedDate:  pgtype.Date{Time: since, Valid: true},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("query recurring candidates: %w", err)
+	}
+	charges := make([]recurringCharge, 0, len(rows))
+	for _, row := range rows {
+		amount, err := numericToCents(row.Amount)
+		if err != nil {
+			return nil, fmt.Errorf("convert amount: %w", err)
+		}
+		charges = append(charges, recurringCharge{
+			ID:          row.ID,
+			Date:        row.PostedDate.Time,
+			Description: row.Description,
+			AmountCents: amount,
+			Currency:    normalizeCurrency(row.Currency),
+		})
+	}
+	return charges, nil
+}
+
+// detectRecurring stores newly detected series. A cluster a stored series already follows, as
+// matched by matchRecurringCluster, is skipped, so stored series, including ignored ones, keep
+// their status and edits. Callers hold the import lock.
+func detectRecurring(ctx context.Context, queries *dbgen.Queries, workspaceID int32, now time.Time) (int, error) {
+	charges, err := loadRecurringCharges(ctx, queries, workspaceID, now)
+	if err != nil {
+		return 0, err
+	}
+	groups := groupRecurringCharges(charges)
+	stored, err := queries.ListRecurringSeries(ctx, dbgen.ListRecurringSeriesParams{
+		WorkspaceID:    workspaceID,
+		IncludeIgnored: true,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("query recurring series: %w", err)
+	}
+	followed := make(map[int64]bool, len(stored))
+	for _, row := range stored {
+		amount, err := numericToCents(row.Amount)
+		if err != nil {
+			return 0, fmt.Errorf("convert amount: %w", err)
+		}
+		if cluster := matchRecurringCluster(groups[recurringGroupKey{merchant: row.MerchantKey, currency: row.Currency}], amount); len(cluster) > 0 {
+			followed[cluster[0].ID] = true
+		}
+	}
+
+	created := 0
+	for _, series := range detectRecurringSeries(groups) {
+		if followed[series.Charges[0].ID] {
+			continue
+		}
+		amount, err := numericFromCents(series.AmountCents)
+		if err != nil {
+			return created, fmt.Errorf("convert amount: %w", err)
+		}
+		inserted, err := queries.CreateRecurringSeries(ctx, dbgen.CreateRecurringSeriesParams{
+			WorkspaceID: workspaceID,
+			MerchantKey: series.Merchant,
+			Name:        series.Name,
+			Currency:    series.Currency,
+			Cadence:     series.Cadence,
+			Amount:      amount,
+		})
+		if err != nil {
+			return created, fmt.Errorf("insert recurring series: %w", err)
+		}
+		created += int(inserted)
+	}
+	return created, nil
+}
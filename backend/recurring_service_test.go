@@ -0,0 +1,217 @@
+package cashtrack
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	apiv1 "cashtrack/backend/gen/api/v1"
+)
+
+func TestRecurringMerchant(t *testing.T) {
+	tests := map[string]string{
+		"APPLE.COM/BILL ITUNES.COM IRL":               "apple com bill",
+		"OPENAI *CHATGPT SUBSCR   OPENAI.COM   USACA": "openai chatgpt subscr",
+		"Starbucks 80091          Zurich       CHE":   "starbucks zurich che",
+		"12345 / 67": "",
+	}
+	for description, expected := range tests {
+		if got := recurringMerchant(description); got != expected {
+			t.Fatalf("recurringMerchant(%q) = %q, expected %q", description, got, expected)
+		}
+	}
+}
+
+func TestDetectRecurringSeries(t *testing.T) {
+	date := func(month time.Month, day int) time.Time {
+		return time.Date(2026, month, day, 0, 0, 0, 0, time.UTC)
+	}
+	charges := []recurringCharge{
+		// Monthly subscription with a price change.
+		{ID: 1, Date: date(1, 25), Description: "APPLE.COM/BILL ITUNES.COM IRL", AmountCents: -1000, Currency: "CHF"},
+		{ID: 2, Date: date(2, 25), Description: "APPLE.COM/BILL ITUNES.COM IRL", AmountCents: -1000, Currency: "CHF"},
+		{ID: 3, Date: date(3, 26), Description: "APPLE.COM/BILL ITUNES.COM IRL", AmountCents: -1200, Currency: "CHF"},
+		// Weekly, but the amounts vary too much to be a subscription.
+		{ID: 4, Date: date(1, 5), Description: "UBER   *EATS             HELP.UBER.COMNLD", AmountCents: -9995, Currency: "CHF"},
+		{ID: 5, Date: date(1, 11), Description: "UBER   *EATS             HELP.UBER.COMNLD", AmountCents: -4839, Currency: "CHF"},
+		{ID: 6, Date: date(1, 18), Description: "UBER   *EATS             HELP.UBER.COMNLD", AmountCents: -5328, Currency: "CHF"},
+		// Weekly pass.
+		{ID: 7, Date: date(1, 1), Description: "SBB EasyRide Bern", AmountCents: -590, Currency: "CHF"},
+		{ID: 8, Date: date(1, 8), Description: "SBB EasyRide Bern", AmountCents: -590, Currency: "CHF"},
+		{ID: 9, Date: date(1, 15), Description: "SBB EasyRide Bern", AmountCents: -590, Currency: "CHF"},
+		// Regular amounts, irregular dates.
+		{ID: 10, Date: date(1, 2), Description: "kkiosk Zuerich", AmountCents: -385, Currency: "CHF"},
+		{ID: 11, Date: date(1, 20), Description: "kkiosk Zuerich", AmountCents: -385, Currency: "CHF"},
+		{ID: 12, Date: date(3, 1), Description: "kkiosk Zuerich", AmountCents: -385, Currency: "CHF"},
+	}
+
+	detected := detectRecurringSeries(groupRecurringCharges(charges))
+	if len(detected) != 2 {
+		t.Fatalf("expected 2 series, got %+v", detected)
+	}
+	apple, sbb := detected[0], detected[1]
+	if apple.Merchant != "apple com bill" || apple.Cadence != RecurringCadenceMonthly || apple.AmountCents != -1200 || apple.Name != "APPLE.COM/BILL ITUNES.COM IRL" {
+		t.Fatalf("unexpected monthly series %+v", apple)
+	}
+	if sbb.Merchant != "sbb easyride bern" || sbb.Cadence != RecurringCadenceWeekly {
+		t.Fatalf("unexpected weekly series %+v", sbb)
+	}
+}
+
+func TestDetectRecurringSeriesAllowsOnePriceChange(t *testing.T) {
+	date := func(month time.Month) time.Time {
+		return time.Date(2026, month, 3, 0, 0, 0, 0, time.UTC)
+	}
+	netflix := func(id int64, month time.Month, cents int64) recurringCharge {
+		return recurringCharge{ID: id, Date: date(month), Description: "NETFLIX.COM", AmountCents: cents, Currency: "USD"}
+	}
+	charges := []recurringCharge{netflix(1, 1, -999), netflix(2, 2, -999), netflix(3, 3, -999), netflix(4, 4, -1499)}
+
+	monthly, _ := findRecurringCadence(RecurringCadenceMonthly)
+	detected := detectRecurringSeries(groupRecurringCharges(charges))
+	if len(detected) != 1 || detected[0].AmountCents != -1499 || detected[0].Cadence != RecurringCadenceMonthly {
+		t.Fatalf("expected the series to survive the price change, got %+v", detected)
+	}
+	analysis := analyzeRecurringSeries(charges, monthly, detected[0].AmountCents, date(4))
+	if !analysis.PriceChanged {
+		t.Fatalf("expected the last charge to report the new price, got %+v", analysis)
+	}
+
+	charges = append(charges, netflix(5, 5, -1499))
+	if analysis := analyzeRecurringSeries(charges, monthly, -1499, date(5)); analysis.PriceChanged {
+		t.Fatalf("expected the new price to be settled after a second charge, got %+v", analysis)
+	}
+
+	charges = append(charges, netflix(6, 6, -999))
+	if detected := detectRecurringSeries(groupRecurringCharges(charges)); len(detected) != 0 {
+		t.Fatalf("expected a second price change to break the series, got %+v", detected)
+	}
+}
+
+func TestDetectRecurringSeriesSplitsOneMerchantByAmount(t *testing.T) {
+	date := func(month time.Month, day int) time.Time {
+		return time.Date(2026, month, day, 0, 0, 0, 0, time.UTC)
+	}
+	apple := func(id int64, month time.Month, day int, cents int64) recurringCharge {
+		return recurringCharge{ID: id, Date: date(month, day), Description: "APPLE.COM/BILL ITUNES.COM IRL", AmountCents: cents, Currency: "CHF"}
+	}
+	charges := []recurringCharge{
+		apple(1, 1, 4, -99), apple(2, 1, 18, -1099),
+		apple(3, 2, 4, -99), apple(4, 2, 18, -1099),
+		apple(5, 3, 4, -99), apple(6, 3, 18, -1099),
+		apple(7, 4, 4, -99),
+	}
+
+	groups := groupRecurringCharges(charges)
+	detected := detectRecurringSeries(groups)
+	if len(detected) != 2 {
+		t.Fatalf("expected 2 series, got %+v", detected)
+	}
+	icloud, music := detected[0], detected[1]
+	if icloud.Merchant != "apple com bill" || icloud.Cadence != RecurringCadenceMonthly || icloud.AmountCents != -99 || len(icloud.Charges) != 4 {
+		t.Fatalf("unexpected series %+v", icloud)
+	}
+	if music.Merchant != "apple com bill" || music.Cadence != RecurringCadenceMonthly || music.AmountCents != -1099 || len(music.Charges) != 3 {
+		t.Fatalf("unexpected series %+v", music)
+	}
+
+	clusters := groups[recurringGroupKey{merchant: "apple com bill", currency: "CHF"}]
+	if match := matchRecurringCluster(clusters, -1199); len(match) != 3 || match[0].ID != 2 {
+		t.Fatalf("expected a stored series at a nearby price to follow the 10.99 charges, got %+v", match)
+	}
+}
+
+func TestAnalyzeRecurringSeries(t *testing.T) {
+	monthly, _ := findRecurringCadence(RecurringCadenceMonthly)
+	group := []recurringCharge{
+		{ID: 1, Date: time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC), AmountCents: -1000},
+		{ID: 2, Date: time.Date(2026, 2, 28, 0, 0, 0, 0, time.UTC), AmountCents: -1200},
+	}
+
+	analysis := analyzeRecurringSeries(group, monthly, -1000, time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC))
+	if !sameDate(analysis.NextDate, time.Date(2026, 3, 28, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("unexpected next date %v", analysis.NextDate)
+	}
+	if !analysis.PriceChanged || analysis.LastAmount != -1200 {
+		t.Fatalf("expected a price change, got %+v", analysis)
+	}
+	if analysis.Missed {
+		t.Fatalf("expected the charge to be within the grace period")
+	}
+
+	analysis = analyzeRecurringSeries(group, monthly, -1200, time.Date(2026, 4, 10, 0, 0, 0, 0, time.UTC))
+	if analysis.PriceChanged || !analysis.Missed {
+		t.Fatalf("expected a missed charge at the expected price, got %+v", analysis)
+	}
+}
+
+func TestRecurringServiceKeepsIgnoredSeries(t *testing.T) {
+	db, cleanup := openTestDB(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	createSummaryTables(t, db)
+	_, err := db.conn.Exec(ctx, `
+		CREATE TABLE recurring_series (
+			id bigserial PRIMARY KEY,
+			workspace_id integer NOT NULL REFERENCES workspaces(id) ON DELETE CASCADE,
+			merchant_key varchar(255) NOT NULL,
+			name varchar(255) NOT NULL,
+			currency varchar(3) NOT NULL,
+			cadence varchar(16) NOT NULL,
+			amount numeric(18, 2) NOT NULL,
+			status varchar(16) NOT NULL DEFAULT 'detected',
+			created_at timestamptz NOT NULL DEFAULT now()
+		);
+	`)
+	if err != nil {
+		t.Fatalf("create recurring tables: %v", err)
+	}
+	userID := createUser(t, db, "recurring@example.com")
+	now := time.Now().UTC()
+	for months := 3; months >= 1; months-- {
+		_, err := db.conn.Exec(ctx, `
+			INSERT INTO transactions (workspace_id, posted_date, description, amount, currency, entry_type)
+			VALUES ($1, $2, 'APPLE.COM/BILL ITUNES.COM IRL', -10.00, 'CHF', 'debit')
+		`, userID, now.AddDate(0, -months, 0))
+		if err != nil {
+			t.Fatalf("insert transaction: %v", err)
+		}
+	}
+
+	created, err := detectRecurring(ctx, db.Queries, userID, now)
+	if err != nil {
+		t.Fatalf("detect: %v", err)
+	}
+	if created != 1 {
+		t.Fatalf("expected 1 series, got %d", created)
+	}
+
+	service := &RecurringService{db: db}
+	userCtx := contextWithPersonalWorkspace(ctx, userID)
+	listed, err := service.ListRecurring(userCtx, &apiv1.ListRecurringRequest{})
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	if len(listed.Series) != 1 {
+		t.Fatalf("expected 1 series, got %+v", listed.Series)
+	}
+	series := listed.Series[0]
+	if series.Cadence != RecurringCadenceMonthly || series.ChargeCount != 3 || series.Amount != -1000 || series.PriceChanged || series.Missed {
+		t.Fatalf("unexpected series %+v", series)
+	}
+
+	if _, err := service.IgnoreRecurring(userCtx, &apiv1.IgnoreRecurringRequest{Id: series.Id}); err != nil {
+		t.Fatalf("ignore: %v", err)
+	}
+	if created, err := detectRecurring(ctx, db.Queries, userID, now); err != nil || created != 0 {
+		t.Fatalf("expected the ignored series to stay ignored, got %d, %v", created, err)
+	}
+	listed, err = service.ListRecurring(userCtx, &apiv1.ListRecurringRequest{})
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	if len(listed.Series) != 0 {
+		t.Fatalf("expected ignored series to be hidden, got %+v", listed.Series)
+	}
+}
@@ -0,0 +1,98 @@
+package cashtrack
+
+import (
+	"context"
+	"strconv"
+	"sync"
+)
+
+// ReportEvents fans report notifications out to per-workspace subscribers. The database listener
+// only runs while somebody is subscribed.
+type ReportEvents struct {
+	db          *Db
+	mu          sync.Mutex
+	subscribers map[int32]map[chan struct{}]struct{}
+	stopListen  context.CancelFunc
+	closed      bool
+}
+
+func NewReportEvents(db *Db) *ReportEvents {
+	return &ReportEvents{
+		db:          db,
+		subscribers: make(map[int32]map[chan struct{}]struct{}),
+	}
+}
+
+// Subscribe returns a channel that receives a signal whenever any report of the workspace may have
+// changed. Signals are coalesced, so receivers must re-read the state instead of counting them.
+// The channel is closed when the events are shut down.
+func (e *ReportEvents) Subscribe(workspaceID int32) (<-chan struct{}, func()) {
+	ch := make(chan struct{}, 1)
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.closed {
+		close(ch)
+		return ch, func() {}
+	}
+	if e.subscribers[workspaceID] == nil {
+		e.subscribers[workspaceID] = make(map[chan struct{}]struct{})
+	}
+	e.subscribers[workspaceID][ch] = struct{}{}
+	if e.stopListen == nil {
+		ctx, cancel := context.WithCancel(context.Background())
+		e.stopListen = cancel
+		go listenForNotifications(ctx, e.db, []string{reportUploadedChannel, reportStatusChannel}, e.publish)
+	}
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() { e.unsubscribe(workspaceID, ch) })
+	}
+}
+
+func (e *ReportEvents) unsubscribe(workspaceID int32, ch chan struct{}) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	delete(e.subscribers[workspaceID], ch)
+	if len(e.subscribers[workspaceID]) == 0 {
+		delete(e.subscribers, workspaceID)
+	}
+	if len(e.subscribers) == 0 && e.stopListen != nil {
+		e.stopListen()
+		e.stopListen = nil
+	}
+}
+
+// publish signals the subscribers of the workspace in payload, or everyone if the payload is not a workspace id.
+func (e *ReportEvents) publish(payload string) {
+	workspaceID, err := strconv.ParseInt(payload, 10, 32)
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for subscriberID, channels := range e.subscribers {
+		if err == nil && int32(workspaceID) != subscriberID {
+			continue
+		}
+		for ch := range channels {
+			signalWake(ch)
+		}
+	}
+}
+
+// Close ends all subscriptions, so that open streams don't hold up server shutdown.
+func (e *ReportEvents) Close() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.closed = true
+	if e.stopListen != nil {
+		e.stopListen()
+		e.stopListen = nil
+	}
+	for workspaceID, channels := range e.subscribers {
+		for ch := range channels {
+			close(ch)
+		}
+		delete(e.subscribers, workspaceID)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"path/filepath"
+	"sort"
 	"strings"
 	"time"
 
@@ -14,18 +15,28 @@ import (
 	"connectrpc.com/validate"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/protobuf/proto"
 )
 
 const maxReportUploadSize = 10 << 20
 
+var allowedReportExtensions = map[string]bool{
+	".csv": true,
+	".xml": true,
+	".ofx": true,
+	".qfx": true,
+}
+
 type ReportService struct {
-	db *Db
+	db      *Db
+	events  *ReportEvents
+	parsing *ReportParsingService
 }
 
 type ReportServiceHandler Handler
 
-func NewReportServiceHandler(db *Db) *ReportServiceHandler {
-	service := &ReportService{db: db}
+func NewReportServiceHandler(db *Db, events *ReportEvents, parsing *ReportParsingService) *ReportServiceHandler {
+	service := &ReportService{db: db, events: events, parsing: parsing}
 	path, handler := apiv1connect.NewReportServiceHandler(
 		service,
 		connect.WithInterceptors(validate.NewInterceptor(), NewAuthInterceptor(db)),
@@ -34,7 +45,7 @@ func NewReportServiceHandler(db *Db) *ReportServiceHandler {
 }
 
 func (s *ReportService) UploadReport(ctx context.Context, req *apiv1.UploadReportRequest) (*apiv1.UploadReportResponse, error) {
-	user, err := requireUser(ctx)
+	workspace, err := requireWorkspace(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -43,8 +54,8 @@ func (s *ReportService) UploadReport(ctx context.Context, req *apiv1.UploadRepor
 	if filename == "" {
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("filename is required"))
 	}
-	if strings.ToLower(filepath.Ext(filename)) != ".csv" {
-		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("only csv files are allowed"))
+	if !allowedReportExtensions[strings.ToLower(filepath.Ext(filename))] {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("only csv, xml, ofx and qfx files are allowed"))
 	}
 	if len(req.Data) == 0 {
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("file is empty"))
@@ -54,49 +65,119 @@ func (s *ReportService) UploadReport(ctx context.Context, req *apiv1.UploadRepor
 	}
 
 	if err := s.db.Queries.CreateReport(ctx, dbgen.CreateReportParams{
-		UserID:      user.Id,
+		WorkspaceID: workspace.ID,
 		Filename:    filename,
 		ContentType: pgtype.Text{String: defaultContentType(req.ContentType), Valid: true},
 		Data:        req.Data,
-		Status:      "pending",
+		Status:      ReportStatusPending,
 	}); err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
+	notifyReportUploaded(ctx, s.db, workspace.ID)
 
 	return &apiv1.UploadReportResponse{}, nil
 }
 
 func (s *ReportService) ListReports(ctx context.Context, req *apiv1.ListReportsRequest) (*apiv1.ListReportsResponse, error) {
-	user, err := requireUser(ctx)
+	workspace, err := requireWorkspace(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	rows, err := s.db.Queries.ListReportsByUser(ctx, user.Id)
+	reports, err := s.listReports(ctx, workspace.ID)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
+	return &apiv1.ListReportsResponse{Reports: reports}, nil
+}
+
+// WatchReports sends the current reports and then every report that was added or changed, e.g.
+// moved from pending to processing to processed, until the client disconnects.
+func (s *ReportService) WatchReports(ctx context.Context, req *apiv1.WatchReportsRequest, stream *connect.ServerStream[apiv1.WatchReportsResponse]) error {
+	workspace, err := requireWorkspace(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Subscribe before the first listing so that no change slips in between.
+	changes, unsubscribe := s.events.Subscribe(workspace.ID)
+	defer unsubscribe()
+
+	sent := make(map[int32]*apiv1.ReportInfo)
+	for {
+		reports, err := s.listReports(ctx, workspace.ID)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return connect.NewError(connect.CodeInternal, err)
+		}
+		if err := sendReportChanges(stream, sent, reports); err != nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case _, ok := <-changes:
+			if !ok {
+				return nil
+			}
+		}
+	}
+}
+
+func sendReportChanges(stream *connect.ServerStream[apiv1.WatchReportsResponse], sent map[int32]*apiv1.ReportInfo, reports []*apiv1.ReportInfo) error {
+	current := make(map[int32]bool, len(reports))
+	for _, report := range reports {
+		current[report.Id] = true
+		if previous, ok := sent[report.Id]; ok && proto.Equal(previous, report) {
+			continue
+		}
+		if err := stream.Send(&apiv1.WatchReportsResponse{Report: report}); err != nil {
+			return err
+		}
+		sent[report.Id] = report
+	}
+	for id := range sent {
+		if current[id] {
+			continue
+		}
+		if err := stream.Send(&apiv1.WatchReportsResponse{Report: &apiv1.ReportInfo{Id: id}, Deleted: true}); err != nil {
+			return err
+		}
+		delete(sent, id)
+	}
+	return nil
+}
+
+func (s *ReportService) listReports(ctx context.Context, workspaceID int32) ([]*apiv1.ReportInfo, error) {
+	rows, err := s.db.Queries.ListReportsByWorkspace(ctx, workspaceID)
+	if err != nil {
+		return nil, err
+	}
 
 	reports := make([]*apiv1.ReportInfo, 0, len(rows))
 	for _, row := range rows {
 		report := &apiv1.ReportInfo{
-			Id:         int32(row.ID),
-			Filename:   row.Filename,
-			SizeBytes:  int32(row.SizeBytes),
-			Status:     row.Status,
-			UploadedAt: row.UploadedAt.Time.Format(time.RFC3339Nano),
+			Id:               int32(row.ID),
+			Filename:         row.Filename,
+			SizeBytes:        int32(row.SizeBytes),
+			Status:           row.Status,
+			UploadedAt:       row.UploadedAt.Time.Format(time.RFC3339Nano),
+			TransactionCount: row.TransactionCount,
+			DiagnosticCount:  row.DiagnosticCount,
 		}
 		if row.StatusDescription.Valid {
 			report.StatusDescription = row.StatusDescription.String
 		}
 		reports = append(reports, report)
 	}
-
-	return &apiv1.ListReportsResponse{Reports: reports}, nil
+	return reports, nil
 }
 
 func (s *ReportService) DownloadReport(ctx context.Context, req *apiv1.DownloadReportRequest) (*apiv1.DownloadReportResponse, error) {
-	user, err := requireUser(ctx)
+	workspace, err := requireWorkspace(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -106,8 +187,8 @@ func (s *ReportService) DownloadReport(ctx context.Context, req *apiv1.DownloadR
 	}
 
 	report, err := s.db.Queries.GetReportByID(ctx, dbgen.GetReportByIDParams{
-		ID:     int64(req.Id),
-		UserID: user.Id,
+		ID:          int64(req.Id),
+		WorkspaceID: workspace.ID,
 	})
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -128,6 +209,148 @@ func (s *ReportService) DownloadReport(ctx context.Context, req *apiv1.DownloadR
 	}, nil
 }
 
+func (s *ReportService) GetReportDiagnostics(ctx context.Context, req *apiv1.GetReportDiagnosticsRequest) (*apiv1.GetReportDiagnosticsResponse, error) {
+	workspace, err := requireWorkspace(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Id == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("id is required"))
+	}
+	exists, err := s.db.Queries.ReportExists(ctx, dbgen.ReportExistsParams{
+		ID:          int64(req.Id),
+		WorkspaceID: workspace.ID,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if !exists {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("file not found"))
+	}
+
+	rows, err := s.db.Queries.ListReportDiagnostics(ctx, dbgen.ListReportDiagnosticsParams{
+		ReportID:    int64(req.Id),
+		WorkspaceID: workspace.ID,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	diagnostics := make([]*apiv1.ReportDiagnostic, 0, len(rows))
+	for _, row := range rows {
+		diagnostics = append(diagnostics, &apiv1.ReportDiagnostic{
+			Row:      row.RowNumber,
+			Severity: row.Severity,
+			Record:   row.RawRecord,
+			Reason:   row.Reason,
+		})
+	}
+	return &apiv1.GetReportDiagnosticsResponse{Diagnostics: diagnostics}, nil
+}
+
+// ReprocessReport queues a processed or failed report to be parsed again from its stored data.
+// Reports that are still pending or processing are left alone.
+func (s *ReportService) ReprocessReport(ctx context.Context, req *apiv1.ReprocessReportRequest) (*apiv1.ReprocessReportResponse, error) {
+	workspace, err := requireWorkspace(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Id == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("id is required"))
+	}
+	requeued, err := s.requeueReports(ctx, workspace.ID, []int64{int64(req.Id)})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if len(requeued) > 0 {
+		return &apiv1.ReprocessReportResponse{}, nil
+	}
+
+	exists, err := s.db.Queries.ReportExists(ctx, dbgen.ReportExistsParams{
+		ID:          int64(req.Id),
+		WorkspaceID: workspace.ID,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if !exists {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("file not found"))
+	}
+	return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("report is already queued for processing"))
+}
+
+// ReprocessReports queues the given reports, and with outdated set every report whose
+// transactions were parsed by an older version of a built-in parser.
+func (s *ReportService) ReprocessReports(ctx context.Context, req *apiv1.ReprocessReportsRequest) (*apiv1.ReprocessReportsResponse, error) {
+	workspace, err := requireWorkspace(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(req.Ids) == 0 && !req.Outdated {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("ids or outdated is required"))
+	}
+	ids := make([]int64, 0, len(req.Ids))
+	for _, id := range req.Ids {
+		ids = append(ids, int64(id))
+	}
+	if req.Outdated {
+		outdated, err := s.outdatedReports(ctx, workspace.ID)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		ids = append(ids, outdated...)
+	}
+
+	requeued, err := s.requeueReports(ctx, workspace.ID, ids)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	response := &apiv1.ReprocessReportsResponse{Ids: make([]int32, 0, len(requeued))}
+	for _, id := range requeued {
+		response.Ids = append(response.Ids, int32(id))
+	}
+	return response, nil
+}
+
+func (s *ReportService) requeueReports(ctx context.Context, workspaceID int32, ids []int64) ([]int64, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	requeued, err := s.db.Queries.RequeueReports(ctx, dbgen.RequeueReportsParams{
+		WorkspaceID: workspaceID,
+		Ids:         ids,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(requeued) > 0 {
+		notifyReportUploaded(ctx, s.db, workspaceID)
+		notifyReportStatus(ctx, s.db.Queries, workspaceID)
+	}
+	sort.Slice(requeued, func(i, j int) bool { return requeued[i] < requeued[j] })
+	return requeued, nil
+}
+
+func (s *ReportService) outdatedReports(ctx context.Context, workspaceID int32) ([]int64, error) {
+	versions := s.parsing.Versions()
+	names := make([]string, 0, len(versions))
+	for name := range versions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	parserVersions := make([]int32, 0, len(names))
+	for _, name := range names {
+		parserVersions = append(parserVersions, int32(versions[name]))
+	}
+	return s.db.Queries.ListOutdatedReports(ctx, dbgen.ListOutdatedReportsParams{
+		ParserNames:    names,
+		ParserVersions: parserVersions,
+		WorkspaceID:    workspaceID,
+	})
+}
+
 func defaultContentType(value string) string {
 	contentType := strings.TrimSpace(value)
 	if contentType == "" {
@@ -137,7 +360,7 @@ func defaultContentType(value string) string {
 }
 
 func (s *ReportService) DeleteReport(ctx context.Context, req *apiv1.DeleteReportRequest) (*apiv1.DeleteReportResponse, error) {
-	user, err := requireUser(ctx)
+	workspace, err := requireWorkspace(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -146,10 +369,11 @@ func (s *ReportService) DeleteReport(ctx context.Context, req *apiv1.DeleteRepor
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("id is required"))
 	}
 	if err := s.db.Queries.DeleteReportByID(ctx, dbgen.DeleteReportByIDParams{
-		ID:     int64(req.Id),
-		UserID: user.Id,
+		ID:          int64(req.Id),
+		WorkspaceID: workspace.ID,
 	}); err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
+	notifyReportStatus(ctx, s.db.Queries, workspace.ID)
 	return &apiv1.DeleteReportResponse{}, nil
 }
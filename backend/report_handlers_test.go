@@ -0,0 +1,159 @@
+package cashtrack
+
+import (
+	"context"
+	"fmt"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	apiv1 "cashtrack/backend/gen/api/v1"
+	"cashtrack/backend/gen/api/v1/apiv1connect"
+	"connectrpc.com/connect"
+)
+
+func TestReportServiceWatchReportsRequiresUser(t *testing.T) {
+	handler := NewReportServiceHandler(nil, NewReportEvents(nil), NewReportParsingService())
+	server := httptest.NewServer(handler.Handler)
+	defer server.Close()
+
+	client := apiv1connect.NewReportServiceClient(server.Client(), server.URL)
+	stream, err := client.WatchReports(context.Background(), &apiv1.WatchReportsRequest{})
+	if err != nil {
+		t.Fatalf("open stream: %v", err)
+	}
+	defer stream.Close()
+	if stream.Receive() {
+		t.Fatalf("expected no messages without a session")
+	}
+	if connect.CodeOf(stream.Err()) != connect.CodeUnauthenticated {
+		t.Fatalf("expected unauthenticated, got %v", stream.Err())
+	}
+}
+
+func TestReportServiceWatchReportsStreamsStatusChanges(t *testing.T) {
+	db, cleanup := openTestDB(t)
+	defer cleanup()
+
+	createReportTables(t, db)
+	userID := createUser(t, db, "reports-watch@example.com")
+	otherUserID := createUser(t, db, "reports-watch-other@example.com")
+	sessionID := createSessionForUser(t, db, userID)
+	reportID := insertReport(t, db, userID, "transactions.csv", mustReadTestFile(t, "ubs_account_transactions.csv"))
+
+	events := NewReportEvents(db)
+	defer events.Close()
+	handler := NewReportServiceHandler(db, events, NewReportParsingService())
+	server := httptest.NewServer(handler.Handler)
+	defer server.Close()
+
+	client := connect.NewClient[apiv1.WatchReportsRequest, apiv1.WatchReportsResponse](
+		server.Client(),
+		server.URL+apiv1connect.ReportServiceWatchReportsProcedure,
+	)
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+	req := connect.NewRequest(&apiv1.WatchReportsRequest{})
+	req.Header().Set("Cookie", fmt.Sprintf("%s=%s", sessionCookieName, sessionID))
+	stream, err := client.CallServerStream(ctx, req)
+	if err != nil {
+		t.Fatalf("open stream: %v", err)
+	}
+	defer stream.Close()
+
+	receive := func() *apiv1.WatchReportsResponse {
+		t.Helper()
+		if !stream.Receive() {
+			t.Fatalf("stream ended: %v", stream.Err())
+		}
+		return stream.Msg()
+	}
+
+	initial := receive()
+	if initial.Report.GetId() != int32(reportID) || initial.Report.GetStatus() != ReportStatusPending {
+		t.Fatalf("unexpected initial report %+v", initial.Report)
+	}
+
+	// Reports of other users must not show up in the stream.
+	insertReport(t, db, otherUserID, "other.csv", mustReadTestFile(t, "ubs_account_transactions.csv"))
+	notifyReportUploaded(context.Background(), db, otherUserID)
+
+	processor := newTestReportProcessor(t, db, "worker-1")
+	if err := processor.ProcessPendingReports(context.Background()); err != nil {
+		t.Fatalf("process reports: %v", err)
+	}
+
+	var last *apiv1.ReportInfo
+	for last == nil || last.Status != ReportStatusProcessed {
+		update := receive()
+		if update.Report.GetId() != int32(reportID) {
+			t.Fatalf("unexpected report %+v", update.Report)
+		}
+		if last == nil && update.Report.Status != ReportStatusProcessing && update.Report.Status != ReportStatusProcessed {
+			t.Fatalf("unexpected status %q", update.Report.Status)
+		}
+		last = update.Report
+	}
+	if last.TransactionCount != 22 {
+		t.Fatalf("expected 22 transactions, got %d", last.TransactionCount)
+	}
+
+	if _, err := db.conn.Exec(context.Background(), `DELETE FROM financial_reports WHERE id = $1`, reportID); err != nil {
+		t.Fatalf("delete report: %v", err)
+	}
+	notifyReportStatus(context.Background(), db.Queries, userID)
+	deleted := receive()
+	if !deleted.Deleted || deleted.Report.GetId() != int32(reportID) {
+		t.Fatalf("expected deletion of report %d, got %+v", reportID, deleted)
+	}
+}
+
+func TestReportEventsPublishesToSubscribedUser(t *testing.T) {
+	events := NewReportEvents(nil)
+	// Pretend the listener is already running, there is no database here.
+	events.stopListen = func() {}
+
+	first, unsubscribeFirst := events.Subscribe(1)
+	second, unsubscribeSecond := events.Subscribe(2)
+	defer unsubscribeSecond()
+
+	events.publish("1")
+	events.publish("1")
+	assertSignalled(t, first, true)
+	assertSignalled(t, first, false)
+	assertSignalled(t, second, false)
+
+	// A reconnect may have missed notifications of anyone.
+	events.publish("")
+	assertSignalled(t, first, true)
+	assertSignalled(t, second, true)
+
+	unsubscribeFirst()
+	unsubscribeFirst()
+	events.publish("1")
+	assertSignalled(t, first, false)
+
+	events.Close()
+	if _, ok := <-second; ok {
+		t.Fatalf("expected subscription to be closed")
+	}
+	late, unsubscribeLate := events.Subscribe(3)
+	defer unsubscribeLate()
+	if _, ok := <-late; ok {
+		t.Fatalf("expected subscription after close to be closed")
+	}
+}
+
+func assertSignalled(t *testing.T, ch <-chan struct{}, expected bool) {
+	t.Helper()
+	select {
+	case <-ch:
+		if !expected {
+			t.Fatalf("unexpected signal")
+		}
+	default:
+		if expected {
+			t.Fatalf("expected a signal")
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package cashtrack
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	dbgen "cashtrack/backend/gen/db"
+
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	reportUploadedChannel   = "report_uploaded"
+	reportStatusChannel     = "report_status"
+	reportListenRetryDelay  = 5 * time.Second
+	reportListenDialTimeout = 10 * time.Second
+)
+
+func notifyReportUploaded(ctx context.Context, db *Db, workspaceID int32) {
+	if err := db.Queries.NotifyReportUploaded(ctx, strconv.Itoa(int(workspaceID))); err != nil {
+		log.Warn().Err(err).Int32("workspace_id", workspaceID).Msg("failed to notify report upload")
+	}
+}
+
+func notifyReportStatus(ctx context.Context, queries *dbgen.Queries, workspaceID int32) {
+	if err := queries.NotifyReportStatus(ctx, strconv.Itoa(int(workspaceID))); err != nil {
+		log.Warn().Err(err).Int32("workspace_id", workspaceID).Msg("failed to notify report status change")
+	}
+}
+
+// listenForReportUploads keeps a dedicated connection listening on the report_uploaded channel
+// and signals wake for every notification, reconnecting until ctx is cancelled.
+func listenForReportUploads(ctx context.Context, db *Db, wake chan<- struct{}) {
+	listenForNotifications(ctx, db, []string{reportUploadedChannel}, func(string) {
+		signalWake(wake)
+	})
+}
+
+// listenForNotifications calls notify with the payload of every notification on the given channels.
+// After each (re)connect notify is called with an empty payload, since notifications sent while the
+// connection was down are lost.
+func listenForNotifications(ctx context.Context, db *Db, channels []string, notify func(payload string)) {
+	for ctx.Err() == nil {
+		err := listenOnce(ctx, db, channels, notify)
+		if ctx.Err() != nil {
+			return
+		}
+		log.Warn().Err(err).Strs("channels", channels).Dur("retry_in", reportListenRetryDelay).Msg("report notification listener stopped")
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(reportListenRetryDelay):
+		}
+	}
+}
+
+func listenOnce(ctx context.Context, db *Db, channels []string, notify func(payload string)) error {
+	dialCtx, cancel := context.WithTimeout(ctx, reportListenDialTimeout)
+	conn, err := pgx.ConnectConfig(dialCtx, db.conn.Config().ConnConfig.Copy())
+	cancel()
+	if err != nil {
+		return err
+	}
+	defer conn.Close(context.WithoutCancel(ctx))
+
+	for _, channel := range channels {
+		if _, err := conn.Exec(ctx, "LISTEN "+channel); err != nil {
+			return err
+		}
+	}
+	notify("")
+
+	for {
+		notification, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+		notify(notification.Payload)
+	}
+}
+
+func signalWake(wake chan<- struct{}) {
+	select {
+	case wake <- struct{}{}:
+	default:
+	}
+}
@@ -3,7 +3,9 @@ package cashtrack
 import (
 	"bufio"
 	"errors"
-	"io"
+	"fmt"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -22,13 +24,64 @@ type ParsedTransaction struct {
 	ParserMeta          map[string]any
 }
 
+// ParsedAccount describes an account a statement covers, for statements that say more about it
+// than the account number on each row.
+type ParsedAccount struct {
+	Number   string
+	IBAN     string
+	Currency string
+	Balances []ParsedBalance
+}
+
+// ParsedBalance is the balance of an account at the end of a day.
+type ParsedBalance struct {
+	Date   time.Time
+	Amount string
+}
+
 type ParsedReport struct {
-	ParserName   string
-	Transactions []ParsedTransaction
+	ParserName    string
+	ParserVersion int
+	Transactions  []ParsedTransaction
+	Accounts      []ParsedAccount
+	Diagnostics   []ParseDiagnostic
+}
+
+const parserVersionMetaKey = "parser_version"
+
+const (
+	DiagnosticSeverityWarning = "warning"
+	DiagnosticSeverityError   = "error"
+)
+
+// ParseDiagnostic describes a row that was skipped (error) or imported with a caveat (warning).
+// Parsers only fail as a whole when they can't make sense of the file at all.
+type ParseDiagnostic struct {
+	Row      int
+	Severity string
+	Record   string
+	Reason   string
+}
+
+func rowError(row int, record string, format string, args ...any) ParseDiagnostic {
+	return ParseDiagnostic{Row: row, Severity: DiagnosticSeverityError, Record: record, Reason: fmt.Sprintf(format, args...)}
+}
+
+// hasErrors reports whether any row was skipped.
+func (r ParsedReport) hasErrors() bool {
+	for _, diagnostic := range r.Diagnostics {
+		if diagnostic.Severity == DiagnosticSeverityError {
+			return true
+		}
+	}
+	return false
 }
 
 type ReportParser interface {
 	Name() string
+	// Version is bumped whenever a change makes the parser read the same file differently, so
+	// reports parsed by an older version can be found and reprocessed.
+	Version() int
 	CanParse(sample string, filename string) bool
 	Parse(data []byte) (ParsedReport, error)
 }
@@ -42,35 +95,100 @@ func NewReportParsingService() *ReportParsingService {
 		parsers: []ReportParser{
 			NewUBSAccountParser(),
 			NewCreditCardParser(),
+			NewOFXParser(),
+			NewCAMTParser(),
 		},
 	}
 }
 
 func (s *ReportParsingService) Parse(data []byte, filename string) (ParsedReport, error) {
-	sample := firstNonEmptyLine(data)
-	for _, parser := range s.parsers {
-		if parser.CanParse(sample, filename) {
-			return parser.Parse(data)
+	return s.ParseWith(data, filename, nil)
+}
+
+// ParseWith tries the user's own parsers, e.g. CSV templates, before the built-in ones.
+func (s *ReportParsingService) ParseWith(data []byte, filename string, userParsers []ReportParser) (ParsedReport, error) {
+	sample := reportSample(data)
+	for _, parsers := range [][]ReportParser{userParsers, s.parsers} {
+		for _, parser := range parsers {
+			if parser.CanParse(sample, filename) {
+				report, err := parser.Parse(data)
+				if err != nil {
+					return report, err
+				}
+				return stampParserVersion(validateParsedReport(report), parser.Version()), nil
+			}
 		}
 	}
 	return ParsedReport{}, errors.New("no parser available")
 }
 
-func firstNonEmptyLine(data []byte) string {
+// Versions returns the current version of every built-in parser by name.
+func (s *ReportParsingService) Versions() map[string]int {
+	versions := make(map[string]int, len(s.parsers))
+	for _, parser := range s.parsers {
+		versions[parser.Name()] = parser.Version()
+	}
+	return versions
+}
+
+// stampParserVersion records the parser version in each transaction's parser meta, which is
+// where ListOutdatedReports looks for it.
+func stampParserVersion(report ParsedReport, version int) ParsedReport {
+	report.ParserVersion = version
+	for i := range report.Transactions {
+		meta := make(map[string]any, len(report.Transactions[i].ParserMeta)+1)
+		for key, value := range report.Transactions[i].ParserMeta {
+			meta[key] = value
+		}
+		meta[parserVersionMetaKey] = version
+		report.Transactions[i].ParserMeta = meta
+	}
+	return report
+}
+
+// validateParsedReport moves rows that could not be stored, like non-numeric amounts, from the
+// transactions to the diagnostics, so one bad row doesn't fail the whole import.
+func validateParsedReport(report ParsedReport) ParsedReport {
+	valid := make([]ParsedTransaction, 0, len(report.Transactions))
+	for _, transaction := range report.Transactions {
+		record := joinNonEmpty(transaction.PostedDate.Format("2006-01-02"), transaction.Description, transaction.Amount, transaction.Currency)
+		if _, err := strconv.ParseFloat(strings.TrimSpace(transaction.Amount), 64); err != nil {
+			report.Diagnostics = append(report.Diagnostics, rowError(transaction.SourceFileRow, record, "amount %q is not a number", transaction.Amount))
+			continue
+		}
+		if !isCurrencyCode(normalizeCurrency(transaction.Currency)) {
+			report.Diagnostics = append(report.Diagnostics, rowError(transaction.SourceFileRow, record, "currency %q is not a 3-letter ISO code", transaction.Currency))
+			continue
+		}
+		valid = append(valid, transaction)
+	}
+	report.Transactions = valid
+	sort.SliceStable(report.Diagnostics, func(i, j int) bool {
+		return report.Diagnostics[i].Row < report.Diagnostics[j].Row
+	})
+	return report
+}
+
+// reportSampleLines covers headers that follow an XML declaration, like <?OFX ...?>, and the
+// preamble some banks put before the CSV column headers.
+const reportSampleLines = 20
+
+// reportSample returns the first non-empty lines of a report, trimmed and joined with newlines,
+// so parsers can detect a format by prefix and still see headers a few lines in.
+func reportSample(data []byte) string {
 	reader := bufio.NewReader(strings.NewReader(string(data)))
-	for {
+	lines := make([]string, 0, reportSampleLines)
+	for len(lines) < reportSampleLines {
 		line, err := reader.ReadString('\n')
 		line = strings.TrimSpace(line)
 		if line != "" {
-			return line
+			lines = append(lines, line)
 		}
 		if err != nil {
-			if errors.Is(err, io.EOF) {
-				return ""
-			}
-			return ""
+			break
 		}
 	}
+	return strings.Join(lines, "\n")
 }
 
 const (
@@ -0,0 +1,20 @@
+package cashtrack
+
+import "testing"
+
+func TestReportParsingServiceStampsParserVersion(t *testing.T) {
+	service := NewReportParsingService()
+	report, err := service.Parse(mustReadTestFile(t, "ubs_account_transactions.csv"), "transactions.csv")
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	version := service.Versions()[report.ParserName]
+	if version == 0 || report.ParserVersion != version {
+		t.Fatalf("expected parser version %d, got %d", version, report.ParserVersion)
+	}
+	for _, transaction := range report.Transactions {
+		if transaction.ParserMeta[parserVersionMetaKey] != version {
+			t.Fatalf("expected parser version in meta, got %+v", transaction.ParserMeta)
+		}
+	}
+}
@@ -3,59 +3,212 @@ package cashtrack
 import (
 	db "cashtrack/backend/gen/db"
 	"context"
+	"errors"
 	"fmt"
+	"os"
 	"strings"
 	"time"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog"
 )
 
+const (
+	ReportStatusPending    = "pending"
+	ReportStatusProcessing = "processing"
+	ReportStatusProcessed  = "processed"
+	// Some rows were skipped or imported with warnings; see the report diagnostics.
+	ReportStatusProcessedWithWarnings = "processed_with_warnings"
+	ReportStatusFailed                = "failed"
+)
+
+// maxReportDiagnostics caps the stored diagnostics, so a file in the wrong format doesn't
+// produce one row per line.
+const maxReportDiagnostics = 1000
+
+var errReportLeaseLost = errors.New("report lease lost")
+
+type ReportProcessorConfig struct {
+	// Identifies this replica in claimed_by; defaults to hostname and pid.
+	WorkerID     string        `envDefault:""`
+	LeaseTimeout time.Duration `envDefault:"5m"`
+	MaxAttempts  int           `envDefault:"5"`
+	RetryBackoff time.Duration `envDefault:"30s"`
+	// How long an in-flight report may keep running after shutdown starts.
+	ShutdownGrace time.Duration `envDefault:"5s"`
+}
+
 type ReportProcessor struct {
 	db           *Db
 	parsing      *ReportParsingService
 	transactions *TransactionsService
+	config       ReportProcessorConfig
 }
 
-func NewReportProcessor(db *Db, parsing *ReportParsingService, transactions *TransactionsService) *ReportProcessor {
+func NewReportProcessor(db *Db, parsing *ReportParsingService, transactions *TransactionsService, config ReportProcessorConfig) *ReportProcessor {
+	if config.WorkerID == "" {
+		hostname, _ := os.Hostname()
+		config.WorkerID = fmt.Sprintf("%s-%d", hostname, os.Getpid())
+	}
+	if config.LeaseTimeout <= 0 {
+		config.LeaseTimeout = 5 * time.Minute
+	}
+	if config.MaxAttempts <= 0 {
+		config.MaxAttempts = 1
+	}
 	return &ReportProcessor{
 		db:           db,
 		parsing:      parsing,
 		transactions: transactions,
+		config:       config,
 	}
 }
 
 func (p *ReportProcessor) ProcessPendingReports(ctx context.Context) error {
-	reports, err := p.db.Queries.ListPendingReports(ctx)
-	if err != nil {
-		return fmt.Errorf("load pending reports: %w", err)
+	for ctx.Err() == nil {
+		report, err := p.db.Queries.ClaimNextReport(ctx, db.ClaimNextReportParams{
+			ClaimedBy:    p.workerID(),
+			LeaseSeconds: p.config.LeaseTimeout.Seconds(),
+		})
+		if errors.Is(err, pgx.ErrNoRows) || ctx.Err() != nil {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("claim pending report: %w", err)
+		}
+		notifyReportStatus(ctx, p.db.Queries, report.WorkspaceID)
+		if err := p.processWithGrace(ctx, report); err != nil {
+			return err
+		}
 	}
+	return nil
+}
 
-	for _, report := range reports {
-		parsed, err := p.parsing.Parse(report.Data, report.Filename)
-		if err != nil {
-			log.Error().Err(err).Int64("report_id", report.ID).Msg("failed to parse report")
-			if updateErr := p.updateReportStatus(ctx, report.ID, report.UserID, "failed", err.Error()); updateErr != nil {
-				return updateErr
-			}
-			continue
+// processWithGrace lets an in-flight report finish after ctx is cancelled for up to ShutdownGrace.
+// If it still doesn't finish, the claim is released so another replica can take it over right away
+// instead of waiting for the lease to expire.
+func (p *ReportProcessor) processWithGrace(ctx context.Context, report db.ClaimNextReportRow) error {
+	workCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	defer cancel()
+	stop := context.AfterFunc(ctx, func() {
+		timer := time.NewTimer(p.config.ShutdownGrace)
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+			cancel()
+		case <-workCtx.Done():
 		}
+	})
+	defer stop()
 
-		if err := p.replaceTransactionsForReport(ctx, report.ID, report.UserID, parsed.Transactions); err != nil {
-			log.Error().Err(err).Int64("report_id", report.ID).Msg("failed to store transactions")
-			if updateErr := p.updateReportStatus(ctx, report.ID, report.UserID, "failed", err.Error()); updateErr != nil {
-				return updateErr
-			}
-			continue
+	err := p.processClaimedReport(workCtx, report)
+	if workCtx.Err() != nil {
+		p.releaseClaim(report)
+		return nil
+	}
+	return err
+}
+
+func (p *ReportProcessor) releaseClaim(report db.ClaimNextReportRow) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	released, err := p.db.Queries.ReleaseReportClaim(ctx, db.ReleaseReportClaimParams{
+		ID:        report.ID,
+		ClaimedBy: p.workerID(),
+	})
+	if err != nil {
+		log.Error().Err(err).Int64("report_id", report.ID).Msg("failed to release report claim")
+		return
+	}
+	if released > 0 {
+		log.Info().Int64("report_id", report.ID).Msg("released report claim on shutdown")
+		notifyReportUploaded(ctx, p.db, report.WorkspaceID)
+	}
+}
+
+func (p *ReportProcessor) processClaimedReport(ctx context.Context, report db.ClaimNextReportRow) error {
+	logger := log.With().Int64("report_id", report.ID).Int32("attempt", report.Attempts).Logger()
+	if int(report.Attempts) > p.config.MaxAttempts {
+		// Only reachable through reclaimed leases, i.e. the report keeps crashing its worker.
+		logger.Error().Msg("report exceeded max processing attempts")
+		return p.completeClaim(ctx, report, ReportStatusFailed, "processing abandoned after too many attempts")
+	}
+
+	userParsers, err := loadCSVTemplateParsers(ctx, p.db.Queries, report.WorkspaceID)
+	if err != nil {
+		return p.retryClaim(ctx, report, logger, fmt.Errorf("load csv templates: %w", err))
+	}
+	parsed, err := p.parsing.ParseWith(report.Data, report.Filename, userParsers)
+	if err != nil {
+		// Parsing is deterministic, so retrying the same bytes cannot succeed.
+		logger.Error().Err(err).Msg("failed to parse report")
+		if err := p.db.Queries.DeleteReportDiagnostics(ctx, report.ID); err != nil {
+			return p.retryClaim(ctx, report, logger, fmt.Errorf("delete report diagnostics: %w", err))
 		}
+		return p.completeClaim(ctx, report, ReportStatusFailed, err.Error())
+	}
+
+	err = p.replaceTransactionsForReport(ctx, report.ID, report.WorkspaceID, parsed)
+	if errors.Is(err, errReportLeaseLost) {
+		logger.Warn().Msg("report lease expired while processing, leaving it to the new owner")
+		return nil
 	}
+	if err != nil {
+		return p.retryClaim(ctx, report, logger, err)
+	}
+	return nil
+}
 
+// retryClaim schedules another attempt after a transient failure, or fails the report once it
+// has run out of attempts.
+func (p *ReportProcessor) retryClaim(ctx context.Context, report db.ClaimNextReportRow, logger zerolog.Logger, err error) error {
+	if int(report.Attempts) >= p.config.MaxAttempts {
+		logger.Error().Err(err).Msg("failed to process report, giving up")
+		return p.completeClaim(ctx, report, ReportStatusFailed, err.Error())
+	}
+	delay := p.retryDelay(report.Attempts)
+	logger.Warn().Err(err).Dur("retry_in", delay).Msg("failed to process report, will retry")
+	_, updateErr := p.db.Queries.RetryReportClaim(ctx, db.RetryReportClaimParams{
+		StatusDescription: errorTextOrNull(err.Error()),
+		DelaySeconds:      delay.Seconds(),
+		ID:                report.ID,
+		ClaimedBy:         p.workerID(),
+	})
+	if updateErr != nil {
+		return fmt.Errorf("update report status: %w", updateErr)
+	}
+	notifyReportStatus(ctx, p.db.Queries, report.WorkspaceID)
 	return nil
 }
 
+// retryDelay doubles the backoff with every attempt, capped at the lease timeout.
+func (p *ReportProcessor) retryDelay(attempts int32) time.Duration {
+	delay := p.config.RetryBackoff
+	for i := int32(1); i < attempts && delay < p.config.LeaseTimeout; i++ {
+		delay *= 2
+	}
+	if delay > p.config.LeaseTimeout {
+		delay = p.config.LeaseTimeout
+	}
+	return delay
+}
+
+func (p *ReportProcessor) workerID() pgtype.Text {
+	return pgtype.Text{String: p.config.WorkerID, Valid: true}
+}
+
+// Run processes reports whenever an upload is announced on the report_uploaded channel, and every
+// interval as a safety net for missed notifications and retries. It returns once ctx is cancelled
+// and the in-flight report, if any, has finished or been released.
 func (p *ReportProcessor) Run(ctx context.Context, interval time.Duration) {
 	ticker := time.NewTicker(interval)
 	defer ticker.Stop()
 
+	wake := make(chan struct{}, 1)
+	go listenForReportUploads(ctx, p.db, wake)
+
 	for {
 		if err := p.ProcessPendingReports(ctx); err != nil {
 			log.Error().Err(err).Msg("failed to process pending reports")
@@ -65,29 +218,64 @@ func (p *ReportProcessor) Run(ctx context.Context, interval time.Duration) {
 		case <-ctx.Done():
 			return
 		case <-ticker.C:
+		case <-wake:
 		}
 	}
 }
 
-func (p *ReportProcessor) replaceTransactionsForReport(ctx context.Context, reportID int64, userID int32, entries []ParsedTransaction) error {
+// replaceTransactionsForReport imports the valid rows of a parsed report together with its
+// diagnostics. A report where every row failed is marked failed and keeps its transactions.
+func (p *ReportProcessor) replaceTransactionsForReport(ctx context.Context, reportID int64, workspaceID int32, parsed ParsedReport) error {
 	tx, err := p.db.conn.Begin(ctx)
 	if err != nil {
 		return fmt.Errorf("begin transaction: %w", err)
 	}
 	defer tx.Rollback(ctx)
 
-	if err := p.transactions.ReplaceForSourceTx(ctx, tx, userID, reportID, entries); err != nil {
+	txQueries := p.db.Queries.WithTx(tx)
+	status := ReportStatusProcessed
+	var description string
+	if len(parsed.Transactions) == 0 && parsed.hasErrors() {
+		status = ReportStatusFailed
+		description = fmt.Sprintf("no valid rows, rows skipped: %d", len(parsed.Diagnostics))
+	} else {
+		summary, err := p.transactions.ReplaceForSourceTx(ctx, tx, workspaceID, reportID, parsed.Transactions)
+		if err != nil {
+			return err
+		}
+		if err := syncReportAccounts(ctx, txQueries, workspaceID, reportID, parsed); err != nil {
+			return err
+		}
+		// The other leg of a transfer may come from a report imported earlier.
+		if _, err := detectTransfers(ctx, txQueries, workspaceID); err != nil {
+			return err
+		}
+		if _, err := detectRecurring(ctx, txQueries, workspaceID, time.Now().UTC()); err != nil {
+			return err
+		}
+		if len(parsed.Diagnostics) > 0 {
+			status = ReportStatusProcessedWithWarnings
+		}
+		description = reportStatusDescription(summary, parsed.Diagnostics)
+	}
+	if err := storeReportDiagnostics(ctx, txQueries, reportID, workspaceID, parsed.Diagnostics); err != nil {
 		return err
 	}
-	txQueries := p.db.Queries.WithTx(tx)
-	if err := txQueries.UpdateReportStatusWithError(ctx, db.UpdateReportStatusWithErrorParams{
-		Status:            "processed",
-		StatusDescription: errorTextOrNull(fmt.Sprintf("transactions: %d", len(entries))),
+
+	updated, err := txQueries.CompleteReportClaim(ctx, db.CompleteReportClaimParams{
+		Status:            status,
+		StatusDescription: errorTextOrNull(description),
 		ID:                reportID,
-		UserID:            userID,
-	}); err != nil {
+		ClaimedBy:         p.workerID(),
+	})
+	if err != nil {
 		return fmt.Errorf("update report status: %w", err)
 	}
+	if updated == 0 {
+		return errReportLeaseLost
+	}
+	// Delivered on commit, when the transactions are visible to listeners.
+	notifyReportStatus(ctx, txQueries, workspaceID)
 
 	if err := tx.Commit(ctx); err != nil {
 		return fmt.Errorf("commit transaction: %w", err)
@@ -95,19 +283,71 @@ func (p *ReportProcessor) replaceTransactionsForReport(ctx context.Context, repo
 	return nil
 }
 
-func (p *ReportProcessor) updateReportStatus(ctx context.Context, reportID int64, userID int32, status string, errorText string) error {
-	err := p.db.Queries.UpdateReportStatusWithError(ctx, db.UpdateReportStatusWithErrorParams{
+func (p *ReportProcessor) completeClaim(ctx context.Context, report db.ClaimNextReportRow, status string, description string) error {
+	_, err := p.db.Queries.CompleteReportClaim(ctx, db.CompleteReportClaimParams{
 		Status:            status,
-		StatusDescription: errorTextOrNull(errorText),
-		ID:                reportID,
-		UserID:            userID,
+		StatusDescription: errorTextOrNull(description),
+		ID:                report.ID,
+		ClaimedBy:         p.workerID(),
 	})
 	if err != nil {
 		return fmt.Errorf("update report status: %w", err)
 	}
+	notifyReportStatus(ctx, p.db.Queries, report.WorkspaceID)
+	return nil
+}
+
+func storeReportDiagnostics(ctx context.Context, queries *db.Queries, reportID int64, workspaceID int32, diagnostics []ParseDiagnostic) error {
+	if err := queries.DeleteReportDiagnostics(ctx, reportID); err != nil {
+		return fmt.Errorf("delete report diagnostics: %w", err)
+	}
+	if len(diagnostics) > maxReportDiagnostics {
+		diagnostics = diagnostics[:maxReportDiagnostics]
+	}
+	for _, diagnostic := range diagnostics {
+		err := queries.CreateReportDiagnostic(ctx, db.CreateReportDiagnosticParams{
+			ReportID:    reportID,
+			WorkspaceID: workspaceID,
+			RowNumber:   int32(diagnostic.Row),
+			Severity:    diagnostic.Severity,
+			RawRecord:   diagnostic.Record,
+			Reason:      diagnostic.Reason,
+		})
+		if err != nil {
+			return fmt.Errorf("insert report diagnostic: %w", err)
+		}
+	}
 	return nil
 }
 
+func reportStatusDescription(summary ReplaceSummary, diagnostics []ParseDiagnostic) string {
+	description := fmt.Sprintf("transactions: %d", summary.Inserted+summary.Updated+summary.Kept)
+	if summary.Updated > 0 {
+		description += fmt.Sprintf(", updated: %d", summary.Updated)
+	}
+	if summary.Deleted > 0 {
+		description += fmt.Sprintf(", removed: %d", summary.Deleted)
+	}
+	if summary.Duplicates > 0 {
+		description += fmt.Sprintf(", duplicates skipped: %d", summary.Duplicates)
+	}
+	var skipped, warnings int
+	for _, diagnostic := range diagnostics {
+		if diagnostic.Severity == DiagnosticSeverityError {
+			skipped++
+		} else {
+			warnings++
+		}
+	}
+	if skipped > 0 {
+		description += fmt.Sprintf(", rows skipped: %d", skipped)
+	}
+	if warnings > 0 {
+		description += fmt.Sprintf(", warnings: %d", warnings)
+	}
+	return description
+}
+
 func errorTextOrNull(value string) pgtype.Text {
 	if strings.TrimSpace(value) == "" {
 		return pgtype.Text{}
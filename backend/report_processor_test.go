@@ -6,6 +6,9 @@ import (
 	"testing"
 	"time"
 
+	apiv1 "cashtrack/backend/gen/api/v1"
+	dbgen "cashtrack/backend/gen/db"
+	"connectrpc.com/connect"
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
@@ -23,7 +26,7 @@ func TestReportProcessor_ProcessPendingReports(t *testing.T) {
 	ubsReportID := insertReport(t, db, userID, "transactions.csv", ubsData)
 	cardReportID := insertReport(t, db, userID, "transactions (1).csv", cardData)
 
-	processor := NewReportProcessor(db, NewReportParsingService(), NewTransactionsService(db))
+	processor := newTestReportProcessor(t, db, "worker-1")
 	if err := processor.ProcessPendingReports(ctx); err != nil {
 		t.Fatalf("process pending reports: %v", err)
 	}
@@ -67,7 +70,7 @@ func TestReportProcessor_ReprocessReplacesRows(t *testing.T) {
 	ubsData := mustReadTestFile(t, "ubs_account_transactions.csv")
 	ubsReportID := insertReport(t, db, userID, "transactions.csv", ubsData)
 
-	processor := NewReportProcessor(db, NewReportParsingService(), NewTransactionsService(db))
+	processor := newTestReportProcessor(t, db, "worker-1")
 	if err := processor.ProcessPendingReports(ctx); err != nil {
 		t.Fatalf("process pending reports: %v", err)
 	}
@@ -82,22 +85,373 @@ func TestReportProcessor_ReprocessReplacesRows(t *testing.T) {
 	assertTransactionCount(t, db, ubsReportID, 22)
 }
 
+func TestReportServiceReprocessKeepsManualCategories(t *testing.T) {
+	db, cleanup := openTestDB(t)
+	defer cleanup()
+
+	createReportTables(t, db)
+	userID := createUser(t, db, "reports-requeue@example.com")
+	ctx := contextWithPersonalWorkspace(context.Background(), userID)
+	reportID := insertReport(t, db, userID, "transactions.csv", mustReadTestFile(t, "ubs_account_transactions.csv"))
+
+	processor := newTestReportProcessor(t, db, "worker-1")
+	if err := processor.ProcessPendingReports(ctx); err != nil {
+		t.Fatalf("process pending reports: %v", err)
+	}
+
+	var categoryID int64
+	if err := db.conn.QueryRow(ctx, `INSERT INTO categories (workspace_id, name) VALUES ($1, 'Transfers') RETURNING id`, userID).Scan(&categoryID); err != nil {
+		t.Fatalf("insert category: %v", err)
+	}
+	if _, err := db.conn.Exec(ctx, `UPDATE transactions SET category_id = $1, category_source = 'manual' WHERE source_file_id = $2 AND source_file_row = 1`, categoryID, reportID); err != nil {
+		t.Fatalf("set manual category: %v", err)
+	}
+	// Pretend the rows came from a parser version before versions were recorded.
+	if _, err := db.conn.Exec(ctx, `UPDATE transactions SET parser_meta = NULL WHERE source_file_id = $1`, reportID); err != nil {
+		t.Fatalf("clear parser meta: %v", err)
+	}
+
+	service := &ReportService{db: db, parsing: NewReportParsingService()}
+	response, err := service.ReprocessReports(ctx, &apiv1.ReprocessReportsRequest{Outdated: true})
+	if err != nil {
+		t.Fatalf("reprocess reports: %v", err)
+	}
+	if len(response.Ids) != 1 || response.Ids[0] != int32(reportID) {
+		t.Fatalf("expected the outdated report to be queued, got %v", response.Ids)
+	}
+	if _, err := service.ReprocessReport(ctx, &apiv1.ReprocessReportRequest{Id: int32(reportID)}); connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Fatalf("expected queued report to be rejected, got %v", err)
+	}
+
+	if err := processor.ProcessPendingReports(ctx); err != nil {
+		t.Fatalf("process pending reports: %v", err)
+	}
+	assertReportStatus(t, db, reportID, userID, ReportStatusProcessed)
+	assertTransactionCount(t, db, reportID, 22)
+
+	var manualCount int
+	if err := db.conn.QueryRow(ctx, `SELECT count(*) FROM transactions WHERE source_file_id = $1 AND category_id = $2 AND category_source = 'manual'`, reportID, categoryID).Scan(&manualCount); err != nil {
+		t.Fatalf("count manual categories: %v", err)
+	}
+	if manualCount != 1 {
+		t.Fatalf("expected the manual category to survive reprocessing, got %d rows", manualCount)
+	}
+	outdated, err := service.outdatedReports(ctx, userID)
+	if err != nil {
+		t.Fatalf("list outdated reports: %v", err)
+	}
+	if len(outdated) != 0 {
+		t.Fatalf("expected no outdated reports after reprocessing, got %v", outdated)
+	}
+}
+
+func TestReportProcessor_ReprocessMergesChangedRows(t *testing.T) {
+	db, cleanup := openTestDB(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	createReportTables(t, db)
+	userID := createUser(t, db, "reports-merge@example.com")
+	data := string(mustReadTestFile(t, "ubs_account_transactions.csv"))
+	reportID := insertReport(t, db, userID, "transactions.csv", []byte(data))
+
+	processor := newTestReportProcessor(t, db, "worker-1")
+	if err := processor.ProcessPendingReports(ctx); err != nil {
+		t.Fatalf("process pending reports: %v", err)
+	}
+
+	var categoryID, firstID int64
+	if err := db.conn.QueryRow(ctx, `INSERT INTO categories (workspace_id, name) VALUES ($1, 'Twint') RETURNING id`, userID).Scan(&categoryID); err != nil {
+		t.Fatalf("insert category: %v", err)
+	}
+	err := db.conn.QueryRow(ctx, `UPDATE transactions SET category_id = $1, category_source = 'manual' WHERE source_file_id = $2 AND source_file_row = 1 RETURNING id`, categoryID, reportID).Scan(&firstID)
+	if err != nil {
+		t.Fatalf("set manual category: %v", err)
+	}
+
+	// The bank renamed one booking text and dropped the last row from the export.
+	lines := strings.Split(strings.TrimRight(strings.Replace(data, "Payment to card", "Card payment", 1), "\n"), "\n")
+	edited := strings.Join(lines[:len(lines)-1], "\n")
+	if _, err := db.conn.Exec(ctx, `UPDATE financial_reports SET data = $1 WHERE id = $2`, []byte(edited), reportID); err != nil {
+		t.Fatalf("update report data: %v", err)
+	}
+	setReportStatus(t, db, reportID, userID, ReportStatusPending)
+	if err := processor.ProcessPendingReports(ctx); err != nil {
+		t.Fatalf("process pending reports: %v", err)
+	}
+
+	assertTransactionCount(t, db, reportID, 21)
+	var description string
+	if err := db.conn.QueryRow(ctx, `SELECT status_description FROM financial_reports WHERE id = $1`, reportID).Scan(&description); err != nil {
+		t.Fatalf("load status description: %v", err)
+	}
+	if description != "transactions: 21, updated: 1, removed: 1" {
+		t.Fatalf("unexpected status description %q", description)
+	}
+	var storedCategory pgtype.Int8
+	if err := db.conn.QueryRow(ctx, `SELECT category_id FROM transactions WHERE id = $1`, firstID).Scan(&storedCategory); err != nil {
+		t.Fatalf("expected the first transaction to keep its id: %v", err)
+	}
+	if storedCategory.Int64 != categoryID {
+		t.Fatalf("expected the manual category to survive, got %+v", storedCategory)
+	}
+}
+
+func TestReportProcessor_SkipsDuplicatesAcrossReports(t *testing.T) {
+	db, cleanup := openTestDB(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	createReportTables(t, db)
+	userID := createUser(t, db, "reports-dedup@example.com")
+
+	data := mustReadTestFile(t, "ubs_account_transactions.csv")
+	firstID := insertReport(t, db, userID, "transactions.csv", data)
+	secondID := insertReport(t, db, userID, "transactions (2).csv", data)
+
+	processor := newTestReportProcessor(t, db, "worker-1")
+	if err := processor.ProcessPendingReports(ctx); err != nil {
+		t.Fatalf("process pending reports: %v", err)
+	}
+
+	assertReportStatus(t, db, firstID, userID, ReportStatusProcessed)
+	assertReportStatus(t, db, secondID, userID, ReportStatusProcessed)
+	assertTransactionCount(t, db, firstID, 22)
+	assertTransactionCount(t, db, secondID, 0)
+	assertTotalTransactions(t, db, 22)
+
+	var description string
+	err := db.conn.QueryRow(ctx, `SELECT status_description FROM financial_reports WHERE id = $1`, secondID).Scan(&description)
+	if err != nil {
+		t.Fatalf("load status description: %v", err)
+	}
+	if description != "transactions: 0, duplicates skipped: 22" {
+		t.Fatalf("unexpected status description %q", description)
+	}
+}
+
+func TestReportProcessor_ImportsValidRowsWithDiagnostics(t *testing.T) {
+	db, cleanup := openTestDB(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	createReportTables(t, db)
+	userID := createUser(t, db, "reports-diagnostics@example.com")
+
+	data := strings.Replace(string(mustReadTestFile(t, "credit_card_transactions.csv")), "25.01.2026", "2026-01-25", 1)
+	reportID := insertReport(t, db, userID, "transactions.csv", []byte(data))
+
+	processor := newTestReportProcessor(t, db, "worker-1")
+	if err := processor.ProcessPendingReports(ctx); err != nil {
+		t.Fatalf("process pending reports: %v", err)
+	}
+
+	assertReportStatus(t, db, reportID, userID, ReportStatusProcessedWithWarnings)
+	assertTransactionCount(t, db, reportID, 46)
+
+	diagnostics, err := db.Queries.ListReportDiagnostics(ctx, dbgen.ListReportDiagnosticsParams{ReportID: reportID, WorkspaceID: userID})
+	if err != nil {
+		t.Fatalf("list diagnostics: %v", err)
+	}
+	if len(diagnostics) != 1 || diagnostics[0].RowNumber != 1 || diagnostics[0].Severity != DiagnosticSeverityError {
+		t.Fatalf("unexpected diagnostics %+v", diagnostics)
+	}
+	if !strings.Contains(diagnostics[0].RawRecord, "2026-01-25") {
+		t.Fatalf("expected raw record in diagnostic, got %q", diagnostics[0].RawRecord)
+	}
+}
+
+func TestReportProcessor_ReclaimsStaleLease(t *testing.T) {
+	db, cleanup := openTestDB(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	createReportTables(t, db)
+	userID := createUser(t, db, "reports-lease@example.com")
+
+	staleID := insertReport(t, db, userID, "transactions.csv", mustReadTestFile(t, "ubs_account_transactions.csv"))
+	activeID := insertReport(t, db, userID, "transactions (1).csv", mustReadTestFile(t, "credit_card_transactions.csv"))
+	_, err := db.conn.Exec(ctx, `
+		UPDATE financial_reports
+		SET status = 'processing', claimed_by = 'crashed', claimed_at = now() - interval '1 hour', attempts = 1
+		WHERE id = $1
+	`, staleID)
+	if err != nil {
+		t.Fatalf("mark stale lease: %v", err)
+	}
+	_, err = db.conn.Exec(ctx, `
+		UPDATE financial_reports
+		SET status = 'processing', claimed_by = 'busy', claimed_at = now(), attempts = 1
+		WHERE id = $1
+	`, activeID)
+	if err != nil {
+		t.Fatalf("mark active lease: %v", err)
+	}
+
+	processor := newTestReportProcessor(t, db, "worker-1")
+	if err := processor.ProcessPendingReports(ctx); err != nil {
+		t.Fatalf("process pending reports: %v", err)
+	}
+
+	assertReportStatus(t, db, staleID, userID, ReportStatusProcessed)
+	assertReportStatus(t, db, activeID, userID, ReportStatusProcessing)
+	assertTransactionCount(t, db, staleID, 22)
+	assertTransactionCount(t, db, activeID, 0)
+}
+
+func TestReportProcessor_ConcurrentWorkersClaimEachReportOnce(t *testing.T) {
+	db, cleanup := openTestDB(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	createReportTables(t, db)
+	userID := createUser(t, db, "reports-concurrent@example.com")
+
+	data := mustReadTestFile(t, "ubs_account_transactions.csv")
+	reportIDs := make([]int64, 0, 6)
+	for i := 0; i < cap(reportIDs); i++ {
+		reportIDs = append(reportIDs, insertReport(t, db, userID, "transactions.csv", data))
+	}
+
+	errs := make(chan error, 2)
+	for _, worker := range []string{"worker-1", "worker-2"} {
+		processor := newTestReportProcessor(t, db, worker)
+		go func() {
+			errs <- processor.ProcessPendingReports(ctx)
+		}()
+	}
+	for i := 0; i < 2; i++ {
+		if err := <-errs; err != nil {
+			t.Fatalf("process pending reports: %v", err)
+		}
+	}
+
+	for _, reportID := range reportIDs {
+		assertReportStatus(t, db, reportID, userID, ReportStatusProcessed)
+		var attempts int
+		if err := db.conn.QueryRow(ctx, `SELECT attempts FROM financial_reports WHERE id = $1`, reportID).Scan(&attempts); err != nil {
+			t.Fatalf("load attempts: %v", err)
+		}
+		if attempts != 1 {
+			t.Fatalf("expected report %d to be claimed once, got %d attempts", reportID, attempts)
+		}
+	}
+}
+
+func TestReportProcessor_FailsAfterMaxAttempts(t *testing.T) {
+	db, cleanup := openTestDB(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	createReportTables(t, db)
+	userID := createUser(t, db, "reports-attempts@example.com")
+
+	reportID := insertReport(t, db, userID, "transactions.csv", mustReadTestFile(t, "ubs_account_transactions.csv"))
+	_, err := db.conn.Exec(ctx, `
+		UPDATE financial_reports
+		SET status = 'processing', claimed_by = 'crashed', claimed_at = now() - interval '1 hour', attempts = 3
+		WHERE id = $1
+	`, reportID)
+	if err != nil {
+		t.Fatalf("mark stale lease: %v", err)
+	}
+
+	processor := newTestReportProcessor(t, db, "worker-1")
+	if err := processor.ProcessPendingReports(ctx); err != nil {
+		t.Fatalf("process pending reports: %v", err)
+	}
+
+	assertReportStatus(t, db, reportID, userID, ReportStatusFailed)
+	assertTransactionCount(t, db, reportID, 0)
+}
+
+func TestReportProcessor_RunWakesOnNotification(t *testing.T) {
+	db, cleanup := openTestDB(t)
+	defer cleanup()
+
+	createReportTables(t, db)
+	userID := createUser(t, db, "reports-notify@example.com")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	processor := newTestReportProcessor(t, db, "worker-1")
+	go func() {
+		defer close(done)
+		processor.Run(ctx, time.Hour)
+	}()
+	// Give the listener time to subscribe before announcing the upload.
+	time.Sleep(500 * time.Millisecond)
+
+	reportID := insertReport(t, db, userID, "transactions.csv", mustReadTestFile(t, "ubs_account_transactions.csv"))
+	notifyReportUploaded(context.Background(), db, userID)
+
+	deadline := time.Now().Add(10 * time.Second)
+	for {
+		var status string
+		err := db.conn.QueryRow(context.Background(), `SELECT status FROM financial_reports WHERE id = $1`, reportID).Scan(&status)
+		if err != nil {
+			t.Fatalf("load report status: %v", err)
+		}
+		if status == ReportStatusProcessed {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("report was not processed after notification, status %q", status)
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("processor did not stop after cancellation")
+	}
+}
+
+func TestReportProcessorRetryDelay(t *testing.T) {
+	processor := &ReportProcessor{config: ReportProcessorConfig{
+		LeaseTimeout: 5 * time.Minute,
+		RetryBackoff: 30 * time.Second,
+	}}
+	expected := []time.Duration{30 * time.Second, time.Minute, 2 * time.Minute, 4 * time.Minute, 5 * time.Minute, 5 * time.Minute}
+	for i, want := range expected {
+		if got := processor.retryDelay(int32(i + 1)); got != want {
+			t.Fatalf("attempt %d: expected %v, got %v", i+1, want, got)
+		}
+	}
+}
+
+func newTestReportProcessor(t *testing.T, db *Db, workerID string) *ReportProcessor {
+	t.Helper()
+	return NewReportProcessor(db, NewReportParsingService(), newTestTransactionsService(t, db), ReportProcessorConfig{
+		WorkerID:     workerID,
+		LeaseTimeout: time.Minute,
+		MaxAttempts:  3,
+		RetryBackoff: time.Second,
+	})
+}
+
 func createReportTables(t *testing.T, db *Db) {
 	t.Helper()
 	_, err := db.conn.Exec(context.Background(), `
 		CREATE TABLE financial_reports (
 			id bigserial PRIMARY KEY,
-			user_id integer NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+			workspace_id integer NOT NULL REFERENCES workspaces(id) ON DELETE CASCADE,
 			filename varchar(255) NOT NULL,
 			content_type varchar(255),
 			data bytea NOT NULL,
 			uploaded_at timestamptz NOT NULL DEFAULT now(),
 			status varchar(32) NOT NULL DEFAULT 'pending',
-			status_description text
+			status_description text,
+			claimed_at timestamptz,
+			claimed_by varchar(255),
+			attempts integer NOT NULL DEFAULT 0,
+			next_attempt_at timestamptz
 		);
 		CREATE TABLE categories (
 			id bigserial PRIMARY KEY,
-			user_id integer NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+			workspace_id integer NOT NULL REFERENCES workspaces(id) ON DELETE CASCADE,
 			name varchar(255) NOT NULL,
 			created_at timestamptz NOT NULL DEFAULT now(),
 			color varchar(7),
@@ -106,14 +460,42 @@ func createReportTables(t *testing.T, db *Db) {
 		);
 		CREATE TABLE category_rules (
 			id bigserial PRIMARY KEY,
-			user_id integer NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+			workspace_id integer NOT NULL REFERENCES workspaces(id) ON DELETE CASCADE,
 			category_id bigint,
-			description_contains text NOT NULL,
+			description_contains text NOT NULL DEFAULT '',
+			created_at timestamptz NOT NULL DEFAULT now(),
+			position integer NOT NULL DEFAULT 1,
+			description_regex text NOT NULL DEFAULT '',
+			description_exact text NOT NULL DEFAULT '',
+			description_prefix text NOT NULL DEFAULT '',
+			amount_min bigint,
+			amount_max bigint,
+			entry_type varchar(16) NOT NULL DEFAULT '',
+			currency varchar(3) NOT NULL DEFAULT '',
+			account varchar(64) NOT NULL DEFAULT '',
+			parser_name varchar(64) NOT NULL DEFAULT ''
+		);
+		CREATE TABLE csv_templates (
+			id bigserial PRIMARY KEY,
+			workspace_id integer NOT NULL REFERENCES workspaces(id) ON DELETE CASCADE,
+			name varchar(255) NOT NULL,
+			signature text NOT NULL,
+			delimiter varchar(1) NOT NULL DEFAULT ',',
+			date_column text NOT NULL,
+			date_format varchar(32) NOT NULL,
+			decimal_separator varchar(1) NOT NULL DEFAULT '.',
+			description_columns text[] NOT NULL DEFAULT '{}',
+			amount_column text NOT NULL DEFAULT '',
+			debit_column text NOT NULL DEFAULT '',
+			credit_column text NOT NULL DEFAULT '',
+			currency_column text NOT NULL DEFAULT '',
+			account_column text NOT NULL DEFAULT '',
+			default_currency varchar(3) NOT NULL DEFAULT '',
 			created_at timestamptz NOT NULL DEFAULT now()
 		);
 		CREATE TABLE transactions (
 			id bigserial PRIMARY KEY,
-			user_id integer NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+			workspace_id integer NOT NULL REFERENCES workspaces(id) ON DELETE CASCADE,
 			source_file_id bigint NOT NULL REFERENCES financial_reports(id) ON DELETE CASCADE,
 			source_file_row integer NOT NULL,
 			parser_name varchar(64) NOT NULL,
@@ -126,7 +508,71 @@ func createReportTables(t *testing.T, db *Db) {
 			source_account_number varchar(64),
 			source_card_number varchar(64),
 			category_id bigint,
+			category_source text,
 			parser_meta jsonb,
+			created_at timestamptz NOT NULL DEFAULT now(),
+			account_id bigint
+		);
+		CREATE TABLE transaction_splits (
+			id bigserial PRIMARY KEY,
+			transaction_id bigint NOT NULL REFERENCES transactions(id) ON DELETE CASCADE,
+			workspace_id integer NOT NULL REFERENCES workspaces(id) ON DELETE CASCADE,
+			category_id bigint,
+			amount numeric(18, 2) NOT NULL,
+			created_at timestamptz NOT NULL DEFAULT now()
+		);
+		CREATE TABLE transaction_transfers (
+			id bigserial PRIMARY KEY,
+			workspace_id integer NOT NULL REFERENCES workspaces(id) ON DELETE CASCADE,
+			debit_transaction_id bigint NOT NULL REFERENCES transactions(id) ON DELETE CASCADE,
+			credit_transaction_id bigint NOT NULL REFERENCES transactions(id) ON DELETE CASCADE,
+			status varchar(16) NOT NULL DEFAULT 'suggested',
+			created_at timestamptz NOT NULL DEFAULT now(),
+			UNIQUE (debit_transaction_id, credit_transaction_id)
+		);
+		CREATE UNIQUE INDEX transaction_transfers_debit_active_idx ON transaction_transfers (debit_transaction_id) WHERE status <> 'rejected';
+		CREATE UNIQUE INDEX transaction_transfers_credit_active_idx ON transaction_transfers (credit_transaction_id) WHERE status <> 'rejected';
+		CREATE TABLE accounts (
+			id bigserial PRIMARY KEY,
+			workspace_id integer NOT NULL REFERENCES workspaces(id) ON DELETE CASCADE,
+			name varchar(255) NOT NULL,
+			type varchar(16) NOT NULL,
+			identifier varchar(64),
+			iban varchar(34),
+			currency varchar(3),
+			archived boolean NOT NULL DEFAULT false,
+			merged_into_id bigint REFERENCES accounts(id) ON DELETE CASCADE,
+			created_at timestamptz NOT NULL DEFAULT now(),
+			UNIQUE (workspace_id, identifier)
+		);
+		CREATE TABLE account_balances (
+			id bigserial PRIMARY KEY,
+			account_id bigint NOT NULL REFERENCES accounts(id) ON DELETE CASCADE,
+			workspace_id integer NOT NULL REFERENCES workspaces(id) ON DELETE CASCADE,
+			source_file_id bigint REFERENCES financial_reports(id) ON DELETE CASCADE,
+			balance_date date NOT NULL,
+			balance numeric(18, 2) NOT NULL,
+			created_at timestamptz NOT NULL DEFAULT now()
+		);
+		CREATE TABLE recurring_series (
+			id bigserial PRIMARY KEY,
+			workspace_id integer NOT NULL REFERENCES workspaces(id) ON DELETE CASCADE,
+			merchant_key varchar(255) NOT NULL,
+			name varchar(255) NOT NULL,
+			currency varchar(3) NOT NULL,
+			cadence varchar(16) NOT NULL,
+			amount numeric(18, 2) NOT NULL,
+			status varchar(16) NOT NULL DEFAULT 'detected',
+			created_at timestamptz NOT NULL DEFAULT now()
+		);
+		CREATE TABLE report_diagnostics (
+			id bigserial PRIMARY KEY,
+			report_id bigint NOT NULL REFERENCES financial_reports(id) ON DELETE CASCADE,
+			workspace_id integer NOT NULL REFERENCES workspaces(id) ON DELETE CASCADE,
+			row_number integer NOT NULL,
+			severity varchar(16) NOT NULL,
+			raw_record text NOT NULL DEFAULT '',
+			reason text NOT NULL,
 			created_at timestamptz NOT NULL DEFAULT now()
 		);
 	`)
@@ -140,7 +586,7 @@ func insertReport(t *testing.T, db *Db, userID int32, filename string, data []by
 	var reportID int64
 	err := db.conn.QueryRow(
 		context.Background(),
-		`INSERT INTO financial_reports (user_id, filename, content_type, data, status) VALUES ($1, $2, $3, $4, $5) RETURNING id`,
+		`INSERT INTO financial_reports (workspace_id, filename, content_type, data, status) VALUES ($1, $2, $3, $4, $5) RETURNING id`,
 		userID,
 		filename,
 		"text/csv",
@@ -158,7 +604,7 @@ func assertReportStatus(t *testing.T, db *Db, reportID int64, userID int32, expe
 	var status string
 	err := db.conn.QueryRow(
 		context.Background(),
-		`SELECT status FROM financial_reports WHERE id = $1 AND user_id = $2`,
+		`SELECT status FROM financial_reports WHERE id = $1 AND workspace_id = $2`,
 		reportID,
 		userID,
 	).Scan(&status)
@@ -203,7 +649,7 @@ func insertDummyTransaction(t *testing.T, db *Db, userID int32, reportID int64)
 	_, err := db.conn.Exec(
 		context.Background(),
 		`INSERT INTO transactions (
-			user_id,
+			workspace_id,
 			source_file_id,
 			source_file_row,
 			parser_name,
@@ -234,7 +680,7 @@ func setReportStatus(t *testing.T, db *Db, reportID int64, userID int32, status
 	t.Helper()
 	_, err := db.conn.Exec(
 		context.Background(),
-		`UPDATE financial_reports SET status = $1 WHERE id = $2 AND user_id = $3`,
+		`UPDATE financial_reports SET status = $1 WHERE id = $2 AND workspace_id = $3`,
 		status,
 		reportID,
 		userID,
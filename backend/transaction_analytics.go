@@ -0,0 +1,188 @@
+package cashtrack
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	apiv1 "cashtrack/backend/gen/api/v1"
+	db "cashtrack/backend/gen/db"
+)
+
+const (
+	AnalyticsIntervalDay   = "day"
+	AnalyticsIntervalWeek  = "week"
+	AnalyticsIntervalMonth = "month"
+
+	AnalyticsGroupByCategory = "category"
+	AnalyticsGroupByGroup    = "group"
+)
+
+type analyticsKey struct {
+	PeriodStart time.Time
+	// Zero for uncategorized transactions.
+	CategoryID int64
+}
+
+type analyticsBucket struct {
+	Count   int64
+	Income  float64
+	Expense float64
+}
+
+// Analytics returns income and expense per interval and category, converted to the base currency.
+// Daily sums per currency come from SQL and are converted at the rate of their posted date.
+// With AnalyticsGroupByGroup each category is rolled up into its top-level ancestor. Transfers between own
+// accounts are left out unless filters.IncludeTransfers is set.
+func (s *TransactionsService) Analytics(ctx context.Context, workspaceID int32, baseCurrency string, filters TransactionFilters, interval string, groupBy string) ([]*apiv1.TransactionAnalyticsPoint, error) {
+	baseCurrency = normalizeCurrency(baseCurrency)
+	if baseCurrency == "" {
+		baseCurrency = defaultCurrency
+	}
+	rows, err := s.db.Queries.AggregateTransactionsByDay(ctx, db.AggregateTransactionsByDayParams{
+		WorkspaceID:         workspaceID,
+		FromDate:            dateOrNull(filters.FromDate),
+		ToDate:              dateOrNull(filters.ToDate),
+		SourceFileID:        int64OrNull(filters.SourceFileID),
+		EntryType:           textOrNull(filters.EntryType),
+		SourceAccountNumber: textOrNull(filters.SourceAccountNumber),
+		SourceCardNumber:    textOrNull(filters.SourceCardNumber),
+		SearchText:          textOrNull(filters.SearchText),
+		IncludeTransfers:    filters.IncludeTransfers,
+		CategoryID:          int64OrNull(filters.CategoryID),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("aggregate transactions: %w", err)
+	}
+
+	var roots map[int64]int64
+	if groupBy == AnalyticsGroupByGroup {
+		categories, err := s.db.Queries.ListCategoriesByWorkspace(ctx, workspaceID)
+		if err != nil {
+			return nil, fmt.Errorf("load categories: %w", err)
+		}
+		roots = categoryRoots(categories)
+	}
+
+	buckets := make(map[analyticsKey]*analyticsBucket)
+	for _, row := range rows {
+		income, err := numericToFloat(row.Income)
+		if err != nil {
+			return nil, fmt.Errorf("parse income: %w", err)
+		}
+		expense, err := numericToFloat(row.Expense)
+		if err != nil {
+			return nil, fmt.Errorf("parse expense: %w", err)
+		}
+		currency := normalizeCurrency(row.Currency)
+		if currency == "" {
+			currency = defaultCurrency
+		}
+		if currency != baseCurrency {
+			rate, err := s.exchangeRates.GetRate(ctx, currency, baseCurrency, row.PostedDate.Time)
+			if err != nil {
+				log.Error().Err(err).Str("currency", currency).Str("base_currency", baseCurrency).Time("date", row.PostedDate.Time).Msg("failed to convert currency")
+				return nil, err
+			}
+			income *= rate
+			expense *= rate
+		}
+
+		key := analyticsKey{PeriodStart: analyticsPeriodStart(interval, row.PostedDate.Time)}
+		if row.CategoryID.Valid {
+			key.CategoryID = row.CategoryID.Int64
+			if root, ok := roots[key.CategoryID]; ok {
+				key.CategoryID = root
+			}
+		}
+		bucket := buckets[key]
+		if bucket == nil {
+			bucket = &analyticsBucket{}
+			buckets[key] = bucket
+		}
+		bucket.Count += row.Count
+		bucket.Income += income
+		bucket.Expense += expense
+	}
+
+	keys := make([]analyticsKey, 0, len(buckets))
+	for key := range buckets {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if !keys[i].PeriodStart.Equal(keys[j].PeriodStart) {
+			return keys[i].PeriodStart.Before(keys[j].PeriodStart)
+		}
+		return keys[i].CategoryID < keys[j].CategoryID
+	})
+
+	points := make([]*apiv1.TransactionAnalyticsPoint, 0, len(keys))
+	for _, key := range keys {
+		bucket := buckets[key]
+		var categoryID *int32
+		if key.CategoryID != 0 {
+			value := int32(key.CategoryID)
+			categoryID = &value
+		}
+		income := centsFromFloat(bucket.Income)
+		expense := centsFromFloat(bucket.Expense)
+		points = append(points, &apiv1.TransactionAnalyticsPoint{
+			PeriodStart: key.PeriodStart.Format("2006-01-02"),
+			CategoryId:  categoryID,
+			Count:       int32(bucket.Count),
+			Income:      income,
+			Expense:     expense,
+			Total:       income + expense,
+		})
+	}
+	return points, nil
+}
+
+func isAnalyticsInterval(interval string) bool {
+	switch interval {
+	case AnalyticsIntervalDay, AnalyticsIntervalWeek, AnalyticsIntervalMonth:
+		return true
+	}
+	return false
+}
+
+// analyticsPeriodStart truncates a date to its interval; weeks start on Monday.
+func analyticsPeriodStart(interval string, date time.Time) time.Time {
+	year, month, day := date.Date()
+	start := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	switch interval {
+	case AnalyticsIntervalWeek:
+		offset := (int(start.Weekday()) + 6) % 7
+		return start.AddDate(0, 0, -offset)
+	case AnalyticsIntervalMonth:
+		return time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	default:
+		return start
+	}
+}
+
+// categoryRoots maps every category to its top-level ancestor through parent_id.
+func categoryRoots(rows []db.ListCategoriesByWorkspaceRow) map[int64]int64 {
+	parents := make(map[int64]int64, len(rows))
+	for _, row := range rows {
+		if row.ParentID.Valid {
+			parents[row.ID] = row.ParentID.Int64
+		}
+	}
+	roots := make(map[int64]int64, len(rows))
+	for _, row := range rows {
+		current := row.ID
+		visited := map[int64]bool{current: true}
+		for {
+			parent, ok := parents[current]
+			if !ok || visited[parent] {
+				break
+			}
+			visited[parent] = true
+			current = parent
+		}
+		roots[row.ID] = current
+	}
+	return roots
+}
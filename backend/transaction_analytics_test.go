@@ -0,0 +1,154 @@
+package cashtrack
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	db "cashtrack/backend/gen/db"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func TestAnalyticsPeriodStart(t *testing.T) {
+	// 2026-03-05 is a Thursday.
+	date := time.Date(2026, 3, 5, 0, 0, 0, 0, time.UTC)
+	cases := map[string]string{
+		AnalyticsIntervalDay:   "2026-03-05",
+		AnalyticsIntervalWeek:  "2026-03-02",
+		AnalyticsIntervalMonth: "2026-03-01",
+	}
+	for interval, expected := range cases {
+		if got := analyticsPeriodStart(interval, date).Format("2006-01-02"); got != expected {
+			t.Fatalf("%s: expected %s, got %s", interval, expected, got)
+		}
+	}
+	sunday := time.Date(2026, 3, 8, 0, 0, 0, 0, time.UTC)
+	if got := analyticsPeriodStart(AnalyticsIntervalWeek, sunday).Format("2006-01-02"); got != "2026-03-02" {
+		t.Fatalf("expected sunday to belong to the week of 2026-03-02, got %s", got)
+	}
+}
+
+func TestCategoryRootsFollowsParents(t *testing.T) {
+	roots := categoryRoots([]db.ListCategoriesByWorkspaceRow{
+		{ID: 1},
+		{ID: 2, ParentID: pgtype.Int8{Int64: 1, Valid: true}},
+		{ID: 3, ParentID: pgtype.Int8{Int64: 2, Valid: true}},
+		{ID: 4},
+	})
+	if roots[1] != 1 || roots[2] != 1 || roots[3] != 1 || roots[4] != 4 {
+		t.Fatalf("unexpected roots %v", roots)
+	}
+}
+
+func TestTransactionsAnalyticsGroupsAndConverts(t *testing.T) {
+	db, cleanup := openTestDB(t)
+	defer cleanup()
+
+	createSummaryTables(t, db)
+	createCategoriesTable(t, db)
+	userID := createUser(t, db, "analytics@example.com")
+
+	ctx := context.Background()
+	var groupID, childID int64
+	if err := db.conn.QueryRow(ctx, `INSERT INTO categories (workspace_id, name, is_group) VALUES ($1, 'Food', true) RETURNING id`, userID).Scan(&groupID); err != nil {
+		t.Fatalf("insert group: %v", err)
+	}
+	if err := db.conn.QueryRow(ctx, `INSERT INTO categories (workspace_id, name, parent_id) VALUES ($1, 'Groceries', $2) RETURNING id`, userID, groupID).Scan(&childID); err != nil {
+		t.Fatalf("insert category: %v", err)
+	}
+	_, err := db.conn.Exec(ctx, `
+		INSERT INTO transactions (workspace_id, posted_date, description, amount, currency, category_id) VALUES
+			($1, '2026-03-05', 'coop', -40.00, 'CHF', $2),
+			($1, '2026-03-06', 'lidl', -100.00, 'EUR', $2),
+			($1, '2026-03-20', 'salary', 1000.00, 'CHF', NULL),
+			($1, '2026-04-02', 'restaurant', -30.00, 'CHF', $3)
+	`, userID, childID, groupID)
+	if err != nil {
+		t.Fatalf("insert transactions: %v", err)
+	}
+
+	service := newTestTransactionsService(t, db)
+	points, err := service.Analytics(ctx, userID, "CHF", TransactionFilters{}, AnalyticsIntervalMonth, AnalyticsGroupByCategory)
+	if err != nil {
+		t.Fatalf("analytics: %v", err)
+	}
+	if len(points) != 3 {
+		t.Fatalf("expected 3 points, got %+v", points)
+	}
+	if points[0].PeriodStart != "2026-03-01" || points[0].CategoryId != nil || points[0].Income != 100000 {
+		t.Fatalf("unexpected uncategorized point %+v", points[0])
+	}
+	// 40 CHF + 100 EUR at 0.95.
+	if points[1].GetCategoryId() != int32(childID) || points[1].Count != 2 || points[1].Expense != -13500 || points[1].Total != -13500 {
+		t.Fatalf("unexpected category point %+v", points[1])
+	}
+
+	points, err = service.Analytics(ctx, userID, "CHF", TransactionFilters{}, AnalyticsIntervalMonth, AnalyticsGroupByGroup)
+	if err != nil {
+		t.Fatalf("analytics by group: %v", err)
+	}
+	for _, point := range points {
+		if point.CategoryId != nil && point.GetCategoryId() != int32(groupID) {
+			t.Fatalf("expected categories to roll up into the group, got %+v", point)
+		}
+	}
+}
+
+func TestTransactionsAnalyticsExcludesTransfers(t *testing.T) {
+	db, cleanup := openTestDB(t)
+	defer cleanup()
+
+	createSummaryTables(t, db)
+	createCategoriesTable(t, db)
+	userID := createUser(t, db, "analytics-transfers@example.com")
+
+	ctx := context.Background()
+	var debitID, creditID int64
+	if err := db.conn.QueryRow(ctx, `INSERT INTO transactions (workspace_id, posted_date, description, amount, currency) VALUES ($1, '2026-03-05', 'to savings', -200.00, 'CHF') RETURNING id`, userID).Scan(&debitID); err != nil {
+		t.Fatalf("insert debit: %v", err)
+	}
+	if err := db.conn.QueryRow(ctx, `INSERT INTO transactions (workspace_id, posted_date, description, amount, currency) VALUES ($1, '2026-03-05', 'from checking', 200.00, 'CHF') RETURNING id`, userID).Scan(&creditID); err != nil {
+		t.Fatalf("insert credit: %v", err)
+	}
+	if _, err := db.conn.Exec(ctx, `INSERT INTO transactions (workspace_id, posted_date, description, amount, currency) VALUES ($1, '2026-03-07', 'kiosk', -10.00, 'CHF')`, userID); err != nil {
+		t.Fatalf("insert expense: %v", err)
+	}
+	if _, err := db.conn.Exec(ctx, `INSERT INTO transaction_transfers (workspace_id, debit_transaction_id, credit_transaction_id, status) VALUES ($1, $2, $3, 'confirmed')`, userID, debitID, creditID); err != nil {
+		t.Fatalf("insert transfer: %v", err)
+	}
+
+	service := newTestTransactionsService(t, db)
+	points, err := service.Analytics(ctx, userID, "CHF", TransactionFilters{}, AnalyticsIntervalMonth, AnalyticsGroupByCategory)
+	if err != nil {
+		t.Fatalf("analytics: %v", err)
+	}
+	if len(points) != 1 || points[0].Count != 1 || points[0].Income != 0 || points[0].Expense != -1000 {
+		t.Fatalf("expected transfers to be left out, got %+v", points)
+	}
+
+	points, err = service.Analytics(ctx, userID, "CHF", TransactionFilters{IncludeTransfers: true}, AnalyticsIntervalMonth, AnalyticsGroupByCategory)
+	if err != nil {
+		t.Fatalf("analytics with transfers: %v", err)
+	}
+	if len(points) != 1 || points[0].Count != 3 || points[0].Income != 20000 || points[0].Expense != -21000 {
+		t.Fatalf("expected transfers to be included, got %+v", points)
+	}
+}
+
+func createCategoriesTable(t *testing.T, db *Db) {
+	t.Helper()
+	_, err := db.conn.Exec(context.Background(), `
+		CREATE TABLE categories (
+			id bigserial PRIMARY KEY,
+			workspace_id integer NOT NULL REFERENCES workspaces(id) ON DELETE CASCADE,
+			name varchar(255) NOT NULL,
+			created_at timestamptz NOT NULL DEFAULT now(),
+			color varchar(7),
+			parent_id bigint,
+			is_group boolean NOT NULL DEFAULT false
+		);
+	`)
+	if err != nil {
+		t.Fatalf("create categories table: %v", err)
+	}
+}
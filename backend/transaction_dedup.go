@@ -0,0 +1,97 @@
+package cashtrack
+
+import (
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+type duplicateCandidate struct {
+	ID            int64
+	PostedDate    time.Time
+	AmountCents   int64
+	TransactionID string
+	Account       string
+	Description   string
+}
+
+// duplicateIndex matches incoming transactions against rows already stored from other reports.
+// Parser-provided transaction ids are authoritative; the fuzzy key (date, amount, account and
+// normalized description) is only used when one side has no id. Each stored row absorbs at
+// most one incoming transaction, so repeated identical purchases are not collapsed.
+type duplicateIndex struct {
+	byTransactionID map[string][]int64
+	byFuzzyKey      map[string][]int64
+	transactionIDs  map[int64]string
+	used            map[int64]bool
+}
+
+func newDuplicateIndex(candidates []duplicateCandidate) *duplicateIndex {
+	index := &duplicateIndex{
+		byTransactionID: make(map[string][]int64),
+		byFuzzyKey:      make(map[string][]int64),
+		transactionIDs:  make(map[int64]string),
+		used:            make(map[int64]bool),
+	}
+	for _, candidate := range candidates {
+		if candidate.TransactionID != "" {
+			index.byTransactionID[candidate.TransactionID] = append(index.byTransactionID[candidate.TransactionID], candidate.ID)
+			index.transactionIDs[candidate.ID] = candidate.TransactionID
+		}
+		key := duplicateFuzzyKey(candidate.PostedDate, candidate.AmountCents, candidate.Account, candidate.Description)
+		index.byFuzzyKey[key] = append(index.byFuzzyKey[key], candidate.ID)
+	}
+	return index
+}
+
+// match returns the id of the stored duplicate of the given transaction, consuming it.
+func (i *duplicateIndex) match(transactionID string, postedDate time.Time, amountCents int64, account string, description string) (int64, bool) {
+	if transactionID != "" {
+		if id, ok := i.take(i.byTransactionID[transactionID], func(int64) bool { return true }); ok {
+			return id, true
+		}
+	}
+	key := duplicateFuzzyKey(postedDate, amountCents, account, description)
+	return i.take(i.byFuzzyKey[key], func(id int64) bool {
+		return transactionID == "" || i.transactionIDs[id] == ""
+	})
+}
+
+func (i *duplicateIndex) take(ids []int64, accept func(int64) bool) (int64, bool) {
+	for _, id := range ids {
+		if i.used[id] || !accept(id) {
+			continue
+		}
+		i.used[id] = true
+		return id, true
+	}
+	return 0, false
+}
+
+func duplicateFuzzyKey(postedDate time.Time, amountCents int64, account string, description string) string {
+	return strings.Join([]string{
+		postedDate.Format("2006-01-02"),
+		strconv.FormatInt(amountCents, 10),
+		normalizeDuplicateText(account),
+		normalizeDuplicateText(description),
+	}, "|")
+}
+
+func normalizeDuplicateText(value string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(value) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// duplicateAccount prefers the card number, since several cards can share one account.
+func duplicateAccount(accountNumber string, cardNumber string) string {
+	if strings.TrimSpace(cardNumber) != "" {
+		return cardNumber
+	}
+	return accountNumber
+}
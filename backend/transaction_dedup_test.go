@@ -0,0 +1,41 @@
+package cashtrack
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDuplicateIndexMatchesByTransactionID(t *testing.T) {
+	date := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+	index := newDuplicateIndex([]duplicateCandidate{
+		{ID: 1, PostedDate: date, AmountCents: -1500, TransactionID: "9930023GK2701888", Account: "0230 00826810.40", Description: "Coop"},
+	})
+
+	if id, ok := index.match("9930023GK2701888", date.AddDate(0, 0, 1), -1499, "other", "Different text"); !ok || id != 1 {
+		t.Fatalf("expected match by transaction id, got %d %v", id, ok)
+	}
+	if _, ok := index.match("9930023GK2701888", date, -1500, "0230 00826810.40", "Coop"); ok {
+		t.Fatalf("expected stored row to be consumed by the first match")
+	}
+}
+
+func TestDuplicateIndexFuzzyFallback(t *testing.T) {
+	date := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+	index := newDuplicateIndex([]duplicateCandidate{
+		{ID: 1, PostedDate: date, AmountCents: -2895, Account: "4894 33XX XXXX 9396", Description: "UBR* PENDING.UBER.COM"},
+		{ID: 2, PostedDate: date, AmountCents: -500, TransactionID: "cc-abc", Account: "4894 33XX XXXX 9396", Description: "Kiosk"},
+	})
+
+	if id, ok := index.match("cc-new", date, -2895, "4894 33xx xxxx 9396", "ubr pending uber com"); !ok || id != 1 {
+		t.Fatalf("expected fuzzy match ignoring case and punctuation, got %d %v", id, ok)
+	}
+	if _, ok := index.match("cc-other", date, -500, "4894 33XX XXXX 9396", "Kiosk"); ok {
+		t.Fatalf("expected rows with different transaction ids not to match fuzzily")
+	}
+	if id, ok := index.match("", date, -500, "4894 33XX XXXX 9396", "Kiosk"); !ok || id != 2 {
+		t.Fatalf("expected fuzzy match for row without transaction id, got %d %v", id, ok)
+	}
+	if _, ok := index.match("", date, -2895, "4894 33XX XXXX 9396", "UBR* PENDING.UBER.COM"); ok {
+		t.Fatalf("expected identical repeated purchase not to be collapsed")
+	}
+}
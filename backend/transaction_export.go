@@ -0,0 +1,406 @@
+package cashtrack
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	apiv1 "cashtrack/backend/gen/api/v1"
+	"cashtrack/backend/gen/db"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const (
+	ExportFormatCsv   = "csv"
+	ExportFormatJsonl = "jsonl"
+	ExportFormatXlsx  = "xlsx"
+	// ExportFormatBeancount and ExportFormatLedger write double-entry journals; the ledger format is
+	// read by both Ledger and hledger.
+	ExportFormatBeancount = "beancount"
+	ExportFormatLedger    = "ledger"
+)
+
+const (
+	exportBatchSize = 1000
+	exportChunkSize = 64 * 1024
+)
+
+// exportColumns names the fields of an exported line, in column order for CSV and XLSX and as keys
+// for JSON Lines.
+var exportColumns = []string{
+	"id", "date", "description", "amount", "currency", "converted_amount", "base_currency",
+	"entry_type", "category", "category_group", "split", "transfer",
+	"account", "account_number", "card_number", "reference", "source_file", "source_row", "parser",
+}
+
+// exportLine is one exported row. Split transactions become one line per split, carrying the split's
+// amount and category. Rate converts Currency to BaseCurrency and CategoryPath lists the category
+// below its ancestors, with their ids in CategoryPathIDs.
+type exportLine struct {
+	TransactionID   int64
+	PostedDate      time.Time
+	Description     string
+	AmountCents     int64
+	Currency        string
+	ConvertedCents  int64
+	BaseCurrency    string
+	Rate            float64
+	EntryType       string
+	CategoryID      *int64
+	Category        string
+	CategoryGroup   string
+	CategoryPath    []string
+	CategoryPathIDs []int64
+	Split           bool
+	Transfer        bool
+	Account         string
+	AccountType     string
+	AccountNumber   string
+	CardNumber      string
+	Reference       string
+	SourceFile      string
+	SourceRow       int32
+	Parser          string
+}
+
+// exportValue is a cell of an exported line, keeping numbers and dates apart from text so formats
+// that know the difference can keep it.
+type exportValue struct {
+	Text   string
+	Number bool
+	Date   bool
+	Bool   bool
+}
+
+func (l exportLine) values() []exportValue {
+	text := func(value string) exportValue { return exportValue{Text: value} }
+	number := func(value string) exportValue { return exportValue{Text: value, Number: true} }
+	boolean := func(value bool) exportValue { return exportValue{Text: strconv.FormatBool(value), Bool: true} }
+	return []exportValue{
+		number(strconv.FormatInt(l.TransactionID, 10)),
+		{Text: l.PostedDate.Format("2006-01-02"), Date: true},
+		text(l.Description),
+		number(formatCents(l.AmountCents)),
+		text(l.Currency),
+		number(formatCents(l.ConvertedCents)),
+		text(l.BaseCurrency),
+		text(l.EntryType),
+		text(l.Category),
+		text(l.CategoryGroup),
+		boolean(l.Split),
+		boolean(l.Transfer),
+		text(l.Account),
+		text(l.AccountNumber),
+		text(l.CardNumber),
+		text(l.Reference),
+		text(l.SourceFile),
+		number(strconv.FormatInt(int64(l.SourceRow), 10)),
+		text(l.Parser),
+	}
+}
+
+// categoryPath is a category's name and the names and ids of its ancestors, outermost first.
+type categoryPath struct {
+	Name      string
+	Parents   []string
+	ParentIDs []int64
+}
+
+func categoryPaths(rows []db.ListCategoriesByWorkspaceRow) map[int64]categoryPath {
+	byID := make(map[int64]db.ListCategoriesByWorkspaceRow, len(rows))
+	for _, row := range rows {
+		byID[row.ID] = row
+	}
+	paths := make(map[int64]categoryPath, len(rows))
+	for _, row := range rows {
+		var parents []string
+		var parentIDs []int64
+		visited := map[int64]bool{row.ID: true}
+		current := row
+		for current.ParentID.Valid && !visited[current.ParentID.Int64] {
+			parent, ok := byID[current.ParentID.Int64]
+			if !ok {
+				break
+			}
+			visited[parent.ID] = true
+			parents = append([]string{parent.Name}, parents...)
+			parentIDs = append([]int64{parent.ID}, parentIDs...)
+			current = parent
+		}
+		paths[row.ID] = categoryPath{Name: row.Name, Parents: parents, ParentIDs: parentIDs}
+	}
+	return paths
+}
+
+// Export walks every transaction matching the filters in date order and hands emit one line per
+// transaction, or per split, with the amount converted to baseCurrency. Limit and offset are ignored.
+func (s *TransactionsService) Export(ctx context.Context, workspaceID int32, baseCurrency string, filters TransactionFilters, emit func(exportLine) error) error {
+	baseCurrency = normalizeCurrency(baseCurrency)
+	if baseCurrency == "" {
+		baseCurrency = defaultCurrency
+	}
+	categories, err := s.db.Queries.ListCategoriesByWorkspace(ctx, workspaceID)
+	if err != nil {
+		return fmt.Errorf("query categories: %w", err)
+	}
+	paths := categoryPaths(categories)
+
+	params := db.ExportTransactionsParams{
+		WorkspaceID:         workspaceID,
+		FromDate:            dateOrNull(filters.FromDate),
+		ToDate:              dateOrNull(filters.ToDate),
+		SourceFileID:        int64OrNull(filters.SourceFileID),
+		EntryType:           textOrNull(filters.EntryType),
+		SourceAccountNumber: textOrNull(filters.SourceAccountNumber),
+		SourceCardNumber:    textOrNull(filters.SourceCardNumber),
+		SearchText:          textOrNull(filters.SearchText),
+		CategoryID:          int64OrNull(filters.CategoryID),
+		IncludeTransfers:    filters.IncludeTransfers,
+		LimitCount:          exportBatchSize,
+	}
+	for {
+		rows, err := s.db.Queries.ExportTransactions(ctx, params)
+		if err != nil {
+			return fmt.Errorf("query transactions: %w", err)
+		}
+		if len(rows) == 0 {
+			return nil
+		}
+		ids := make([]int64, 0, len(rows))
+		for _, row := range rows {
+			ids = append(ids, row.ID)
+		}
+		splits, err := listSplits(ctx, s.db.Queries, workspaceID, ids)
+		if err != nil {
+			return err
+		}
+
+		for _, row := range rows {
+			lines, err := exportLines(row, splits[row.ID], filters.CategoryID)
+			if err != nil {
+				return err
+			}
+			rate, err := s.exchangeRates.GetRate(ctx, row.Currency, baseCurrency, row.PostedDate.Time)
+			if err != nil {
+				return fmt.Errorf("convert %s to %s on %s: %w", row.Currency, baseCurrency, row.PostedDate.Time.Format("2006-01-02"), err)
+			}
+			for _, line := range lines {
+				line.ConvertedCents = centsFromFloat(float64(line.AmountCents) / 100 * rate)
+				line.BaseCurrency = baseCurrency
+				line.Rate = rate
+				if line.CategoryID != nil {
+					path := paths[*line.CategoryID]
+					line.Category = path.Name
+					line.CategoryGroup = strings.Join(path.Parents, " / ")
+					line.CategoryPath = append(append([]string(nil), path.Parents...), path.Name)
+					line.CategoryPathIDs = append(append([]int64(nil), path.ParentIDs...), *line.CategoryID)
+				}
+				if err := emit(line); err != nil {
+					return err
+				}
+			}
+		}
+
+		last := rows[len(rows)-1]
+		params.AfterDate = last.PostedDate
+		params.AfterID = last.ID
+		if len(rows) < exportBatchSize {
+			return nil
+		}
+	}
+}
+
+// exportLines turns a transaction into its exported lines. With a category filter only the splits of
+// that category are kept, matching what the filter selected.
+func exportLines(row db.ExportTransactionsRow, splits []*apiv1.TransactionSplit, categoryID *int64) ([]exportLine, error) {
+	amountCents, err := numericToCents(row.Amount)
+	if err != nil {
+		return nil, fmt.Errorf("convert amount: %w", err)
+	}
+	base := exportLine{
+		TransactionID: row.ID,
+		PostedDate:    row.PostedDate.Time,
+		Description:   row.Description,
+		AmountCents:   amountCents,
+		Currency:      row.Currency,
+		EntryType:     row.EntryType,
+		Transfer:      row.IsTransfer,
+		Account:       row.AccountName.String,
+		AccountType:   row.AccountType.String,
+		AccountNumber: row.SourceAccountNumber.String,
+		CardNumber:    row.SourceCardNumber.String,
+		Reference:     row.TransactionID.String,
+		SourceFile:    row.SourceFilename,
+		SourceRow:     row.SourceFileRow,
+		Parser:        row.ParserName,
+	}
+	if len(splits) == 0 {
+		base.CategoryID = int8Pointer(row.CategoryID)
+		return []exportLine{base}, nil
+	}
+
+	lines := make([]exportLine, 0, len(splits))
+	for _, split := range splits {
+		var splitCategoryID *int64
+		if split.CategoryId != nil {
+			value := int64(*split.CategoryId)
+			splitCategoryID = &value
+		}
+		if categoryID != nil && (splitCategoryID == nil || *splitCategoryID != *categoryID) {
+			continue
+		}
+		line := base
+		line.AmountCents = split.Amount
+		line.CategoryID = splitCategoryID
+		line.Split = true
+		lines = append(lines, line)
+	}
+	return lines, nil
+}
+
+func int8Pointer(value pgtype.Int8) *int64 {
+	if !value.Valid {
+		return nil
+	}
+	return &value.Int64
+}
+
+func isExportFormat(format string) bool {
+	switch format {
+	case ExportFormatCsv, ExportFormatJsonl, ExportFormatXlsx, ExportFormatBeancount, ExportFormatLedger:
+		return true
+	}
+	return false
+}
+
+func exportContentType(format string) string {
+	switch format {
+	case ExportFormatJsonl:
+		return "application/x-ndjson"
+	case ExportFormatXlsx:
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	case ExportFormatBeancount, ExportFormatLedger:
+		return "text/plain; charset=utf-8"
+	default:
+		return "text/csv; charset=utf-8"
+	}
+}
+
+// exportWriter encodes exported lines in one of the export formats. Close finishes the file but
+// leaves the underlying writer open.
+type exportWriter interface {
+	Write(line exportLine) error
+	Close() error
+}
+
+// newExportWriter returns the writer for format. accounts only matters to the plain-text accounting
+// formats.
+func newExportWriter(format string, w io.Writer, accounts ledgerAccounts) (exportWriter, error) {
+	switch format {
+	case ExportFormatCsv:
+		return newCsvExportWriter(w)
+	case ExportFormatJsonl:
+		return &jsonlExportWriter{encoder: json.NewEncoder(w)}, nil
+	case ExportFormatXlsx:
+		return newXlsxExportWriter(w)
+	case ExportFormatBeancount, ExportFormatLedger:
+		return newLedgerExportWriter(format, w, accounts), nil
+	}
+	return nil, fmt.Errorf("unknown export format %q", format)
+}
+
+type csvExportWriter struct {
+	writer *csv.Writer
+}
+
+func newCsvExportWriter(w io.Writer) (*csvExportWriter, error) {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(exportColumns); err != nil {
+		return nil, err
+	}
+	return &csvExportWriter{writer: writer}, nil
+}
+
+func (w *csvExportWriter) Write(line exportLine) error {
+	values := line.values()
+	record := make([]string, len(values))
+	for i, value := range values {
+		record[i] = value.Text
+	}
+	return w.writer.Write(record)
+}
+
+func (w *csvExportWriter) Close() error {
+	w.writer.Flush()
+	return w.writer.Error()
+}
+
+type jsonlExportWriter struct {
+	encoder *json.Encoder
+}
+
+func (w *jsonlExportWriter) Write(line exportLine) error {
+	values := line.values()
+	object := make(map[string]any, len(values))
+	for i, value := range values {
+		switch {
+		case value.Number:
+			object[exportColumns[i]] = json.Number(value.Text)
+		case value.Bool:
+			object[exportColumns[i]] = value.Text == "true"
+		default:
+			object[exportColumns[i]] = value.Text
+		}
+	}
+	return w.encoder.Encode(object)
+}
+
+func (w *jsonlExportWriter) Close() error {
+	return nil
+}
+
+// exportStreamWriter collects export output into chunks of exportChunkSize for send. The first chunk
+// carries the file's name and content type.
+type exportStreamWriter struct {
+	send        func(*apiv1.ExportTransactionsResponse) error
+	filename    string
+	contentType string
+	buf         []byte
+	sent        bool
+}
+
+func (w *exportStreamWriter) Write(p []byte) (int, error) {
+	w.buf = append(w.buf, p...)
+	for len(w.buf) >= exportChunkSize {
+		if err := w.sendChunk(w.buf[:exportChunkSize]); err != nil {
+			return 0, err
+		}
+		w.buf = w.buf[exportChunkSize:]
+	}
+	return len(p), nil
+}
+
+// Flush sends what is left, and the file's name even when the export is empty.
+func (w *exportStreamWriter) Flush() error {
+	if len(w.buf) == 0 && w.sent {
+		return nil
+	}
+	err := w.sendChunk(w.buf)
+	w.buf = nil
+	return err
+}
+
+func (w *exportStreamWriter) sendChunk(chunk []byte) error {
+	res := &apiv1.ExportTransactionsResponse{Chunk: append([]byte(nil), chunk...)}
+	if !w.sent {
+		res.Filename = w.filename
+		res.ContentType = w.contentType
+		w.sent = true
+	}
+	return w.send(res)
+}
@@ -0,0 +1,236 @@
+package cashtrack
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	apiv1 "cashtrack/backend/gen/api/v1"
+	"cashtrack/backend/gen/db"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func testExportLine() exportLine {
+	return exportLine{
+		TransactionID:  7,
+		PostedDate:     time.Date(2024, time.March, 5, 0, 0, 0, 0, time.UTC),
+		Description:    "Coop <Basel>, \"fresh\"",
+		AmountCents:    -1250,
+		Currency:       "EUR",
+		ConvertedCents: -1175,
+		BaseCurrency:   "CHF",
+		EntryType:      "debit",
+		Category:       "Groceries",
+		CategoryGroup:  "Living / Food",
+		Split:          true,
+		SourceFile:     "statement.csv",
+		SourceRow:      3,
+		Parser:         "ubs",
+	}
+}
+
+func TestCategoryPaths(t *testing.T) {
+	paths := categoryPaths([]db.ListCategoriesByWorkspaceRow{
+		{ID: 1, Name: "Living", IsGroup: true},
+		{ID: 2, Name: "Food", ParentID: pgtype.Int8{Int64: 1, Valid: true}, IsGroup: true},
+		{ID: 3, Name: "Groceries", ParentID: pgtype.Int8{Int64: 2, Valid: true}},
+		{ID: 4, Name: "Loop", ParentID: pgtype.Int8{Int64: 4, Valid: true}},
+	})
+	if expected := (categoryPath{Name: "Groceries", Parents: []string{"Living", "Food"}, ParentIDs: []int64{1, 2}}); !reflect.DeepEqual(paths[3], expected) {
+		t.Fatalf("expected %+v, got %+v", expected, paths[3])
+	}
+	if len(paths[4].Parents) != 0 {
+		t.Fatalf("expected a self-referencing category to have no parents, got %+v", paths[4])
+	}
+}
+
+func TestExportLinesSplitsByCategory(t *testing.T) {
+	amount, err := numericFromCents(-3000)
+	if err != nil {
+		t.Fatalf("amount: %v", err)
+	}
+	row := db.ExportTransactionsRow{ID: 9, Amount: amount, Currency: "CHF", CategoryID: pgtype.Int8{Int64: 1, Valid: true}}
+	groceries, household := int32(2), int32(3)
+	splits := []*apiv1.TransactionSplit{
+		{Id: 1, CategoryId: &groceries, Amount: -2000},
+		{Id: 2, CategoryId: &household, Amount: -1000},
+	}
+
+	lines, err := exportLines(row, nil, nil)
+	if err != nil {
+		t.Fatalf("export lines: %v", err)
+	}
+	if len(lines) != 1 || lines[0].AmountCents != -3000 || *lines[0].CategoryID != 1 || lines[0].Split {
+		t.Fatalf("unexpected lines without splits %+v", lines)
+	}
+
+	lines, err = exportLines(row, splits, nil)
+	if err != nil {
+		t.Fatalf("export lines: %v", err)
+	}
+	if len(lines) != 2 || lines[0].AmountCents != -2000 || lines[1].AmountCents != -1000 || !lines[1].Split {
+		t.Fatalf("expected one line per split, got %+v", lines)
+	}
+
+	filter := int64(household)
+	lines, err = exportLines(row, splits, &filter)
+	if err != nil {
+		t.Fatalf("export lines: %v", err)
+	}
+	if len(lines) != 1 || *lines[0].CategoryID != filter {
+		t.Fatalf("expected only the filtered split, got %+v", lines)
+	}
+}
+
+func TestCsvExportWriter(t *testing.T) {
+	var buf bytes.Buffer
+	writer, err := newExportWriter(ExportFormatCsv, &buf, ledgerAccounts{})
+	if err != nil {
+		t.Fatalf("new writer: %v", err)
+	}
+	if err := writer.Write(testExportLine()); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatalf("close: %v", err)
+	}
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatalf("read csv: %v", err)
+	}
+	if len(records) != 2 || !reflect.DeepEqual(records[0], exportColumns) {
+		t.Fatalf("unexpected records %v", records)
+	}
+	row := make(map[string]string)
+	for i, column := range exportColumns {
+		row[column] = records[1][i]
+	}
+	if row["date"] != "2024-03-05" || row["amount"] != "-12.50" || row["converted_amount"] != "-11.75" || row["description"] != "Coop <Basel>, \"fresh\"" || row["category_group"] != "Living / Food" {
+		t.Fatalf("unexpected row %v", row)
+	}
+}
+
+func TestJsonlExportWriter(t *testing.T) {
+	var buf bytes.Buffer
+	writer, err := newExportWriter(ExportFormatJsonl, &buf, ledgerAccounts{})
+	if err != nil {
+		t.Fatalf("new writer: %v", err)
+	}
+	for range 2 {
+		if err := writer.Write(testExportLine()); err != nil {
+			t.Fatalf("write: %v", err)
+		}
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatalf("close: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected two lines, got %q", buf.String())
+	}
+	var object map[string]any
+	if err := json.Unmarshal([]byte(lines[0]), &object); err != nil {
+		t.Fatalf("decode line: %v", err)
+	}
+	if object["amount"] != -12.5 || object["split"] != true || object["transfer"] != false || object["category"] != "Groceries" {
+		t.Fatalf("unexpected object %v", object)
+	}
+}
+
+func TestXlsxExportWriter(t *testing.T) {
+	var buf bytes.Buffer
+	writer, err := newExportWriter(ExportFormatXlsx, &buf, ledgerAccounts{})
+	if err != nil {
+		t.Fatalf("new writer: %v", err)
+	}
+	if err := writer.Write(testExportLine()); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatalf("close: %v", err)
+	}
+
+	archive, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("open workbook: %v", err)
+	}
+	var sheet string
+	for _, file := range archive.File {
+		if file.Name != "xl/worksheets/sheet1.xml" {
+			continue
+		}
+		reader, err := file.Open()
+		if err != nil {
+			t.Fatalf("open sheet: %v", err)
+		}
+		content, err := io.ReadAll(reader)
+		reader.Close()
+		if err != nil {
+			t.Fatalf("read sheet: %v", err)
+		}
+		sheet = string(content)
+	}
+	for _, expected := range []string{
+		`<c r="A1" t="inlineStr"><is><t xml:space="preserve">id</t></is></c>`,
+		`<c r="B2" s="1"><v>45356</v></c>`,
+		`<c r="C2" t="inlineStr"><is><t xml:space="preserve">Coop &lt;Basel&gt;, &#34;fresh&#34;</t></is></c>`,
+		`<c r="D2" s="2"><v>-12.50</v></c>`,
+		`<c r="K2" t="b"><v>1</v></c>`,
+		`</sheetData></worksheet>`,
+	} {
+		if !strings.Contains(sheet, expected) {
+			t.Fatalf("expected sheet to contain %s, got %s", expected, sheet)
+		}
+	}
+}
+
+func TestXlsxColumnName(t *testing.T) {
+	for index, expected := range map[int]string{0: "A", 25: "Z", 26: "AA", 51: "AZ", 702: "AAA"} {
+		if got := xlsxColumnName(index); got != expected {
+			t.Fatalf("xlsxColumnName(%d) = %q, expected %q", index, got, expected)
+		}
+	}
+}
+
+func TestExportStreamWriterChunks(t *testing.T) {
+	var responses []*apiv1.ExportTransactionsResponse
+	out := &exportStreamWriter{
+		send: func(res *apiv1.ExportTransactionsResponse) error {
+			responses = append(responses, res)
+			return nil
+		},
+		filename:    "transactions.csv",
+		contentType: exportContentType(ExportFormatCsv),
+	}
+	data := bytes.Repeat([]byte("x"), exportChunkSize+10)
+	if _, err := out.Write(data); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	if err := out.Flush(); err != nil {
+		t.Fatalf("flush: %v", err)
+	}
+
+	if len(responses) != 2 || len(responses[0].Chunk) != exportChunkSize || len(responses[1].Chunk) != 10 {
+		t.Fatalf("unexpected chunks %d", len(responses))
+	}
+	if responses[0].Filename != "transactions.csv" || responses[1].Filename != "" {
+		t.Fatalf("expected only the first chunk to carry the filename")
+	}
+
+	responses = nil
+	empty := &exportStreamWriter{send: out.send, filename: "transactions.jsonl"}
+	if err := empty.Flush(); err != nil {
+		t.Fatalf("flush: %v", err)
+	}
+	if len(responses) != 1 || responses[0].Filename != "transactions.jsonl" {
+		t.Fatalf("expected an empty export to still send its filename, got %+v", responses)
+	}
+}
@@ -0,0 +1,137 @@
+package cashtrack
+
+import (
+	"archive/zip"
+	"bufio"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+)
+
+// xlsxEpoch is day zero of spreadsheet date serials.
+var xlsxEpoch = time.Date(1899, time.December, 30, 0, 0, 0, 0, time.UTC)
+
+// xlsxParts are the fixed parts of a workbook with a single sheet. Style 1 formats dates as
+// yyyy-mm-dd, style 2 amounts with two decimals.
+var xlsxParts = []struct {
+	name    string
+	content string
+}{
+	{"[Content_Types].xml", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types"><Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/><Default Extension="xml" ContentType="application/xml"/><Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/><Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/><Override PartName="/xl/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.styles+xml"/></Types>`},
+	{"_rels/.rels", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships"><Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/></Relationships>`},
+	{"xl/workbook.xml", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships"><sheets><sheet name="Transactions" sheetId="1" r:id="rId1"/></sheets></workbook>`},
+	{"xl/_rels/workbook.xml.rels", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships"><Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/><Relationship Id="rId2" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles" Target="styles.xml"/></Relationships>`},
+	{"xl/styles.xml", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<styleSheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><numFmts count="1"><numFmt numFmtId="164" formatCode="yyyy-mm-dd"/></numFmts><fonts count="1"><font><sz val="11"/><name val="Calibri"/></font></fonts><fills count="2"><fill><patternFill patternType="none"/></fill><fill><patternFill patternType="gray125"/></fill></fills><borders count="1"><border><left/><right/><top/><bottom/><diagonal/></border></borders><cellStyleXfs count="1"><xf numFmtId="0" fontId="0" fillId="0" borderId="0"/></cellStyleXfs><cellXfs count="3"><xf numFmtId="0" fontId="0" fillId="0" borderId="0" xfId="0"/><xf numFmtId="164" fontId="0" fillId="0" borderId="0" xfId="0" applyNumberFormat="1"/><xf numFmtId="2" fontId="0" fillId="0" borderId="0" xfId="0" applyNumberFormat="1"/></cellXfs></styleSheet>`},
+}
+
+const xlsxSheetHeader = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`
+
+const xlsxSheetFooter = `</sheetData></worksheet>`
+
+// xlsxExportWriter streams rows into the worksheet, which is written last so the workbook never has
+// to be held in memory. Text is stored as inline strings to avoid a shared string table.
+type xlsxExportWriter struct {
+	archive *zip.Writer
+	sheet   *bufio.Writer
+	row     int
+}
+
+func newXlsxExportWriter(w io.Writer) (*xlsxExportWriter, error) {
+	archive := zip.NewWriter(w)
+	for _, part := range xlsxParts {
+		entry, err := archive.Create(part.name)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := io.WriteString(entry, part.content); err != nil {
+			return nil, err
+		}
+	}
+	entry, err := archive.Create("xl/worksheets/sheet1.xml")
+	if err != nil {
+		return nil, err
+	}
+	writer := &xlsxExportWriter{archive: archive, sheet: bufio.NewWriter(entry)}
+	if _, err := writer.sheet.WriteString(xlsxSheetHeader); err != nil {
+		return nil, err
+	}
+	header := make([]exportValue, len(exportColumns))
+	for i, column := range exportColumns {
+		header[i] = exportValue{Text: column}
+	}
+	if err := writer.writeRow(header); err != nil {
+		return nil, err
+	}
+	return writer, nil
+}
+
+func (w *xlsxExportWriter) Write(line exportLine) error {
+	return w.writeRow(line.values())
+}
+
+func (w *xlsxExportWriter) writeRow(values []exportValue) error {
+	w.row++
+	fmt.Fprintf(w.sheet, `<row r="%d">`, w.row)
+	for i, value := range values {
+		ref := xlsxColumnName(i) + strconv.Itoa(w.row)
+		switch {
+		case value.Date:
+			date, err := time.Parse("2006-01-02", value.Text)
+			if err != nil {
+				return err
+			}
+			days := int(date.Sub(xlsxEpoch).Hours() / 24)
+			fmt.Fprintf(w.sheet, `<c r="%s" s="1"><v>%d</v></c>`, ref, days)
+		case value.Number:
+			style := ""
+			if exportColumns[i] == "amount" || exportColumns[i] == "converted_amount" {
+				style = ` s="2"`
+			}
+			fmt.Fprintf(w.sheet, `<c r="%s"%s><v>%s</v></c>`, ref, style, value.Text)
+		case value.Bool:
+			flag := 0
+			if value.Text == "true" {
+				flag = 1
+			}
+			fmt.Fprintf(w.sheet, `<c r="%s" t="b"><v>%d</v></c>`, ref, flag)
+		case value.Text == "":
+			continue
+		default:
+			fmt.Fprintf(w.sheet, `<c r="%s" t="inlineStr"><is><t xml:space="preserve">`, ref)
+			if err := xml.EscapeText(w.sheet, []byte(value.Text)); err != nil {
+				return err
+			}
+			w.sheet.WriteString(`</t></is></c>`)
+		}
+	}
+	_, err := w.sheet.WriteString(`</row>`)
+	return err
+}
+
+func (w *xlsxExportWriter) Close() error {
+	if _, err := w.sheet.WriteString(xlsxSheetFooter); err != nil {
+		return err
+	}
+	if err := w.sheet.Flush(); err != nil {
+		return err
+	}
+	return w.archive.Close()
+}
+
+// xlsxColumnName returns the letters of the zero-based column, e.g. 0 as "A" and 26 as "AA".
+func xlsxColumnName(index int) string {
+	name := ""
+	for index >= 0 {
+		name = string(rune('A'+index%26)) + name
+		index = index/26 - 1
+	}
+	return name
+}
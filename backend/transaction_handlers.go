@@ -31,7 +31,7 @@ func NewTransactionServiceHandler(db *Db, transactions *TransactionsService) *Tr
 }
 
 func (s *TransactionService) ListTransactions(ctx context.Context, req *apiv1.ListTransactionsRequest) (*apiv1.ListTransactionsResponse, error) {
-	user, err := requireUser(ctx)
+	workspace, err := requireWorkspace(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -41,12 +41,12 @@ func (s *TransactionService) ListTransactions(ctx context.Context, req *apiv1.Li
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
-	items, err := s.transactions.ListWithCategories(ctx, user.Id, filters)
+	items, err := s.transactions.ListWithCategories(ctx, workspace.ID, filters)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
-	summary, err := s.transactions.Summary(ctx, user.Id, filters)
+	summary, err := s.transactions.Summary(ctx, workspace.ID, workspace.BaseCurrency, filters)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
@@ -54,8 +54,61 @@ func (s *TransactionService) ListTransactions(ctx context.Context, req *apiv1.Li
 	return &apiv1.ListTransactionsResponse{Items: items, Summary: summary}, nil
 }
 
+func (s *TransactionService) ExportTransactions(ctx context.Context, req *apiv1.ExportTransactionsRequest, stream *connect.ServerStream[apiv1.ExportTransactionsResponse]) error {
+	workspace, err := requireWorkspace(ctx)
+	if err != nil {
+		return err
+	}
+
+	format := strings.ToLower(strings.TrimSpace(req.Format))
+	if format == "" {
+		format = ExportFormatCsv
+	}
+	if !isExportFormat(format) {
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("format must be csv, jsonl, xlsx, beancount or ledger"))
+	}
+	filters := TransactionFilters{}
+	if req.Filters != nil {
+		filters, err = transactionFiltersFromRequest(req.Filters)
+		if err != nil {
+			return connect.NewError(connect.CodeInvalidArgument, err)
+		}
+	}
+	// Like the listed items, the export keeps transfer legs unless include_transfers is false.
+	filters.IncludeTransfers = req.Filters == nil || req.Filters.IncludeTransfers == nil || *req.Filters.IncludeTransfers
+
+	var accounts ledgerAccounts
+	if format == ExportFormatBeancount || format == ExportFormatLedger {
+		mappings, err := s.db.Queries.ListLedgerAccountMappings(ctx, workspace.ID)
+		if err != nil {
+			return connect.NewError(connect.CodeInternal, err)
+		}
+		accounts = newLedgerAccounts(mappings)
+	}
+
+	out := &exportStreamWriter{
+		send:        stream.Send,
+		filename:    "transactions." + format,
+		contentType: exportContentType(format),
+	}
+	writer, err := newExportWriter(format, out, accounts)
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	if err := s.transactions.Export(ctx, workspace.ID, workspace.BaseCurrency, filters, writer.Write); err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	if err := writer.Close(); err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	if err := out.Flush(); err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	return nil
+}
+
 func (s *TransactionService) UpdateTransactionCategory(ctx context.Context, req *apiv1.UpdateTransactionCategoryRequest) (*apiv1.UpdateTransactionCategoryResponse, error) {
-	user, err := requireUser(ctx)
+	workspace, err := requireWorkspace(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -69,7 +122,7 @@ func (s *TransactionService) UpdateTransactionCategory(ctx context.Context, req
 		if value == 0 {
 			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("category_id must be set or omitted"))
 		}
-		category, err := getCategory(ctx, s.db, user.Id, int32(value))
+		category, err := getCategory(ctx, s.db, workspace.ID, int32(value))
 		if err != nil {
 			if errors.Is(err, errNotFound) {
 				return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("category not found"))
@@ -82,7 +135,7 @@ func (s *TransactionService) UpdateTransactionCategory(ctx context.Context, req
 		categoryID = &value
 	}
 
-	if err := updateTransactionCategory(ctx, s.db, user.Id, req.TransactionId, categoryID); err != nil {
+	if err := updateTransactionCategory(ctx, s.db, workspace.ID, req.TransactionId, categoryID); err != nil {
 		if errors.Is(err, errNotFound) {
 			return nil, connect.NewError(connect.CodeNotFound, err)
 		}
@@ -91,6 +144,178 @@ func (s *TransactionService) UpdateTransactionCategory(ctx context.Context, req
 	return &apiv1.UpdateTransactionCategoryResponse{}, nil
 }
 
+func (s *TransactionService) GetTransactionAnalytics(ctx context.Context, req *apiv1.GetTransactionAnalyticsRequest) (*apiv1.GetTransactionAnalyticsResponse, error) {
+	workspace, err := requireWorkspace(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	interval := strings.ToLower(strings.TrimSpace(req.Interval))
+	if interval == "" {
+		interval = AnalyticsIntervalMonth
+	}
+	if !isAnalyticsInterval(interval) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("interval must be day, week or month"))
+	}
+	groupBy := strings.ToLower(strings.TrimSpace(req.GroupBy))
+	if groupBy == "" {
+		groupBy = AnalyticsGroupByCategory
+	}
+	if groupBy != AnalyticsGroupByCategory && groupBy != AnalyticsGroupByGroup {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("group_by must be category or group"))
+	}
+
+	filters, err := transactionFiltersFromRequest(&apiv1.ListTransactionsRequest{
+		FromDate:         req.FromDate,
+		ToDate:           req.ToDate,
+		SourceFileId:     req.SourceFileId,
+		EntryType:        req.EntryType,
+		SearchText:       req.SearchText,
+		CategoryId:       req.CategoryId,
+		AccountNumber:    req.AccountNumber,
+		CardNumber:       req.CardNumber,
+		IncludeTransfers: &req.IncludeTransfers,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	points, err := s.transactions.Analytics(ctx, workspace.ID, workspace.BaseCurrency, filters, interval, groupBy)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return &apiv1.GetTransactionAnalyticsResponse{
+		Points:   points,
+		Currency: normalizeBaseCurrency(workspace.BaseCurrency),
+		Interval: interval,
+		GroupBy:  groupBy,
+	}, nil
+}
+
+func (s *TransactionService) SetTransactionSplits(ctx context.Context, req *apiv1.SetTransactionSplitsRequest) (*apiv1.SetTransactionSplitsResponse, error) {
+	workspace, err := requireWorkspace(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.TransactionId == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("transaction_id is required"))
+	}
+
+	splits := make([]TransactionSplit, 0, len(req.Splits))
+	for _, split := range req.Splits {
+		entry := TransactionSplit{AmountCents: split.Amount}
+		if split.CategoryId != nil {
+			value := int64(*split.CategoryId)
+			category, err := getCategory(ctx, s.db, workspace.ID, int32(value))
+			if err != nil {
+				if errors.Is(err, errNotFound) {
+					return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("category not found"))
+				}
+				return nil, connect.NewError(connect.CodeInternal, err)
+			}
+			if category.IsGroup {
+				return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("category cannot be a group"))
+			}
+			entry.CategoryID = &value
+		}
+		splits = append(splits, entry)
+	}
+
+	stored, err := s.transactions.SetSplits(ctx, workspace.ID, int64(req.TransactionId), splits)
+	if err != nil {
+		if errors.Is(err, errNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		if errors.Is(err, errInvalidSplits) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return &apiv1.SetTransactionSplitsResponse{Splits: stored}, nil
+}
+
+func (s *TransactionService) DeleteTransactionSplits(ctx context.Context, req *apiv1.DeleteTransactionSplitsRequest) (*apiv1.DeleteTransactionSplitsResponse, error) {
+	workspace, err := requireWorkspace(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.TransactionId == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("transaction_id is required"))
+	}
+
+	if err := s.transactions.DeleteSplits(ctx, workspace.ID, int64(req.TransactionId)); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return &apiv1.DeleteTransactionSplitsResponse{}, nil
+}
+
+func (s *TransactionService) DetectTransfers(ctx context.Context, req *apiv1.DetectTransfersRequest) (*apiv1.DetectTransfersResponse, error) {
+	workspace, err := requireWorkspace(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := s.transactions.DetectTransfers(ctx, workspace.ID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return &apiv1.DetectTransfersResponse{Created: int32(created)}, nil
+}
+
+func (s *TransactionService) ListTransfers(ctx context.Context, req *apiv1.ListTransfersRequest) (*apiv1.ListTransfersResponse, error) {
+	workspace, err := requireWorkspace(ctx)
+	if err != nil {
+		return nil, err
+	}
+	status := strings.ToLower(strings.TrimSpace(req.Status))
+	switch status {
+	case "", TransferStatusSuggested, TransferStatusConfirmed, TransferStatusRejected:
+	default:
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("unknown transfer status"))
+	}
+
+	items, err := s.transactions.ListTransfers(ctx, workspace.ID, status)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return &apiv1.ListTransfersResponse{Items: items}, nil
+}
+
+func (s *TransactionService) ConfirmTransfer(ctx context.Context, req *apiv1.ConfirmTransferRequest) (*apiv1.ConfirmTransferResponse, error) {
+	if err := s.setTransferStatus(ctx, req.TransferId, TransferStatusConfirmed); err != nil {
+		return nil, err
+	}
+	return &apiv1.ConfirmTransferResponse{}, nil
+}
+
+func (s *TransactionService) UnlinkTransfer(ctx context.Context, req *apiv1.UnlinkTransferRequest) (*apiv1.UnlinkTransferResponse, error) {
+	if err := s.setTransferStatus(ctx, req.TransferId, TransferStatusRejected); err != nil {
+		return nil, err
+	}
+	return &apiv1.UnlinkTransferResponse{}, nil
+}
+
+func (s *TransactionService) setTransferStatus(ctx context.Context, transferID int32, status string) error {
+	workspace, err := requireWorkspace(ctx)
+	if err != nil {
+		return err
+	}
+	if transferID == 0 {
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("transfer_id is required"))
+	}
+
+	if err := s.transactions.SetTransferStatus(ctx, workspace.ID, int64(transferID), status); err != nil {
+		if errors.Is(err, errNotFound) {
+			return connect.NewError(connect.CodeNotFound, err)
+		}
+		if errors.Is(err, errTransferConflict) {
+			return connect.NewError(connect.CodeFailedPrecondition, err)
+		}
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	return nil
+}
+
 func transactionFiltersFromRequest(req *apiv1.ListTransactionsRequest) (TransactionFilters, error) {
 	filters := TransactionFilters{}
 
@@ -142,6 +367,8 @@ func transactionFiltersFromRequest(req *apiv1.ListTransactionsRequest) (Transact
 		filters.SourceCardNumber = cardNumber
 	}
 
+	filters.IncludeTransfers = req.GetIncludeTransfers()
+
 	if req.Limit > 0 {
 		filters.Limit = int(req.Limit)
 	}
@@ -153,7 +380,7 @@ func transactionFiltersFromRequest(req *apiv1.ListTransactionsRequest) (Transact
 	return filters, nil
 }
 
-func updateTransactionCategory(ctx context.Context, db *Db, userID int32, transactionID int32, categoryID *int64) error {
+func updateTransactionCategory(ctx context.Context, db *Db, workspaceID int32, transactionID int32, categoryID *int64) error {
 	var category pgtype.Int8
 	var categorySource pgtype.Text
 	if categoryID != nil {
@@ -164,7 +391,7 @@ func updateTransactionCategory(ctx context.Context, db *Db, userID int32, transa
 		CategoryID:     category,
 		CategorySource: categorySource,
 		ID:             int64(transactionID),
-		UserID:         userID,
+		WorkspaceID:    workspaceID,
 	})
 	if err != nil {
 		return err
@@ -0,0 +1,152 @@
+package cashtrack
+
+import (
+	apiv1 "cashtrack/backend/gen/api/v1"
+	db "cashtrack/backend/gen/db"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// TransactionSplit assigns part of a transaction's amount to a category. When a transaction has
+// splits, they replace its own category everywhere amounts are counted per category.
+type TransactionSplit struct {
+	CategoryID  *int64
+	AmountCents int64
+}
+
+var errInvalidSplits = errors.New("invalid splits")
+
+// validateSplits requires at least two non-zero parts that add up to the transaction amount.
+func validateSplits(amountCents int64, splits []TransactionSplit) error {
+	if len(splits) < 2 {
+		return errors.New("at least two splits are required")
+	}
+	var total int64
+	for _, split := range splits {
+		if split.AmountCents == 0 {
+			return errors.New("split amount must not be zero")
+		}
+		total += split.AmountCents
+	}
+	if total != amountCents {
+		return fmt.Errorf("splits add up to %d, expected %d", total, amountCents)
+	}
+	return nil
+}
+
+// SetSplits replaces the splits of a transaction. It returns errNotFound for transactions of
+// other users and a wrapped errInvalidSplits when the splits don't add up.
+func (s *TransactionsService) SetSplits(ctx context.Context, workspaceID int32, transactionID int64, splits []TransactionSplit) ([]*apiv1.TransactionSplit, error) {
+	tx, err := s.db.conn.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	txQueries := s.db.Queries.WithTx(tx)
+	amount, err := txQueries.GetTransactionAmount(ctx, db.GetTransactionAmountParams{
+		ID:          transactionID,
+		WorkspaceID: workspaceID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errNotFound
+		}
+		return nil, fmt.Errorf("load transaction: %w", err)
+	}
+	amountCents, err := numericToCents(amount)
+	if err != nil {
+		return nil, fmt.Errorf("convert amount: %w", err)
+	}
+	if err := validateSplits(amountCents, splits); err != nil {
+		return nil, fmt.Errorf("%w: %v", errInvalidSplits, err)
+	}
+
+	if err := txQueries.DeleteTransactionSplits(ctx, db.DeleteTransactionSplitsParams{
+		TransactionID: transactionID,
+		WorkspaceID:   workspaceID,
+	}); err != nil {
+		return nil, fmt.Errorf("delete splits: %w", err)
+	}
+	for _, split := range splits {
+		var categoryID pgtype.Int8
+		if split.CategoryID != nil {
+			categoryID = pgtype.Int8{Int64: *split.CategoryID, Valid: true}
+		}
+		splitAmount, err := numericFromCents(split.AmountCents)
+		if err != nil {
+			return nil, fmt.Errorf("convert split amount: %w", err)
+		}
+		if err := txQueries.CreateTransactionSplit(ctx, db.CreateTransactionSplitParams{
+			TransactionID: transactionID,
+			WorkspaceID:   workspaceID,
+			CategoryID:    categoryID,
+			Amount:        splitAmount,
+		}); err != nil {
+			return nil, fmt.Errorf("insert split: %w", err)
+		}
+	}
+
+	stored, err := listSplits(ctx, txQueries, workspaceID, []int64{transactionID})
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+	return stored[transactionID], nil
+}
+
+func (s *TransactionsService) DeleteSplits(ctx context.Context, workspaceID int32, transactionID int64) error {
+	return s.db.Queries.DeleteTransactionSplits(ctx, db.DeleteTransactionSplitsParams{
+		TransactionID: transactionID,
+		WorkspaceID:   workspaceID,
+	})
+}
+
+// attachSplits fills in the splits of the listed transactions.
+func (s *TransactionsService) attachSplits(ctx context.Context, workspaceID int32, entries []*apiv1.Transaction) error {
+	if len(entries) == 0 {
+		return nil
+	}
+	ids := make([]int64, 0, len(entries))
+	for _, entry := range entries {
+		ids = append(ids, int64(entry.Id))
+	}
+	splits, err := listSplits(ctx, s.db.Queries, workspaceID, ids)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		entry.Splits = splits[int64(entry.Id)]
+	}
+	return nil
+}
+
+func listSplits(ctx context.Context, queries *db.Queries, workspaceID int32, transactionIDs []int64) (map[int64][]*apiv1.TransactionSplit, error) {
+	rows, err := queries.ListTransactionSplits(ctx, db.ListTransactionSplitsParams{
+		WorkspaceID:    workspaceID,
+		TransactionIds: transactionIDs,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("query splits: %w", err)
+	}
+	splits := make(map[int64][]*apiv1.TransactionSplit)
+	for _, row := range rows {
+		amountCents, err := numericToCents(row.Amount)
+		if err != nil {
+			return nil, fmt.Errorf("convert split amount: %w", err)
+		}
+		split := &apiv1.TransactionSplit{Id: int32(row.ID), Amount: amountCents}
+		if row.CategoryID.Valid {
+			categoryID := int32(row.CategoryID.Int64)
+			split.CategoryId = &categoryID
+		}
+		splits[row.TransactionID] = append(splits[row.TransactionID], split)
+	}
+	return splits, nil
+}
@@ -0,0 +1,108 @@
+package cashtrack
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestValidateSplits(t *testing.T) {
+	tests := []struct {
+		name   string
+		amount int64
+		splits []TransactionSplit
+		valid  bool
+	}{
+		{name: "single split", amount: -1000, splits: []TransactionSplit{{AmountCents: -1000}}},
+		{name: "zero part", amount: -1000, splits: []TransactionSplit{{AmountCents: -1000}, {AmountCents: 0}}},
+		{name: "wrong total", amount: -1000, splits: []TransactionSplit{{AmountCents: -600}, {AmountCents: -300}}},
+		{name: "matching total", amount: -1000, splits: []TransactionSplit{{AmountCents: -600}, {AmountCents: -400}}, valid: true},
+		{name: "mixed signs", amount: -1000, splits: []TransactionSplit{{AmountCents: -1500}, {AmountCents: 500}}, valid: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateSplits(tt.amount, tt.splits)
+			if tt.valid && err != nil {
+				t.Fatalf("expected valid splits, got %v", err)
+			}
+			if !tt.valid && err == nil {
+				t.Fatalf("expected error")
+			}
+		})
+	}
+}
+
+func TestTransactionSplitsCountPerCategory(t *testing.T) {
+	db, cleanup := openTestDB(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	createSummaryTables(t, db)
+	userID := createUser(t, db, "splits@example.com")
+
+	var transactionID int64
+	err := db.conn.QueryRow(ctx, `
+		INSERT INTO transactions (workspace_id, posted_date, description, amount, currency, entry_type, category_id)
+		VALUES ($1, '2026-01-05', 'supermarket', -100.00, 'CHF', $2, 4)
+		RETURNING id
+	`, userID, EntryTypeDebit).Scan(&transactionID)
+	if err != nil {
+		t.Fatalf("insert transaction: %v", err)
+	}
+
+	service := newTestTransactionsService(t, db)
+	_, err = service.SetSplits(ctx, userID, transactionID, []TransactionSplit{
+		{CategoryID: int64Ptr(4), AmountCents: -6000},
+		{CategoryID: int64Ptr(5), AmountCents: -3000},
+	})
+	if !errors.Is(err, errInvalidSplits) {
+		t.Fatalf("expected invalid splits error, got %v", err)
+	}
+	if _, err := service.SetSplits(ctx, userID, transactionID+1, nil); !errors.Is(err, errNotFound) {
+		t.Fatalf("expected not found, got %v", err)
+	}
+
+	splits, err := service.SetSplits(ctx, userID, transactionID, []TransactionSplit{
+		{CategoryID: int64Ptr(4), AmountCents: -7000},
+		{CategoryID: int64Ptr(5), AmountCents: -3000},
+	})
+	if err != nil {
+		t.Fatalf("set splits: %v", err)
+	}
+	if len(splits) != 2 {
+		t.Fatalf("expected 2 splits, got %d", len(splits))
+	}
+
+	groceries, err := service.Summary(ctx, userID, "CHF", TransactionFilters{CategoryID: int64Ptr(4)})
+	if err != nil {
+		t.Fatalf("summary: %v", err)
+	}
+	if groceries.Count != 1 {
+		t.Fatalf("expected count 1, got %d", groceries.Count)
+	}
+	assertSummaryCents(t, groceries.Total, -7000)
+	household, err := service.Summary(ctx, userID, "CHF", TransactionFilters{CategoryID: int64Ptr(5)})
+	if err != nil {
+		t.Fatalf("summary: %v", err)
+	}
+	assertSummaryCents(t, household.Total, -3000)
+
+	entries, err := service.ListWithCategories(ctx, userID, TransactionFilters{CategoryID: int64Ptr(5)})
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	if len(entries) != 1 || len(entries[0].Splits) != 2 {
+		t.Fatalf("expected the split transaction with its splits, got %+v", entries)
+	}
+
+	if err := service.DeleteSplits(ctx, userID, transactionID); err != nil {
+		t.Fatalf("delete splits: %v", err)
+	}
+	household, err = service.Summary(ctx, userID, "CHF", TransactionFilters{CategoryID: int64Ptr(5)})
+	if err != nil {
+		t.Fatalf("summary: %v", err)
+	}
+	if household.Count != 0 {
+		t.Fatalf("expected no transactions in the second category, got %d", household.Count)
+	}
+}
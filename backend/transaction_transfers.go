@@ -0,0 +1,243 @@
+package cashtrack
+
+import (
+	apiv1 "cashtrack/backend/gen/api/v1"
+	db "cashtrack/backend/gen/db"
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const (
+	TransferStatusSuggested = "suggested"
+	TransferStatusConfirmed = "confirmed"
+	TransferStatusRejected  = "rejected"
+)
+
+// transferWindowDays is how many days apart the two legs of a transfer may be booked. Card
+// payments from an account usually land on the card statement a day or two later.
+const transferWindowDays = 5
+
+var errTransferConflict = errors.New("transaction already belongs to another transfer")
+
+type transferCandidate struct {
+	ID          int64
+	Account     string
+	PostedDate  time.Time
+	AmountCents int64
+	Currency    string
+}
+
+type transferPair struct {
+	DebitID  int64
+	CreditID int64
+}
+
+// matchTransfers pairs each debit with a credit of the same amount and currency that was booked
+// on another of the workspace's accounts within transferWindowDays. The closest credit by date wins,
+// the lower id breaking ties, and rejected pairs are never suggested again.
+func matchTransfers(candidates []transferCandidate, rejected map[transferPair]struct{}) []transferPair {
+	type amountKey struct {
+		currency string
+		cents    int64
+	}
+	credits := make(map[amountKey][]transferCandidate)
+	debits := make([]transferCandidate, 0)
+	for _, candidate := range candidates {
+		switch {
+		case candidate.AmountCents > 0:
+			key := amountKey{currency: candidate.Currency, cents: candidate.AmountCents}
+			credits[key] = append(credits[key], candidate)
+		case candidate.AmountCents < 0:
+			debits = append(debits, candidate)
+		}
+	}
+	sort.SliceStable(debits, func(i, j int) bool {
+		if !debits[i].PostedDate.Equal(debits[j].PostedDate) {
+			return debits[i].PostedDate.Before(debits[j].PostedDate)
+		}
+		return debits[i].ID < debits[j].ID
+	})
+
+	used := make(map[int64]struct{})
+	pairs := make([]transferPair, 0)
+	for _, debit := range debits {
+		var best *transferCandidate
+		bestDays := 0
+		matches := credits[amountKey{currency: debit.Currency, cents: -debit.AmountCents}]
+		for i := range matches {
+			credit := &matches[i]
+			if _, ok := used[credit.ID]; ok || credit.Account == debit.Account {
+				continue
+			}
+			if _, ok := rejected[transferPair{DebitID: debit.ID, CreditID: credit.ID}]; ok {
+				continue
+			}
+			days := daysApart(debit.PostedDate, credit.PostedDate)
+			if days > transferWindowDays {
+				continue
+			}
+			if best == nil || days < bestDays || (days == bestDays && credit.ID < best.ID) {
+				best = credit
+				bestDays = days
+			}
+		}
+		if best == nil {
+			continue
+		}
+		used[best.ID] = struct{}{}
+		pairs = append(pairs, transferPair{DebitID: debit.ID, CreditID: best.ID})
+	}
+	return pairs
+}
+
+func daysApart(a, b time.Time) int {
+	days := int(a.Sub(b).Hours() / 24)
+	if days < 0 {
+		return -days
+	}
+	return days
+}
+
+// transferAccount identifies the account a transaction was booked on. Rows without account or
+// card numbers fall back to their report, so two legs from the same statement never pair up.
+func transferAccount(row db.ListTransferCandidatesRow) string {
+	if account := strings.TrimSpace(row.SourceAccountNumber.String); account != "" {
+		return "account:" + account
+	}
+	if card := strings.TrimSpace(row.SourceCardNumber.String); card != "" {
+		return "card:" + card
+	}
+	return fmt.Sprintf("report:%d", row.SourceFileID)
+}
+
+// DetectTransfers suggests transfer pairs among the workspace's transactions that aren't paired yet.
+func (s *TransactionsService) DetectTransfers(ctx context.Context, workspaceID int32) (int, error) {
+	return detectTransfers(ctx, s.db.Queries, workspaceID)
+}
+
+func detectTransfers(ctx context.Context, queries *db.Queries, workspaceID int32) (int, error) {
+	rows, err := queries.ListTransferCandidates(ctx, workspaceID)
+	if err != nil {
+		return 0, fmt.Errorf("query transfer candidates: %w", err)
+	}
+	candidates := make([]transferCandidate, 0, len(rows))
+	for _, row := range rows {
+		amountCents, err := numericToCents(row.Amount)
+		if err != nil {
+			return 0, fmt.Errorf("convert amount: %w", err)
+		}
+		candidates = append(candidates, transferCandidate{
+			ID:          row.ID,
+			Account:     transferAccount(row),
+			PostedDate:  row.PostedDate.Time,
+			AmountCents: amountCents,
+			Currency:    normalizeCurrency(row.Currency),
+		})
+	}
+
+	rejectedRows, err := queries.ListRejectedTransfers(ctx, workspaceID)
+	if err != nil {
+		return 0, fmt.Errorf("query rejected transfers: %w", err)
+	}
+	rejected := make(map[transferPair]struct{}, len(rejectedRows))
+	for _, row := range rejectedRows {
+		rejected[transferPair{DebitID: row.DebitTransactionID, CreditID: row.CreditTransactionID}] = struct{}{}
+	}
+
+	created := 0
+	for _, pair := range matchTransfers(candidates, rejected) {
+		inserted, err := queries.CreateTransactionTransfer(ctx, db.CreateTransactionTransferParams{
+			WorkspaceID:         workspaceID,
+			DebitTransactionID:  pair.DebitID,
+			CreditTransactionID: pair.CreditID,
+			Status:              TransferStatusSuggested,
+		})
+		if err != nil {
+			return created, fmt.Errorf("insert transfer: %w", err)
+		}
+		created += int(inserted)
+	}
+	return created, nil
+}
+
+func (s *TransactionsService) ListTransfers(ctx context.Context, workspaceID int32, status string) ([]*apiv1.TransactionTransfer, error) {
+	rows, err := s.db.Queries.ListTransactionTransfers(ctx, db.ListTransactionTransfersParams{
+		WorkspaceID: workspaceID,
+		Status:      textOrNull(status),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("query transfers: %w", err)
+	}
+	transfers := make([]*apiv1.TransactionTransfer, 0, len(rows))
+	for _, row := range rows {
+		debit, err := transferLeg(row.DebitID, row.DebitPostedDate, row.DebitDescription, row.DebitAmount, row.DebitCurrency, row.DebitSourceAccountNumber, row.DebitSourceCardNumber)
+		if err != nil {
+			return nil, err
+		}
+		credit, err := transferLeg(row.CreditID, row.CreditPostedDate, row.CreditDescription, row.CreditAmount, row.CreditCurrency, row.CreditSourceAccountNumber, row.CreditSourceCardNumber)
+		if err != nil {
+			return nil, err
+		}
+		createdAt := ""
+		if row.CreatedAt.Valid {
+			createdAt = row.CreatedAt.Time.Format(time.RFC3339Nano)
+		}
+		transfers = append(transfers, &apiv1.TransactionTransfer{
+			Id:        int32(row.ID),
+			Status:    row.Status,
+			Debit:     debit,
+			Credit:    credit,
+			CreatedAt: createdAt,
+		})
+	}
+	return transfers, nil
+}
+
+func transferLeg(id int64, postedDate pgtype.Date, description string, amount pgtype.Numeric, currency string, accountNumber pgtype.Text, cardNumber pgtype.Text) (*apiv1.Transaction, error) {
+	amountCents, err := numericToCents(amount)
+	if err != nil {
+		return nil, fmt.Errorf("convert amount: %w", err)
+	}
+	date := ""
+	if postedDate.Valid {
+		date = postedDate.Time.Format(time.RFC3339Nano)
+	}
+	return &apiv1.Transaction{
+		Id:                  int32(id),
+		PostedDate:          date,
+		Description:         description,
+		Amount:              amountCents,
+		Currency:            currency,
+		SourceAccountNumber: accountNumber.String,
+		SourceCardNumber:    cardNumber.String,
+	}, nil
+}
+
+// SetTransferStatus confirms or unlinks a transfer pair. Unlinked pairs are kept as rejected so
+// detection doesn't suggest them again. Confirming a rejected pair fails with errTransferConflict
+// when one of its transactions has been paired with something else since.
+func (s *TransactionsService) SetTransferStatus(ctx context.Context, workspaceID int32, transferID int64, status string) error {
+	updated, err := s.db.Queries.UpdateTransactionTransferStatus(ctx, db.UpdateTransactionTransferStatusParams{
+		Status:      status,
+		ID:          transferID,
+		WorkspaceID: workspaceID,
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return errTransferConflict
+		}
+		return fmt.Errorf("update transfer: %w", err)
+	}
+	if updated == 0 {
+		return errNotFound
+	}
+	return nil
+}
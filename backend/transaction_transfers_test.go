@@ -0,0 +1,120 @@
+package cashtrack
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestMatchTransfers(t *testing.T) {
+	day := func(d int) time.Time {
+		return time.Date(2026, 1, d, 0, 0, 0, 0, time.UTC)
+	}
+	candidates := []transferCandidate{
+		{ID: 1, Account: "account:ubs", PostedDate: day(23), AmountCents: -100000, Currency: "CHF"},
+		{ID: 2, Account: "card:9396", PostedDate: day(23), AmountCents: 100000, Currency: "CHF"},
+		// Same amount but on the same account: a refund, not a transfer.
+		{ID: 3, Account: "account:ubs", PostedDate: day(10), AmountCents: -2000, Currency: "CHF"},
+		{ID: 4, Account: "account:ubs", PostedDate: day(11), AmountCents: 2000, Currency: "CHF"},
+		// Too far apart.
+		{ID: 5, Account: "account:ubs", PostedDate: day(1), AmountCents: -5000, Currency: "CHF"},
+		{ID: 6, Account: "card:9396", PostedDate: day(15), AmountCents: 5000, Currency: "CHF"},
+		// Different currency.
+		{ID: 7, Account: "account:ubs", PostedDate: day(5), AmountCents: -3000, Currency: "CHF"},
+		{ID: 8, Account: "account:eur", PostedDate: day(5), AmountCents: 3000, Currency: "EUR"},
+		// The closer credit wins.
+		{ID: 9, Account: "account:ubs", PostedDate: day(20), AmountCents: -7000, Currency: "CHF"},
+		{ID: 10, Account: "card:9396", PostedDate: day(24), AmountCents: 7000, Currency: "CHF"},
+		{ID: 11, Account: "account:savings", PostedDate: day(21), AmountCents: 7000, Currency: "CHF"},
+	}
+
+	pairs := matchTransfers(candidates, nil)
+	expected := []transferPair{{DebitID: 9, CreditID: 11}, {DebitID: 1, CreditID: 2}}
+	if !reflect.DeepEqual(pairs, expected) {
+		t.Fatalf("expected %+v, got %+v", expected, pairs)
+	}
+
+	rejected := map[transferPair]struct{}{{DebitID: 9, CreditID: 11}: {}}
+	pairs = matchTransfers(candidates, rejected)
+	expected = []transferPair{{DebitID: 9, CreditID: 10}, {DebitID: 1, CreditID: 2}}
+	if !reflect.DeepEqual(pairs, expected) {
+		t.Fatalf("expected %+v with rejected pair skipped, got %+v", expected, pairs)
+	}
+}
+
+func TestTransfersExcludedFromSummary(t *testing.T) {
+	db, cleanup := openTestDB(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	createSummaryTables(t, db)
+	userID := createUser(t, db, "transfers@example.com")
+
+	_, err := db.conn.Exec(ctx, `
+		INSERT INTO transactions (workspace_id, source_file_id, posted_date, description, amount, currency, entry_type, source_account_number, source_card_number) VALUES
+			($1, 1, '2026-01-23', 'Payment to card', -1000.00, 'CHF', 'debit', 'CH25 0023', NULL),
+			($1, 2, '2026-01-24', 'Receipt of payment', 1000.00, 'CHF', 'credit', NULL, '4894 33XX XXXX 9396'),
+			($1, 1, '2026-01-25', 'Groceries', -50.00, 'CHF', 'debit', 'CH25 0023', NULL)
+	`, userID)
+	if err != nil {
+		t.Fatalf("insert transactions: %v", err)
+	}
+
+	service := newTestTransactionsService(t, db)
+	created, err := service.DetectTransfers(ctx, userID)
+	if err != nil {
+		t.Fatalf("detect transfers: %v", err)
+	}
+	if created != 1 {
+		t.Fatalf("expected 1 transfer, got %d", created)
+	}
+
+	summary, err := service.Summary(ctx, userID, "CHF", TransactionFilters{})
+	if err != nil {
+		t.Fatalf("summary: %v", err)
+	}
+	if summary.Count != 1 {
+		t.Fatalf("expected transfers to be excluded, got count %d", summary.Count)
+	}
+	assertSummaryCents(t, summary.Total, -5000)
+
+	summary, err = service.Summary(ctx, userID, "CHF", TransactionFilters{IncludeTransfers: true})
+	if err != nil {
+		t.Fatalf("summary: %v", err)
+	}
+	if summary.Count != 3 {
+		t.Fatalf("expected transfers to be included, got count %d", summary.Count)
+	}
+
+	transfers, err := service.ListTransfers(ctx, userID, "")
+	if err != nil {
+		t.Fatalf("list transfers: %v", err)
+	}
+	if len(transfers) != 1 || transfers[0].Status != TransferStatusSuggested || transfers[0].Debit.Amount != -100000 || transfers[0].Credit.Amount != 100000 {
+		t.Fatalf("unexpected transfers %+v", transfers)
+	}
+
+	transferID := int64(transfers[0].Id)
+	if err := service.SetTransferStatus(ctx, userID+1, transferID, TransferStatusConfirmed); !errors.Is(err, errNotFound) {
+		t.Fatalf("expected not found for another user, got %v", err)
+	}
+	if err := service.SetTransferStatus(ctx, userID, transferID, TransferStatusRejected); err != nil {
+		t.Fatalf("unlink transfer: %v", err)
+	}
+	created, err = service.DetectTransfers(ctx, userID)
+	if err != nil {
+		t.Fatalf("detect transfers: %v", err)
+	}
+	if created != 0 {
+		t.Fatalf("expected the unlinked pair to stay unlinked, got %d new transfers", created)
+	}
+	summary, err = service.Summary(ctx, userID, "CHF", TransactionFilters{})
+	if err != nil {
+		t.Fatalf("summary: %v", err)
+	}
+	if summary.Count != 3 {
+		t.Fatalf("expected unlinked transactions to count again, got count %d", summary.Count)
+	}
+}
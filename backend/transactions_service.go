@@ -6,6 +6,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"reflect"
 	"sort"
 	"strings"
 	"time"
@@ -28,38 +29,98 @@ type TransactionFilters struct {
 	SourceAccountNumber string
 	SourceCardNumber    string
 	CategoryID          *int64
+	IncludeTransfers    bool
 	Limit               int
 	Offset              int
 }
 
-func NewTransactionsService(db *Db) *TransactionsService {
+func NewTransactionsService(db *Db, exchangeRates *ExchangeRateService) *TransactionsService {
 	return &TransactionsService{
 		db:            db,
-		exchangeRates: NewExchangeRateService(db),
+		exchangeRates: exchangeRates,
 	}
 }
 
-func (s *TransactionsService) ReplaceForSourceTx(ctx context.Context, tx pgx.Tx, userID int32, sourceFileID int64, entries []ParsedTransaction) error {
+// ReplaceSummary counts what merging a report's rows into its stored transactions did. Kept rows
+// were already stored unchanged; duplicates were skipped because another report has them.
+type ReplaceSummary struct {
+	Inserted   int
+	Updated    int
+	Deleted    int
+	Kept       int
+	Duplicates int
+}
+
+// transactionsLockNamespace is the first key of the per-workspace import lock, so it can't collide with
+// advisory locks taken on a bare id.
+const transactionsLockNamespace int32 = 0x63740001
+
+// ReplaceForSourceTx merges the parsed rows of a report into the transactions stored for it.
+// Rows are matched by transaction id, or by date, amount, account and description when there is
+// none, so a stored transaction keeps its id and manual category across re-imports. Matched rows
+// are updated when the parser output changed, and stored rows that no longer appear are deleted.
+func (s *TransactionsService) ReplaceForSourceTx(ctx context.Context, tx pgx.Tx, workspaceID int32, sourceFileID int64, entries []ParsedTransaction) (ReplaceSummary, error) {
+	var summary ReplaceSummary
 	txQueries := s.db.Queries.WithTx(tx)
-	err := txQueries.DeleteTransactionsBySource(ctx, db.DeleteTransactionsBySourceParams{
+	// Serialize imports per workspace so concurrent reports see each other's rows when deduplicating.
+	if err := txQueries.LockWorkspaceTransactions(ctx, db.LockWorkspaceTransactionsParams{Namespace: transactionsLockNamespace, WorkspaceID: workspaceID}); err != nil {
+		return summary, fmt.Errorf("lock workspace transactions: %w", err)
+	}
+	storedRows, err := txQueries.ListTransactionsBySource(ctx, db.ListTransactionsBySourceParams{
 		SourceFileID: sourceFileID,
-		UserID:       userID,
+		WorkspaceID:  workspaceID,
 	})
 	if err != nil {
-		return fmt.Errorf("delete transactions: %w", err)
+		return summary, fmt.Errorf("load stored transactions: %w", err)
+	}
+	stored, err := newSourceIndex(storedRows)
+	if err != nil {
+		return summary, fmt.Errorf("load stored transactions: %w", err)
+	}
+
+	if len(entries) > 0 {
+		if err := s.mergeEntries(ctx, txQueries, workspaceID, sourceFileID, entries, stored, &summary); err != nil {
+			return summary, err
+		}
 	}
 
-	if len(entries) == 0 {
-		return nil
+	vanished := stored.unmatched()
+	if len(vanished) > 0 {
+		deleted, err := txQueries.DeleteTransactionsByIDs(ctx, db.DeleteTransactionsByIDsParams{
+			WorkspaceID: workspaceID,
+			Ids:         vanished,
+		})
+		if err != nil {
+			return summary, fmt.Errorf("delete transactions: %w", err)
+		}
+		summary.Deleted = int(deleted)
 	}
+	return summary, nil
+}
 
-	rules, err := s.listCategoryRules(ctx, userID)
+func (s *TransactionsService) mergeEntries(ctx context.Context, txQueries *db.Queries, workspaceID int32, sourceFileID int64, entries []ParsedTransaction, stored *sourceIndex, summary *ReplaceSummary) error {
+	rules, err := s.listCategoryRules(ctx, workspaceID)
 	if err != nil {
 		return fmt.Errorf("load category rules: %w", err)
 	}
 	normalizedRules := normalizeRules(rules)
 
+	duplicates, err := s.loadDuplicateIndex(ctx, txQueries, workspaceID, sourceFileID, entries)
+	if err != nil {
+		return fmt.Errorf("load duplicate candidates: %w", err)
+	}
+
 	for _, entry := range entries {
+		amount, err := numericFromString(entry.Amount)
+		if err != nil {
+			return fmt.Errorf("parse amount %q: %w", entry.Amount, err)
+		}
+		amountCents, err := numericToCents(amount)
+		if err != nil {
+			return fmt.Errorf("parse amount %q: %w", entry.Amount, err)
+		}
+		account := duplicateAccount(entry.SourceAccountNumber, entry.SourceCardNumber)
+
 		var meta json.RawMessage
 		if entry.ParserMeta != nil {
 			payload, err := json.Marshal(entry.ParserMeta)
@@ -69,19 +130,70 @@ func (s *TransactionsService) ReplaceForSourceTx(ctx context.Context, tx pgx.Tx,
 			meta = payload
 		}
 
-		amount, err := numericFromString(entry.Amount)
-		if err != nil {
-			return fmt.Errorf("parse amount %q: %w", entry.Amount, err)
-		}
-
-		categoryID := categoryIDFromDescription(entry.Description, normalizedRules)
+		categoryID := categoryIDFromRules(categoryRuleSubject{
+			Description:   entry.Description,
+			AmountCents:   amountCents,
+			EntryType:     entry.EntryType,
+			Currency:      entry.Currency,
+			AccountNumber: entry.SourceAccountNumber,
+			CardNumber:    entry.SourceCardNumber,
+			ParserName:    entry.ParserName,
+		}, normalizedRules)
 		categorySource := pgtype.Text{}
 		if categoryID.Valid {
 			categorySource = pgtype.Text{String: categorySourceRule, Valid: true}
 		}
 
+		if row, ok := stored.match(entry.TransactionID, entry.PostedDate, amountCents, account, entry.Description); ok {
+			if row.CategorySource.String == categorySourceManual {
+				categoryID = row.CategoryID
+				categorySource = row.CategorySource
+			}
+			update := db.UpdateTransactionFromSourceParams{
+				SourceFileRow:       int32(entry.SourceFileRow),
+				ParserName:          entry.ParserName,
+				PostedDate:          pgtype.Date{Time: entry.PostedDate, Valid: true},
+				Description:         entry.Description,
+				Amount:              amount,
+				Currency:            entry.Currency,
+				TransactionID:       nullableText(entry.TransactionID),
+				EntryType:           entry.EntryType,
+				SourceAccountNumber: nullableText(entry.SourceAccountNumber),
+				SourceCardNumber:    nullableText(entry.SourceCardNumber),
+				CategoryID:          categoryID,
+				CategorySource:      categorySource,
+				ParserMeta:          meta,
+				ID:                  row.ID,
+				WorkspaceID:         workspaceID,
+			}
+			if !storedTransactionChanged(row, update, amountCents) {
+				summary.Kept++
+				continue
+			}
+			if storedCents, err := numericToCents(row.Amount); err != nil || storedCents != amountCents {
+				// Splits must add up to the amount, so they can't survive a change to it.
+				if err := txQueries.DeleteTransactionSplits(ctx, db.DeleteTransactionSplitsParams{
+					TransactionID: row.ID,
+					WorkspaceID:   workspaceID,
+				}); err != nil {
+					return fmt.Errorf("delete splits: %w", err)
+				}
+			}
+			if err := txQueries.UpdateTransactionFromSource(ctx, update); err != nil {
+				return fmt.Errorf("update transaction: %w", err)
+			}
+			summary.Updated++
+			continue
+		}
+
+		if duplicateID, ok := duplicates.match(entry.TransactionID, entry.PostedDate, amountCents, account, entry.Description); ok {
+			log.Debug().Int64("source_file_id", sourceFileID).Int("row", entry.SourceFileRow).Int64("duplicate_of", duplicateID).Msg("skipping duplicate transaction")
+			summary.Duplicates++
+			continue
+		}
+
 		err = txQueries.CreateTransaction(ctx, db.CreateTransactionParams{
-			UserID:              userID,
+			WorkspaceID:         workspaceID,
 			SourceFileID:        sourceFileID,
 			SourceFileRow:       int32(entry.SourceFileRow),
 			ParserName:          entry.ParserName,
@@ -100,14 +212,135 @@ func (s *TransactionsService) ReplaceForSourceTx(ctx context.Context, tx pgx.Tx,
 		if err != nil {
 			return fmt.Errorf("insert transaction: %w", err)
 		}
+		summary.Inserted++
 	}
-
 	return nil
 }
 
-func (s *TransactionsService) List(ctx context.Context, userID int32, filters TransactionFilters) ([]*apiv1.Transaction, error) {
+// sourceIndex matches parsed rows against the transactions already stored for the same report,
+// with the same rules duplicateIndex applies across reports.
+type sourceIndex struct {
+	index *duplicateIndex
+	rows  map[int64]db.ListTransactionsBySourceRow
+	order []int64
+}
+
+func newSourceIndex(rows []db.ListTransactionsBySourceRow) (*sourceIndex, error) {
+	candidates := make([]duplicateCandidate, 0, len(rows))
+	byID := make(map[int64]db.ListTransactionsBySourceRow, len(rows))
+	order := make([]int64, 0, len(rows))
+	for _, row := range rows {
+		amountCents, err := numericToCents(row.Amount)
+		if err != nil {
+			return nil, err
+		}
+		candidates = append(candidates, duplicateCandidate{
+			ID:            row.ID,
+			PostedDate:    row.PostedDate.Time,
+			AmountCents:   amountCents,
+			TransactionID: row.TransactionID.String,
+			Account:       duplicateAccount(row.SourceAccountNumber.String, row.SourceCardNumber.String),
+			Description:   row.Description,
+		})
+		byID[row.ID] = row
+		order = append(order, row.ID)
+	}
+	return &sourceIndex{index: newDuplicateIndex(candidates), rows: byID, order: order}, nil
+}
+
+func (i *sourceIndex) match(transactionID string, postedDate time.Time, amountCents int64, account string, description string) (db.ListTransactionsBySourceRow, bool) {
+	id, ok := i.index.match(transactionID, postedDate, amountCents, account, description)
+	if !ok {
+		return db.ListTransactionsBySourceRow{}, false
+	}
+	return i.rows[id], true
+}
+
+// unmatched returns the stored rows that no parsed row matched.
+func (i *sourceIndex) unmatched() []int64 {
+	var ids []int64
+	for _, id := range i.order {
+		if !i.index.used[id] {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+func storedTransactionChanged(row db.ListTransactionsBySourceRow, update db.UpdateTransactionFromSourceParams, amountCents int64) bool {
+	storedCents, err := numericToCents(row.Amount)
+	if err != nil || storedCents != amountCents {
+		return true
+	}
+	return row.SourceFileRow != update.SourceFileRow ||
+		row.ParserName != update.ParserName ||
+		!row.PostedDate.Time.Equal(update.PostedDate.Time) ||
+		row.Description != update.Description ||
+		row.Currency != update.Currency ||
+		row.TransactionID != update.TransactionID ||
+		row.EntryType != update.EntryType ||
+		row.SourceAccountNumber != update.SourceAccountNumber ||
+		row.SourceCardNumber != update.SourceCardNumber ||
+		row.CategoryID != update.CategoryID ||
+		row.CategorySource != update.CategorySource ||
+		!sameJSON(row.ParserMeta, update.ParserMeta)
+}
+
+// sameJSON compares documents by value, since jsonb doesn't keep the encoder's formatting.
+func sameJSON(a []byte, b []byte) bool {
+	if len(a) == 0 || len(b) == 0 {
+		return len(a) == len(b)
+	}
+	var left, right any
+	if json.Unmarshal(a, &left) != nil || json.Unmarshal(b, &right) != nil {
+		return false
+	}
+	return reflect.DeepEqual(left, right)
+}
+
+func (s *TransactionsService) loadDuplicateIndex(ctx context.Context, queries *db.Queries, workspaceID int32, sourceFileID int64, entries []ParsedTransaction) (*duplicateIndex, error) {
+	fromDate := entries[0].PostedDate
+	toDate := entries[0].PostedDate
+	for _, entry := range entries[1:] {
+		if entry.PostedDate.Before(fromDate) {
+			fromDate = entry.PostedDate
+		}
+		if entry.PostedDate.After(toDate) {
+			toDate = entry.PostedDate
+		}
+	}
+
+	rows, err := queries.ListDuplicateCandidates(ctx, db.ListDuplicateCandidatesParams{
+		WorkspaceID:  workspaceID,
+		SourceFileID: sourceFileID,
+		FromDate:     pgtype.Date{Time: fromDate, Valid: true},
+		ToDate:       pgtype.Date{Time: toDate, Valid: true},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := make([]duplicateCandidate, 0, len(rows))
+	for _, row := range rows {
+		amountCents, err := numericToCents(row.Amount)
+		if err != nil {
+			return nil, fmt.Errorf("convert amount: %w", err)
+		}
+		candidates = append(candidates, duplicateCandidate{
+			ID:            row.ID,
+			PostedDate:    row.PostedDate.Time,
+			AmountCents:   amountCents,
+			TransactionID: row.TransactionID.String,
+			Account:       duplicateAccount(row.SourceAccountNumber.String, row.SourceCardNumber.String),
+			Description:   row.Description,
+		})
+	}
+	return newDuplicateIndex(candidates), nil
+}
+
+func (s *TransactionsService) List(ctx context.Context, workspaceID int32, filters TransactionFilters) ([]*apiv1.Transaction, error) {
 	params := db.ListTransactionsParams{
-		UserID:              userID,
+		WorkspaceID:         workspaceID,
 		FromDate:            dateOrNull(filters.FromDate),
 		ToDate:              dateOrNull(filters.ToDate),
 		SourceFileID:        int64OrNull(filters.SourceFileID),
@@ -166,14 +399,13 @@ func (s *TransactionsService) List(ctx context.Context, userID int32, filters Tr
 	return entries, nil
 }
 
-type CategoryRuleEntry struct {
-	CategoryID          int64
-	DescriptionContains string
-}
-
-func (s *TransactionsService) Summary(ctx context.Context, userID int32, filters TransactionFilters) (*apiv1.TransactionSummary, error) {
+func (s *TransactionsService) Summary(ctx context.Context, workspaceID int32, baseCurrency string, filters TransactionFilters) (*apiv1.TransactionSummary, error) {
+	baseCurrency = normalizeCurrency(baseCurrency)
+	if baseCurrency == "" {
+		baseCurrency = defaultCurrency
+	}
 	rows, err := s.db.Queries.ListTransactionsSummaryRows(ctx, db.ListTransactionsSummaryRowsParams{
-		UserID:              userID,
+		WorkspaceID:         workspaceID,
 		FromDate:            dateOrNull(filters.FromDate),
 		ToDate:              dateOrNull(filters.ToDate),
 		SourceFileID:        int64OrNull(filters.SourceFileID),
@@ -181,6 +413,7 @@ func (s *TransactionsService) Summary(ctx context.Context, userID int32, filters
 		SourceAccountNumber: textOrNull(filters.SourceAccountNumber),
 		SourceCardNumber:    textOrNull(filters.SourceCardNumber),
 		SearchText:          textOrNull(filters.SearchText),
+		IncludeTransfers:    filters.IncludeTransfers,
 		CategoryID:          int64OrNull(filters.CategoryID),
 	})
 	if err != nil {
@@ -194,7 +427,7 @@ func (s *TransactionsService) Summary(ctx context.Context, userID int32, filters
 			Total:          0,
 			Average:        0,
 			Median:         0,
-			Currency:       "CHF",
+			Currency:       baseCurrency,
 			UniqueAccounts: 0,
 			DateRangeStart: "",
 			DateRangeEnd:   "",
@@ -213,14 +446,14 @@ func (s *TransactionsService) Summary(ctx context.Context, userID int32, filters
 		if err != nil {
 			return nil, fmt.Errorf("parse amount: %w", err)
 		}
-		currency := strings.ToUpper(strings.TrimSpace(row.Currency))
+		currency := normalizeCurrency(row.Currency)
 		if currency == "" {
-			currency = "CHF"
+			currency = defaultCurrency
 		}
-		if currency != "CHF" {
-			rate, err := s.exchangeRates.GetRateToCHF(ctx, currency, row.PostedDate.Time)
+		if currency != baseCurrency {
+			rate, err := s.exchangeRates.GetRate(ctx, currency, baseCurrency, row.PostedDate.Time)
 			if err != nil {
-				log.Error().Err(err).Str("currency", currency).Time("date", row.PostedDate.Time).Msg("failed to convert currency")
+				log.Error().Err(err).Str("currency", currency).Str("base_currency", baseCurrency).Time("date", row.PostedDate.Time).Msg("failed to convert currency")
 				return nil, err
 			}
 			value = value * rate
@@ -286,19 +519,26 @@ func (s *TransactionsService) Summary(ctx context.Context, userID int32, filters
 		Total:          centsFromFloat(total),
 		Average:        centsFromFloat(average),
 		Median:         centsFromFloat(median),
-		Currency:       "CHF",
+		Currency:       baseCurrency,
 		UniqueAccounts: int32(len(uniqueAccounts)),
 		DateRangeStart: dateRangeStart,
 		DateRangeEnd:   dateRangeEnd,
 	}, nil
 }
 
-func (s *TransactionsService) ListWithCategories(ctx context.Context, userID int32, filters TransactionFilters) ([]*apiv1.Transaction, error) {
-	return s.List(ctx, userID, filters)
+func (s *TransactionsService) ListWithCategories(ctx context.Context, workspaceID int32, filters TransactionFilters) ([]*apiv1.Transaction, error) {
+	entries, err := s.List(ctx, workspaceID, filters)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.attachSplits(ctx, workspaceID, entries); err != nil {
+		return nil, err
+	}
+	return entries, nil
 }
 
-func (s *TransactionsService) listCategoryRules(ctx context.Context, userID int32) ([]CategoryRuleEntry, error) {
-	rows, err := s.db.Queries.ListCategoryRulesByUser(ctx, userID)
+func (s *TransactionsService) listCategoryRules(ctx context.Context, workspaceID int32) ([]CategoryRuleEntry, error) {
+	rows, err := s.db.Queries.ListCategoryRulesByWorkspace(ctx, workspaceID)
 	if err != nil {
 		return nil, err
 	}
@@ -306,49 +546,34 @@ func (s *TransactionsService) listCategoryRules(ctx context.Context, userID int3
 	rules := make([]CategoryRuleEntry, 0, len(rows))
 	for _, row := range rows {
 		rules = append(rules, CategoryRuleEntry{
-			CategoryID:          row.CategoryID,
-			DescriptionContains: row.DescriptionContains,
+			ID:                     row.ID,
+			CategoryID:             row.CategoryID,
+			CategoryRuleConditions: categoryRuleConditionsFromRow(row),
 		})
 	}
 	return rules, nil
 }
 
-func (s *TransactionsService) ApplyCategoryRules(ctx context.Context, userID int32, applyToAll bool) (int64, error) {
-	rules, err := s.listCategoryRules(ctx, userID)
+func (s *TransactionsService) ApplyCategoryRules(ctx context.Context, workspaceID int32, applyToAll bool) (int64, error) {
+	rules, err := s.listCategoryRules(ctx, workspaceID)
 	if err != nil {
 		return 0, fmt.Errorf("load category rules: %w", err)
 	}
-	normalizedRules := normalizeRules(rules)
-
-	rows, err := s.db.Queries.ListTransactionsForRuleApply(ctx, db.ListTransactionsForRuleApplyParams{
-		UserID:  userID,
-		Column2: applyToAll,
-	})
+	changes, err := s.planCategoryRuleChanges(ctx, workspaceID, applyToAll, normalizeRules(rules))
 	if err != nil {
-		return 0, fmt.Errorf("load transactions: %w", err)
+		return 0, err
 	}
 
 	var updated int64
-	for _, row := range rows {
-		var nextCategoryID pgtype.Int8
-		var nextCategorySource pgtype.Text
-		if derivedID := matchCategoryRule(row.Description, normalizedRules); derivedID != nil {
-			nextCategoryID = pgtype.Int8{Int64: *derivedID, Valid: true}
-			nextCategorySource = pgtype.Text{String: categorySourceRule, Valid: true}
-		}
-
-		if sameInt8(row.CategoryID, nextCategoryID) && sameText(row.CategorySource, nextCategorySource) {
-			continue
-		}
-
+	for _, change := range changes {
 		affected, err := s.db.Queries.UpdateTransactionCategory(ctx, db.UpdateTransactionCategoryParams{
-			CategoryID:     nextCategoryID,
-			CategorySource: nextCategorySource,
-			ID:             row.ID,
-			UserID:         userID,
+			CategoryID:     change.ToCategoryID,
+			CategorySource: change.ToCategorySource,
+			ID:             change.TransactionID,
+			WorkspaceID:    workspaceID,
 		})
 		if err != nil {
-			return updated, fmt.Errorf("update transaction %d: %w", row.ID, err)
+			return updated, fmt.Errorf("update transaction %d: %w", change.TransactionID, err)
 		}
 		updated += affected
 	}
@@ -356,45 +581,82 @@ func (s *TransactionsService) ApplyCategoryRules(ctx context.Context, userID int
 	return updated, nil
 }
 
-type normalizedRule struct {
-	CategoryID int64
-	Needle     string
+// PreviewCategoryRules returns the changes ApplyCategoryRules would make without writing anything.
+// A draft rule is evaluated after the saved ones, where CreateCategoryRule would put it.
+func (s *TransactionsService) PreviewCategoryRules(ctx context.Context, workspaceID int32, applyToAll bool, draft *normalizedRule) ([]categoryRuleChange, error) {
+	rules, err := s.listCategoryRules(ctx, workspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("load category rules: %w", err)
+	}
+	normalizedRules := normalizeRules(rules)
+	if draft != nil {
+		rule := *draft
+		rule.RuleID = 0
+		normalizedRules = append(normalizedRules, rule)
+	}
+	return s.planCategoryRuleChanges(ctx, workspaceID, applyToAll, normalizedRules)
 }
 
-func normalizeRules(rules []CategoryRuleEntry) []normalizedRule {
-	normalized := make([]normalizedRule, 0, len(rules))
-	for _, rule := range rules {
-		needle := strings.ToLower(strings.TrimSpace(rule.DescriptionContains))
-		if needle == "" {
-			continue
-		}
-		normalized = append(normalized, normalizedRule{CategoryID: rule.CategoryID, Needle: needle})
-	}
-	return normalized
+type categoryRuleChange struct {
+	TransactionID    int64
+	PostedDate       pgtype.Date
+	Description      string
+	AmountCents      int64
+	Currency         string
+	FromCategoryID   pgtype.Int8
+	ToCategoryID     pgtype.Int8
+	ToCategorySource pgtype.Text
+	// Rule that produced ToCategoryID; nil when no rule matches any more.
+	Rule *normalizedRule
 }
 
-func matchCategoryRule(description string, rules []normalizedRule) *int64 {
-	if len(rules) == 0 {
-		return nil
+func (s *TransactionsService) planCategoryRuleChanges(ctx context.Context, workspaceID int32, applyToAll bool, normalizedRules []normalizedRule) ([]categoryRuleChange, error) {
+	rows, err := s.db.Queries.ListTransactionsForRuleApply(ctx, db.ListTransactionsForRuleApplyParams{
+		WorkspaceID: workspaceID,
+		Column2:     applyToAll,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("load transactions: %w", err)
 	}
-	haystack := strings.ToLower(description)
-	for _, rule := range rules {
-		if strings.Contains(haystack, rule.Needle) {
-			value := rule.CategoryID
-			return &value
+
+	var changes []categoryRuleChange
+	for _, row := range rows {
+		amountCents, err := numericToCents(row.Amount)
+		if err != nil {
+			return nil, fmt.Errorf("convert amount of transaction %d: %w", row.ID, err)
+		}
+		var nextCategoryID pgtype.Int8
+		var nextCategorySource pgtype.Text
+		rule := firstMatchingRule(categoryRuleSubject{
+			Description:   row.Description,
+			AmountCents:   amountCents,
+			EntryType:     row.EntryType,
+			Currency:      row.Currency,
+			AccountNumber: row.SourceAccountNumber.String,
+			CardNumber:    row.SourceCardNumber.String,
+			ParserName:    row.ParserName,
+		}, normalizedRules)
+		if rule != nil {
+			nextCategoryID = pgtype.Int8{Int64: rule.CategoryID, Valid: true}
+			nextCategorySource = pgtype.Text{String: categorySourceRule, Valid: true}
 		}
-	}
-	return nil
-}
 
-func categoryIDFromDescription(description string, rules []normalizedRule) pgtype.Int8 {
-	if description == "" {
-		return pgtype.Int8{}
-	}
-	if derivedID := matchCategoryRule(description, rules); derivedID != nil {
-		return pgtype.Int8{Int64: *derivedID, Valid: true}
+		if sameInt8(row.CategoryID, nextCategoryID) && sameText(row.CategorySource, nextCategorySource) {
+			continue
+		}
+		changes = append(changes, categoryRuleChange{
+			TransactionID:    row.ID,
+			PostedDate:       row.PostedDate,
+			Description:      row.Description,
+			AmountCents:      amountCents,
+			Currency:         row.Currency,
+			FromCategoryID:   row.CategoryID,
+			ToCategoryID:     nextCategoryID,
+			ToCategorySource: nextCategorySource,
+			Rule:             rule,
+		})
 	}
-	return pgtype.Int8{}
+	return changes, nil
 }
 
 func nullableText(value string) pgtype.Text {
@@ -16,7 +16,7 @@ func TestTransactionsSummaryHandlesNegativeMedian(t *testing.T) {
 
 	_, err := db.conn.Exec(ctx, `
 		INSERT INTO transactions (
-			user_id,
+			workspace_id,
 			source_file_id,
 			posted_date,
 			description,
@@ -29,8 +29,8 @@ func TestTransactionsSummaryHandlesNegativeMedian(t *testing.T) {
 		t.Fatalf("insert transaction: %v", err)
 	}
 
-	service := NewTransactionsService(db)
-	summary, err := service.Summary(ctx, userID, TransactionFilters{CategoryID: int64Ptr(4)})
+	service := newTestTransactionsService(t, db)
+	summary, err := service.Summary(ctx, userID, "CHF", TransactionFilters{CategoryID: int64Ptr(4)})
 	if err != nil {
 		t.Fatalf("summary: %v", err)
 	}
@@ -51,8 +51,8 @@ func TestTransactionsSummaryEmpty(t *testing.T) {
 	createSummaryTables(t, db)
 	userID := createUser(t, db, "summary-empty@example.com")
 
-	service := NewTransactionsService(db)
-	summary, err := service.Summary(ctx, userID, TransactionFilters{})
+	service := newTestTransactionsService(t, db)
+	summary, err := service.Summary(ctx, userID, "CHF", TransactionFilters{})
 	if err != nil {
 		t.Fatalf("summary: %v", err)
 	}
@@ -75,7 +75,7 @@ func TestTransactionsSummaryUsesDebitForAverageMedian(t *testing.T) {
 
 	_, err := db.conn.Exec(ctx, `
 		INSERT INTO transactions (
-			user_id,
+			workspace_id,
 			source_file_id,
 			posted_date,
 			description,
@@ -90,7 +90,7 @@ func TestTransactionsSummaryUsesDebitForAverageMedian(t *testing.T) {
 
 	_, err = db.conn.Exec(ctx, `
 		INSERT INTO transactions (
-			user_id,
+			workspace_id,
 			source_file_id,
 			posted_date,
 			description,
@@ -103,8 +103,8 @@ func TestTransactionsSummaryUsesDebitForAverageMedian(t *testing.T) {
 		t.Fatalf("insert credit transaction: %v", err)
 	}
 
-	service := NewTransactionsService(db)
-	summary, err := service.Summary(ctx, userID, TransactionFilters{CategoryID: int64Ptr(4)})
+	service := newTestTransactionsService(t, db)
+	summary, err := service.Summary(ctx, userID, "CHF", TransactionFilters{CategoryID: int64Ptr(4)})
 	if err != nil {
 		t.Fatalf("summary: %v", err)
 	}
@@ -117,21 +117,88 @@ func TestTransactionsSummaryUsesDebitForAverageMedian(t *testing.T) {
 	assertSummaryCents(t, summary.Median, -590)
 }
 
+func TestTransactionsSummaryConvertsToBaseCurrency(t *testing.T) {
+	db, cleanup := openTestDB(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	createSummaryTables(t, db)
+	userID := createUser(t, db, "summary-currency@example.com")
+
+	for _, tx := range []struct {
+		amount   string
+		currency string
+	}{
+		{"-9.50", "CHF"},
+		{"-10.00", "EUR"},
+		{"-9.50", "USD"},
+	} {
+		_, err := db.conn.Exec(ctx, `
+			INSERT INTO transactions (workspace_id, source_file_id, posted_date, description, amount, currency, entry_type)
+			VALUES ($1, $2, $3, $4, $5, $6, $7)
+		`, userID, int64(1), time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC), "Debit", tx.amount, tx.currency, EntryTypeDebit)
+		if err != nil {
+			t.Fatalf("insert transaction: %v", err)
+		}
+	}
+
+	service := newTestTransactionsService(t, db)
+	summary, err := service.Summary(ctx, userID, "eur", TransactionFilters{})
+	if err != nil {
+		t.Fatalf("summary: %v", err)
+	}
+
+	if summary.Currency != "EUR" {
+		t.Fatalf("expected EUR summary, got %q", summary.Currency)
+	}
+	// CHF and USD are converted through the CHF quotes of the static provider.
+	assertSummaryCents(t, summary.Total, -2900)
+	assertSummaryCents(t, summary.Median, -1000)
+}
+
 func createSummaryTables(t *testing.T, db *Db) {
 	t.Helper()
 	_, err := db.conn.Exec(context.Background(), `
 		CREATE TABLE transactions (
 			id bigserial PRIMARY KEY,
-			user_id integer NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+			workspace_id integer NOT NULL REFERENCES workspaces(id) ON DELETE CASCADE,
 			source_file_id bigint,
 			posted_date date NOT NULL,
 			description text NOT NULL,
 			amount numeric(18, 2) NOT NULL,
+			currency varchar(3),
 			entry_type varchar(16),
 			source_account_number varchar(64),
 			source_card_number varchar(64),
 			category_id bigint
 		);
+		CREATE TABLE transaction_splits (
+			id bigserial PRIMARY KEY,
+			transaction_id bigint NOT NULL REFERENCES transactions(id) ON DELETE CASCADE,
+			workspace_id integer NOT NULL REFERENCES workspaces(id) ON DELETE CASCADE,
+			category_id bigint,
+			amount numeric(18, 2) NOT NULL,
+			created_at timestamptz NOT NULL DEFAULT now()
+		);
+		CREATE TABLE transaction_transfers (
+			id bigserial PRIMARY KEY,
+			workspace_id integer NOT NULL REFERENCES workspaces(id) ON DELETE CASCADE,
+			debit_transaction_id bigint NOT NULL REFERENCES transactions(id) ON DELETE CASCADE,
+			credit_transaction_id bigint NOT NULL REFERENCES transactions(id) ON DELETE CASCADE,
+			status varchar(16) NOT NULL DEFAULT 'suggested',
+			created_at timestamptz NOT NULL DEFAULT now(),
+			UNIQUE (debit_transaction_id, credit_transaction_id)
+		);
+		CREATE UNIQUE INDEX transaction_transfers_debit_active_idx ON transaction_transfers (debit_transaction_id) WHERE status <> 'rejected';
+		CREATE UNIQUE INDEX transaction_transfers_credit_active_idx ON transaction_transfers (credit_transaction_id) WHERE status <> 'rejected';
+		CREATE TABLE exchange_rates (
+			id bigserial PRIMARY KEY,
+			rate_date date NOT NULL,
+			base_currency varchar(3) NOT NULL,
+			target_currency varchar(3) NOT NULL,
+			rate numeric(18, 8) NOT NULL,
+			UNIQUE (rate_date, base_currency, target_currency)
+		);
 	`)
 	if err != nil {
 		t.Fatalf("create summary tables: %v", err)
@@ -148,3 +215,24 @@ func assertSummaryCents(t *testing.T, raw int64, expected int64) {
 func int64Ptr(value int64) *int64 {
 	return &value
 }
+
+func newTestTransactionsService(t *testing.T, db *Db) *TransactionsService {
+	t.Helper()
+	provider, err := NewStaticRateProvider([]StaticRate{
+		{Base: "EUR", Target: "CHF", Rate: 0.95},
+		{Base: "USD", Target: "CHF", Rate: 0.9},
+	})
+	if err != nil {
+		t.Fatalf("static rates: %v", err)
+	}
+	return NewTransactionsService(db, NewExchangeRateService(db, provider, ExchangeRateConfig{PivotCurrency: "CHF"}))
+}
+
+func TestSameJSONIgnoresFormatting(t *testing.T) {
+	if !sameJSON([]byte(`{"b": 1, "a": ["x"]}`), []byte(`{"a":["x"],"b":1}`)) {
+		t.Fatalf("expected equal documents")
+	}
+	if sameJSON([]byte(`{"a": 1}`), nil) || sameJSON([]byte(`{"a": 1}`), []byte(`{"a": 2}`)) {
+		t.Fatalf("expected different documents")
+	}
+}
@@ -14,6 +14,11 @@ func handlers(
 	reportService *ReportServiceHandler,
 	transactionService *TransactionServiceHandler,
 	categoryService *CategoryServiceHandler,
+	budgetService *BudgetServiceHandler,
+	csvTemplateService *CsvTemplateServiceHandler,
+	accountService *AccountServiceHandler,
+	recurringService *RecurringServiceHandler,
+	householdService *HouseholdServiceHandler,
 ) []*Handler {
 	return []*Handler{
 		(*Handler)(todo),
@@ -23,6 +28,11 @@ func handlers(
 		(*Handler)(reportService),
 		(*Handler)(transactionService),
 		(*Handler)(categoryService),
+		(*Handler)(budgetService),
+		(*Handler)(csvTemplateService),
+		(*Handler)(accountService),
+		(*Handler)(recurringService),
+		(*Handler)(householdService),
 	}
 }
 
@@ -36,9 +46,16 @@ func InitializeApp(ctx context.Context) (*App, error) {
 		NewReportServiceHandler,
 		NewTransactionServiceHandler,
 		NewCategoryServiceHandler,
-		NewReportParsingService, NewTransactionsService, NewReportProcessor,
+		NewBudgetServiceHandler,
+		NewCsvTemplateServiceHandler,
+		NewAccountServiceHandler,
+		NewRecurringServiceHandler,
+		NewHouseholdServiceHandler,
+		NewReportParsingService, NewTransactionsService, NewReportProcessor, NewReportEvents,
+		NewGoogleTokenVerifier,
+		NewExchangeRateProvider, NewExchangeRateService,
 		ProvideConfig,
-		wire.FieldsOf(new(Config), "ServerConfig", "Db"),
+		wire.FieldsOf(new(Config), "ServerConfig", "Db", "Google", "Rates", "Processor"),
 		NewHttpServer, NewPgxPool, NewDB,
 		wire.Struct(new(App), "*"),
 	)
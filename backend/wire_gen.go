@@ -19,6 +19,9 @@ func InitializeApp(ctx context.Context) (*App, error) {
 	}
 	serverConfig := config.ServerConfig
 	dbConfig := config.Db
+	googleConfig := config.Google
+	exchangeRateConfig := config.Rates
+	reportProcessorConfig := config.Processor
 	pool, err := NewPgxPool(ctx, dbConfig)
 	if err != nil {
 		return nil, err
@@ -29,19 +32,32 @@ func InitializeApp(ctx context.Context) (*App, error) {
 	}
 	todoHandler := NewTodoHandler(db)
 	greetHandler := NewGreetHandler()
-	authHandler := NewAuthHandler(db)
+	googleTokenVerifier := NewGoogleTokenVerifier(googleConfig)
+	authHandler := NewAuthHandler(db, googleTokenVerifier)
 	authServiceHandler := NewAuthServiceHandler(db)
-	reportServiceHandler := NewReportServiceHandler(db)
-	transactionsService := NewTransactionsService(db)
+	reportEvents := NewReportEvents(db)
+	reportParsingService := NewReportParsingService()
+	reportServiceHandler := NewReportServiceHandler(db, reportEvents, reportParsingService)
+	exchangeRateProvider, err := NewExchangeRateProvider(exchangeRateConfig)
+	if err != nil {
+		return nil, err
+	}
+	exchangeRateService := NewExchangeRateService(db, exchangeRateProvider, exchangeRateConfig)
+	transactionsService := NewTransactionsService(db, exchangeRateService)
 	transactionServiceHandler := NewTransactionServiceHandler(db, transactionsService)
 	categoryServiceHandler := NewCategoryServiceHandler(db, transactionsService)
-	v := handlers(todoHandler, greetHandler, authHandler, authServiceHandler, reportServiceHandler, transactionServiceHandler, categoryServiceHandler)
+	budgetServiceHandler := NewBudgetServiceHandler(db, exchangeRateService)
+	csvTemplateServiceHandler := NewCsvTemplateServiceHandler(db)
+	accountServiceHandler := NewAccountServiceHandler(db)
+	recurringServiceHandler := NewRecurringServiceHandler(db)
+	householdServiceHandler := NewHouseholdServiceHandler(db)
+	v := handlers(todoHandler, greetHandler, authHandler, authServiceHandler, reportServiceHandler, transactionServiceHandler, categoryServiceHandler, budgetServiceHandler, csvTemplateServiceHandler, accountServiceHandler, recurringServiceHandler, householdServiceHandler)
 	server := NewHttpServer(serverConfig, v)
-	reportParsingService := NewReportParsingService()
-	reportProcessor := NewReportProcessor(db, reportParsingService, transactionsService)
+	reportProcessor := NewReportProcessor(db, reportParsingService, transactionsService, reportProcessorConfig)
 	app := &App{
 		Server:    server,
 		Processor: reportProcessor,
+		Events:    reportEvents,
 	}
 	return app, nil
 }
@@ -56,6 +72,11 @@ func handlers(
 	reportService *ReportServiceHandler,
 	transactionService *TransactionServiceHandler,
 	categoryService *CategoryServiceHandler,
+	budgetService *BudgetServiceHandler,
+	csvTemplateService *CsvTemplateServiceHandler,
+	accountService *AccountServiceHandler,
+	recurringService *RecurringServiceHandler,
+	householdService *HouseholdServiceHandler,
 ) []*Handler {
 	return []*Handler{
 		(*Handler)(todo),
@@ -65,5 +86,10 @@ func handlers(
 		(*Handler)(reportService),
 		(*Handler)(transactionService),
 		(*Handler)(categoryService),
+		(*Handler)(budgetService),
+		(*Handler)(csvTemplateService),
+		(*Handler)(accountService),
+		(*Handler)(recurringService),
+		(*Handler)(householdService),
 	}
 }
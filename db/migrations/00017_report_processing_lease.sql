@@ -0,0 +1,17 @@
+-- +goose Up
+ALTER TABLE financial_reports
+ADD COLUMN claimed_at timestamptz,
+ADD COLUMN claimed_by varchar(255),
+ADD COLUMN attempts integer NOT NULL DEFAULT 0,
+ADD COLUMN next_attempt_at timestamptz;
+
+CREATE INDEX financial_reports_status_idx ON financial_reports (status, uploaded_at);
+
+-- +goose Down
+DROP INDEX IF EXISTS financial_reports_status_idx;
+
+ALTER TABLE financial_reports
+DROP COLUMN next_attempt_at,
+DROP COLUMN attempts,
+DROP COLUMN claimed_by,
+DROP COLUMN claimed_at;
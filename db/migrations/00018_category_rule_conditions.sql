@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE category_rules
+    ALTER COLUMN description_contains SET DEFAULT '',
+    ADD COLUMN description_regex TEXT NOT NULL DEFAULT '',
+    ADD COLUMN description_exact TEXT NOT NULL DEFAULT '',
+    ADD COLUMN description_prefix TEXT NOT NULL DEFAULT '',
+    ADD COLUMN amount_min BIGINT,
+    ADD COLUMN amount_max BIGINT,
+    ADD COLUMN entry_type VARCHAR(16) NOT NULL DEFAULT '',
+    ADD COLUMN currency VARCHAR(3) NOT NULL DEFAULT '',
+    ADD COLUMN account VARCHAR(64) NOT NULL DEFAULT '',
+    ADD COLUMN parser_name VARCHAR(64) NOT NULL DEFAULT '';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE category_rules
+    ALTER COLUMN description_contains DROP DEFAULT,
+    DROP COLUMN description_regex,
+    DROP COLUMN description_exact,
+    DROP COLUMN description_prefix,
+    DROP COLUMN amount_min,
+    DROP COLUMN amount_max,
+    DROP COLUMN entry_type,
+    DROP COLUMN currency,
+    DROP COLUMN account,
+    DROP COLUMN parser_name;
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+CREATE TABLE public.budgets (
+    id bigserial PRIMARY KEY,
+    user_id integer NOT NULL REFERENCES public.users(id) ON DELETE CASCADE,
+    category_id bigint NOT NULL REFERENCES public.categories(id) ON DELETE CASCADE,
+    period character varying(16) NOT NULL,
+    amount numeric(18,2) NOT NULL,
+    currency character varying(3) NOT NULL,
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    CONSTRAINT budgets_period_check CHECK (period IN ('monthly', 'quarterly', 'yearly')),
+    CONSTRAINT budgets_amount_check CHECK (amount > 0)
+);
+
+CREATE INDEX budgets_user_id_idx ON public.budgets USING btree (user_id);
+CREATE UNIQUE INDEX budgets_user_category_period_idx ON public.budgets USING btree (user_id, category_id, period);
+
+-- +goose Down
+DROP INDEX IF EXISTS budgets_user_category_period_idx;
+DROP INDEX IF EXISTS budgets_user_id_idx;
+DROP TABLE IF EXISTS public.budgets;
@@ -0,0 +1,25 @@
+-- +goose Up
+CREATE TABLE public.csv_templates (
+    id bigserial PRIMARY KEY,
+    user_id integer NOT NULL REFERENCES public.users(id) ON DELETE CASCADE,
+    name character varying(255) NOT NULL,
+    signature text NOT NULL,
+    delimiter character varying(1) NOT NULL DEFAULT ',',
+    date_column text NOT NULL,
+    date_format character varying(32) NOT NULL,
+    decimal_separator character varying(1) NOT NULL DEFAULT '.',
+    description_columns text[] NOT NULL DEFAULT '{}',
+    amount_column text NOT NULL DEFAULT '',
+    debit_column text NOT NULL DEFAULT '',
+    credit_column text NOT NULL DEFAULT '',
+    currency_column text NOT NULL DEFAULT '',
+    account_column text NOT NULL DEFAULT '',
+    default_currency character varying(3) NOT NULL DEFAULT '',
+    created_at timestamp with time zone NOT NULL DEFAULT now()
+);
+
+CREATE INDEX csv_templates_user_id_idx ON public.csv_templates USING btree (user_id);
+
+-- +goose Down
+DROP INDEX IF EXISTS csv_templates_user_id_idx;
+DROP TABLE IF EXISTS public.csv_templates;
@@ -0,0 +1,17 @@
+-- +goose Up
+CREATE TABLE public.report_diagnostics (
+    id bigserial PRIMARY KEY,
+    report_id bigint NOT NULL REFERENCES public.financial_reports(id) ON DELETE CASCADE,
+    user_id integer NOT NULL REFERENCES public.users(id) ON DELETE CASCADE,
+    row_number integer NOT NULL,
+    severity character varying(16) NOT NULL,
+    raw_record text NOT NULL DEFAULT '',
+    reason text NOT NULL,
+    created_at timestamp with time zone NOT NULL DEFAULT now()
+);
+
+CREATE INDEX report_diagnostics_report_id_idx ON public.report_diagnostics USING btree (report_id);
+
+-- +goose Down
+DROP INDEX IF EXISTS report_diagnostics_report_id_idx;
+DROP TABLE IF EXISTS public.report_diagnostics;
@@ -0,0 +1,17 @@
+-- +goose Up
+CREATE TABLE public.transaction_splits (
+    id bigserial PRIMARY KEY,
+    transaction_id bigint NOT NULL REFERENCES public.transactions(id) ON DELETE CASCADE,
+    user_id integer NOT NULL REFERENCES public.users(id) ON DELETE CASCADE,
+    category_id bigint REFERENCES public.categories(id) ON DELETE SET NULL,
+    amount numeric(18,2) NOT NULL,
+    created_at timestamp with time zone NOT NULL DEFAULT now()
+);
+
+CREATE INDEX transaction_splits_transaction_id_idx ON public.transaction_splits USING btree (transaction_id);
+CREATE INDEX transaction_splits_category_id_idx ON public.transaction_splits USING btree (category_id);
+
+-- +goose Down
+DROP INDEX IF EXISTS transaction_splits_category_id_idx;
+DROP INDEX IF EXISTS transaction_splits_transaction_id_idx;
+DROP TABLE IF EXISTS public.transaction_splits;
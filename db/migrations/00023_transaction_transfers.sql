@@ -0,0 +1,21 @@
+-- +goose Up
+CREATE TABLE public.transaction_transfers (
+    id bigserial PRIMARY KEY,
+    user_id integer NOT NULL REFERENCES public.users(id) ON DELETE CASCADE,
+    debit_transaction_id bigint NOT NULL REFERENCES public.transactions(id) ON DELETE CASCADE,
+    credit_transaction_id bigint NOT NULL REFERENCES public.transactions(id) ON DELETE CASCADE,
+    status varchar(16) NOT NULL DEFAULT 'suggested',
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    UNIQUE (debit_transaction_id, credit_transaction_id)
+);
+
+-- A transaction belongs to at most one live pair; rejected pairs are kept so detection doesn't suggest them again.
+CREATE UNIQUE INDEX transaction_transfers_debit_active_idx ON public.transaction_transfers USING btree (debit_transaction_id) WHERE ((status)::text <> 'rejected'::text);
+CREATE UNIQUE INDEX transaction_transfers_credit_active_idx ON public.transaction_transfers USING btree (credit_transaction_id) WHERE ((status)::text <> 'rejected'::text);
+CREATE INDEX transaction_transfers_user_id_idx ON public.transaction_transfers USING btree (user_id);
+
+-- +goose Down
+DROP INDEX IF EXISTS transaction_transfers_user_id_idx;
+DROP INDEX IF EXISTS transaction_transfers_credit_active_idx;
+DROP INDEX IF EXISTS transaction_transfers_debit_active_idx;
+DROP TABLE IF EXISTS public.transaction_transfers;
@@ -0,0 +1,62 @@
+-- +goose Up
+CREATE TABLE public.accounts (
+    id bigserial PRIMARY KEY,
+    user_id integer NOT NULL REFERENCES public.users(id) ON DELETE CASCADE,
+    name character varying(255) NOT NULL,
+    type character varying(16) NOT NULL,
+    identifier character varying(64),
+    iban character varying(34),
+    currency character varying(3),
+    archived boolean NOT NULL DEFAULT false,
+    merged_into_id bigint REFERENCES public.accounts(id) ON DELETE CASCADE,
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    CONSTRAINT accounts_type_check CHECK (type IN ('bank', 'card', 'cash')),
+    CONSTRAINT accounts_user_identifier_key UNIQUE (user_id, identifier)
+);
+
+CREATE INDEX accounts_user_id_idx ON public.accounts USING btree (user_id);
+
+CREATE TABLE public.account_balances (
+    id bigserial PRIMARY KEY,
+    account_id bigint NOT NULL REFERENCES public.accounts(id) ON DELETE CASCADE,
+    user_id integer NOT NULL REFERENCES public.users(id) ON DELETE CASCADE,
+    source_file_id bigint REFERENCES public.financial_reports(id) ON DELETE CASCADE,
+    balance_date date NOT NULL,
+    balance numeric(18,2) NOT NULL,
+    created_at timestamp with time zone NOT NULL DEFAULT now()
+);
+
+CREATE INDEX account_balances_account_id_idx ON public.account_balances USING btree (account_id, balance_date);
+CREATE INDEX account_balances_source_file_id_idx ON public.account_balances USING btree (source_file_id);
+
+ALTER TABLE public.transactions
+    ADD COLUMN account_id bigint REFERENCES public.accounts(id) ON DELETE SET NULL;
+
+CREATE INDEX transactions_account_id_idx ON public.transactions USING btree (account_id);
+
+-- Accounts for transactions imported before accounts existed. Statement balances appear once the
+-- reports are reprocessed.
+INSERT INTO public.accounts (user_id, name, type, identifier)
+SELECT DISTINCT user_id, identifier, type
+FROM (
+    SELECT user_id,
+           COALESCE(NULLIF(source_card_number, ''), NULLIF(source_account_number, '')) AS identifier,
+           CASE WHEN NULLIF(source_card_number, '') IS NULL THEN 'bank' ELSE 'card' END AS type
+    FROM public.transactions
+) AS sources
+WHERE identifier IS NOT NULL;
+
+UPDATE public.transactions
+SET account_id = accounts.id
+FROM public.accounts
+WHERE accounts.user_id = transactions.user_id
+  AND accounts.identifier = COALESCE(NULLIF(transactions.source_card_number, ''), transactions.source_account_number);
+
+-- +goose Down
+DROP INDEX IF EXISTS transactions_account_id_idx;
+ALTER TABLE public.transactions DROP COLUMN IF EXISTS account_id;
+DROP INDEX IF EXISTS account_balances_source_file_id_idx;
+DROP INDEX IF EXISTS account_balances_account_id_idx;
+DROP TABLE IF EXISTS public.account_balances;
+DROP INDEX IF EXISTS accounts_user_id_idx;
+DROP TABLE IF EXISTS public.accounts;
@@ -0,0 +1,21 @@
+-- +goose Up
+CREATE TABLE public.recurring_series (
+    id bigserial PRIMARY KEY,
+    user_id integer NOT NULL REFERENCES public.users(id) ON DELETE CASCADE,
+    merchant_key character varying(255) NOT NULL,
+    name character varying(255) NOT NULL,
+    currency character varying(3) NOT NULL,
+    cadence character varying(16) NOT NULL,
+    amount numeric(18,2) NOT NULL,
+    status character varying(16) NOT NULL DEFAULT 'detected',
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    CONSTRAINT recurring_series_cadence_check CHECK (cadence IN ('weekly', 'monthly', 'yearly')),
+    CONSTRAINT recurring_series_status_check CHECK (status IN ('detected', 'confirmed', 'ignored'))
+);
+
+-- A merchant can bill several subscriptions, told apart by amount, so merchant_key isn't unique.
+CREATE INDEX recurring_series_user_merchant_idx ON public.recurring_series USING btree (user_id, merchant_key, currency);
+
+-- +goose Down
+DROP INDEX IF EXISTS recurring_series_user_merchant_idx;
+DROP TABLE IF EXISTS public.recurring_series;
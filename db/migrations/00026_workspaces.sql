@@ -0,0 +1,216 @@
+-- +goose Up
+-- Reports, transactions, categories, rules and everything derived from them belong to a workspace.
+-- Every user has a personal workspace, created together with the user. A household is a workspace
+-- without a user_id, shared through household_members. The personal workspaces of existing users
+-- take the user's id, so their rows keep pointing at the same ledger.
+CREATE TABLE public.workspaces (
+    id serial PRIMARY KEY,
+    user_id integer UNIQUE REFERENCES public.users(id) ON DELETE CASCADE,
+    name character varying(255) NOT NULL DEFAULT '',
+    base_currency character varying(3) NOT NULL DEFAULT 'CHF',
+    created_at timestamp with time zone NOT NULL DEFAULT now()
+);
+
+INSERT INTO public.workspaces (id, user_id, base_currency)
+SELECT id, id, base_currency FROM public.users;
+SELECT setval('public.workspaces_id_seq', COALESCE((SELECT max(id) FROM public.workspaces), 0) + 1, false);
+
+ALTER TABLE public.users DROP COLUMN base_currency;
+
+ALTER TABLE public.account_balances DROP CONSTRAINT account_balances_user_id_fkey;
+ALTER TABLE public.account_balances RENAME COLUMN user_id TO workspace_id;
+ALTER TABLE public.account_balances
+    ADD CONSTRAINT account_balances_workspace_id_fkey FOREIGN KEY (workspace_id) REFERENCES public.workspaces(id) ON DELETE CASCADE;
+
+ALTER TABLE public.accounts DROP CONSTRAINT accounts_user_id_fkey;
+ALTER TABLE public.accounts RENAME COLUMN user_id TO workspace_id;
+ALTER TABLE public.accounts
+    ADD CONSTRAINT accounts_workspace_id_fkey FOREIGN KEY (workspace_id) REFERENCES public.workspaces(id) ON DELETE CASCADE;
+
+ALTER TABLE public.budgets DROP CONSTRAINT budgets_user_id_fkey;
+ALTER TABLE public.budgets RENAME COLUMN user_id TO workspace_id;
+ALTER TABLE public.budgets
+    ADD CONSTRAINT budgets_workspace_id_fkey FOREIGN KEY (workspace_id) REFERENCES public.workspaces(id) ON DELETE CASCADE;
+
+ALTER TABLE public.categories DROP CONSTRAINT categories_user_id_fkey;
+ALTER TABLE public.categories RENAME COLUMN user_id TO workspace_id;
+ALTER TABLE public.categories
+    ADD CONSTRAINT categories_workspace_id_fkey FOREIGN KEY (workspace_id) REFERENCES public.workspaces(id) ON DELETE CASCADE;
+
+ALTER TABLE public.category_rules DROP CONSTRAINT category_rules_user_id_fkey;
+ALTER TABLE public.category_rules RENAME COLUMN user_id TO workspace_id;
+ALTER TABLE public.category_rules
+    ADD CONSTRAINT category_rules_workspace_id_fkey FOREIGN KEY (workspace_id) REFERENCES public.workspaces(id) ON DELETE CASCADE;
+
+ALTER TABLE public.csv_templates DROP CONSTRAINT csv_templates_user_id_fkey;
+ALTER TABLE public.csv_templates RENAME COLUMN user_id TO workspace_id;
+ALTER TABLE public.csv_templates
+    ADD CONSTRAINT csv_templates_workspace_id_fkey FOREIGN KEY (workspace_id) REFERENCES public.workspaces(id) ON DELETE CASCADE;
+
+ALTER TABLE public.financial_reports DROP CONSTRAINT financial_reports_user_id_fkey;
+ALTER TABLE public.financial_reports RENAME COLUMN user_id TO workspace_id;
+ALTER TABLE public.financial_reports
+    ADD CONSTRAINT financial_reports_workspace_id_fkey FOREIGN KEY (workspace_id) REFERENCES public.workspaces(id) ON DELETE CASCADE;
+
+ALTER TABLE public.recurring_series DROP CONSTRAINT recurring_series_user_id_fkey;
+ALTER TABLE public.recurring_series RENAME COLUMN user_id TO workspace_id;
+ALTER TABLE public.recurring_series
+    ADD CONSTRAINT recurring_series_workspace_id_fkey FOREIGN KEY (workspace_id) REFERENCES public.workspaces(id) ON DELETE CASCADE;
+
+ALTER TABLE public.report_diagnostics DROP CONSTRAINT report_diagnostics_user_id_fkey;
+ALTER TABLE public.report_diagnostics RENAME COLUMN user_id TO workspace_id;
+ALTER TABLE public.report_diagnostics
+    ADD CONSTRAINT report_diagnostics_workspace_id_fkey FOREIGN KEY (workspace_id) REFERENCES public.workspaces(id) ON DELETE CASCADE;
+
+ALTER TABLE public.transaction_splits DROP CONSTRAINT transaction_splits_user_id_fkey;
+ALTER TABLE public.transaction_splits RENAME COLUMN user_id TO workspace_id;
+ALTER TABLE public.transaction_splits
+    ADD CONSTRAINT transaction_splits_workspace_id_fkey FOREIGN KEY (workspace_id) REFERENCES public.workspaces(id) ON DELETE CASCADE;
+
+ALTER TABLE public.transaction_transfers DROP CONSTRAINT transaction_transfers_user_id_fkey;
+ALTER TABLE public.transaction_transfers RENAME COLUMN user_id TO workspace_id;
+ALTER TABLE public.transaction_transfers
+    ADD CONSTRAINT transaction_transfers_workspace_id_fkey FOREIGN KEY (workspace_id) REFERENCES public.workspaces(id) ON DELETE CASCADE;
+
+ALTER TABLE public.transactions DROP CONSTRAINT transactions_user_id_fkey;
+ALTER TABLE public.transactions RENAME COLUMN user_id TO workspace_id;
+ALTER TABLE public.transactions
+    ADD CONSTRAINT transactions_workspace_id_fkey FOREIGN KEY (workspace_id) REFERENCES public.workspaces(id) ON DELETE CASCADE;
+
+ALTER INDEX public.accounts_user_id_idx RENAME TO accounts_workspace_id_idx;
+ALTER TABLE public.accounts RENAME CONSTRAINT accounts_user_identifier_key TO accounts_workspace_identifier_key;
+ALTER INDEX public.budgets_user_category_period_idx RENAME TO budgets_workspace_category_period_idx;
+ALTER INDEX public.budgets_user_id_idx RENAME TO budgets_workspace_id_idx;
+ALTER INDEX public.categories_user_id_idx RENAME TO categories_workspace_id_idx;
+ALTER INDEX public.category_rules_user_id_idx RENAME TO category_rules_workspace_id_idx;
+ALTER INDEX public.category_rules_user_position_idx RENAME TO category_rules_workspace_position_idx;
+ALTER INDEX public.csv_templates_user_id_idx RENAME TO csv_templates_workspace_id_idx;
+ALTER INDEX public.financial_reports_user_id_idx RENAME TO financial_reports_workspace_id_idx;
+ALTER INDEX public.recurring_series_user_merchant_idx RENAME TO recurring_series_workspace_merchant_idx;
+ALTER INDEX public.transaction_transfers_user_id_idx RENAME TO transaction_transfers_workspace_id_idx;
+ALTER INDEX public.transactions_user_id_idx RENAME TO transactions_workspace_id_idx;
+
+CREATE TABLE public.household_members (
+    household_id integer NOT NULL REFERENCES public.workspaces(id) ON DELETE CASCADE,
+    user_id integer NOT NULL REFERENCES public.users(id) ON DELETE CASCADE,
+    role character varying(16) NOT NULL,
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    PRIMARY KEY (household_id, user_id),
+    CONSTRAINT household_members_role_check CHECK (role IN ('owner', 'editor', 'viewer'))
+);
+
+CREATE INDEX household_members_user_id_idx ON public.household_members USING btree (user_id);
+
+CREATE TABLE public.household_invitations (
+    id bigserial PRIMARY KEY,
+    household_id integer NOT NULL REFERENCES public.workspaces(id) ON DELETE CASCADE,
+    token_hash character varying(64) NOT NULL UNIQUE,
+    role character varying(16) NOT NULL,
+    created_by integer NOT NULL REFERENCES public.users(id) ON DELETE CASCADE,
+    expires_at timestamp with time zone NOT NULL,
+    accepted_by integer REFERENCES public.users(id) ON DELETE SET NULL,
+    accepted_at timestamp with time zone,
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    CONSTRAINT household_invitations_role_check CHECK (role IN ('owner', 'editor', 'viewer'))
+);
+
+CREATE INDEX household_invitations_household_id_idx ON public.household_invitations USING btree (household_id);
+
+-- +goose Down
+DROP INDEX IF EXISTS household_invitations_household_id_idx;
+DROP TABLE IF EXISTS public.household_invitations;
+DROP INDEX IF EXISTS household_members_user_id_idx;
+DROP TABLE IF EXISTS public.household_members;
+
+-- Households have no user to fall back to; their data goes with them.
+DELETE FROM public.workspaces WHERE user_id IS NULL;
+
+ALTER INDEX public.transactions_workspace_id_idx RENAME TO transactions_user_id_idx;
+ALTER INDEX public.transaction_transfers_workspace_id_idx RENAME TO transaction_transfers_user_id_idx;
+ALTER INDEX public.recurring_series_workspace_merchant_idx RENAME TO recurring_series_user_merchant_idx;
+ALTER INDEX public.financial_reports_workspace_id_idx RENAME TO financial_reports_user_id_idx;
+ALTER INDEX public.csv_templates_workspace_id_idx RENAME TO csv_templates_user_id_idx;
+ALTER INDEX public.category_rules_workspace_position_idx RENAME TO category_rules_user_position_idx;
+ALTER INDEX public.category_rules_workspace_id_idx RENAME TO category_rules_user_id_idx;
+ALTER INDEX public.categories_workspace_id_idx RENAME TO categories_user_id_idx;
+ALTER INDEX public.budgets_workspace_id_idx RENAME TO budgets_user_id_idx;
+ALTER INDEX public.budgets_workspace_category_period_idx RENAME TO budgets_user_category_period_idx;
+ALTER TABLE public.accounts RENAME CONSTRAINT accounts_workspace_identifier_key TO accounts_user_identifier_key;
+ALTER INDEX public.accounts_workspace_id_idx RENAME TO accounts_user_id_idx;
+
+ALTER TABLE public.transactions DROP CONSTRAINT transactions_workspace_id_fkey;
+UPDATE public.transactions SET workspace_id = workspaces.user_id FROM public.workspaces WHERE workspaces.id = transactions.workspace_id;
+ALTER TABLE public.transactions RENAME COLUMN workspace_id TO user_id;
+ALTER TABLE public.transactions
+    ADD CONSTRAINT transactions_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
+
+ALTER TABLE public.transaction_transfers DROP CONSTRAINT transaction_transfers_workspace_id_fkey;
+UPDATE public.transaction_transfers SET workspace_id = workspaces.user_id FROM public.workspaces WHERE workspaces.id = transaction_transfers.workspace_id;
+ALTER TABLE public.transaction_transfers RENAME COLUMN workspace_id TO user_id;
+ALTER TABLE public.transaction_transfers
+    ADD CONSTRAINT transaction_transfers_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
+
+ALTER TABLE public.transaction_splits DROP CONSTRAINT transaction_splits_workspace_id_fkey;
+UPDATE public.transaction_splits SET workspace_id = workspaces.user_id FROM public.workspaces WHERE workspaces.id = transaction_splits.workspace_id;
+ALTER TABLE public.transaction_splits RENAME COLUMN workspace_id TO user_id;
+ALTER TABLE public.transaction_splits
+    ADD CONSTRAINT transaction_splits_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
+
+ALTER TABLE public.report_diagnostics DROP CONSTRAINT report_diagnostics_workspace_id_fkey;
+UPDATE public.report_diagnostics SET workspace_id = workspaces.user_id FROM public.workspaces WHERE workspaces.id = report_diagnostics.workspace_id;
+ALTER TABLE public.report_diagnostics RENAME COLUMN workspace_id TO user_id;
+ALTER TABLE public.report_diagnostics
+    ADD CONSTRAINT report_diagnostics_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
+
+ALTER TABLE public.recurring_series DROP CONSTRAINT recurring_series_workspace_id_fkey;
+UPDATE public.recurring_series SET workspace_id = workspaces.user_id FROM public.workspaces WHERE workspaces.id = recurring_series.workspace_id;
+ALTER TABLE public.recurring_series RENAME COLUMN workspace_id TO user_id;
+ALTER TABLE public.recurring_series
+    ADD CONSTRAINT recurring_series_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
+
+ALTER TABLE public.financial_reports DROP CONSTRAINT financial_reports_workspace_id_fkey;
+UPDATE public.financial_reports SET workspace_id = workspaces.user_id FROM public.workspaces WHERE workspaces.id = financial_reports.workspace_id;
+ALTER TABLE public.financial_reports RENAME COLUMN workspace_id TO user_id;
+ALTER TABLE public.financial_reports
+    ADD CONSTRAINT financial_reports_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
+
+ALTER TABLE public.csv_templates DROP CONSTRAINT csv_templates_workspace_id_fkey;
+UPDATE public.csv_templates SET workspace_id = workspaces.user_id FROM public.workspaces WHERE workspaces.id = csv_templates.workspace_id;
+ALTER TABLE public.csv_templates RENAME COLUMN workspace_id TO user_id;
+ALTER TABLE public.csv_templates
+    ADD CONSTRAINT csv_templates_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
+
+ALTER TABLE public.category_rules DROP CONSTRAINT category_rules_workspace_id_fkey;
+UPDATE public.category_rules SET workspace_id = workspaces.user_id FROM public.workspaces WHERE workspaces.id = category_rules.workspace_id;
+ALTER TABLE public.category_rules RENAME COLUMN workspace_id TO user_id;
+ALTER TABLE public.category_rules
+    ADD CONSTRAINT category_rules_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
+
+ALTER TABLE public.categories DROP CONSTRAINT categories_workspace_id_fkey;
+UPDATE public.categories SET workspace_id = workspaces.user_id FROM public.workspaces WHERE workspaces.id = categories.workspace_id;
+ALTER TABLE public.categories RENAME COLUMN workspace_id TO user_id;
+ALTER TABLE public.categories
+    ADD CONSTRAINT categories_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
+
+ALTER TABLE public.budgets DROP CONSTRAINT budgets_workspace_id_fkey;
+UPDATE public.budgets SET workspace_id = workspaces.user_id FROM public.workspaces WHERE workspaces.id = budgets.workspace_id;
+ALTER TABLE public.budgets RENAME COLUMN workspace_id TO user_id;
+ALTER TABLE public.budgets
+    ADD CONSTRAINT budgets_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
+
+ALTER TABLE public.accounts DROP CONSTRAINT accounts_workspace_id_fkey;
+UPDATE public.accounts SET workspace_id = workspaces.user_id FROM public.workspaces WHERE workspaces.id = accounts.workspace_id;
+ALTER TABLE public.accounts RENAME COLUMN workspace_id TO user_id;
+ALTER TABLE public.accounts
+    ADD CONSTRAINT accounts_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
+
+ALTER TABLE public.account_balances DROP CONSTRAINT account_balances_workspace_id_fkey;
+UPDATE public.account_balances SET workspace_id = workspaces.user_id FROM public.workspaces WHERE workspaces.id = account_balances.workspace_id;
+ALTER TABLE public.account_balances RENAME COLUMN workspace_id TO user_id;
+ALTER TABLE public.account_balances
+    ADD CONSTRAINT account_balances_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
+
+ALTER TABLE public.users ADD COLUMN base_currency character varying(3) NOT NULL DEFAULT 'CHF';
+UPDATE public.users SET base_currency = workspaces.base_currency FROM public.workspaces WHERE workspaces.user_id = users.id;
+
+DROP TABLE IF EXISTS public.workspaces;
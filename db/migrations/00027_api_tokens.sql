@@ -0,0 +1,19 @@
+-- +goose Up
+-- Only a SHA-256 hash of each token is kept; the prefix lets users tell their tokens apart.
+CREATE TABLE public.api_tokens (
+    id bigserial PRIMARY KEY,
+    user_id integer NOT NULL REFERENCES public.users(id) ON DELETE CASCADE,
+    name character varying(255) NOT NULL,
+    token_prefix character varying(16) NOT NULL,
+    token_hash character varying(64) NOT NULL UNIQUE,
+    scopes text[] NOT NULL,
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    last_used_at timestamp with time zone,
+    revoked_at timestamp with time zone
+);
+
+CREATE INDEX api_tokens_user_id_idx ON public.api_tokens USING btree (user_id);
+
+-- +goose Down
+DROP INDEX IF EXISTS api_tokens_user_id_idx;
+DROP TABLE IF EXISTS public.api_tokens;
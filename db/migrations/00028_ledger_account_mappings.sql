@@ -0,0 +1,15 @@
+-- +goose Up
+-- Overrides the ledger account that plain-text accounting exports use for a bank account, a card or a
+-- category. source holds the account number, the card number or the category id.
+CREATE TABLE public.ledger_account_mappings (
+    id bigserial PRIMARY KEY,
+    workspace_id integer NOT NULL REFERENCES public.workspaces(id) ON DELETE CASCADE,
+    kind character varying(16) NOT NULL CHECK (kind IN ('account', 'card', 'category')),
+    source character varying(255) NOT NULL,
+    ledger_account character varying(255) NOT NULL,
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    UNIQUE (workspace_id, kind, source)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS public.ledger_account_mappings;
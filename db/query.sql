@@ -12,14 +12,23 @@ INSERT INTO todo (title, user_id)
 SELECT unnest(sqlc.arg(titles)::text[]) AS title, sqlc.arg(user_id) AS user_id;
 
 -- name: GetUserByUsername :one
-SELECT id, username, language
-FROM users
-WHERE username = $1;
+SELECT u.id, u.username, u.language, w.base_currency
+FROM users u
+JOIN workspaces w ON w.user_id = u.id
+WHERE u.username = $1;
 
 -- name: CreateUser :one
-INSERT INTO users (username, password, language)
-VALUES ($1, $2, 'en')
-RETURNING id, username, language;
+WITH created AS (
+    INSERT INTO users (username, password, language)
+    VALUES ($1, $2, 'en')
+    RETURNING id, username, language
+), workspace AS (
+    INSERT INTO workspaces (user_id)
+    SELECT id FROM created
+    RETURNING base_currency
+)
+SELECT created.id, created.username, created.language, workspace.base_currency
+FROM created, workspace;
 
 -- name: CreateSession :one
 INSERT INTO sessions (user_id, expires)
@@ -31,30 +40,63 @@ DELETE FROM sessions
 WHERE id = $1;
 
 -- name: GetUserBySession :one
-SELECT u.id, u.username, u.language, s.expires
+SELECT u.id, u.username, u.language, w.base_currency, s.expires
 FROM sessions s
 JOIN users u ON u.id = s.user_id
+JOIN workspaces w ON w.user_id = u.id
 WHERE s.id = $1;
 
+-- name: GetUserWorkspace :one
+SELECT id, base_currency
+FROM workspaces
+WHERE user_id = $1;
+
 -- name: CreateReport :exec
-INSERT INTO financial_reports (user_id, filename, content_type, data, status)
+INSERT INTO financial_reports (workspace_id, filename, content_type, data, status)
 VALUES ($1, $2, $3, $4, $5);
 
--- name: ListReportsByUser :many
+-- name: ListReportsByWorkspace :many
 SELECT id,
        filename,
        octet_length(data) AS size_bytes,
        status,
        uploaded_at,
-       status_description
+       status_description,
+       (SELECT count(*)
+        FROM transactions
+        WHERE transactions.source_file_id = financial_reports.id)::int AS transaction_count,
+       (SELECT count(*)
+        FROM report_diagnostics
+        WHERE report_diagnostics.report_id = financial_reports.id)::int AS diagnostic_count
 FROM financial_reports
-WHERE user_id = $1
+WHERE workspace_id = $1
 ORDER BY uploaded_at DESC, id DESC;
 
 -- name: GetReportByID :one
 SELECT filename, content_type, data
 FROM financial_reports
-WHERE id = $1 AND user_id = $2;
+WHERE id = $1 AND workspace_id = $2;
+
+-- name: ReportExists :one
+SELECT EXISTS(
+    SELECT 1
+    FROM financial_reports
+    WHERE id = $1 AND workspace_id = $2
+);
+
+-- name: ListReportDiagnostics :many
+SELECT row_number, severity, raw_record, reason
+FROM report_diagnostics
+WHERE report_id = $1 AND workspace_id = $2
+ORDER BY row_number, id;
+
+-- name: DeleteReportDiagnostics :exec
+DELETE FROM report_diagnostics
+WHERE report_id = $1;
+
+-- name: CreateReportDiagnostic :exec
+INSERT INTO report_diagnostics (report_id, workspace_id, row_number, severity, raw_record, reason)
+VALUES ($1, $2, $3, $4, $5, $6);
 
 -- name: GetExchangeRate :one
 SELECT rate
@@ -69,14 +111,14 @@ VALUES ($1, $2, $3, $4)
 ON CONFLICT (rate_date, base_currency, target_currency)
 DO UPDATE SET rate = EXCLUDED.rate;
 
--- name: ListCategoriesByUser :many
+-- name: ListCategoriesByWorkspace :many
 SELECT id, name, color, created_at, parent_id, is_group
 FROM categories
-WHERE user_id = $1
+WHERE workspace_id = $1
 ORDER BY name;
 
 -- name: CreateCategory :one
-INSERT INTO categories (user_id, name, color, parent_id, is_group)
+INSERT INTO categories (workspace_id, name, color, parent_id, is_group)
 VALUES ($1, $2, $3, $4, $5)
 RETURNING id, name, color, created_at, parent_id, is_group;
 
@@ -86,89 +128,228 @@ SET name = $1,
     color = $2,
     parent_id = $3,
     is_group = $4
-WHERE id = $5 AND user_id = $6;
+WHERE id = $5 AND workspace_id = $6;
 
 -- name: DeleteCategory :execrows
 DELETE FROM categories
-WHERE id = $1 AND user_id = $2;
+WHERE id = $1 AND workspace_id = $2;
 
 -- name: CategoryExists :one
 SELECT EXISTS(
     SELECT 1
     FROM categories
-    WHERE id = $1 AND user_id = $2
+    WHERE id = $1 AND workspace_id = $2
 );
 
 -- name: GetCategoryByID :one
 SELECT id, name, color, created_at, parent_id, is_group
 FROM categories
-WHERE id = $1 AND user_id = $2;
+WHERE id = $1 AND workspace_id = $2;
 
--- name: ListCategoryRulesByUser :many
-SELECT id, category_id, description_contains, position, created_at
+-- name: ListCategoryRulesByWorkspace :many
+SELECT id, category_id, description_contains, position, created_at,
+       description_regex, description_exact, description_prefix,
+       amount_min, amount_max, entry_type, currency, account, parser_name
 FROM category_rules
-WHERE user_id = $1
+WHERE workspace_id = $1
 ORDER BY position, id;
 
 -- name: CreateCategoryRule :one
-INSERT INTO category_rules (user_id, category_id, description_contains, position)
+INSERT INTO category_rules (
+    workspace_id, category_id, description_contains, description_regex, description_exact, description_prefix,
+    amount_min, amount_max, entry_type, currency, account, parser_name, position
+)
 VALUES (
     $1,
     $2,
     $3,
-    COALESCE((SELECT MAX(position) FROM category_rules WHERE user_id = $1), 0) + 1
+    $4,
+    $5,
+    $6,
+    $7,
+    $8,
+    $9,
+    $10,
+    $11,
+    $12,
+    COALESCE((SELECT MAX(position) FROM category_rules WHERE workspace_id = $1), 0) + 1
 )
-RETURNING id, category_id, description_contains, position, created_at;
+RETURNING id, category_id, description_contains, position, created_at,
+       description_regex, description_exact, description_prefix,
+       amount_min, amount_max, entry_type, currency, account, parser_name;
 
 -- name: UpdateCategoryRule :execrows
 UPDATE category_rules
 SET category_id = $1,
-    description_contains = $2
-WHERE id = $3 AND user_id = $4;
+    description_contains = $2,
+    description_regex = $3,
+    description_exact = $4,
+    description_prefix = $5,
+    amount_min = $6,
+    amount_max = $7,
+    entry_type = $8,
+    currency = $9,
+    account = $10,
+    parser_name = $11
+WHERE id = $12 AND workspace_id = $13;
 
 -- name: UpdateCategoryRulePosition :execrows
 UPDATE category_rules
 SET position = $1
-WHERE id = $2 AND user_id = $3;
+WHERE id = $2 AND workspace_id = $3;
 
 -- name: DeleteCategoryRule :execrows
 DELETE FROM category_rules
-WHERE id = $1 AND user_id = $2;
+WHERE id = $1 AND workspace_id = $2;
 
 -- name: UpdateTransactionCategory :execrows
 UPDATE transactions
 SET category_id = $1,
     category_source = $2
-WHERE id = $3 AND user_id = $4;
+WHERE id = $3 AND workspace_id = $4;
 
--- name: ListPendingReports :many
-SELECT id, user_id, filename, data
-FROM financial_reports
-WHERE status = 'pending'
-ORDER BY uploaded_at ASC, id ASC;
+-- name: ClaimNextReport :one
+UPDATE financial_reports
+SET status = 'processing',
+    claimed_at = now(),
+    claimed_by = sqlc.arg(claimed_by),
+    attempts = attempts + 1
+WHERE id = (
+    SELECT id
+    FROM financial_reports
+    WHERE (status = 'pending' AND (next_attempt_at IS NULL OR next_attempt_at <= now()))
+       OR (status = 'processing' AND claimed_at < now() - make_interval(secs => sqlc.arg(lease_seconds)::double precision))
+    ORDER BY uploaded_at ASC, id ASC
+    LIMIT 1
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING id, workspace_id, filename, data, attempts;
+
+-- name: CompleteReportClaim :execrows
+UPDATE financial_reports
+SET status = sqlc.arg(status),
+    status_description = sqlc.arg(status_description),
+    claimed_at = NULL,
+    claimed_by = NULL,
+    next_attempt_at = NULL
+WHERE id = sqlc.arg(id)
+  AND status = 'processing'
+  AND claimed_by = sqlc.arg(claimed_by);
+
+-- name: RetryReportClaim :execrows
+UPDATE financial_reports
+SET status = 'pending',
+    status_description = sqlc.arg(status_description),
+    claimed_at = NULL,
+    claimed_by = NULL,
+    next_attempt_at = now() + make_interval(secs => sqlc.arg(delay_seconds)::double precision)
+WHERE id = sqlc.arg(id)
+  AND status = 'processing'
+  AND claimed_by = sqlc.arg(claimed_by);
+
+-- name: ReleaseReportClaim :execrows
+UPDATE financial_reports
+SET status = 'pending',
+    claimed_at = NULL,
+    claimed_by = NULL,
+    attempts = GREATEST(attempts - 1, 0)
+WHERE id = sqlc.arg(id)
+  AND status = 'processing'
+  AND claimed_by = sqlc.arg(claimed_by);
+
+-- name: NotifyReportUploaded :exec
+SELECT pg_notify('report_uploaded', sqlc.arg(payload)::text);
+
+-- name: NotifyReportStatus :exec
+SELECT pg_notify('report_status', sqlc.arg(payload)::text);
 
 -- name: UpdateReportStatus :exec
 UPDATE financial_reports
 SET status = $1
-WHERE id = $2 AND user_id = $3;
+WHERE id = $2 AND workspace_id = $3;
 
 -- name: UpdateReportStatusWithError :exec
 UPDATE financial_reports
 SET status = $1,
     status_description = $2
-WHERE id = $3 AND user_id = $4;
+WHERE id = $3 AND workspace_id = $4;
+
+-- name: RequeueReports :many
+UPDATE financial_reports
+SET status = 'pending',
+    status_description = NULL,
+    attempts = 0,
+    next_attempt_at = NULL
+WHERE workspace_id = sqlc.arg(workspace_id)
+  AND id = ANY(sqlc.arg(ids)::bigint[])
+  AND status NOT IN ('pending', 'processing')
+RETURNING id;
+
+-- name: ListOutdatedReports :many
+SELECT DISTINCT transactions.source_file_id
+FROM transactions
+JOIN unnest(sqlc.arg(parser_names)::text[], sqlc.arg(parser_versions)::int[]) AS current_parsers(parser_name, parser_version)
+  ON current_parsers.parser_name = transactions.parser_name
+WHERE transactions.workspace_id = sqlc.arg(workspace_id)
+  AND COALESCE((transactions.parser_meta->>'parser_version')::int, 0) < current_parsers.parser_version;
 
 -- name: DeleteReportByID :exec
 DELETE FROM financial_reports
-WHERE id = $1 AND user_id = $2;
+WHERE id = $1 AND workspace_id = $2;
+
+-- name: ListTransactionsBySource :many
+SELECT id,
+       source_file_row,
+       parser_name,
+       posted_date,
+       description,
+       amount,
+       currency,
+       transaction_id,
+       entry_type,
+       source_account_number,
+       source_card_number,
+       category_id,
+       category_source,
+       parser_meta
+FROM transactions
+WHERE source_file_id = $1 AND workspace_id = $2
+ORDER BY source_file_row, id;
 
--- name: DeleteTransactionsBySource :exec
+-- name: UpdateTransactionFromSource :exec
+UPDATE transactions
+SET source_file_row = $1,
+    parser_name = $2,
+    posted_date = $3,
+    description = $4,
+    amount = $5,
+    currency = $6,
+    transaction_id = $7,
+    entry_type = $8,
+    source_account_number = $9,
+    source_card_number = $10,
+    category_id = $11,
+    category_source = $12,
+    parser_meta = $13
+WHERE id = $14 AND workspace_id = $15;
+
+-- name: DeleteTransactionsByIDs :execrows
 DELETE FROM transactions
-WHERE source_file_id = $1 AND user_id = $2;
+WHERE workspace_id = sqlc.arg(workspace_id) AND id = ANY(sqlc.arg(ids)::bigint[]);
+
+-- name: LockWorkspaceTransactions :exec
+SELECT pg_advisory_xact_lock(sqlc.arg(namespace)::integer, sqlc.arg(workspace_id)::integer);
+
+-- name: ListDuplicateCandidates :many
+SELECT id, posted_date, amount, transaction_id, source_account_number, source_card_number, description
+FROM transactions
+WHERE workspace_id = sqlc.arg(workspace_id)
+  AND source_file_id <> sqlc.arg(source_file_id)
+  AND posted_date BETWEEN sqlc.arg(from_date) AND sqlc.arg(to_date);
 
 -- name: CreateTransaction :exec
 INSERT INTO transactions (
-    user_id,
+    workspace_id,
     source_file_id,
     source_file_row,
     parser_name,
@@ -203,42 +384,127 @@ INSERT INTO transactions (
 
 -- name: ListTransactionsForRuleApply :many
 SELECT id,
+       posted_date,
        description,
+       amount,
+       currency,
+       entry_type,
+       source_account_number,
+       source_card_number,
+       parser_name,
        category_id,
        category_source
 FROM transactions
-WHERE user_id = $1
-  AND ($2::boolean OR category_source IS DISTINCT FROM 'manual');
+WHERE workspace_id = $1
+  AND ($2::boolean OR category_source IS DISTINCT FROM 'manual')
+ORDER BY posted_date DESC, id DESC;
 
 -- name: SummaryTransactions :one
+WITH lines AS (
+    SELECT transactions.id,
+           transactions.posted_date,
+           transactions.currency,
+           transactions.source_account_number,
+           transactions.source_card_number,
+           CASE WHEN transaction_splits.id IS NULL THEN transactions.category_id ELSE transaction_splits.category_id END AS category_id,
+           COALESCE(transaction_splits.amount, transactions.amount) AS amount
+    FROM transactions
+    LEFT JOIN transaction_splits ON transaction_splits.transaction_id = transactions.id
+    WHERE transactions.workspace_id = sqlc.arg(workspace_id)
+      AND (sqlc.narg(from_date)::date IS NULL OR transactions.posted_date >= sqlc.narg(from_date))
+      AND (sqlc.narg(to_date)::date IS NULL OR transactions.posted_date <= sqlc.narg(to_date))
+      AND (sqlc.narg(source_file_id)::bigint IS NULL OR transactions.source_file_id = sqlc.narg(source_file_id))
+      AND (sqlc.narg(entry_type)::text IS NULL OR transactions.entry_type = sqlc.narg(entry_type))
+      AND (sqlc.narg(source_account_number)::text IS NULL OR transactions.source_account_number = sqlc.narg(source_account_number))
+      AND (sqlc.narg(source_card_number)::text IS NULL OR transactions.source_card_number = sqlc.narg(source_card_number))
+      AND (sqlc.narg(search_text)::text IS NULL OR to_tsvector('simple', transactions.description) @@ plainto_tsquery('simple', sqlc.narg(search_text)))
+      AND (sqlc.arg(include_transfers)::boolean OR NOT EXISTS (
+          SELECT 1
+          FROM transaction_transfers
+          WHERE transaction_transfers.status <> 'rejected'
+            AND transactions.id IN (transaction_transfers.debit_transaction_id, transaction_transfers.credit_transaction_id)
+      ))
+),
+scoped AS (
+    SELECT id, SUM(amount) AS amount
+    FROM lines
+    WHERE sqlc.narg(category_id)::bigint IS NULL OR category_id = sqlc.narg(category_id)
+    GROUP BY id
+)
 SELECT
     COUNT(*) AS count,
     COALESCE(SUM(amount), 0::numeric)::text AS total_amount,
     COALESCE(AVG(amount), 0::numeric)::text AS average_amount,
     COALESCE(PERCENTILE_CONT(0.5) WITHIN GROUP (ORDER BY amount), 0::numeric)::text AS median_amount
-FROM transactions
-WHERE user_id = sqlc.arg(user_id)
-  AND (sqlc.narg(from_date)::date IS NULL OR posted_date >= sqlc.narg(from_date))
-  AND (sqlc.narg(to_date)::date IS NULL OR posted_date <= sqlc.narg(to_date))
-  AND (sqlc.narg(source_file_id)::bigint IS NULL OR source_file_id = sqlc.narg(source_file_id))
-  AND (sqlc.narg(entry_type)::text IS NULL OR entry_type = sqlc.narg(entry_type))
-  AND (sqlc.narg(source_account_number)::text IS NULL OR source_account_number = sqlc.narg(source_account_number))
-  AND (sqlc.narg(source_card_number)::text IS NULL OR source_card_number = sqlc.narg(source_card_number))
-  AND (sqlc.narg(search_text)::text IS NULL OR to_tsvector('simple', description) @@ plainto_tsquery('simple', sqlc.narg(search_text)))
-  AND (sqlc.narg(category_id)::bigint IS NULL OR category_id = sqlc.narg(category_id));
+FROM scoped;
 
 -- name: ListTransactionsSummaryRows :many
-SELECT posted_date, amount, currency, source_account_number, source_card_number
-FROM transactions
-WHERE user_id = sqlc.arg(user_id)
-  AND (sqlc.narg(from_date)::date IS NULL OR posted_date >= sqlc.narg(from_date))
-  AND (sqlc.narg(to_date)::date IS NULL OR posted_date <= sqlc.narg(to_date))
-  AND (sqlc.narg(source_file_id)::bigint IS NULL OR source_file_id = sqlc.narg(source_file_id))
-  AND (sqlc.narg(entry_type)::text IS NULL OR entry_type = sqlc.narg(entry_type))
-  AND (sqlc.narg(source_account_number)::text IS NULL OR source_account_number = sqlc.narg(source_account_number))
-  AND (sqlc.narg(source_card_number)::text IS NULL OR source_card_number = sqlc.narg(source_card_number))
-  AND (sqlc.narg(search_text)::text IS NULL OR to_tsvector('simple', description) @@ plainto_tsquery('simple', sqlc.narg(search_text)))
-  AND (sqlc.narg(category_id)::bigint IS NULL OR category_id = sqlc.narg(category_id));
+WITH lines AS (
+    SELECT transactions.id,
+           transactions.posted_date,
+           transactions.currency,
+           transactions.source_account_number,
+           transactions.source_card_number,
+           CASE WHEN transaction_splits.id IS NULL THEN transactions.category_id ELSE transaction_splits.category_id END AS category_id,
+           COALESCE(transaction_splits.amount, transactions.amount) AS amount
+    FROM transactions
+    LEFT JOIN transaction_splits ON transaction_splits.transaction_id = transactions.id
+    WHERE transactions.workspace_id = sqlc.arg(workspace_id)
+      AND (sqlc.narg(from_date)::date IS NULL OR transactions.posted_date >= sqlc.narg(from_date))
+      AND (sqlc.narg(to_date)::date IS NULL OR transactions.posted_date <= sqlc.narg(to_date))
+      AND (sqlc.narg(source_file_id)::bigint IS NULL OR transactions.source_file_id = sqlc.narg(source_file_id))
+      AND (sqlc.narg(entry_type)::text IS NULL OR transactions.entry_type = sqlc.narg(entry_type))
+      AND (sqlc.narg(source_account_number)::text IS NULL OR transactions.source_account_number = sqlc.narg(source_account_number))
+      AND (sqlc.narg(source_card_number)::text IS NULL OR transactions.source_card_number = sqlc.narg(source_card_number))
+      AND (sqlc.narg(search_text)::text IS NULL OR to_tsvector('simple', transactions.description) @@ plainto_tsquery('simple', sqlc.narg(search_text)))
+      AND (sqlc.arg(include_transfers)::boolean OR NOT EXISTS (
+          SELECT 1
+          FROM transaction_transfers
+          WHERE transaction_transfers.status <> 'rejected'
+            AND transactions.id IN (transaction_transfers.debit_transaction_id, transaction_transfers.credit_transaction_id)
+      ))
+)
+SELECT posted_date, SUM(amount)::numeric AS amount, currency, source_account_number, source_card_number
+FROM lines
+WHERE sqlc.narg(category_id)::bigint IS NULL OR category_id = sqlc.narg(category_id)
+GROUP BY id, posted_date, currency, source_account_number, source_card_number;
+
+-- name: AggregateTransactionsByDay :many
+WITH lines AS (
+    SELECT transactions.id,
+           transactions.posted_date,
+           transactions.currency,
+           transactions.source_account_number,
+           transactions.source_card_number,
+           CASE WHEN transaction_splits.id IS NULL THEN transactions.category_id ELSE transaction_splits.category_id END AS category_id,
+           COALESCE(transaction_splits.amount, transactions.amount) AS amount
+    FROM transactions
+    LEFT JOIN transaction_splits ON transaction_splits.transaction_id = transactions.id
+    WHERE transactions.workspace_id = sqlc.arg(workspace_id)
+      AND (sqlc.narg(from_date)::date IS NULL OR transactions.posted_date >= sqlc.narg(from_date))
+      AND (sqlc.narg(to_date)::date IS NULL OR transactions.posted_date <= sqlc.narg(to_date))
+      AND (sqlc.narg(source_file_id)::bigint IS NULL OR transactions.source_file_id = sqlc.narg(source_file_id))
+      AND (sqlc.narg(entry_type)::text IS NULL OR transactions.entry_type = sqlc.narg(entry_type))
+      AND (sqlc.narg(source_account_number)::text IS NULL OR transactions.source_account_number = sqlc.narg(source_account_number))
+      AND (sqlc.narg(source_card_number)::text IS NULL OR transactions.source_card_number = sqlc.narg(source_card_number))
+      AND (sqlc.narg(search_text)::text IS NULL OR to_tsvector('simple', transactions.description) @@ plainto_tsquery('simple', sqlc.narg(search_text)))
+      AND (sqlc.arg(include_transfers)::boolean OR NOT EXISTS (
+          SELECT 1
+          FROM transaction_transfers
+          WHERE transaction_transfers.status <> 'rejected'
+            AND transactions.id IN (transaction_transfers.debit_transaction_id, transaction_transfers.credit_transaction_id)
+      ))
+)
+SELECT posted_date,
+       currency,
+       category_id,
+       COUNT(DISTINCT id) AS count,
+       COALESCE(SUM(amount) FILTER (WHERE amount > 0), 0)::numeric AS income,
+       COALESCE(SUM(amount) FILTER (WHERE amount < 0), 0)::numeric AS expense
+FROM lines
+WHERE sqlc.narg(category_id)::bigint IS NULL OR category_id = sqlc.narg(category_id)
+GROUP BY posted_date, currency, category_id
+ORDER BY posted_date, currency, category_id;
 
 -- name: ListTransactions :many
 SELECT id,
@@ -257,7 +523,7 @@ SELECT id,
        parser_meta,
        created_at
 FROM transactions
-WHERE user_id = $1
+WHERE workspace_id = $1
   AND (sqlc.narg(from_date)::date IS NULL OR posted_date >= sqlc.narg(from_date))
   AND (sqlc.narg(to_date)::date IS NULL OR posted_date <= sqlc.narg(to_date))
   AND (sqlc.narg(source_file_id)::bigint IS NULL OR source_file_id = sqlc.narg(source_file_id))
@@ -265,7 +531,13 @@ WHERE user_id = $1
   AND (sqlc.narg(source_account_number)::text IS NULL OR source_account_number = sqlc.narg(source_account_number))
   AND (sqlc.narg(source_card_number)::text IS NULL OR source_card_number = sqlc.narg(source_card_number))
   AND (sqlc.narg(search_text)::text IS NULL OR to_tsvector('simple', description) @@ plainto_tsquery('simple', sqlc.narg(search_text)))
-  AND (sqlc.narg(category_id)::bigint IS NULL OR category_id = sqlc.narg(category_id))
+  AND (sqlc.narg(category_id)::bigint IS NULL
+       OR (category_id = sqlc.narg(category_id)
+           AND NOT EXISTS (SELECT 1 FROM transaction_splits WHERE transaction_splits.transaction_id = transactions.id))
+       OR EXISTS (SELECT 1
+                  FROM transaction_splits
+                  WHERE transaction_splits.transaction_id = transactions.id
+                    AND transaction_splits.category_id = sqlc.narg(category_id)))
 ORDER BY posted_date DESC, id DESC
 LIMIT sqlc.arg(limit_count)
 OFFSET sqlc.arg(offset_count);
@@ -274,3 +546,423 @@ OFFSET sqlc.arg(offset_count);
 UPDATE users
 SET language = $1
 WHERE id = $2;
+
+-- name: UpdateUserBaseCurrency :exec
+UPDATE workspaces
+SET base_currency = $1
+WHERE user_id = $2;
+
+-- name: ListBudgetsByWorkspace :many
+SELECT id, category_id, period, amount, currency, created_at
+FROM budgets
+WHERE workspace_id = $1
+ORDER BY id;
+
+-- name: CreateBudget :one
+INSERT INTO budgets (workspace_id, category_id, period, amount, currency)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, category_id, period, amount, currency, created_at;
+
+-- name: UpdateBudget :execrows
+UPDATE budgets
+SET category_id = $1,
+    period = $2,
+    amount = $3,
+    currency = $4
+WHERE id = $5 AND workspace_id = $6;
+
+-- name: DeleteBudget :execrows
+DELETE FROM budgets
+WHERE id = $1 AND workspace_id = $2;
+
+-- name: ListCategorizedTransactions :many
+SELECT transactions.posted_date,
+       COALESCE(transaction_splits.amount, transactions.amount)::numeric AS amount,
+       transactions.currency,
+       CASE WHEN transaction_splits.id IS NULL THEN transactions.category_id ELSE transaction_splits.category_id END AS category_id
+FROM transactions
+LEFT JOIN transaction_splits ON transaction_splits.transaction_id = transactions.id
+WHERE transactions.workspace_id = sqlc.arg(workspace_id)
+  AND transactions.posted_date >= sqlc.arg(from_date)
+  AND transactions.posted_date < sqlc.arg(to_date)
+  AND CASE WHEN transaction_splits.id IS NULL THEN transactions.category_id ELSE transaction_splits.category_id END IS NOT NULL
+  AND NOT EXISTS (
+      SELECT 1
+      FROM transaction_transfers
+      WHERE transaction_transfers.status <> 'rejected'
+        AND transactions.id IN (transaction_transfers.debit_transaction_id, transaction_transfers.credit_transaction_id)
+  );
+
+-- name: ListCsvTemplatesByWorkspace :many
+SELECT id, name, signature, delimiter, date_column, date_format, decimal_separator, description_columns,
+       amount_column, debit_column, credit_column, currency_column, account_column, default_currency, created_at
+FROM csv_templates
+WHERE workspace_id = $1
+ORDER BY name, id;
+
+-- name: CreateCsvTemplate :one
+INSERT INTO csv_templates (
+    workspace_id, name, signature, delimiter, date_column, date_format, decimal_separator, description_columns,
+    amount_column, debit_column, credit_column, currency_column, account_column, default_currency
+)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+RETURNING id, name, signature, delimiter, date_column, date_format, decimal_separator, description_columns,
+       amount_column, debit_column, credit_column, currency_column, account_column, default_currency, created_at;
+
+-- name: UpdateCsvTemplate :execrows
+UPDATE csv_templates
+SET name = $1,
+    signature = $2,
+    delimiter = $3,
+    date_column = $4,
+    date_format = $5,
+    decimal_separator = $6,
+    description_columns = $7,
+    amount_column = $8,
+    debit_column = $9,
+    credit_column = $10,
+    currency_column = $11,
+    account_column = $12,
+    default_currency = $13
+WHERE id = $14 AND workspace_id = $15;
+
+-- name: DeleteCsvTemplate :execrows
+DELETE FROM csv_templates
+WHERE id = $1 AND workspace_id = $2;
+
+-- name: GetTransactionAmount :one
+SELECT amount
+FROM transactions
+WHERE id = $1 AND workspace_id = $2;
+
+-- name: ListTransactionSplits :many
+SELECT id, transaction_id, category_id, amount
+FROM transaction_splits
+WHERE workspace_id = sqlc.arg(workspace_id) AND transaction_id = ANY(sqlc.arg(transaction_ids)::bigint[])
+ORDER BY transaction_id, id;
+
+-- name: CreateTransactionSplit :exec
+INSERT INTO transaction_splits (transaction_id, workspace_id, category_id, amount)
+VALUES ($1, $2, $3, $4);
+
+-- name: DeleteTransactionSplits :exec
+DELETE FROM transaction_splits
+WHERE transaction_id = $1 AND workspace_id = $2;
+
+-- name: ListTransferCandidates :many
+SELECT id, source_file_id, posted_date, amount, currency, source_account_number, source_card_number
+FROM transactions
+WHERE workspace_id = $1
+  AND NOT EXISTS (
+      SELECT 1
+      FROM transaction_transfers
+      WHERE transaction_transfers.status <> 'rejected'
+        AND transactions.id IN (transaction_transfers.debit_transaction_id, transaction_transfers.credit_transaction_id)
+  )
+ORDER BY posted_date, id;
+
+-- name: ListRejectedTransfers :many
+SELECT debit_transaction_id, credit_transaction_id
+FROM transaction_transfers
+WHERE workspace_id = $1 AND status = 'rejected';
+
+-- name: CreateTransactionTransfer :execrows
+INSERT INTO transaction_transfers (workspace_id, debit_transaction_id, credit_transaction_id, status)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT DO NOTHING;
+
+-- name: ListTransactionTransfers :many
+SELECT transaction_transfers.id,
+       transaction_transfers.status,
+       transaction_transfers.created_at,
+       debit.id AS debit_id,
+       debit.posted_date AS debit_posted_date,
+       debit.description AS debit_description,
+       debit.amount AS debit_amount,
+       debit.currency AS debit_currency,
+       debit.source_account_number AS debit_source_account_number,
+       debit.source_card_number AS debit_source_card_number,
+       credit.id AS credit_id,
+       credit.posted_date AS credit_posted_date,
+       credit.description AS credit_description,
+       credit.amount AS credit_amount,
+       credit.currency AS credit_currency,
+       credit.source_account_number AS credit_source_account_number,
+       credit.source_card_number AS credit_source_card_number
+FROM transaction_transfers
+JOIN transactions AS debit ON debit.id = transaction_transfers.debit_transaction_id
+JOIN transactions AS credit ON credit.id = transaction_transfers.credit_transaction_id
+WHERE transaction_transfers.workspace_id = sqlc.arg(workspace_id)
+  AND (sqlc.narg(status)::text IS NULL OR transaction_transfers.status = sqlc.narg(status))
+ORDER BY debit.posted_date DESC, transaction_transfers.id DESC;
+
+-- name: UpdateTransactionTransferStatus :execrows
+UPDATE transaction_transfers
+SET status = $1
+WHERE id = $2 AND workspace_id = $3;
+
+-- name: UpsertAccount :one
+INSERT INTO accounts (workspace_id, name, type, identifier, iban, currency)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (workspace_id, identifier) DO UPDATE
+SET iban = COALESCE(accounts.iban, EXCLUDED.iban),
+    currency = COALESCE(accounts.currency, EXCLUDED.currency)
+RETURNING COALESCE(merged_into_id, id)::bigint AS id;
+
+-- name: AssignReportTransactionAccounts :exec
+UPDATE transactions
+SET account_id = COALESCE(accounts.merged_into_id, accounts.id)
+FROM accounts
+WHERE transactions.source_file_id = $1
+  AND transactions.workspace_id = $2
+  AND accounts.workspace_id = transactions.workspace_id
+  AND accounts.identifier = COALESCE(NULLIF(transactions.source_card_number, ''), transactions.source_account_number);
+
+-- name: DeleteAccountBalancesBySource :exec
+DELETE FROM account_balances
+WHERE source_file_id = $1 AND workspace_id = $2;
+
+-- name: CreateAccountBalance :exec
+INSERT INTO account_balances (account_id, workspace_id, source_file_id, balance_date, balance)
+VALUES ($1, $2, $3, $4, $5);
+
+-- name: ListAccounts :many
+SELECT id, name, type, identifier, iban, currency, archived, created_at
+FROM accounts
+WHERE workspace_id = sqlc.arg(workspace_id)
+  AND merged_into_id IS NULL
+  AND (sqlc.arg(include_archived)::boolean OR NOT archived)
+ORDER BY archived, name, id;
+
+-- name: GetAccount :one
+SELECT id, name, type, identifier, iban, currency, archived, created_at
+FROM accounts
+WHERE id = $1 AND workspace_id = $2 AND merged_into_id IS NULL;
+
+-- name: CreateAccount :one
+INSERT INTO accounts (workspace_id, name, type, currency)
+VALUES ($1, $2, $3, $4)
+RETURNING id, name, type, identifier, iban, currency, archived, created_at;
+
+-- name: RenameAccount :execrows
+UPDATE accounts
+SET name = $1
+WHERE id = $2 AND workspace_id = $3 AND merged_into_id IS NULL;
+
+-- name: SetAccountArchived :execrows
+UPDATE accounts
+SET archived = $1
+WHERE id = $2 AND workspace_id = $3 AND merged_into_id IS NULL;
+
+-- name: MergeAccountInto :exec
+UPDATE accounts
+SET merged_into_id = sqlc.arg(target_id), archived = true
+WHERE workspace_id = sqlc.arg(workspace_id)
+  AND (id = sqlc.arg(source_id) OR merged_into_id = sqlc.arg(source_id));
+
+-- name: MoveAccountTransactions :exec
+UPDATE transactions
+SET account_id = sqlc.arg(target_id)
+WHERE account_id = sqlc.arg(source_id) AND workspace_id = sqlc.arg(workspace_id);
+
+-- name: MoveAccountBalances :exec
+UPDATE account_balances
+SET account_id = sqlc.arg(target_id)
+WHERE account_id = sqlc.arg(source_id) AND workspace_id = sqlc.arg(workspace_id);
+
+-- name: ListAccountBalanceCheckpoints :many
+SELECT balance_date, balance
+FROM account_balances
+WHERE account_id = $1 AND workspace_id = $2
+ORDER BY balance_date, id;
+
+-- name: ListAccountDailyTotals :many
+SELECT posted_date, SUM(amount)::numeric AS amount
+FROM transactions
+WHERE account_id = $1 AND workspace_id = $2
+GROUP BY posted_date
+ORDER BY posted_date;
+
+-- name: ListRecurringCandidates :many
+SELECT id, posted_date, description, amount, currency
+FROM transactions
+WHERE workspace_id = $1 AND amount < 0 AND posted_date >= $2
+ORDER BY posted_date, id;
+
+-- name: CreateRecurringSeries :execrows
+INSERT INTO recurring_series (workspace_id, merchant_key, name, currency, cadence, amount)
+VALUES ($1, $2, $3, $4, $5, $6);
+
+-- name: ListRecurringSeries :many
+SELECT id, merchant_key, name, currency, cadence, amount, status, created_at
+FROM recurring_series
+WHERE workspace_id = sqlc.arg(workspace_id)
+  AND (sqlc.arg(include_ignored)::boolean OR status <> 'ignored')
+ORDER BY name, id;
+
+-- name: UpdateRecurringSeriesStatus :execrows
+UPDATE recurring_series
+SET status = $1
+WHERE id = $2 AND workspace_id = $3;
+
+-- name: UpdateRecurringSeries :execrows
+UPDATE recurring_series
+SET name = $1, cadence = $2, amount = $3, status = 'confirmed'
+WHERE id = $4 AND workspace_id = $5;
+
+-- name: CreateHousehold :one
+INSERT INTO workspaces (name, base_currency)
+VALUES ($1, $2)
+RETURNING id, created_at;
+
+-- name: UpdateHousehold :execrows
+UPDATE workspaces
+SET name = $1, base_currency = $2
+WHERE id = $3 AND user_id IS NULL;
+
+-- name: AddHouseholdMember :execrows
+INSERT INTO household_members (household_id, user_id, role)
+VALUES ($1, $2, $3)
+ON CONFLICT (household_id, user_id) DO NOTHING;
+
+-- name: ListHouseholdsByUser :many
+SELECT w.id, w.name, m.role, w.base_currency, w.created_at
+FROM household_members m
+JOIN workspaces w ON w.id = m.household_id
+WHERE m.user_id = $1
+ORDER BY w.name, w.id;
+
+-- name: GetHouseholdMembership :one
+SELECT w.id, w.name, m.role, w.base_currency, w.created_at
+FROM household_members m
+JOIN workspaces w ON w.id = m.household_id
+WHERE m.household_id = $1 AND m.user_id = $2;
+
+-- name: LockHousehold :exec
+SELECT id FROM workspaces WHERE id = $1 FOR UPDATE;
+
+-- name: ListHouseholdMembers :many
+SELECT m.user_id, u.username, m.role, m.created_at
+FROM household_members m
+JOIN users u ON u.id = m.user_id
+WHERE m.household_id = $1
+ORDER BY m.created_at, m.user_id;
+
+-- name: CountHouseholdOwners :one
+SELECT COUNT(*)
+FROM household_members
+WHERE household_id = $1 AND role = 'owner';
+
+-- name: UpdateHouseholdMemberRole :execrows
+UPDATE household_members
+SET role = $1
+WHERE household_id = $2 AND user_id = $3;
+
+-- name: DeleteHouseholdMember :execrows
+DELETE FROM household_members
+WHERE household_id = $1 AND user_id = $2;
+
+-- name: CreateHouseholdInvitation :exec
+INSERT INTO household_invitations (household_id, token_hash, role, created_by, expires_at)
+VALUES ($1, $2, $3, $4, $5);
+
+-- name: ClaimHouseholdInvitation :one
+UPDATE household_invitations
+SET accepted_by = $1, accepted_at = now()
+WHERE token_hash = $2 AND accepted_at IS NULL AND expires_at > now()
+RETURNING household_id, role;
+
+-- name: CreateApiToken :one
+INSERT INTO api_tokens (user_id, name, token_prefix, token_hash, scopes)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, created_at;
+
+-- name: ListApiTokens :many
+SELECT id, name, token_prefix, scopes, created_at, last_used_at
+FROM api_tokens
+WHERE user_id = $1 AND revoked_at IS NULL
+ORDER BY created_at DESC, id DESC;
+
+-- name: RevokeApiToken :execrows
+UPDATE api_tokens
+SET revoked_at = now()
+WHERE id = $1 AND user_id = $2 AND revoked_at IS NULL;
+
+-- name: GetUserByApiToken :one
+SELECT t.id AS token_id, t.scopes, u.id, u.username, u.language, w.base_currency
+FROM api_tokens t
+JOIN users u ON u.id = t.user_id
+JOIN workspaces w ON w.user_id = u.id
+WHERE t.token_hash = $1 AND t.revoked_at IS NULL;
+
+-- name: TouchApiToken :exec
+UPDATE api_tokens
+SET last_used_at = now()
+WHERE id = $1 AND (last_used_at IS NULL OR last_used_at < now() - interval '1 minute');
+
+-- name: ExportTransactions :many
+SELECT t.id,
+       t.posted_date,
+       t.description,
+       t.amount,
+       t.currency,
+       t.transaction_id,
+       t.entry_type,
+       t.source_account_number,
+       t.source_card_number,
+       t.source_file_row,
+       t.parser_name,
+       t.category_id,
+       a.name AS account_name,
+       a.type AS account_type,
+       f.filename AS source_filename,
+       EXISTS (
+           SELECT 1
+           FROM transaction_transfers
+           WHERE transaction_transfers.status <> 'rejected'
+             AND t.id IN (transaction_transfers.debit_transaction_id, transaction_transfers.credit_transaction_id)
+       ) AS is_transfer
+FROM transactions t
+JOIN financial_reports f ON f.id = t.source_file_id
+LEFT JOIN accounts a ON a.id = t.account_id
+WHERE t.workspace_id = sqlc.arg(workspace_id)
+  AND (sqlc.narg(from_date)::date IS NULL OR t.posted_date >= sqlc.narg(from_date))
+  AND (sqlc.narg(to_date)::date IS NULL OR t.posted_date <= sqlc.narg(to_date))
+  AND (sqlc.narg(source_file_id)::bigint IS NULL OR t.source_file_id = sqlc.narg(source_file_id))
+  AND (sqlc.narg(entry_type)::text IS NULL OR t.entry_type = sqlc.narg(entry_type))
+  AND (sqlc.narg(source_account_number)::text IS NULL OR t.source_account_number = sqlc.narg(source_account_number))
+  AND (sqlc.narg(source_card_number)::text IS NULL OR t.source_card_number = sqlc.narg(source_card_number))
+  AND (sqlc.narg(search_text)::text IS NULL OR to_tsvector('simple', t.description) @@ plainto_tsquery('simple', sqlc.narg(search_text)))
+  AND (sqlc.narg(category_id)::bigint IS NULL
+       OR (t.category_id = sqlc.narg(category_id)
+           AND NOT EXISTS (SELECT 1 FROM transaction_splits WHERE transaction_splits.transaction_id = t.id))
+       OR EXISTS (SELECT 1
+                  FROM transaction_splits
+                  WHERE transaction_splits.transaction_id = t.id
+                    AND transaction_splits.category_id = sqlc.narg(category_id)))
+  AND (sqlc.arg(include_transfers)::boolean OR NOT EXISTS (
+      SELECT 1
+      FROM transaction_transfers
+      WHERE transaction_transfers.status <> 'rejected'
+        AND t.id IN (transaction_transfers.debit_transaction_id, transaction_transfers.credit_transaction_id)
+  ))
+  AND (sqlc.narg(after_date)::date IS NULL OR (t.posted_date, t.id) > (sqlc.narg(after_date), sqlc.arg(after_id)::bigint))
+ORDER BY t.posted_date, t.id
+LIMIT sqlc.arg(limit_count);
+
+-- name: ListLedgerAccountMappings :many
+SELECT id, kind, source, ledger_account
+FROM ledger_account_mappings
+WHERE workspace_id = $1
+ORDER BY kind, source;
+
+-- name: UpsertLedgerAccountMapping :one
+INSERT INTO ledger_account_mappings (workspace_id, kind, source, ledger_account)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (workspace_id, kind, source) DO UPDATE
+SET ledger_account = EXCLUDED.ledger_account
+RETURNING id;
+
+-- name: DeleteLedgerAccountMapping :execrows
+DELETE FROM ledger_account_mappings
+WHERE id = $1 AND workspace_id = $2;
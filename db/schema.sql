@@ -1,9 +1,81 @@
 -- Generated by "make generate". DO NOT EDIT.
 CREATE EXTENSION IF NOT EXISTS "uuid-ossp" WITH SCHEMA public;
-CREATE TABLE public.categories (
+CREATE TABLE public.account_balances (
+    id bigint NOT NULL,
+    account_id bigint NOT NULL,
+    workspace_id integer NOT NULL,
+    source_file_id bigint,
+    balance_date date NOT NULL,
+    balance numeric(18,2) NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL
+);
+CREATE SEQUENCE public.account_balances_id_seq
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+ALTER SEQUENCE public.account_balances_id_seq OWNED BY public.account_balances.id;
+CREATE TABLE public.accounts (
+    id bigint NOT NULL,
+    workspace_id integer NOT NULL,
+    name character varying(255) NOT NULL,
+    type character varying(16) NOT NULL,
+    identifier character varying(64),
+    iban character varying(34),
+    currency character varying(3),
+    archived boolean DEFAULT false NOT NULL,
+    merged_into_id bigint,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    CONSTRAINT accounts_type_check CHECK (((type)::text = ANY ((ARRAY['bank'::character varying, 'card'::character varying, 'cash'::character varying])::text[])))
+);
+CREATE SEQUENCE public.accounts_id_seq
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+ALTER SEQUENCE public.accounts_id_seq OWNED BY public.accounts.id;
+CREATE TABLE public.api_tokens (
     id bigint NOT NULL,
     user_id integer NOT NULL,
     name character varying(255) NOT NULL,
+    token_prefix character varying(16) NOT NULL,
+    token_hash character varying(64) NOT NULL,
+    scopes text[] NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    last_used_at timestamp with time zone,
+    revoked_at timestamp with time zone
+);
+CREATE SEQUENCE public.api_tokens_id_seq
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+ALTER SEQUENCE public.api_tokens_id_seq OWNED BY public.api_tokens.id;
+CREATE TABLE public.budgets (
+    id bigint NOT NULL,
+    workspace_id integer NOT NULL,
+    category_id bigint NOT NULL,
+    period character varying(16) NOT NULL,
+    amount numeric(18,2) NOT NULL,
+    currency character varying(3) NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    CONSTRAINT budgets_amount_check CHECK ((amount > (0)::numeric)),
+    CONSTRAINT budgets_period_check CHECK (((period)::text = ANY ((ARRAY['monthly'::character varying, 'quarterly'::character varying, 'yearly'::character varying])::text[])))
+);
+CREATE SEQUENCE public.budgets_id_seq
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+ALTER SEQUENCE public.budgets_id_seq OWNED BY public.budgets.id;
+CREATE TABLE public.categories (
+    id bigint NOT NULL,
+    workspace_id integer NOT NULL,
+    name character varying(255) NOT NULL,
     created_at timestamp with time zone DEFAULT now() NOT NULL,
     color character varying(7),
     parent_id bigint,
@@ -18,11 +90,20 @@ CREATE SEQUENCE public.categories_id_seq
 ALTER SEQUENCE public.categories_id_seq OWNED BY public.categories.id;
 CREATE TABLE public.category_rules (
     id bigint NOT NULL,
-    user_id integer NOT NULL,
+    workspace_id integer NOT NULL,
     category_id bigint NOT NULL,
-    description_contains text NOT NULL,
+    description_contains text DEFAULT ''::text NOT NULL,
     created_at timestamp with time zone DEFAULT now() NOT NULL,
-    "position" integer NOT NULL
+    "position" integer NOT NULL,
+    description_regex text DEFAULT ''::text NOT NULL,
+    description_exact text DEFAULT ''::text NOT NULL,
+    description_prefix text DEFAULT ''::text NOT NULL,
+    amount_min bigint,
+    amount_max bigint,
+    entry_type character varying(16) DEFAULT ''::character varying NOT NULL,
+    currency character varying(3) DEFAULT ''::character varying NOT NULL,
+    account character varying(64) DEFAULT ''::character varying NOT NULL,
+    parser_name character varying(64) DEFAULT ''::character varying NOT NULL
 );
 CREATE SEQUENCE public.category_rules_id_seq
     START WITH 1
@@ -31,6 +112,31 @@ CREATE SEQUENCE public.category_rules_id_seq
     NO MAXVALUE
     CACHE 1;
 ALTER SEQUENCE public.category_rules_id_seq OWNED BY public.category_rules.id;
+CREATE TABLE public.csv_templates (
+    id bigint NOT NULL,
+    workspace_id integer NOT NULL,
+    name character varying(255) NOT NULL,
+    signature text NOT NULL,
+    delimiter character varying(1) DEFAULT ','::character varying NOT NULL,
+    date_column text NOT NULL,
+    date_format character varying(32) NOT NULL,
+    decimal_separator character varying(1) DEFAULT '.'::character varying NOT NULL,
+    description_columns text[] DEFAULT '{}'::text[] NOT NULL,
+    amount_column text DEFAULT ''::text NOT NULL,
+    debit_column text DEFAULT ''::text NOT NULL,
+    credit_column text DEFAULT ''::text NOT NULL,
+    currency_column text DEFAULT ''::text NOT NULL,
+    account_column text DEFAULT ''::text NOT NULL,
+    default_currency character varying(3) DEFAULT ''::character varying NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL
+);
+CREATE SEQUENCE public.csv_templates_id_seq
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+ALTER SEQUENCE public.csv_templates_id_seq OWNED BY public.csv_templates.id;
 CREATE TABLE public.exchange_rates (
     id bigint NOT NULL,
     rate_date date NOT NULL,
@@ -48,13 +154,17 @@ CREATE SEQUENCE public.exchange_rates_id_seq
 ALTER SEQUENCE public.exchange_rates_id_seq OWNED BY public.exchange_rates.id;
 CREATE TABLE public.financial_reports (
     id bigint NOT NULL,
-    user_id integer NOT NULL,
+    workspace_id integer NOT NULL,
     filename character varying(255) NOT NULL,
     content_type character varying(255),
     data bytea NOT NULL,
     uploaded_at timestamp with time zone DEFAULT now() NOT NULL,
     status character varying(32) DEFAULT 'pending'::character varying NOT NULL,
-    status_description text
+    status_description text,
+    claimed_at timestamp with time zone,
+    claimed_by character varying(255),
+    attempts integer DEFAULT 0 NOT NULL,
+    next_attempt_at timestamp with time zone
 );
 CREATE SEQUENCE public.financial_reports_id_seq
     START WITH 1
@@ -63,6 +173,85 @@ CREATE SEQUENCE public.financial_reports_id_seq
     NO MAXVALUE
     CACHE 1;
 ALTER SEQUENCE public.financial_reports_id_seq OWNED BY public.financial_reports.id;
+CREATE TABLE public.household_invitations (
+    id bigint NOT NULL,
+    household_id integer NOT NULL,
+    token_hash character varying(64) NOT NULL,
+    role character varying(16) NOT NULL,
+    created_by integer NOT NULL,
+    expires_at timestamp with time zone NOT NULL,
+    accepted_by integer,
+    accepted_at timestamp with time zone,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    CONSTRAINT household_invitations_role_check CHECK (((role)::text = ANY ((ARRAY['owner'::character varying, 'editor'::character varying, 'viewer'::character varying])::text[])))
+);
+CREATE SEQUENCE public.household_invitations_id_seq
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+ALTER SEQUENCE public.household_invitations_id_seq OWNED BY public.household_invitations.id;
+CREATE TABLE public.household_members (
+    household_id integer NOT NULL,
+    user_id integer NOT NULL,
+    role character varying(16) NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    CONSTRAINT household_members_role_check CHECK (((role)::text = ANY ((ARRAY['owner'::character varying, 'editor'::character varying, 'viewer'::character varying])::text[])))
+);
+CREATE TABLE public.ledger_account_mappings (
+    id bigint NOT NULL,
+    workspace_id integer NOT NULL,
+    kind character varying(16) NOT NULL,
+    source character varying(255) NOT NULL,
+    ledger_account character varying(255) NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    CONSTRAINT ledger_account_mappings_kind_check CHECK (((kind)::text = ANY ((ARRAY['account'::character varying, 'card'::character varying, 'category'::character varying])::text[])))
+);
+CREATE SEQUENCE public.ledger_account_mappings_id_seq
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+ALTER SEQUENCE public.ledger_account_mappings_id_seq OWNED BY public.ledger_account_mappings.id;
+CREATE TABLE public.recurring_series (
+    id bigint NOT NULL,
+    workspace_id integer NOT NULL,
+    merchant_key character varying(255) NOT NULL,
+    name character varying(255) NOT NULL,
+    currency character varying(3) NOT NULL,
+    cadence character varying(16) NOT NULL,
+    amount numeric(18,2) NOT NULL,
+    status character varying(16) DEFAULT 'detected'::character varying NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    CONSTRAINT recurring_series_cadence_check CHECK (((cadence)::text = ANY ((ARRAY['weekly'::character varying, 'monthly'::character varying, 'yearly'::character varying])::text[]))),
+    CONSTRAINT recurring_series_status_check CHECK (((status)::text = ANY ((ARRAY['detected'::character varying, 'confirmed'::character varying, 'ignored'::character varying])::text[])))
+);
+CREATE SEQUENCE public.recurring_series_id_seq
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+ALTER SEQUENCE public.recurring_series_id_seq OWNED BY public.recurring_series.id;
+CREATE TABLE public.report_diagnostics (
+    id bigint NOT NULL,
+    report_id bigint NOT NULL,
+    workspace_id integer NOT NULL,
+    row_number integer NOT NULL,
+    severity character varying(16) NOT NULL,
+    raw_record text DEFAULT ''::text NOT NULL,
+    reason text NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL
+);
+CREATE SEQUENCE public.report_diagnostics_id_seq
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+ALTER SEQUENCE public.report_diagnostics_id_seq OWNED BY public.report_diagnostics.id;
 CREATE TABLE public.sessions (
     id uuid DEFAULT public.uuid_generate_v4() NOT NULL,
     user_id integer,
@@ -81,9 +270,39 @@ CREATE SEQUENCE public.todo_id_seq
     NO MAXVALUE
     CACHE 1;
 ALTER SEQUENCE public.todo_id_seq OWNED BY public.todo.id;
+CREATE TABLE public.transaction_splits (
+    id bigint NOT NULL,
+    transaction_id bigint NOT NULL,
+    workspace_id integer NOT NULL,
+    category_id bigint,
+    amount numeric(18,2) NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL
+);
+CREATE SEQUENCE public.transaction_splits_id_seq
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+ALTER SEQUENCE public.transaction_splits_id_seq OWNED BY public.transaction_splits.id;
+CREATE TABLE public.transaction_transfers (
+    id bigint NOT NULL,
+    workspace_id integer NOT NULL,
+    debit_transaction_id bigint NOT NULL,
+    credit_transaction_id bigint NOT NULL,
+    status character varying(16) DEFAULT 'suggested'::character varying NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL
+);
+CREATE SEQUENCE public.transaction_transfers_id_seq
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+ALTER SEQUENCE public.transaction_transfers_id_seq OWNED BY public.transaction_transfers.id;
 CREATE TABLE public.transactions (
     id bigint NOT NULL,
-    user_id integer NOT NULL,
+    workspace_id integer NOT NULL,
     source_file_id bigint NOT NULL,
     source_file_row integer NOT NULL,
     parser_name character varying(64) NOT NULL,
@@ -99,6 +318,7 @@ CREATE TABLE public.transactions (
     created_at timestamp with time zone DEFAULT now() NOT NULL,
     category_id bigint,
     category_source text,
+    account_id bigint,
     CONSTRAINT transactions_category_source_check CHECK (((category_source = ANY (ARRAY['manual'::text, 'rule'::text])) OR (category_source IS NULL)))
 );
 CREATE SEQUENCE public.transactions_id_seq
@@ -122,38 +342,121 @@ ALTER TABLE public.users ALTER COLUMN id ADD GENERATED ALWAYS AS IDENTITY (
     NO MAXVALUE
     CACHE 1
 );
+CREATE TABLE public.workspaces (
+    id integer NOT NULL,
+    user_id integer,
+    name character varying(255) DEFAULT ''::character varying NOT NULL,
+    base_currency character varying(3) DEFAULT 'CHF'::character varying NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL
+);
+CREATE SEQUENCE public.workspaces_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+ALTER SEQUENCE public.workspaces_id_seq OWNED BY public.workspaces.id;
+ALTER TABLE ONLY public.account_balances ALTER COLUMN id SET DEFAULT nextval('public.account_balances_id_seq'::regclass);
+ALTER TABLE ONLY public.accounts ALTER COLUMN id SET DEFAULT nextval('public.accounts_id_seq'::regclass);
+ALTER TABLE ONLY public.api_tokens ALTER COLUMN id SET DEFAULT nextval('public.api_tokens_id_seq'::regclass);
+ALTER TABLE ONLY public.budgets ALTER COLUMN id SET DEFAULT nextval('public.budgets_id_seq'::regclass);
 ALTER TABLE ONLY public.categories ALTER COLUMN id SET DEFAULT nextval('public.categories_id_seq'::regclass);
 ALTER TABLE ONLY public.category_rules ALTER COLUMN id SET DEFAULT nextval('public.category_rules_id_seq'::regclass);
+ALTER TABLE ONLY public.csv_templates ALTER COLUMN id SET DEFAULT nextval('public.csv_templates_id_seq'::regclass);
 ALTER TABLE ONLY public.exchange_rates ALTER COLUMN id SET DEFAULT nextval('public.exchange_rates_id_seq'::regclass);
 ALTER TABLE ONLY public.financial_reports ALTER COLUMN id SET DEFAULT nextval('public.financial_reports_id_seq'::regclass);
+ALTER TABLE ONLY public.household_invitations ALTER COLUMN id SET DEFAULT nextval('public.household_invitations_id_seq'::regclass);
+ALTER TABLE ONLY public.ledger_account_mappings ALTER COLUMN id SET DEFAULT nextval('public.ledger_account_mappings_id_seq'::regclass);
+ALTER TABLE ONLY public.recurring_series ALTER COLUMN id SET DEFAULT nextval('public.recurring_series_id_seq'::regclass);
+ALTER TABLE ONLY public.report_diagnostics ALTER COLUMN id SET DEFAULT nextval('public.report_diagnostics_id_seq'::regclass);
 ALTER TABLE ONLY public.todo ALTER COLUMN id SET DEFAULT nextval('public.todo_id_seq'::regclass);
+ALTER TABLE ONLY public.transaction_splits ALTER COLUMN id SET DEFAULT nextval('public.transaction_splits_id_seq'::regclass);
+ALTER TABLE ONLY public.transaction_transfers ALTER COLUMN id SET DEFAULT nextval('public.transaction_transfers_id_seq'::regclass);
 ALTER TABLE ONLY public.transactions ALTER COLUMN id SET DEFAULT nextval('public.transactions_id_seq'::regclass);
+ALTER TABLE ONLY public.workspaces ALTER COLUMN id SET DEFAULT nextval('public.workspaces_id_seq'::regclass);
+ALTER TABLE ONLY public.account_balances
+    ADD CONSTRAINT account_balances_pkey PRIMARY KEY (id);
+ALTER TABLE ONLY public.accounts
+    ADD CONSTRAINT accounts_pkey PRIMARY KEY (id);
+ALTER TABLE ONLY public.accounts
+    ADD CONSTRAINT accounts_workspace_identifier_key UNIQUE (workspace_id, identifier);
+ALTER TABLE ONLY public.api_tokens
+    ADD CONSTRAINT api_tokens_pkey PRIMARY KEY (id);
+ALTER TABLE ONLY public.api_tokens
+    ADD CONSTRAINT api_tokens_token_hash_key UNIQUE (token_hash);
+ALTER TABLE ONLY public.budgets
+    ADD CONSTRAINT budgets_pkey PRIMARY KEY (id);
 ALTER TABLE ONLY public.categories
     ADD CONSTRAINT categories_pkey PRIMARY KEY (id);
 ALTER TABLE ONLY public.category_rules
     ADD CONSTRAINT category_rules_pkey PRIMARY KEY (id);
+ALTER TABLE ONLY public.csv_templates
+    ADD CONSTRAINT csv_templates_pkey PRIMARY KEY (id);
 ALTER TABLE ONLY public.exchange_rates
     ADD CONSTRAINT exchange_rates_pkey PRIMARY KEY (id);
 ALTER TABLE ONLY public.financial_reports
     ADD CONSTRAINT financial_reports_pkey PRIMARY KEY (id);
+ALTER TABLE ONLY public.household_invitations
+    ADD CONSTRAINT household_invitations_pkey PRIMARY KEY (id);
+ALTER TABLE ONLY public.household_invitations
+    ADD CONSTRAINT household_invitations_token_hash_key UNIQUE (token_hash);
+ALTER TABLE ONLY public.household_members
+    ADD CONSTRAINT household_members_pkey PRIMARY KEY (household_id, user_id);
+ALTER TABLE ONLY public.ledger_account_mappings
+    ADD CONSTRAINT ledger_account_mappings_pkey PRIMARY KEY (id);
+ALTER TABLE ONLY public.ledger_account_mappings
+    ADD CONSTRAINT ledger_account_mappings_workspace_id_kind_source_key UNIQUE (workspace_id, kind, source);
+ALTER TABLE ONLY public.recurring_series
+    ADD CONSTRAINT recurring_series_pkey PRIMARY KEY (id);
+ALTER TABLE ONLY public.report_diagnostics
+    ADD CONSTRAINT report_diagnostics_pkey PRIMARY KEY (id);
 ALTER TABLE ONLY public.sessions
     ADD CONSTRAINT sessions_pkey PRIMARY KEY (id);
 ALTER TABLE ONLY public.todo
     ADD CONSTRAINT todo_pkey PRIMARY KEY (id);
+ALTER TABLE ONLY public.transaction_splits
+    ADD CONSTRAINT transaction_splits_pkey PRIMARY KEY (id);
+ALTER TABLE ONLY public.transaction_transfers
+    ADD CONSTRAINT transaction_transfers_pkey PRIMARY KEY (id);
+ALTER TABLE ONLY public.transaction_transfers
+    ADD CONSTRAINT transaction_transfers_debit_transaction_id_credit_transaction_id_key UNIQUE (debit_transaction_id, credit_transaction_id);
 ALTER TABLE ONLY public.transactions
     ADD CONSTRAINT transactions_pkey PRIMARY KEY (id);
 ALTER TABLE ONLY public.users
     ADD CONSTRAINT users_pkey PRIMARY KEY (id);
 ALTER TABLE ONLY public.users
     ADD CONSTRAINT users_username_key UNIQUE (username);
-CREATE INDEX categories_user_id_idx ON public.categories USING btree (user_id);
+ALTER TABLE ONLY public.workspaces
+    ADD CONSTRAINT workspaces_pkey PRIMARY KEY (id);
+ALTER TABLE ONLY public.workspaces
+    ADD CONSTRAINT workspaces_user_id_key UNIQUE (user_id);
+CREATE INDEX account_balances_account_id_idx ON public.account_balances USING btree (account_id, balance_date);
+CREATE INDEX account_balances_source_file_id_idx ON public.account_balances USING btree (source_file_id);
+CREATE INDEX accounts_workspace_id_idx ON public.accounts USING btree (workspace_id);
+CREATE INDEX api_tokens_user_id_idx ON public.api_tokens USING btree (user_id);
+CREATE UNIQUE INDEX budgets_workspace_category_period_idx ON public.budgets USING btree (workspace_id, category_id, period);
+CREATE INDEX budgets_workspace_id_idx ON public.budgets USING btree (workspace_id);
+CREATE INDEX categories_workspace_id_idx ON public.categories USING btree (workspace_id);
 CREATE INDEX categories_parent_id_idx ON public.categories USING btree (parent_id);
 CREATE INDEX category_rules_category_id_idx ON public.category_rules USING btree (category_id);
-CREATE INDEX category_rules_user_id_idx ON public.category_rules USING btree (user_id);
-CREATE INDEX category_rules_user_position_idx ON public.category_rules USING btree (user_id, "position");
+CREATE INDEX category_rules_workspace_id_idx ON public.category_rules USING btree (workspace_id);
+CREATE INDEX category_rules_workspace_position_idx ON public.category_rules USING btree (workspace_id, "position");
+CREATE INDEX csv_templates_workspace_id_idx ON public.csv_templates USING btree (workspace_id);
 CREATE UNIQUE INDEX exchange_rates_unique_idx ON public.exchange_rates USING btree (rate_date, base_currency, target_currency);
-CREATE INDEX financial_reports_user_id_idx ON public.financial_reports USING btree (user_id);
+CREATE INDEX financial_reports_status_idx ON public.financial_reports USING btree (status, uploaded_at);
+CREATE INDEX financial_reports_workspace_id_idx ON public.financial_reports USING btree (workspace_id);
+CREATE INDEX household_invitations_household_id_idx ON public.household_invitations USING btree (household_id);
+CREATE INDEX household_members_user_id_idx ON public.household_members USING btree (user_id);
+CREATE INDEX recurring_series_workspace_merchant_idx ON public.recurring_series USING btree (workspace_id, merchant_key, currency);
+CREATE INDEX report_diagnostics_report_id_idx ON public.report_diagnostics USING btree (report_id);
 CREATE INDEX todo_user_id_idx ON public.todo USING btree (user_id);
+CREATE INDEX transaction_splits_category_id_idx ON public.transaction_splits USING btree (category_id);
+CREATE INDEX transaction_splits_transaction_id_idx ON public.transaction_splits USING btree (transaction_id);
+CREATE UNIQUE INDEX transaction_transfers_credit_active_idx ON public.transaction_transfers USING btree (credit_transaction_id) WHERE ((status)::text <> 'rejected'::text);
+CREATE UNIQUE INDEX transaction_transfers_debit_active_idx ON public.transaction_transfers USING btree (debit_transaction_id) WHERE ((status)::text <> 'rejected'::text);
+CREATE INDEX transaction_transfers_workspace_id_idx ON public.transaction_transfers USING btree (workspace_id);
+CREATE INDEX transactions_account_id_idx ON public.transactions USING btree (account_id);
 CREATE INDEX transactions_category_id_idx ON public.transactions USING btree (category_id);
 CREATE INDEX transactions_description_tsv_idx ON public.transactions USING gin (to_tsvector('simple'::regconfig, description));
 CREATE INDEX transactions_entry_type_idx ON public.transactions USING btree (entry_type);
@@ -161,25 +464,77 @@ CREATE INDEX transactions_posted_date_idx ON public.transactions USING btree (po
 CREATE INDEX transactions_source_account_number_idx ON public.transactions USING btree (source_account_number);
 CREATE INDEX transactions_source_card_number_idx ON public.transactions USING btree (source_card_number);
 CREATE INDEX transactions_source_file_id_idx ON public.transactions USING btree (source_file_id);
-CREATE INDEX transactions_user_id_idx ON public.transactions USING btree (user_id);
+CREATE INDEX transactions_workspace_id_idx ON public.transactions USING btree (workspace_id);
 CREATE UNIQUE INDEX users_username_idx ON public.users USING btree (username);
+ALTER TABLE ONLY public.account_balances
+    ADD CONSTRAINT account_balances_account_id_fkey FOREIGN KEY (account_id) REFERENCES public.accounts(id) ON DELETE CASCADE;
+ALTER TABLE ONLY public.account_balances
+    ADD CONSTRAINT account_balances_source_file_id_fkey FOREIGN KEY (source_file_id) REFERENCES public.financial_reports(id) ON DELETE CASCADE;
+ALTER TABLE ONLY public.account_balances
+    ADD CONSTRAINT account_balances_workspace_id_fkey FOREIGN KEY (workspace_id) REFERENCES public.workspaces(id) ON DELETE CASCADE;
+ALTER TABLE ONLY public.accounts
+    ADD CONSTRAINT accounts_merged_into_id_fkey FOREIGN KEY (merged_into_id) REFERENCES public.accounts(id) ON DELETE CASCADE;
+ALTER TABLE ONLY public.accounts
+    ADD CONSTRAINT accounts_workspace_id_fkey FOREIGN KEY (workspace_id) REFERENCES public.workspaces(id) ON DELETE CASCADE;
+ALTER TABLE ONLY public.api_tokens
+    ADD CONSTRAINT api_tokens_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
+ALTER TABLE ONLY public.budgets
+    ADD CONSTRAINT budgets_category_id_fkey FOREIGN KEY (category_id) REFERENCES public.categories(id) ON DELETE CASCADE;
+ALTER TABLE ONLY public.budgets
+    ADD CONSTRAINT budgets_workspace_id_fkey FOREIGN KEY (workspace_id) REFERENCES public.workspaces(id) ON DELETE CASCADE;
 ALTER TABLE ONLY public.categories
-    ADD CONSTRAINT categories_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
+    ADD CONSTRAINT categories_workspace_id_fkey FOREIGN KEY (workspace_id) REFERENCES public.workspaces(id) ON DELETE CASCADE;
 ALTER TABLE ONLY public.categories
     ADD CONSTRAINT categories_parent_id_fkey FOREIGN KEY (parent_id) REFERENCES public.categories(id) ON DELETE SET NULL;
 ALTER TABLE ONLY public.category_rules
     ADD CONSTRAINT category_rules_category_id_fkey FOREIGN KEY (category_id) REFERENCES public.categories(id) ON DELETE CASCADE;
 ALTER TABLE ONLY public.category_rules
-    ADD CONSTRAINT category_rules_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
+    ADD CONSTRAINT category_rules_workspace_id_fkey FOREIGN KEY (workspace_id) REFERENCES public.workspaces(id) ON DELETE CASCADE;
+ALTER TABLE ONLY public.csv_templates
+    ADD CONSTRAINT csv_templates_workspace_id_fkey FOREIGN KEY (workspace_id) REFERENCES public.workspaces(id) ON DELETE CASCADE;
 ALTER TABLE ONLY public.financial_reports
-    ADD CONSTRAINT financial_reports_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
+    ADD CONSTRAINT financial_reports_workspace_id_fkey FOREIGN KEY (workspace_id) REFERENCES public.workspaces(id) ON DELETE CASCADE;
+ALTER TABLE ONLY public.household_invitations
+    ADD CONSTRAINT household_invitations_accepted_by_fkey FOREIGN KEY (accepted_by) REFERENCES public.users(id) ON DELETE SET NULL;
+ALTER TABLE ONLY public.household_invitations
+    ADD CONSTRAINT household_invitations_created_by_fkey FOREIGN KEY (created_by) REFERENCES public.users(id) ON DELETE CASCADE;
+ALTER TABLE ONLY public.household_invitations
+    ADD CONSTRAINT household_invitations_household_id_fkey FOREIGN KEY (household_id) REFERENCES public.workspaces(id) ON DELETE CASCADE;
+ALTER TABLE ONLY public.household_members
+    ADD CONSTRAINT household_members_household_id_fkey FOREIGN KEY (household_id) REFERENCES public.workspaces(id) ON DELETE CASCADE;
+ALTER TABLE ONLY public.household_members
+    ADD CONSTRAINT household_members_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
+ALTER TABLE ONLY public.ledger_account_mappings
+    ADD CONSTRAINT ledger_account_mappings_workspace_id_fkey FOREIGN KEY (workspace_id) REFERENCES public.workspaces(id) ON DELETE CASCADE;
+ALTER TABLE ONLY public.recurring_series
+    ADD CONSTRAINT recurring_series_workspace_id_fkey FOREIGN KEY (workspace_id) REFERENCES public.workspaces(id) ON DELETE CASCADE;
+ALTER TABLE ONLY public.report_diagnostics
+    ADD CONSTRAINT report_diagnostics_report_id_fkey FOREIGN KEY (report_id) REFERENCES public.financial_reports(id) ON DELETE CASCADE;
+ALTER TABLE ONLY public.report_diagnostics
+    ADD CONSTRAINT report_diagnostics_workspace_id_fkey FOREIGN KEY (workspace_id) REFERENCES public.workspaces(id) ON DELETE CASCADE;
 ALTER TABLE ONLY public.sessions
     ADD CONSTRAINT sessions_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
 ALTER TABLE ONLY public.todo
     ADD CONSTRAINT todo_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
+ALTER TABLE ONLY public.transaction_splits
+    ADD CONSTRAINT transaction_splits_category_id_fkey FOREIGN KEY (category_id) REFERENCES public.categories(id) ON DELETE SET NULL;
+ALTER TABLE ONLY public.transaction_splits
+    ADD CONSTRAINT transaction_splits_transaction_id_fkey FOREIGN KEY (transaction_id) REFERENCES public.transactions(id) ON DELETE CASCADE;
+ALTER TABLE ONLY public.transaction_splits
+    ADD CONSTRAINT transaction_splits_workspace_id_fkey FOREIGN KEY (workspace_id) REFERENCES public.workspaces(id) ON DELETE CASCADE;
+ALTER TABLE ONLY public.transaction_transfers
+    ADD CONSTRAINT transaction_transfers_credit_transaction_id_fkey FOREIGN KEY (credit_transaction_id) REFERENCES public.transactions(id) ON DELETE CASCADE;
+ALTER TABLE ONLY public.transaction_transfers
+    ADD CONSTRAINT transaction_transfers_debit_transaction_id_fkey FOREIGN KEY (debit_transaction_id) REFERENCES public.transactions(id) ON DELETE CASCADE;
+ALTER TABLE ONLY public.transaction_transfers
+    ADD CONSTRAINT transaction_transfers_workspace_id_fkey FOREIGN KEY (workspace_id) REFERENCES public.workspaces(id) ON DELETE CASCADE;
+ALTER TABLE ONLY public.transactions
+    ADD CONSTRAINT transactions_account_id_fkey FOREIGN KEY (account_id) REFERENCES public.accounts(id) ON DELETE SET NULL;
 ALTER TABLE ONLY public.transactions
     ADD CONSTRAINT transactions_category_id_fkey FOREIGN KEY (category_id) REFERENCES public.categories(id) ON DELETE SET NULL;
 ALTER TABLE ONLY public.transactions
     ADD CONSTRAINT transactions_source_file_id_fkey FOREIGN KEY (source_file_id) REFERENCES public.financial_reports(id) ON DELETE CASCADE;
 ALTER TABLE ONLY public.transactions
-    ADD CONSTRAINT transactions_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
+    ADD CONSTRAINT transactions_workspace_id_fkey FOREIGN KEY (workspace_id) REFERENCES public.workspaces(id) ON DELETE CASCADE;
+ALTER TABLE ONLY public.workspaces
+    ADD CONSTRAINT workspaces_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
@@ -1,8 +1,13 @@
 import {createClient} from "@connectrpc/connect";
 import {createConnectTransport} from "@connectrpc/connect-web";
+import {AccountService} from "$lib/gen/api/v1/accounts_pb";
 import {AuthService} from "$lib/gen/api/v1/auth_pb";
+import {BudgetService} from "$lib/gen/api/v1/budgets_pb";
+import {CsvTemplateService} from "$lib/gen/api/v1/csv_templates_pb";
 import {CategoryService} from "$lib/gen/api/v1/categories_pb";
 import {GreetService} from "$lib/gen/api/v1/greet_pb";
+import {HouseholdService} from "$lib/gen/api/v1/households_pb";
+import {RecurringService} from "$lib/gen/api/v1/recurring_pb";
 import {ReportService} from "$lib/gen/api/v1/reports_pb";
 import {TodoService} from "$lib/gen/api/v1/todo_pb";
 import {TransactionService} from "$lib/gen/api/v1/transactions_pb";
@@ -10,14 +15,35 @@ import {getApiBaseUrl} from "$lib/url";
 
 const API_BASE_URL = getApiBaseUrl();
 
+let householdId: number | undefined;
+
+// selectHousehold makes the clients below work on a household's data instead of the user's own.
+// Pass undefined to switch back.
+export function selectHousehold(id: number | undefined) {
+    householdId = id;
+}
+
 const transport = createConnectTransport({
     baseUrl: API_BASE_URL,
     fetch: (input, init) => fetch(input, {...init, credentials: "include"}),
+    interceptors: [
+        (next) => (req) => {
+            if (householdId !== undefined) {
+                req.header.set("X-Household-Id", String(householdId));
+            }
+            return next(req);
+        },
+    ],
 });
 
 export const Greet = createClient(GreetService, transport);
 export const Todo = createClient(TodoService, transport);
 export const Auth = createClient(AuthService, transport);
+export const Accounts = createClient(AccountService, transport);
+export const Budgets = createClient(BudgetService, transport);
+export const Households = createClient(HouseholdService, transport);
+export const CsvTemplates = createClient(CsvTemplateService, transport);
 export const Categories = createClient(CategoryService, transport);
+export const Recurring = createClient(RecurringService, transport);
 export const Reports = createClient(ReportService, transport);
 export const Transactions = createClient(TransactionService, transport);
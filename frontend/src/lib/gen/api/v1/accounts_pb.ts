@@ -0,0 +1,380 @@
+// @generated by protoc-gen-es v2.10.1 with parameter "target=ts"
+// @generated from file api/v1/accounts.proto (package api.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file api/v1/accounts.proto.
+ */
+export const file_api_v1_accounts: GenFile = /*@__PURE__*/
+  fileDesc("ChVhcGkvdjEvYWNjb3VudHMucHJvdG8SBmFwaS52MSKLAQoHQWNjb3VudBIKCgJpZBgBIAEoBRIMCgRuYW1lGAIgASgJEgwKBHR5cGUYAyABKAkSEgoKaWRlbnRpZmllchgEIAEoCRIMCgRpYmFuGAUgASgJEhAKCGN1cnJlbmN5GAYgASgJEhAKCGFyY2hpdmVkGAcgASgIEhIKCmNyZWF0ZWRfYXQYCCABKAkiSAoTQWNjb3VudEJhbGFuY2VQb2ludBIMCgRkYXRlGAEgASgJEg8KB2JhbGFuY2UYAiABKAMSEgoKY2hlY2twb2ludBgDIAEoCCIvChNMaXN0QWNjb3VudHNSZXF1ZXN0EhgKEGluY2x1ZGVfYXJjaGl2ZWQYASABKAgiOQoUTGlzdEFjY291bnRzUmVzcG9uc2USIQoIYWNjb3VudHMYASADKAsyDy5hcGkudjEuQWNjb3VudCJEChRDcmVhdGVBY2NvdW50UmVxdWVzdBIMCgRuYW1lGAEgASgJEgwKBHR5cGUYAiABKAkSEAoIY3VycmVuY3kYAyABKAkiOQoVQ3JlYXRlQWNjb3VudFJlc3BvbnNlEiAKB2FjY291bnQYASABKAsyDy5hcGkudjEuQWNjb3VudCIwChRSZW5hbWVBY2NvdW50UmVxdWVzdBIKCgJpZBgBIAEoBRIMCgRuYW1lGAIgASgJIhcKFVJlbmFtZUFjY291bnRSZXNwb25zZSI1ChVBcmNoaXZlQWNjb3VudFJlcXVlc3QSCgoCaWQYASABKAUSEAoIYXJjaGl2ZWQYAiABKAgiGAoWQXJjaGl2ZUFjY291bnRSZXNwb25zZSI8ChRNZXJnZUFjY291bnRzUmVxdWVzdBIRCglzb3VyY2VfaWQYASABKAUSEQoJdGFyZ2V0X2lkGAIgASgFIhcKFU1lcmdlQWNjb3VudHNSZXNwb25zZSJTChlHZXRBY2NvdW50QmFsYW5jZXNSZXF1ZXN0EhIKCmFjY291bnRfaWQYASABKAUSEQoJZnJvbV9kYXRlGAIgASgJEg8KB3RvX2RhdGUYAyABKAkiWwoaR2V0QWNjb3VudEJhbGFuY2VzUmVzcG9uc2USKwoGcG9pbnRzGAEgAygLMhsuYXBpLnYxLkFjY291bnRCYWxhbmNlUG9pbnQSEAoIY3VycmVuY3kYAiABKAkyhQQKDkFjY291bnRTZXJ2aWNlEk4KDExpc3RBY2NvdW50cxIbLmFwaS52MS5MaXN0QWNjb3VudHNSZXF1ZXN0GhwuYXBpLnYxLkxpc3RBY2NvdW50c1Jlc3BvbnNlIgOQAgESTgoNQ3JlYXRlQWNjb3VudBIcLmFwaS52MS5DcmVhdGVBY2NvdW50UmVxdWVzdBodLmFwaS52MS5DcmVhdGVBY2NvdW50UmVzcG9uc2UiABJOCg1SZW5hbWVBY2NvdW50EhwuYXBpLnYxLlJlbmFtZUFjY291bnRSZXF1ZXN0Gh0uYXBpLnYxLlJlbmFtZUFjY291bnRSZXNwb25zZSIAElEKDkFyY2hpdmVBY2NvdW50Eh0uYXBpLnYxLkFyY2hpdmVBY2NvdW50UmVxdWVzdBoeLmFwaS52MS5BcmNoaXZlQWNjb3VudFJlc3BvbnNlIgASTgoNTWVyZ2VBY2NvdW50cxIcLmFwaS52MS5NZXJnZUFjY291bnRzUmVxdWVzdBodLmFwaS52MS5NZXJnZUFjY291bnRzUmVzcG9uc2UiABJgChJHZXRBY2NvdW50QmFsYW5jZXMSIS5hcGkudjEuR2V0QWNjb3VudEJhbGFuY2VzUmVxdWVzdBoiLmFwaS52MS5HZXRBY2NvdW50QmFsYW5jZXNSZXNwb25zZSIDkAIBQngKCmNvbS5hcGkudjFCDUFjY291bnRzUHJvdG9QAVoiY2FzaHRyYWNrL2JhY2tlbmQvZ2VuL2FwaS92MTthcGl2MaICA0FYWKoCBkFwaS5WMcoCBkFwaVxWMeICEkFwaVxWMVxHUEJNZXRhZGF0YeoCB0FwaTo6VjFiBnByb3RvMw");
+
+/**
+ * @generated from message api.v1.Account
+ */
+export type Account = Message<"api.v1.Account"> & {
+  /**
+   * @generated from field: int32 id = 1;
+   */
+  id: number;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string type = 3;
+   */
+  type: string;
+
+  /**
+   * @generated from field: string identifier = 4;
+   */
+  identifier: string;
+
+  /**
+   * @generated from field: string iban = 5;
+   */
+  iban: string;
+
+  /**
+   * @generated from field: string currency = 6;
+   */
+  currency: string;
+
+  /**
+   * @generated from field: bool archived = 7;
+   */
+  archived: boolean;
+
+  /**
+   * @generated from field: string created_at = 8;
+   */
+  createdAt: string;
+};
+
+/**
+ * Describes the message api.v1.Account.
+ * Use `create(AccountSchema)` to create a new message.
+ */
+export const AccountSchema: GenMessage<Account> = /*@__PURE__*/
+  messageDesc(file_api_v1_accounts, 0);
+
+/**
+ * @generated from message api.v1.AccountBalancePoint
+ */
+export type AccountBalancePoint = Message<"api.v1.AccountBalancePoint"> & {
+  /**
+   * @generated from field: string date = 1;
+   */
+  date: string;
+
+  /**
+   * @generated from field: int64 balance = 2;
+   */
+  balance: bigint;
+
+  /**
+   * @generated from field: bool checkpoint = 3;
+   */
+  checkpoint: boolean;
+};
+
+/**
+ * Describes the message api.v1.AccountBalancePoint.
+ * Use `create(AccountBalancePointSchema)` to create a new message.
+ */
+export const AccountBalancePointSchema: GenMessage<AccountBalancePoint> = /*@__PURE__*/
+  messageDesc(file_api_v1_accounts, 1);
+
+/**
+ * @generated from message api.v1.ListAccountsRequest
+ */
+export type ListAccountsRequest = Message<"api.v1.ListAccountsRequest"> & {
+  /**
+   * @generated from field: bool include_archived = 1;
+   */
+  includeArchived: boolean;
+};
+
+/**
+ * Describes the message api.v1.ListAccountsRequest.
+ * Use `create(ListAccountsRequestSchema)` to create a new message.
+ */
+export const ListAccountsRequestSchema: GenMessage<ListAccountsRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_accounts, 2);
+
+/**
+ * @generated from message api.v1.ListAccountsResponse
+ */
+export type ListAccountsResponse = Message<"api.v1.ListAccountsResponse"> & {
+  /**
+   * @generated from field: repeated api.v1.Account accounts = 1;
+   */
+  accounts: Account[];
+};
+
+/**
+ * Describes the message api.v1.ListAccountsResponse.
+ * Use `create(ListAccountsResponseSchema)` to create a new message.
+ */
+export const ListAccountsResponseSchema: GenMessage<ListAccountsResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_accounts, 3);
+
+/**
+ * @generated from message api.v1.CreateAccountRequest
+ */
+export type CreateAccountRequest = Message<"api.v1.CreateAccountRequest"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string type = 2;
+   */
+  type: string;
+
+  /**
+   * @generated from field: string currency = 3;
+   */
+  currency: string;
+};
+
+/**
+ * Describes the message api.v1.CreateAccountRequest.
+ * Use `create(CreateAccountRequestSchema)` to create a new message.
+ */
+export const CreateAccountRequestSchema: GenMessage<CreateAccountRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_accounts, 4);
+
+/**
+ * @generated from message api.v1.CreateAccountResponse
+ */
+export type CreateAccountResponse = Message<"api.v1.CreateAccountResponse"> & {
+  /**
+   * @generated from field: api.v1.Account account = 1;
+   */
+  account?: Account;
+};
+
+/**
+ * Describes the message api.v1.CreateAccountResponse.
+ * Use `create(CreateAccountResponseSchema)` to create a new message.
+ */
+export const CreateAccountResponseSchema: GenMessage<CreateAccountResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_accounts, 5);
+
+/**
+ * @generated from message api.v1.RenameAccountRequest
+ */
+export type RenameAccountRequest = Message<"api.v1.RenameAccountRequest"> & {
+  /**
+   * @generated from field: int32 id = 1;
+   */
+  id: number;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message api.v1.RenameAccountRequest.
+ * Use `create(RenameAccountRequestSchema)` to create a new message.
+ */
+export const RenameAccountRequestSchema: GenMessage<RenameAccountRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_accounts, 6);
+
+/**
+ * @generated from message api.v1.RenameAccountResponse
+ */
+export type RenameAccountResponse = Message<"api.v1.RenameAccountResponse"> & {
+};
+
+/**
+ * Describes the message api.v1.RenameAccountResponse.
+ * Use `create(RenameAccountResponseSchema)` to create a new message.
+ */
+export const RenameAccountResponseSchema: GenMessage<RenameAccountResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_accounts, 7);
+
+/**
+ * @generated from message api.v1.ArchiveAccountRequest
+ */
+export type ArchiveAccountRequest = Message<"api.v1.ArchiveAccountRequest"> & {
+  /**
+   * @generated from field: int32 id = 1;
+   */
+  id: number;
+
+  /**
+   * @generated from field: bool archived = 2;
+   */
+  archived: boolean;
+};
+
+/**
+ * Describes the message api.v1.ArchiveAccountRequest.
+ * Use `create(ArchiveAccountRequestSchema)` to create a new message.
+ */
+export const ArchiveAccountRequestSchema: GenMessage<ArchiveAccountRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_accounts, 8);
+
+/**
+ * @generated from message api.v1.ArchiveAccountResponse
+ */
+export type ArchiveAccountResponse = Message<"api.v1.ArchiveAccountResponse"> & {
+};
+
+/**
+ * Describes the message api.v1.ArchiveAccountResponse.
+ * Use `create(ArchiveAccountResponseSchema)` to create a new message.
+ */
+export const ArchiveAccountResponseSchema: GenMessage<ArchiveAccountResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_accounts, 9);
+
+/**
+ * @generated from message api.v1.MergeAccountsRequest
+ */
+export type MergeAccountsRequest = Message<"api.v1.MergeAccountsRequest"> & {
+  /**
+   * @generated from field: int32 source_id = 1;
+   */
+  sourceId: number;
+
+  /**
+   * @generated from field: int32 target_id = 2;
+   */
+  targetId: number;
+};
+
+/**
+ * Describes the message api.v1.MergeAccountsRequest.
+ * Use `create(MergeAccountsRequestSchema)` to create a new message.
+ */
+export const MergeAccountsRequestSchema: GenMessage<MergeAccountsRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_accounts, 10);
+
+/**
+ * @generated from message api.v1.MergeAccountsResponse
+ */
+export type MergeAccountsResponse = Message<"api.v1.MergeAccountsResponse"> & {
+};
+
+/**
+ * Describes the message api.v1.MergeAccountsResponse.
+ * Use `create(MergeAccountsResponseSchema)` to create a new message.
+ */
+export const MergeAccountsResponseSchema: GenMessage<MergeAccountsResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_accounts, 11);
+
+/**
+ * @generated from message api.v1.GetAccountBalancesRequest
+ */
+export type GetAccountBalancesRequest = Message<"api.v1.GetAccountBalancesRequest"> & {
+  /**
+   * @generated from field: int32 account_id = 1;
+   */
+  accountId: number;
+
+  /**
+   * @generated from field: string from_date = 2;
+   */
+  fromDate: string;
+
+  /**
+   * @generated from field: string to_date = 3;
+   */
+  toDate: string;
+};
+
+/**
+ * Describes the message api.v1.GetAccountBalancesRequest.
+ * Use `create(GetAccountBalancesRequestSchema)` to create a new message.
+ */
+export const GetAccountBalancesRequestSchema: GenMessage<GetAccountBalancesRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_accounts, 12);
+
+/**
+ * @generated from message api.v1.GetAccountBalancesResponse
+ */
+export type GetAccountBalancesResponse = Message<"api.v1.GetAccountBalancesResponse"> & {
+  /**
+   * @generated from field: repeated api.v1.AccountBalancePoint points = 1;
+   */
+  points: AccountBalancePoint[];
+
+  /**
+   * @generated from field: string currency = 2;
+   */
+  currency: string;
+};
+
+/**
+ * Describes the message api.v1.GetAccountBalancesResponse.
+ * Use `create(GetAccountBalancesResponseSchema)` to create a new message.
+ */
+export const GetAccountBalancesResponseSchema: GenMessage<GetAccountBalancesResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_accounts, 13);
+
+/**
+ * @generated from service api.v1.AccountService
+ */
+export const AccountService: GenService<{
+  /**
+   * @generated from rpc api.v1.AccountService.ListAccounts
+   */
+  listAccounts: {
+    methodKind: "unary";
+    input: typeof ListAccountsRequestSchema;
+    output: typeof ListAccountsResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.AccountService.CreateAccount
+   */
+  createAccount: {
+    methodKind: "unary";
+    input: typeof CreateAccountRequestSchema;
+    output: typeof CreateAccountResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.AccountService.RenameAccount
+   */
+  renameAccount: {
+    methodKind: "unary";
+    input: typeof RenameAccountRequestSchema;
+    output: typeof RenameAccountResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.AccountService.ArchiveAccount
+   */
+  archiveAccount: {
+    methodKind: "unary";
+    input: typeof ArchiveAccountRequestSchema;
+    output: typeof ArchiveAccountResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.AccountService.MergeAccounts
+   */
+  mergeAccounts: {
+    methodKind: "unary";
+    input: typeof MergeAccountsRequestSchema;
+    output: typeof MergeAccountsResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.AccountService.GetAccountBalances
+   */
+  getAccountBalances: {
+    methodKind: "unary";
+    input: typeof GetAccountBalancesRequestSchema;
+    output: typeof GetAccountBalancesResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_api_v1_accounts, 0);
+
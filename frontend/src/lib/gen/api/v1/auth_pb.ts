@@ -10,7 +10,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file api/v1/auth.proto.
  */
 export const file_api_v1_auth: GenFile = /*@__PURE__*/
-  fileDesc("ChFhcGkvdjEvYXV0aC5wcm90bxIGYXBpLnYxIjYKBFVzZXISCgoCaWQYASABKAUSEAoIdXNlcm5hbWUYAiABKAkSEAoIbGFuZ3VhZ2UYAyABKAkiDwoNQXV0aE1lUmVxdWVzdCIsCg5BdXRoTWVSZXNwb25zZRIaCgR1c2VyGAEgASgLMgwuYXBpLnYxLlVzZXIiEwoRQXV0aExvZ291dFJlcXVlc3QiFAoSQXV0aExvZ291dFJlc3BvbnNlIikKFVVwZGF0ZUxhbmd1YWdlUmVxdWVzdBIQCghsYW5ndWFnZRgBIAEoCSI0ChZVcGRhdGVMYW5ndWFnZVJlc3BvbnNlEhoKBHVzZXIYASABKAsyDC5hcGkudjEuVXNlcjLaAQoLQXV0aFNlcnZpY2USNQoCTWUSFS5hcGkudjEuQXV0aE1lUmVxdWVzdBoWLmFwaS52MS5BdXRoTWVSZXNwb25zZSIAEkEKBkxvZ291dBIZLmFwaS52MS5BdXRoTG9nb3V0UmVxdWVzdBoaLmFwaS52MS5BdXRoTG9nb3V0UmVzcG9uc2UiABJRCg5VcGRhdGVMYW5ndWFnZRIdLmFwaS52MS5VcGRhdGVMYW5ndWFnZVJlcXVlc3QaHi5hcGkudjEuVXBkYXRlTGFuZ3VhZ2VSZXNwb25zZSIAQnQKCmNvbS5hcGkudjFCCUF1dGhQcm90b1ABWiJjYXNodHJhY2svYmFja2VuZC9nZW4vYXBpL3YxO2FwaXYxogIDQVhYqgIGQXBpLlYxygIGQXBpXFYx4gISQXBpXFYxXEdQQk1ldGFkYXRh6gIHQXBpOjpWMWIGcHJvdG8z");
+  fileDesc("ChFhcGkvdjEvYXV0aC5wcm90bxIGYXBpLnYxIk0KBFVzZXISCgoCaWQYASABKAUSEAoIdXNlcm5hbWUYAiABKAkSEAoIbGFuZ3VhZ2UYAyABKAkSFQoNYmFzZV9jdXJyZW5jeRgEIAEoCSIPCg1BdXRoTWVSZXF1ZXN0IiwKDkF1dGhNZVJlc3BvbnNlEhoKBHVzZXIYASABKAsyDC5hcGkudjEuVXNlciITChFBdXRoTG9nb3V0UmVxdWVzdCIUChJBdXRoTG9nb3V0UmVzcG9uc2UiKQoVVXBkYXRlTGFuZ3VhZ2VSZXF1ZXN0EhAKCGxhbmd1YWdlGAEgASgJIjQKFlVwZGF0ZUxhbmd1YWdlUmVzcG9uc2USGgoEdXNlchgBIAEoCzIMLmFwaS52MS5Vc2VyIjIKGVVwZGF0ZUJhc2VDdXJyZW5jeVJlcXVlc3QSFQoNYmFzZV9jdXJyZW5jeRgBIAEoCSI4ChpVcGRhdGVCYXNlQ3VycmVuY3lSZXNwb25zZRIaCgR1c2VyGAEgASgLMgwuYXBpLnYxLlVzZXIibgoIQXBpVG9rZW4SCgoCaWQYASABKAUSDAoEbmFtZRgCIAEoCRIOCgZwcmVmaXgYAyABKAkSDgoGc2NvcGVzGAQgAygJEhIKCmNyZWF0ZWRfYXQYBSABKAkSFAoMbGFzdF91c2VkX2F0GAYgASgJIjUKFUNyZWF0ZUFwaVRva2VuUmVxdWVzdBIMCgRuYW1lGAEgASgJEg4KBnNjb3BlcxgCIAMoCSJMChZDcmVhdGVBcGlUb2tlblJlc3BvbnNlEiMKCWFwaV90b2tlbhgBIAEoCzIQLmFwaS52MS5BcGlUb2tlbhINCgV0b2tlbhgCIAEoCSIWChRMaXN0QXBpVG9rZW5zUmVxdWVzdCI9ChVMaXN0QXBpVG9rZW5zUmVzcG9uc2USJAoKYXBpX3Rva2VucxgBIAMoCzIQLmFwaS52MS5BcGlUb2tlbiIjChVSZXZva2VBcGlUb2tlblJlcXVlc3QSCgoCaWQYASABKAUiGAoWUmV2b2tlQXBpVG9rZW5SZXNwb25zZTKyBAoLQXV0aFNlcnZpY2USNQoCTWUSFS5hcGkudjEuQXV0aE1lUmVxdWVzdBoWLmFwaS52MS5BdXRoTWVSZXNwb25zZSIAEkEKBkxvZ291dBIZLmFwaS52MS5BdXRoTG9nb3V0UmVxdWVzdBoaLmFwaS52MS5BdXRoTG9nb3V0UmVzcG9uc2UiABJRCg5VcGRhdGVMYW5ndWFnZRIdLmFwaS52MS5VcGRhdGVMYW5ndWFnZVJlcXVlc3QaHi5hcGkudjEuVXBkYXRlTGFuZ3VhZ2VSZXNwb25zZSIAEl0KElVwZGF0ZUJhc2VDdXJyZW5jeRIhLmFwaS52MS5VcGRhdGVCYXNlQ3VycmVuY3lSZXF1ZXN0GiIuYXBpLnYxLlVwZGF0ZUJhc2VDdXJyZW5jeVJlc3BvbnNlIgASUQoOQ3JlYXRlQXBpVG9rZW4SHS5hcGkudjEuQ3JlYXRlQXBpVG9rZW5SZXF1ZXN0Gh4uYXBpLnYxLkNyZWF0ZUFwaVRva2VuUmVzcG9uc2UiABJRCg1MaXN0QXBpVG9rZW5zEhwuYXBpLnYxLkxpc3RBcGlUb2tlbnNSZXF1ZXN0Gh0uYXBpLnYxLkxpc3RBcGlUb2tlbnNSZXNwb25zZSIDkAIBElEKDlJldm9rZUFwaVRva2VuEh0uYXBpLnYxLlJldm9rZUFwaVRva2VuUmVxdWVzdBoeLmFwaS52MS5SZXZva2VBcGlUb2tlblJlc3BvbnNlIgBCdAoKY29tLmFwaS52MUIJQXV0aFByb3RvUAFaImNhc2h0cmFjay9iYWNrZW5kL2dlbi9hcGkvdjE7YXBpdjGiAgNBWFiqAgZBcGkuVjHKAgZBcGlcVjHiAhJBcGlcVjFcR1BCTWV0YWRhdGHqAgdBcGk6OlYxYgZwcm90bzM");
 
 /**
  * @generated from message api.v1.User
@@ -30,6 +30,11 @@ export type User = Message<"api.v1.User"> & {
    * @generated from field: string language = 3;
    */
   language: string;
+
+  /**
+   * @generated from field: string base_currency = 4;
+   */
+  baseCurrency: string;
 };
 
 /**
@@ -129,6 +134,186 @@ export type UpdateLanguageResponse = Message<"api.v1.UpdateLanguageResponse"> &
 export const UpdateLanguageResponseSchema: GenMessage<UpdateLanguageResponse> = /*@__PURE__*/
   messageDesc(file_api_v1_auth, 6);
 
+/**
+ * @generated from message api.v1.UpdateBaseCurrencyRequest
+ */
+export type UpdateBaseCurrencyRequest = Message<"api.v1.UpdateBaseCurrencyRequest"> & {
+  /**
+   * @generated from field: string base_currency = 1;
+   */
+  baseCurrency: string;
+};
+
+/**
+ * Describes the message api.v1.UpdateBaseCurrencyRequest.
+ * Use `create(UpdateBaseCurrencyRequestSchema)` to create a new message.
+ */
+export const UpdateBaseCurrencyRequestSchema: GenMessage<UpdateBaseCurrencyRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_auth, 7);
+
+/**
+ * @generated from message api.v1.UpdateBaseCurrencyResponse
+ */
+export type UpdateBaseCurrencyResponse = Message<"api.v1.UpdateBaseCurrencyResponse"> & {
+  /**
+   * @generated from field: api.v1.User user = 1;
+   */
+  user?: User;
+};
+
+/**
+ * Describes the message api.v1.UpdateBaseCurrencyResponse.
+ * Use `create(UpdateBaseCurrencyResponseSchema)` to create a new message.
+ */
+export const UpdateBaseCurrencyResponseSchema: GenMessage<UpdateBaseCurrencyResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_auth, 8);
+
+/**
+ * @generated from message api.v1.ApiToken
+ */
+export type ApiToken = Message<"api.v1.ApiToken"> & {
+  /**
+   * @generated from field: int32 id = 1;
+   */
+  id: number;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string prefix = 3;
+   */
+  prefix: string;
+
+  /**
+   * @generated from field: repeated string scopes = 4;
+   */
+  scopes: string[];
+
+  /**
+   * @generated from field: string created_at = 5;
+   */
+  createdAt: string;
+
+  /**
+   * @generated from field: string last_used_at = 6;
+   */
+  lastUsedAt: string;
+};
+
+/**
+ * Describes the message api.v1.ApiToken.
+ * Use `create(ApiTokenSchema)` to create a new message.
+ */
+export const ApiTokenSchema: GenMessage<ApiToken> = /*@__PURE__*/
+  messageDesc(file_api_v1_auth, 9);
+
+/**
+ * @generated from message api.v1.CreateApiTokenRequest
+ */
+export type CreateApiTokenRequest = Message<"api.v1.CreateApiTokenRequest"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: repeated string scopes = 2;
+   */
+  scopes: string[];
+};
+
+/**
+ * Describes the message api.v1.CreateApiTokenRequest.
+ * Use `create(CreateApiTokenRequestSchema)` to create a new message.
+ */
+export const CreateApiTokenRequestSchema: GenMessage<CreateApiTokenRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_auth, 10);
+
+/**
+ * @generated from message api.v1.CreateApiTokenResponse
+ */
+export type CreateApiTokenResponse = Message<"api.v1.CreateApiTokenResponse"> & {
+  /**
+   * @generated from field: api.v1.ApiToken api_token = 1;
+   */
+  apiToken?: ApiToken;
+
+  /**
+   * @generated from field: string token = 2;
+   */
+  token: string;
+};
+
+/**
+ * Describes the message api.v1.CreateApiTokenResponse.
+ * Use `create(CreateApiTokenResponseSchema)` to create a new message.
+ */
+export const CreateApiTokenResponseSchema: GenMessage<CreateApiTokenResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_auth, 11);
+
+/**
+ * @generated from message api.v1.ListApiTokensRequest
+ */
+export type ListApiTokensRequest = Message<"api.v1.ListApiTokensRequest"> & {
+};
+
+/**
+ * Describes the message api.v1.ListApiTokensRequest.
+ * Use `create(ListApiTokensRequestSchema)` to create a new message.
+ */
+export const ListApiTokensRequestSchema: GenMessage<ListApiTokensRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_auth, 12);
+
+/**
+ * @generated from message api.v1.ListApiTokensResponse
+ */
+export type ListApiTokensResponse = Message<"api.v1.ListApiTokensResponse"> & {
+  /**
+   * @generated from field: repeated api.v1.ApiToken api_tokens = 1;
+   */
+  apiTokens: ApiToken[];
+};
+
+/**
+ * Describes the message api.v1.ListApiTokensResponse.
+ * Use `create(ListApiTokensResponseSchema)` to create a new message.
+ */
+export const ListApiTokensResponseSchema: GenMessage<ListApiTokensResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_auth, 13);
+
+/**
+ * @generated from message api.v1.RevokeApiTokenRequest
+ */
+export type RevokeApiTokenRequest = Message<"api.v1.RevokeApiTokenRequest"> & {
+  /**
+   * @generated from field: int32 id = 1;
+   */
+  id: number;
+};
+
+/**
+ * Describes the message api.v1.RevokeApiTokenRequest.
+ * Use `create(RevokeApiTokenRequestSchema)` to create a new message.
+ */
+export const RevokeApiTokenRequestSchema: GenMessage<RevokeApiTokenRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_auth, 14);
+
+/**
+ * @generated from message api.v1.RevokeApiTokenResponse
+ */
+export type RevokeApiTokenResponse = Message<"api.v1.RevokeApiTokenResponse"> & {
+};
+
+/**
+ * Describes the message api.v1.RevokeApiTokenResponse.
+ * Use `create(RevokeApiTokenResponseSchema)` to create a new message.
+ */
+export const RevokeApiTokenResponseSchema: GenMessage<RevokeApiTokenResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_auth, 15);
+
 /**
  * @generated from service api.v1.AuthService
  */
@@ -157,6 +342,38 @@ export const AuthService: GenService<{
     input: typeof UpdateLanguageRequestSchema;
     output: typeof UpdateLanguageResponseSchema;
   },
+  /**
+   * @generated from rpc api.v1.AuthService.UpdateBaseCurrency
+   */
+  updateBaseCurrency: {
+    methodKind: "unary";
+    input: typeof UpdateBaseCurrencyRequestSchema;
+    output: typeof UpdateBaseCurrencyResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.AuthService.CreateApiToken
+   */
+  createApiToken: {
+    methodKind: "unary";
+    input: typeof CreateApiTokenRequestSchema;
+    output: typeof CreateApiTokenResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.AuthService.ListApiTokens
+   */
+  listApiTokens: {
+    methodKind: "unary";
+    input: typeof ListApiTokensRequestSchema;
+    output: typeof ListApiTokensResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.AuthService.RevokeApiToken
+   */
+  revokeApiToken: {
+    methodKind: "unary";
+    input: typeof RevokeApiTokenRequestSchema;
+    output: typeof RevokeApiTokenResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_api_v1_auth, 0);
 
@@ -0,0 +1,348 @@
+// @generated by protoc-gen-es v2.10.1 with parameter "target=ts"
+// @generated from file api/v1/budgets.proto (package api.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file api/v1/budgets.proto.
+ */
+export const file_api_v1_budgets: GenFile = /*@__PURE__*/
+  fileDesc("ChRhcGkvdjEvYnVkZ2V0cy5wcm90bxIGYXBpLnYxIm8KBkJ1ZGdldBIKCgJpZBgBIAEoBRITCgtjYXRlZ29yeV9pZBgCIAEoBRIOCgZwZXJpb2QYAyABKAkSDgoGYW1vdW50GAQgASgDEhAKCGN1cnJlbmN5GAUgASgJEhIKCmNyZWF0ZWRfYXQYBiABKAkitgEKDEJ1ZGdldFN0YXR1cxIRCglidWRnZXRfaWQYASABKAUSEwoLY2F0ZWdvcnlfaWQYAiABKAUSDgoGcGVyaW9kGAMgASgJEhQKDHBlcmlvZF9zdGFydBgEIAEoCRISCgpwZXJpb2RfZW5kGAUgASgJEhAKCGJ1ZGdldGVkGAYgASgDEg0KBXNwZW50GAcgASgDEhEKCXJlbWFpbmluZxgIIAEoAxIQCghjdXJyZW5jeRgJIAEoCSIUChJMaXN0QnVkZ2V0c1JlcXVlc3QiNgoTTGlzdEJ1ZGdldHNSZXNwb25zZRIfCgdidWRnZXRzGAEgAygLMg4uYXBpLnYxLkJ1ZGdldCJKChNDcmVhdGVCdWRnZXRSZXF1ZXN0EhMKC2NhdGVnb3J5X2lkGAEgASgFEg4KBnBlcmlvZBgCIAEoCRIOCgZhbW91bnQYAyABKAMiNgoUQ3JlYXRlQnVkZ2V0UmVzcG9uc2USHgoGYnVkZ2V0GAEgASgLMg4uYXBpLnYxLkJ1ZGdldCJWChNVcGRhdGVCdWRnZXRSZXF1ZXN0EgoKAmlkGAEgASgFEhMKC2NhdGVnb3J5X2lkGAIgASgFEg4KBnBlcmlvZBgDIAEoCRIOCgZhbW91bnQYBCABKAMiFgoUVXBkYXRlQnVkZ2V0UmVzcG9uc2UiIQoTRGVsZXRlQnVkZ2V0UmVxdWVzdBIKCgJpZBgBIAEoBSIWChREZWxldGVCdWRnZXRSZXNwb25zZSI3ChZHZXRCdWRnZXRTdGF0dXNSZXF1ZXN0EgwKBGRhdGUYASABKAkSDwoHcGVyaW9kcxgCIAEoBSJBChdHZXRCdWRnZXRTdGF0dXNSZXNwb25zZRImCghzdGF0dXNlcxgBIAMoCzIULmFwaS52MS5CdWRnZXRTdGF0dXMynAMKDUJ1ZGdldFNlcnZpY2USSwoLTGlzdEJ1ZGdldHMSGi5hcGkudjEuTGlzdEJ1ZGdldHNSZXF1ZXN0GhsuYXBpLnYxLkxpc3RCdWRnZXRzUmVzcG9uc2UiA5ACARJLCgxDcmVhdGVCdWRnZXQSGy5hcGkudjEuQ3JlYXRlQnVkZ2V0UmVxdWVzdBocLmFwaS52MS5DcmVhdGVCdWRnZXRSZXNwb25zZSIAEksKDFVwZGF0ZUJ1ZGdldBIbLmFwaS52MS5VcGRhdGVCdWRnZXRSZXF1ZXN0GhwuYXBpLnYxLlVwZGF0ZUJ1ZGdldFJlc3BvbnNlIgASSwoMRGVsZXRlQnVkZ2V0EhsuYXBpLnYxLkRlbGV0ZUJ1ZGdldFJlcXVlc3QaHC5hcGkudjEuRGVsZXRlQnVkZ2V0UmVzcG9uc2UiABJXCg9HZXRCdWRnZXRTdGF0dXMSHi5hcGkudjEuR2V0QnVkZ2V0U3RhdHVzUmVxdWVzdBofLmFwaS52MS5HZXRCdWRnZXRTdGF0dXNSZXNwb25zZSIDkAIBQncKCmNvbS5hcGkudjFCDEJ1ZGdldHNQcm90b1ABWiJjYXNodHJhY2svYmFja2VuZC9nZW4vYXBpL3YxO2FwaXYxogIDQVhYqgIGQXBpLlYxygIGQXBpXFYx4gISQXBpXFYxXEdQQk1ldGFkYXRh6gIHQXBpOjpWMWIGcHJvdG8z");
+
+/**
+ * @generated from message api.v1.Budget
+ */
+export type Budget = Message<"api.v1.Budget"> & {
+  /**
+   * @generated from field: int32 id = 1;
+   */
+  id: number;
+
+  /**
+   * @generated from field: int32 category_id = 2;
+   */
+  categoryId: number;
+
+  /**
+   * @generated from field: string period = 3;
+   */
+  period: string;
+
+  /**
+   * @generated from field: int64 amount = 4;
+   */
+  amount: bigint;
+
+  /**
+   * @generated from field: string currency = 5;
+   */
+  currency: string;
+
+  /**
+   * @generated from field: string created_at = 6;
+   */
+  createdAt: string;
+};
+
+/**
+ * Describes the message api.v1.Budget.
+ * Use `create(BudgetSchema)` to create a new message.
+ */
+export const BudgetSchema: GenMessage<Budget> = /*@__PURE__*/
+  messageDesc(file_api_v1_budgets, 0);
+
+/**
+ * @generated from message api.v1.BudgetStatus
+ */
+export type BudgetStatus = Message<"api.v1.BudgetStatus"> & {
+  /**
+   * @generated from field: int32 budget_id = 1;
+   */
+  budgetId: number;
+
+  /**
+   * @generated from field: int32 category_id = 2;
+   */
+  categoryId: number;
+
+  /**
+   * @generated from field: string period = 3;
+   */
+  period: string;
+
+  /**
+   * @generated from field: string period_start = 4;
+   */
+  periodStart: string;
+
+  /**
+   * @generated from field: string period_end = 5;
+   */
+  periodEnd: string;
+
+  /**
+   * @generated from field: int64 budgeted = 6;
+   */
+  budgeted: bigint;
+
+  /**
+   * @generated from field: int64 spent = 7;
+   */
+  spent: bigint;
+
+  /**
+   * @generated from field: int64 remaining = 8;
+   */
+  remaining: bigint;
+
+  /**
+   * @generated from field: string currency = 9;
+   */
+  currency: string;
+};
+
+/**
+ * Describes the message api.v1.BudgetStatus.
+ * Use `create(BudgetStatusSchema)` to create a new message.
+ */
+export const BudgetStatusSchema: GenMessage<BudgetStatus> = /*@__PURE__*/
+  messageDesc(file_api_v1_budgets, 1);
+
+/**
+ * @generated from message api.v1.ListBudgetsRequest
+ */
+export type ListBudgetsRequest = Message<"api.v1.ListBudgetsRequest"> & {
+};
+
+/**
+ * Describes the message api.v1.ListBudgetsRequest.
+ * Use `create(ListBudgetsRequestSchema)` to create a new message.
+ */
+export const ListBudgetsRequestSchema: GenMessage<ListBudgetsRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_budgets, 2);
+
+/**
+ * @generated from message api.v1.ListBudgetsResponse
+ */
+export type ListBudgetsResponse = Message<"api.v1.ListBudgetsResponse"> & {
+  /**
+   * @generated from field: repeated api.v1.Budget budgets = 1;
+   */
+  budgets: Budget[];
+};
+
+/**
+ * Describes the message api.v1.ListBudgetsResponse.
+ * Use `create(ListBudgetsResponseSchema)` to create a new message.
+ */
+export const ListBudgetsResponseSchema: GenMessage<ListBudgetsResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_budgets, 3);
+
+/**
+ * @generated from message api.v1.CreateBudgetRequest
+ */
+export type CreateBudgetRequest = Message<"api.v1.CreateBudgetRequest"> & {
+  /**
+   * @generated from field: int32 category_id = 1;
+   */
+  categoryId: number;
+
+  /**
+   * @generated from field: string period = 2;
+   */
+  period: string;
+
+  /**
+   * @generated from field: int64 amount = 3;
+   */
+  amount: bigint;
+};
+
+/**
+ * Describes the message api.v1.CreateBudgetRequest.
+ * Use `create(CreateBudgetRequestSchema)` to create a new message.
+ */
+export const CreateBudgetRequestSchema: GenMessage<CreateBudgetRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_budgets, 4);
+
+/**
+ * @generated from message api.v1.CreateBudgetResponse
+ */
+export type CreateBudgetResponse = Message<"api.v1.CreateBudgetResponse"> & {
+  /**
+   * @generated from field: api.v1.Budget budget = 1;
+   */
+  budget?: Budget;
+};
+
+/**
+ * Describes the message api.v1.CreateBudgetResponse.
+ * Use `create(CreateBudgetResponseSchema)` to create a new message.
+ */
+export const CreateBudgetResponseSchema: GenMessage<CreateBudgetResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_budgets, 5);
+
+/**
+ * @generated from message api.v1.UpdateBudgetRequest
+ */
+export type UpdateBudgetRequest = Message<"api.v1.UpdateBudgetRequest"> & {
+  /**
+   * @generated from field: int32 id = 1;
+   */
+  id: number;
+
+  /**
+   * @generated from field: int32 category_id = 2;
+   */
+  categoryId: number;
+
+  /**
+   * @generated from field: string period = 3;
+   */
+  period: string;
+
+  /**
+   * @generated from field: int64 amount = 4;
+   */
+  amount: bigint;
+};
+
+/**
+ * Describes the message api.v1.UpdateBudgetRequest.
+ * Use `create(UpdateBudgetRequestSchema)` to create a new message.
+ */
+export const UpdateBudgetRequestSchema: GenMessage<UpdateBudgetRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_budgets, 6);
+
+/**
+ * @generated from message api.v1.UpdateBudgetResponse
+ */
+export type UpdateBudgetResponse = Message<"api.v1.UpdateBudgetResponse"> & {
+};
+
+/**
+ * Describes the message api.v1.UpdateBudgetResponse.
+ * Use `create(UpdateBudgetResponseSchema)` to create a new message.
+ */
+export const UpdateBudgetResponseSchema: GenMessage<UpdateBudgetResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_budgets, 7);
+
+/**
+ * @generated from message api.v1.DeleteBudgetRequest
+ */
+export type DeleteBudgetRequest = Message<"api.v1.DeleteBudgetRequest"> & {
+  /**
+   * @generated from field: int32 id = 1;
+   */
+  id: number;
+};
+
+/**
+ * Describes the message api.v1.DeleteBudgetRequest.
+ * Use `create(DeleteBudgetRequestSchema)` to create a new message.
+ */
+export const DeleteBudgetRequestSchema: GenMessage<DeleteBudgetRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_budgets, 8);
+
+/**
+ * @generated from message api.v1.DeleteBudgetResponse
+ */
+export type DeleteBudgetResponse = Message<"api.v1.DeleteBudgetResponse"> & {
+};
+
+/**
+ * Describes the message api.v1.DeleteBudgetResponse.
+ * Use `create(DeleteBudgetResponseSchema)` to create a new message.
+ */
+export const DeleteBudgetResponseSchema: GenMessage<DeleteBudgetResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_budgets, 9);
+
+/**
+ * @generated from message api.v1.GetBudgetStatusRequest
+ */
+export type GetBudgetStatusRequest = Message<"api.v1.GetBudgetStatusRequest"> & {
+  /**
+   * @generated from field: string date = 1;
+   */
+  date: string;
+
+  /**
+   * @generated from field: int32 periods = 2;
+   */
+  periods: number;
+};
+
+/**
+ * Describes the message api.v1.GetBudgetStatusRequest.
+ * Use `create(GetBudgetStatusRequestSchema)` to create a new message.
+ */
+export const GetBudgetStatusRequestSchema: GenMessage<GetBudgetStatusRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_budgets, 10);
+
+/**
+ * @generated from message api.v1.GetBudgetStatusResponse
+ */
+export type GetBudgetStatusResponse = Message<"api.v1.GetBudgetStatusResponse"> & {
+  /**
+   * @generated from field: repeated api.v1.BudgetStatus statuses = 1;
+   */
+  statuses: BudgetStatus[];
+};
+
+/**
+ * Describes the message api.v1.GetBudgetStatusResponse.
+ * Use `create(GetBudgetStatusResponseSchema)` to create a new message.
+ */
+export const GetBudgetStatusResponseSchema: GenMessage<GetBudgetStatusResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_budgets, 11);
+
+/**
+ * @generated from service api.v1.BudgetService
+ */
+export const BudgetService: GenService<{
+  /**
+   * @generated from rpc api.v1.BudgetService.ListBudgets
+   */
+  listBudgets: {
+    methodKind: "unary";
+    input: typeof ListBudgetsRequestSchema;
+    output: typeof ListBudgetsResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.BudgetService.CreateBudget
+   */
+  createBudget: {
+    methodKind: "unary";
+    input: typeof CreateBudgetRequestSchema;
+    output: typeof CreateBudgetResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.BudgetService.UpdateBudget
+   */
+  updateBudget: {
+    methodKind: "unary";
+    input: typeof UpdateBudgetRequestSchema;
+    output: typeof UpdateBudgetResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.BudgetService.DeleteBudget
+   */
+  deleteBudget: {
+    methodKind: "unary";
+    input: typeof DeleteBudgetRequestSchema;
+    output: typeof DeleteBudgetResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.BudgetService.GetBudgetStatus
+   */
+  getBudgetStatus: {
+    methodKind: "unary";
+    input: typeof GetBudgetStatusRequestSchema;
+    output: typeof GetBudgetStatusResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_api_v1_budgets, 0);
+
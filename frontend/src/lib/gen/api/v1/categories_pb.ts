@@ -10,7 +10,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file api/v1/categories.proto.
  */
 export const file_api_v1_categories: GenFile = /*@__PURE__*/
-  fileDesc("ChdhcGkvdjEvY2F0ZWdvcmllcy5wcm90bxIGYXBpLnYxImwKCENhdGVnb3J5EgoKAmlkGAEgASgFEgwKBG5hbWUYAiABKAkSDQoFY29sb3IYAyABKAkSEgoKY3JlYXRlZF9hdBgEIAEoCRIRCglwYXJlbnRfaWQYBSABKAUSEAoIaXNfZ3JvdXAYBiABKAgicwoMQ2F0ZWdvcnlSdWxlEgoKAmlkGAEgASgFEhMKC2NhdGVnb3J5X2lkGAIgASgFEhwKFGRlc2NyaXB0aW9uX2NvbnRhaW5zGAMgASgJEhAKCHBvc2l0aW9uGAQgASgFEhIKCmNyZWF0ZWRfYXQYBSABKAkiFwoVTGlzdENhdGVnb3JpZXNSZXF1ZXN0Ij4KFkxpc3RDYXRlZ29yaWVzUmVzcG9uc2USJAoKY2F0ZWdvcmllcxgBIAMoCzIQLmFwaS52MS5DYXRlZ29yeSJZChVDcmVhdGVDYXRlZ29yeVJlcXVlc3QSDAoEbmFtZRgBIAEoCRINCgVjb2xvchgCIAEoCRIRCglwYXJlbnRfaWQYAyABKAUSEAoIaXNfZ3JvdXAYBCABKAgiPAoWQ3JlYXRlQ2F0ZWdvcnlSZXNwb25zZRIiCghjYXRlZ29yeRgBIAEoCzIQLmFwaS52MS5DYXRlZ29yeSJlChVVcGRhdGVDYXRlZ29yeVJlcXVlc3QSCgoCaWQYASABKAUSDAoEbmFtZRgCIAEoCRINCgVjb2xvchgDIAEoCRIRCglwYXJlbnRfaWQYBCABKAUSEAoIaXNfZ3JvdXAYBSABKAgiGAoWVXBkYXRlQ2F0ZWdvcnlSZXNwb25zZSIjChVEZWxldGVDYXRlZ29yeVJlcXVlc3QSCgoCaWQYASABKAUiGAoWRGVsZXRlQ2F0ZWdvcnlSZXNwb25zZSIaChhMaXN0Q2F0ZWdvcnlSdWxlc1JlcXVlc3QiQAoZTGlzdENhdGVnb3J5UnVsZXNSZXNwb25zZRIjCgVydWxlcxgBIAMoCzIULmFwaS52MS5DYXRlZ29yeVJ1bGUiTgoZQ3JlYXRlQ2F0ZWdvcnlSdWxlUmVxdWVzdBITCgtjYXRlZ29yeV9pZBgBIAEoBRIcChRkZXNjcmlwdGlvbl9jb250YWlucxgCIAEoCSJAChpDcmVhdGVDYXRlZ29yeVJ1bGVSZXNwb25zZRIiCgRydWxlGAEgASgLMhQuYXBpLnYxLkNhdGVnb3J5UnVsZSJaChlVcGRhdGVDYXRlZ29yeVJ1bGVSZXF1ZXN0EgoKAmlkGAEgASgFEhMKC2NhdGVnb3J5X2lkGAIgASgFEhwKFGRlc2NyaXB0aW9uX2NvbnRhaW5zGAMgASgJIhwKGlVwZGF0ZUNhdGVnb3J5UnVsZVJlc3BvbnNlIicKGURlbGV0ZUNhdGVnb3J5UnVsZVJlcXVlc3QSCgoCaWQYASABKAUiHAoaRGVsZXRlQ2F0ZWdvcnlSdWxlUmVzcG9uc2UiMQoZQXBwbHlDYXRlZ29yeVJ1bGVzUmVxdWVzdBIUCgxhcHBseV90b19hbGwYASABKAgiMwoaQXBwbHlDYXRlZ29yeVJ1bGVzUmVzcG9uc2USFQoNdXBkYXRlZF9jb3VudBgBIAEoBSIvChtSZW9yZGVyQ2F0ZWdvcnlSdWxlc1JlcXVlc3QSEAoIcnVsZV9pZHMYASADKAUiHgocUmVvcmRlckNhdGVnb3J5UnVsZXNSZXNwb25zZTKaBwoPQ2F0ZWdvcnlTZXJ2aWNlElEKDkxpc3RDYXRlZ29yaWVzEh0uYXBpLnYxLkxpc3RDYXRlZ29yaWVzUmVxdWVzdBoeLmFwaS52MS5MaXN0Q2F0ZWdvcmllc1Jlc3BvbnNlIgASUQoOQ3JlYXRlQ2F0ZWdvcnkSHS5hcGkudjEuQ3JlYXRlQ2F0ZWdvcnlSZXF1ZXN0Gh4uYXBpLnYxLkNyZWF0ZUNhdGVnb3J5UmVzcG9uc2UiABJRCg5VcGRhdGVDYXRlZ29yeRIdLmFwaS52MS5VcGRhdGVDYXRlZ29yeVJlcXVlc3QaHi5hcGkudjEuVXBkYXRlQ2F0ZWdvcnlSZXNwb25zZSIAElEKDkRlbGV0ZUNhdGVnb3J5Eh0uYXBpLnYxLkRlbGV0ZUNhdGVnb3J5UmVxdWVzdBoeLmFwaS52MS5EZWxldGVDYXRlZ29yeVJlc3BvbnNlIgASWgoRTGlzdENhdGVnb3J5UnVsZXMSIC5hcGkudjEuTGlzdENhdGVnb3J5UnVsZXNSZXF1ZXN0GiEuYXBpLnYxLkxpc3RDYXRlZ29yeVJ1bGVzUmVzcG9uc2UiABJdChJDcmVhdGVDYXRlZ29yeVJ1bGUSIS5hcGkudjEuQ3JlYXRlQ2F0ZWdvcnlSdWxlUmVxdWVzdBoiLmFwaS52MS5DcmVhdGVDYXRlZ29yeVJ1bGVSZXNwb25zZSIAEl0KElVwZGF0ZUNhdGVnb3J5UnVsZRIhLmFwaS52MS5VcGRhdGVDYXRlZ29yeVJ1bGVSZXF1ZXN0GiIuYXBpLnYxLlVwZGF0ZUNhdGVnb3J5UnVsZVJlc3BvbnNlIgASXQoSRGVsZXRlQ2F0ZWdvcnlSdWxlEiEuYXBpLnYxLkRlbGV0ZUNhdGVnb3J5UnVsZVJlcXVlc3QaIi5hcGkudjEuRGVsZXRlQ2F0ZWdvcnlSdWxlUmVzcG9uc2UiABJdChJBcHBseUNhdGVnb3J5UnVsZXMSIS5hcGkudjEuQXBwbHlDYXRlZ29yeVJ1bGVzUmVxdWVzdBoiLmFwaS52MS5BcHBseUNhdGVnb3J5UnVsZXNSZXNwb25zZSIAEmMKFFJlb3JkZXJDYXRlZ29yeVJ1bGVzEiMuYXBpLnYxLlJlb3JkZXJDYXRlZ29yeVJ1bGVzUmVxdWVzdBokLmFwaS52MS5SZW9yZGVyQ2F0ZWdvcnlSdWxlc1Jlc3BvbnNlIgBCegoKY29tLmFwaS52MUIPQ2F0ZWdvcmllc1Byb3RvUAFaImNhc2h0cmFjay9iYWNrZW5kL2dlbi9hcGkvdjE7YXBpdjGiAgNBWFiqAgZBcGkuVjHKAgZBcGlcVjHiAhJBcGlcVjFcR1BCTWV0YWRhdGHqAgdBcGk6OlYxYgZwcm90bzM");
+  fileDesc("ChdhcGkvdjEvY2F0ZWdvcmllcy5wcm90bxIGYXBpLnYxImwKCENhdGVnb3J5EgoKAmlkGAEgASgFEgwKBG5hbWUYAiABKAkSDQoFY29sb3IYAyABKAkSEgoKY3JlYXRlZF9hdBgEIAEoCRIRCglwYXJlbnRfaWQYBSABKAUSEAoIaXNfZ3JvdXAYBiABKAgi4QIKDENhdGVnb3J5UnVsZRIKCgJpZBgBIAEoBRITCgtjYXRlZ29yeV9pZBgCIAEoBRIcChRkZXNjcmlwdGlvbl9jb250YWlucxgDIAEoCRIQCghwb3NpdGlvbhgEIAEoBRISCgpjcmVhdGVkX2F0GAUgASgJEhkKEWRlc2NyaXB0aW9uX3JlZ2V4GAYgASgJEhkKEWRlc2NyaXB0aW9uX2V4YWN0GAcgASgJEhoKEmRlc2NyaXB0aW9uX3ByZWZpeBgIIAEoCRIXCgphbW91bnRfbWluGAkgASgDSACIAQESFwoKYW1vdW50X21heBgKIAEoA0gBiAEBEhIKCmVudHJ5X3R5cGUYCyABKAkSEAoIY3VycmVuY3kYDCABKAkSDwoHYWNjb3VudBgNIAEoCRITCgtwYXJzZXJfbmFtZRgOIAEoCUINCgtfYW1vdW50X21pbkINCgtfYW1vdW50X21heCIXChVMaXN0Q2F0ZWdvcmllc1JlcXVlc3QiPgoWTGlzdENhdGVnb3JpZXNSZXNwb25zZRIkCgpjYXRlZ29yaWVzGAEgAygLMhAuYXBpLnYxLkNhdGVnb3J5IlkKFUNyZWF0ZUNhdGVnb3J5UmVxdWVzdBIMCgRuYW1lGAEgASgJEg0KBWNvbG9yGAIgASgJEhEKCXBhcmVudF9pZBgDIAEoBRIQCghpc19ncm91cBgEIAEoCCI8ChZDcmVhdGVDYXRlZ29yeVJlc3BvbnNlEiIKCGNhdGVnb3J5GAEgASgLMhAuYXBpLnYxLkNhdGVnb3J5ImUKFVVwZGF0ZUNhdGVnb3J5UmVxdWVzdBIKCgJpZBgBIAEoBRIMCgRuYW1lGAIgASgJEg0KBWNvbG9yGAMgASgJEhEKCXBhcmVudF9pZBgEIAEoBRIQCghpc19ncm91cBgFIAEoCCIYChZVcGRhdGVDYXRlZ29yeVJlc3BvbnNlIiMKFURlbGV0ZUNhdGVnb3J5UmVxdWVzdBIKCgJpZBgBIAEoBSIYChZEZWxldGVDYXRlZ29yeVJlc3BvbnNlIhoKGExpc3RDYXRlZ29yeVJ1bGVzUmVxdWVzdCJAChlMaXN0Q2F0ZWdvcnlSdWxlc1Jlc3BvbnNlEiMKBXJ1bGVzGAEgAygLMhQuYXBpLnYxLkNhdGVnb3J5UnVsZSK8AgoZQ3JlYXRlQ2F0ZWdvcnlSdWxlUmVxdWVzdBITCgtjYXRlZ29yeV9pZBgBIAEoBRIcChRkZXNjcmlwdGlvbl9jb250YWlucxgCIAEoCRIZChFkZXNjcmlwdGlvbl9yZWdleBgDIAEoCRIZChFkZXNjcmlwdGlvbl9leGFjdBgEIAEoCRIaChJkZXNjcmlwdGlvbl9wcmVmaXgYBSABKAkSFwoKYW1vdW50X21pbhgGIAEoA0gAiAEBEhcKCmFtb3VudF9tYXgYByABKANIAYgBARISCgplbnRyeV90eXBlGAggASgJEhAKCGN1cnJlbmN5GAkgASgJEg8KB2FjY291bnQYCiABKAkSEwoLcGFyc2VyX25hbWUYCyABKAlCDQoLX2Ftb3VudF9taW5CDQoLX2Ftb3VudF9tYXgiQAoaQ3JlYXRlQ2F0ZWdvcnlSdWxlUmVzcG9uc2USIgoEcnVsZRgBIAEoCzIULmFwaS52MS5DYXRlZ29yeVJ1bGUiyAIKGVVwZGF0ZUNhdGVnb3J5UnVsZVJlcXVlc3QSCgoCaWQYASABKAUSEwoLY2F0ZWdvcnlfaWQYAiABKAUSHAoUZGVzY3JpcHRpb25fY29udGFpbnMYAyABKAkSGQoRZGVzY3JpcHRpb25fcmVnZXgYBCABKAkSGQoRZGVzY3JpcHRpb25fZXhhY3QYBSABKAkSGgoSZGVzY3JpcHRpb25fcHJlZml4GAYgASgJEhcKCmFtb3VudF9taW4YByABKANIAIgBARIXCgphbW91bnRfbWF4GAggASgDSAGIAQESEgoKZW50cnlfdHlwZRgJIAEoCRIQCghjdXJyZW5jeRgKIAEoCRIPCgdhY2NvdW50GAsgASgJEhMKC3BhcnNlcl9uYW1lGAwgASgJQg0KC19hbW91bnRfbWluQg0KC19hbW91bnRfbWF4IhwKGlVwZGF0ZUNhdGVnb3J5UnVsZVJlc3BvbnNlIicKGURlbGV0ZUNhdGVnb3J5UnVsZVJlcXVlc3QSCgoCaWQYASABKAUiHAoaRGVsZXRlQ2F0ZWdvcnlSdWxlUmVzcG9uc2UiMQoZQXBwbHlDYXRlZ29yeVJ1bGVzUmVxdWVzdBIUCgxhcHBseV90b19hbGwYASABKAgiMwoaQXBwbHlDYXRlZ29yeVJ1bGVzUmVzcG9uc2USFQoNdXBkYXRlZF9jb3VudBgBIAEoBSK0AgoRQ2F0ZWdvcnlSdWxlRHJhZnQSEwoLY2F0ZWdvcnlfaWQYASABKAUSHAoUZGVzY3JpcHRpb25fY29udGFpbnMYAiABKAkSGQoRZGVzY3JpcHRpb25fcmVnZXgYAyABKAkSGQoRZGVzY3JpcHRpb25fZXhhY3QYBCABKAkSGgoSZGVzY3JpcHRpb25fcHJlZml4GAUgASgJEhcKCmFtb3VudF9taW4YBiABKANIAIgBARIXCgphbW91bnRfbWF4GAcgASgDSAGIAQESEgoKZW50cnlfdHlwZRgIIAEoCRIQCghjdXJyZW5jeRgJIAEoCRIPCgdhY2NvdW50GAogASgJEhMKC3BhcnNlcl9uYW1lGAsgASgJQg0KC19hbW91bnRfbWluQg0KC19hbW91bnRfbWF4IoECChJDYXRlZ29yeVJ1bGVDaGFuZ2USFgoOdHJhbnNhY3Rpb25faWQYASABKAUSEwoLcG9zdGVkX2RhdGUYAiABKAkSEwoLZGVzY3JpcHRpb24YAyABKAkSDgoGYW1vdW50GAQgASgDEhAKCGN1cnJlbmN5GAUgASgJEh0KEGZyb21fY2F0ZWdvcnlfaWQYBiABKAVIAIgBARIbCg50b19jYXRlZ29yeV9pZBgHIAEoBUgBiAEBEg8KB3J1bGVfaWQYCCABKAUSEgoKZHJhZnRfcnVsZRgJIAEoCEITChFfZnJvbV9jYXRlZ29yeV9pZEIRCg9fdG9fY2F0ZWdvcnlfaWQiXQobUHJldmlld0NhdGVnb3J5UnVsZXNSZXF1ZXN0EhQKDGFwcGx5X3RvX2FsbBgBIAEoCBIoCgVkcmFmdBgCIAEoCzIZLmFwaS52MS5DYXRlZ29yeVJ1bGVEcmFmdCJLChxQcmV2aWV3Q2F0ZWdvcnlSdWxlc1Jlc3BvbnNlEisKB2NoYW5nZXMYASADKAsyGi5hcGkudjEuQ2F0ZWdvcnlSdWxlQ2hhbmdlIi8KG1Jlb3JkZXJDYXRlZ29yeVJ1bGVzUmVxdWVzdBIQCghydWxlX2lkcxgBIAMoBSIeChxSZW9yZGVyQ2F0ZWdvcnlSdWxlc1Jlc3BvbnNlMogICg9DYXRlZ29yeVNlcnZpY2USVAoOTGlzdENhdGVnb3JpZXMSHS5hcGkudjEuTGlzdENhdGVnb3JpZXNSZXF1ZXN0Gh4uYXBpLnYxLkxpc3RDYXRlZ29yaWVzUmVzcG9uc2UiA5ACARJRCg5DcmVhdGVDYXRlZ29yeRIdLmFwaS52MS5DcmVhdGVDYXRlZ29yeVJlcXVlc3QaHi5hcGkudjEuQ3JlYXRlQ2F0ZWdvcnlSZXNwb25zZSIAElEKDlVwZGF0ZUNhdGVnb3J5Eh0uYXBpLnYxLlVwZGF0ZUNhdGVnb3J5UmVxdWVzdBoeLmFwaS52MS5VcGRhdGVDYXRlZ29yeVJlc3BvbnNlIgASUQoORGVsZXRlQ2F0ZWdvcnkSHS5hcGkudjEuRGVsZXRlQ2F0ZWdvcnlSZXF1ZXN0Gh4uYXBpLnYxLkRlbGV0ZUNhdGVnb3J5UmVzcG9uc2UiABJdChFMaXN0Q2F0ZWdvcnlSdWxlcxIgLmFwaS52MS5MaXN0Q2F0ZWdvcnlSdWxlc1JlcXVlc3QaIS5hcGkudjEuTGlzdENhdGVnb3J5UnVsZXNSZXNwb25zZSIDkAIBEl0KEkNyZWF0ZUNhdGVnb3J5UnVsZRIhLmFwaS52MS5DcmVhdGVDYXRlZ29yeVJ1bGVSZXF1ZXN0GiIuYXBpLnYxLkNyZWF0ZUNhdGVnb3J5UnVsZVJlc3BvbnNlIgASXQoSVXBkYXRlQ2F0ZWdvcnlSdWxlEiEuYXBpLnYxLlVwZGF0ZUNhdGVnb3J5UnVsZVJlcXVlc3QaIi5hcGkudjEuVXBkYXRlQ2F0ZWdvcnlSdWxlUmVzcG9uc2UiABJdChJEZWxldGVDYXRlZ29yeVJ1bGUSIS5hcGkudjEuRGVsZXRlQ2F0ZWdvcnlSdWxlUmVxdWVzdBoiLmFwaS52MS5EZWxldGVDYXRlZ29yeVJ1bGVSZXNwb25zZSIAEl0KEkFwcGx5Q2F0ZWdvcnlSdWxlcxIhLmFwaS52MS5BcHBseUNhdGVnb3J5UnVsZXNSZXF1ZXN0GiIuYXBpLnYxLkFwcGx5Q2F0ZWdvcnlSdWxlc1Jlc3BvbnNlIgASZgoUUHJldmlld0NhdGVnb3J5UnVsZXMSIy5hcGkudjEuUHJldmlld0NhdGVnb3J5UnVsZXNSZXF1ZXN0GiQuYXBpLnYxLlByZXZpZXdDYXRlZ29yeVJ1bGVzUmVzcG9uc2UiA5ACARJjChRSZW9yZGVyQ2F0ZWdvcnlSdWxlcxIjLmFwaS52MS5SZW9yZGVyQ2F0ZWdvcnlSdWxlc1JlcXVlc3QaJC5hcGkudjEuUmVvcmRlckNhdGVnb3J5UnVsZXNSZXNwb25zZSIAQnoKCmNvbS5hcGkudjFCD0NhdGVnb3JpZXNQcm90b1ABWiJjYXNodHJhY2svYmFja2VuZC9nZW4vYXBpL3YxO2FwaXYxogIDQVhYqgIGQXBpLlYxygIGQXBpXFYx4gISQXBpXFYxXEdQQk1ldGFkYXRh6gIHQXBpOjpWMWIGcHJvdG8z");
 
 /**
  * @generated from message api.v1.Category
@@ -82,6 +82,51 @@ export type CategoryRule = Message<"api.v1.CategoryRule"> & {
    * @generated from field: string created_at = 5;
    */
   createdAt: string;
+
+  /**
+   * @generated from field: string description_regex = 6;
+   */
+  descriptionRegex: string;
+
+  /**
+   * @generated from field: string description_exact = 7;
+   */
+  descriptionExact: string;
+
+  /**
+   * @generated from field: string description_prefix = 8;
+   */
+  descriptionPrefix: string;
+
+  /**
+   * @generated from field: optional int64 amount_min = 9;
+   */
+  amountMin?: bigint;
+
+  /**
+   * @generated from field: optional int64 amount_max = 10;
+   */
+  amountMax?: bigint;
+
+  /**
+   * @generated from field: string entry_type = 11;
+   */
+  entryType: string;
+
+  /**
+   * @generated from field: string currency = 12;
+   */
+  currency: string;
+
+  /**
+   * @generated from field: string account = 13;
+   */
+  account: string;
+
+  /**
+   * @generated from field: string parser_name = 14;
+   */
+  parserName: string;
 };
 
 /**
@@ -293,6 +338,51 @@ export type CreateCategoryRuleRequest = Message<"api.v1.CreateCategoryRuleReques
    * @generated from field: string description_contains = 2;
    */
   descriptionContains: string;
+
+  /**
+   * @generated from field: string description_regex = 3;
+   */
+  descriptionRegex: string;
+
+  /**
+   * @generated from field: string description_exact = 4;
+   */
+  descriptionExact: string;
+
+  /**
+   * @generated from field: string description_prefix = 5;
+   */
+  descriptionPrefix: string;
+
+  /**
+   * @generated from field: optional int64 amount_min = 6;
+   */
+  amountMin?: bigint;
+
+  /**
+   * @generated from field: optional int64 amount_max = 7;
+   */
+  amountMax?: bigint;
+
+  /**
+   * @generated from field: string entry_type = 8;
+   */
+  entryType: string;
+
+  /**
+   * @generated from field: string currency = 9;
+   */
+  currency: string;
+
+  /**
+   * @generated from field: string account = 10;
+   */
+  account: string;
+
+  /**
+   * @generated from field: string parser_name = 11;
+   */
+  parserName: string;
 };
 
 /**
@@ -337,6 +427,51 @@ export type UpdateCategoryRuleRequest = Message<"api.v1.UpdateCategoryRuleReques
    * @generated from field: string description_contains = 3;
    */
   descriptionContains: string;
+
+  /**
+   * @generated from field: string description_regex = 4;
+   */
+  descriptionRegex: string;
+
+  /**
+   * @generated from field: string description_exact = 5;
+   */
+  descriptionExact: string;
+
+  /**
+   * @generated from field: string description_prefix = 6;
+   */
+  descriptionPrefix: string;
+
+  /**
+   * @generated from field: optional int64 amount_min = 7;
+   */
+  amountMin?: bigint;
+
+  /**
+   * @generated from field: optional int64 amount_max = 8;
+   */
+  amountMax?: bigint;
+
+  /**
+   * @generated from field: string entry_type = 9;
+   */
+  entryType: string;
+
+  /**
+   * @generated from field: string currency = 10;
+   */
+  currency: string;
+
+  /**
+   * @generated from field: string account = 11;
+   */
+  account: string;
+
+  /**
+   * @generated from field: string parser_name = 12;
+   */
+  parserName: string;
 };
 
 /**
@@ -423,6 +558,169 @@ export type ApplyCategoryRulesResponse = Message<"api.v1.ApplyCategoryRulesRespo
 export const ApplyCategoryRulesResponseSchema: GenMessage<ApplyCategoryRulesResponse> = /*@__PURE__*/
   messageDesc(file_api_v1_categories, 19);
 
+/**
+ * @generated from message api.v1.CategoryRuleDraft
+ */
+export type CategoryRuleDraft = Message<"api.v1.CategoryRuleDraft"> & {
+  /**
+   * @generated from field: int32 category_id = 1;
+   */
+  categoryId: number;
+
+  /**
+   * @generated from field: string description_contains = 2;
+   */
+  descriptionContains: string;
+
+  /**
+   * @generated from field: string description_regex = 3;
+   */
+  descriptionRegex: string;
+
+  /**
+   * @generated from field: string description_exact = 4;
+   */
+  descriptionExact: string;
+
+  /**
+   * @generated from field: string description_prefix = 5;
+   */
+  descriptionPrefix: string;
+
+  /**
+   * @generated from field: optional int64 amount_min = 6;
+   */
+  amountMin?: bigint;
+
+  /**
+   * @generated from field: optional int64 amount_max = 7;
+   */
+  amountMax?: bigint;
+
+  /**
+   * @generated from field: string entry_type = 8;
+   */
+  entryType: string;
+
+  /**
+   * @generated from field: string currency = 9;
+   */
+  currency: string;
+
+  /**
+   * @generated from field: string account = 10;
+   */
+  account: string;
+
+  /**
+   * @generated from field: string parser_name = 11;
+   */
+  parserName: string;
+};
+
+/**
+ * Describes the message api.v1.CategoryRuleDraft.
+ * Use `create(CategoryRuleDraftSchema)` to create a new message.
+ */
+export const CategoryRuleDraftSchema: GenMessage<CategoryRuleDraft> = /*@__PURE__*/
+  messageDesc(file_api_v1_categories, 20);
+
+/**
+ * @generated from message api.v1.CategoryRuleChange
+ */
+export type CategoryRuleChange = Message<"api.v1.CategoryRuleChange"> & {
+  /**
+   * @generated from field: int32 transaction_id = 1;
+   */
+  transactionId: number;
+
+  /**
+   * @generated from field: string posted_date = 2;
+   */
+  postedDate: string;
+
+  /**
+   * @generated from field: string description = 3;
+   */
+  description: string;
+
+  /**
+   * @generated from field: int64 amount = 4;
+   */
+  amount: bigint;
+
+  /**
+   * @generated from field: string currency = 5;
+   */
+  currency: string;
+
+  /**
+   * @generated from field: optional int32 from_category_id = 6;
+   */
+  fromCategoryId?: number;
+
+  /**
+   * @generated from field: optional int32 to_category_id = 7;
+   */
+  toCategoryId?: number;
+
+  /**
+   * @generated from field: int32 rule_id = 8;
+   */
+  ruleId: number;
+
+  /**
+   * @generated from field: bool draft_rule = 9;
+   */
+  draftRule: boolean;
+};
+
+/**
+ * Describes the message api.v1.CategoryRuleChange.
+ * Use `create(CategoryRuleChangeSchema)` to create a new message.
+ */
+export const CategoryRuleChangeSchema: GenMessage<CategoryRuleChange> = /*@__PURE__*/
+  messageDesc(file_api_v1_categories, 21);
+
+/**
+ * @generated from message api.v1.PreviewCategoryRulesRequest
+ */
+export type PreviewCategoryRulesRequest = Message<"api.v1.PreviewCategoryRulesRequest"> & {
+  /**
+   * @generated from field: bool apply_to_all = 1;
+   */
+  applyToAll: boolean;
+
+  /**
+   * @generated from field: api.v1.CategoryRuleDraft draft = 2;
+   */
+  draft?: CategoryRuleDraft;
+};
+
+/**
+ * Describes the message api.v1.PreviewCategoryRulesRequest.
+ * Use `create(PreviewCategoryRulesRequestSchema)` to create a new message.
+ */
+export const PreviewCategoryRulesRequestSchema: GenMessage<PreviewCategoryRulesRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_categories, 22);
+
+/**
+ * @generated from message api.v1.PreviewCategoryRulesResponse
+ */
+export type PreviewCategoryRulesResponse = Message<"api.v1.PreviewCategoryRulesResponse"> & {
+  /**
+   * @generated from field: repeated api.v1.CategoryRuleChange changes = 1;
+   */
+  changes: CategoryRuleChange[];
+};
+
+/**
+ * Describes the message api.v1.PreviewCategoryRulesResponse.
+ * Use `create(PreviewCategoryRulesResponseSchema)` to create a new message.
+ */
+export const PreviewCategoryRulesResponseSchema: GenMessage<PreviewCategoryRulesResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_categories, 23);
+
 /**
  * @generated from message api.v1.ReorderCategoryRulesRequest
  */
@@ -438,7 +736,7 @@ export type ReorderCategoryRulesRequest = Message<"api.v1.ReorderCategoryRulesRe
  * Use `create(ReorderCategoryRulesRequestSchema)` to create a new message.
  */
 export const ReorderCategoryRulesRequestSchema: GenMessage<ReorderCategoryRulesRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_categories, 20);
+  messageDesc(file_api_v1_categories, 24);
 
 /**
  * @generated from message api.v1.ReorderCategoryRulesResponse
@@ -451,7 +749,7 @@ export type ReorderCategoryRulesResponse = Message<"api.v1.ReorderCategoryRulesR
  * Use `create(ReorderCategoryRulesResponseSchema)` to create a new message.
  */
 export const ReorderCategoryRulesResponseSchema: GenMessage<ReorderCategoryRulesResponse> = /*@__PURE__*/
-  messageDesc(file_api_v1_categories, 21);
+  messageDesc(file_api_v1_categories, 25);
 
 /**
  * @generated from service api.v1.CategoryService
@@ -529,6 +827,14 @@ export const CategoryService: GenService<{
     input: typeof ApplyCategoryRulesRequestSchema;
     output: typeof ApplyCategoryRulesResponseSchema;
   },
+  /**
+   * @generated from rpc api.v1.CategoryService.PreviewCategoryRules
+   */
+  previewCategoryRules: {
+    methodKind: "unary";
+    input: typeof PreviewCategoryRulesRequestSchema;
+    output: typeof PreviewCategoryRulesResponseSchema;
+  },
   /**
    * @generated from rpc api.v1.CategoryService.ReorderCategoryRules
    */
@@ -0,0 +1,405 @@
+// @generated by protoc-gen-es v2.10.1 with parameter "target=ts"
+// @generated from file api/v1/csv_templates.proto (package api.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file api/v1/csv_templates.proto.
+ */
+export const file_api_v1_csv_templates: GenFile = /*@__PURE__*/
+  fileDesc("ChphcGkvdjEvY3N2X3RlbXBsYXRlcy5wcm90bxIGYXBpLnYxItICCgtDc3ZUZW1wbGF0ZRIKCgJpZBgBIAEoBRIMCgRuYW1lGAIgASgJEhEKCXNpZ25hdHVyZRgDIAEoCRIRCglkZWxpbWl0ZXIYBCABKAkSEwoLZGF0ZV9jb2x1bW4YBSABKAkSEwoLZGF0ZV9mb3JtYXQYBiABKAkSGQoRZGVjaW1hbF9zZXBhcmF0b3IYByABKAkSGwoTZGVzY3JpcHRpb25fY29sdW1ucxgIIAMoCRIVCg1hbW91bnRfY29sdW1uGAkgASgJEhQKDGRlYml0X2NvbHVtbhgKIAEoCRIVCg1jcmVkaXRfY29sdW1uGAsgASgJEhcKD2N1cnJlbmN5X2NvbHVtbhgMIAEoCRIWCg5hY2NvdW50X2NvbHVtbhgNIAEoCRIYChBkZWZhdWx0X2N1cnJlbmN5GA4gASgJEhIKCmNyZWF0ZWRfYXQYDyABKAkirQEKDkNzdlRlbXBsYXRlUm93EgsKA3JvdxgBIAEoBRITCgtwb3N0ZWRfZGF0ZRgCIAEoCRITCgtkZXNjcmlwdGlvbhgDIAEoCRIOCgZhbW91bnQYBCABKAMSEAoIY3VycmVuY3kYBSABKAkSEgoKZW50cnlfdHlwZRgGIAEoCRIWCg5hY2NvdW50X251bWJlchgHIAEoCRIWCg50cmFuc2FjdGlvbl9pZBgIIAEoCSIzChNDc3ZUZW1wbGF0ZVJvd0Vycm9yEgsKA3JvdxgBIAEoBRIPCgdtZXNzYWdlGAIgASgJIhkKF0xpc3RDc3ZUZW1wbGF0ZXNSZXF1ZXN0IkIKGExpc3RDc3ZUZW1wbGF0ZXNSZXNwb25zZRImCgl0ZW1wbGF0ZXMYASADKAsyEy5hcGkudjEuQ3N2VGVtcGxhdGUiQQoYQ3JlYXRlQ3N2VGVtcGxhdGVSZXF1ZXN0EiUKCHRlbXBsYXRlGAEgASgLMhMuYXBpLnYxLkNzdlRlbXBsYXRlIkIKGUNyZWF0ZUNzdlRlbXBsYXRlUmVzcG9uc2USJQoIdGVtcGxhdGUYASABKAsyEy5hcGkudjEuQ3N2VGVtcGxhdGUiQQoYVXBkYXRlQ3N2VGVtcGxhdGVSZXF1ZXN0EiUKCHRlbXBsYXRlGAEgASgLMhMuYXBpLnYxLkNzdlRlbXBsYXRlIhsKGVVwZGF0ZUNzdlRlbXBsYXRlUmVzcG9uc2UiJgoYRGVsZXRlQ3N2VGVtcGxhdGVSZXF1ZXN0EgoKAmlkGAEgASgFIhsKGURlbGV0ZUNzdlRlbXBsYXRlUmVzcG9uc2UiYQoWVGVzdENzdlRlbXBsYXRlUmVxdWVzdBIlCgh0ZW1wbGF0ZRgBIAEoCzITLmFwaS52MS5Dc3ZUZW1wbGF0ZRIRCglyZXBvcnRfaWQYAiABKAUSDQoFbGltaXQYAyABKAUimwEKF1Rlc3RDc3ZUZW1wbGF0ZVJlc3BvbnNlEhkKEXNpZ25hdHVyZV9tYXRjaGVzGAEgASgIEiQKBHJvd3MYAiADKAsyFi5hcGkudjEuQ3N2VGVtcGxhdGVSb3cSKwoGZXJyb3JzGAMgAygLMhsuYXBpLnYxLkNzdlRlbXBsYXRlUm93RXJyb3ISEgoKdG90YWxfcm93cxgEIAEoBTLdAwoSQ3N2VGVtcGxhdGVTZXJ2aWNlEloKEExpc3RDc3ZUZW1wbGF0ZXMSHy5hcGkudjEuTGlzdENzdlRlbXBsYXRlc1JlcXVlc3QaIC5hcGkudjEuTGlzdENzdlRlbXBsYXRlc1Jlc3BvbnNlIgOQAgESWgoRQ3JlYXRlQ3N2VGVtcGxhdGUSIC5hcGkudjEuQ3JlYXRlQ3N2VGVtcGxhdGVSZXF1ZXN0GiEuYXBpLnYxLkNyZWF0ZUNzdlRlbXBsYXRlUmVzcG9uc2UiABJaChFVcGRhdGVDc3ZUZW1wbGF0ZRIgLmFwaS52MS5VcGRhdGVDc3ZUZW1wbGF0ZVJlcXVlc3QaIS5hcGkudjEuVXBkYXRlQ3N2VGVtcGxhdGVSZXNwb25zZSIAEloKEURlbGV0ZUNzdlRlbXBsYXRlEiAuYXBpLnYxLkRlbGV0ZUNzdlRlbXBsYXRlUmVxdWVzdBohLmFwaS52MS5EZWxldGVDc3ZUZW1wbGF0ZVJlc3BvbnNlIgASVwoPVGVzdENzdlRlbXBsYXRlEh4uYXBpLnYxLlRlc3RDc3ZUZW1wbGF0ZVJlcXVlc3QaHy5hcGkudjEuVGVzdENzdlRlbXBsYXRlUmVzcG9uc2UiA5ACAUJ8Cgpjb20uYXBpLnYxQhFDc3ZUZW1wbGF0ZXNQcm90b1ABWiJjYXNodHJhY2svYmFja2VuZC9nZW4vYXBpL3YxO2FwaXYxogIDQVhYqgIGQXBpLlYxygIGQXBpXFYx4gISQXBpXFYxXEdQQk1ldGFkYXRh6gIHQXBpOjpWMWIGcHJvdG8z");
+
+/**
+ * @generated from message api.v1.CsvTemplate
+ */
+export type CsvTemplate = Message<"api.v1.CsvTemplate"> & {
+  /**
+   * @generated from field: int32 id = 1;
+   */
+  id: number;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string signature = 3;
+   */
+  signature: string;
+
+  /**
+   * @generated from field: string delimiter = 4;
+   */
+  delimiter: string;
+
+  /**
+   * @generated from field: string date_column = 5;
+   */
+  dateColumn: string;
+
+  /**
+   * @generated from field: string date_format = 6;
+   */
+  dateFormat: string;
+
+  /**
+   * @generated from field: string decimal_separator = 7;
+   */
+  decimalSeparator: string;
+
+  /**
+   * @generated from field: repeated string description_columns = 8;
+   */
+  descriptionColumns: string[];
+
+  /**
+   * @generated from field: string amount_column = 9;
+   */
+  amountColumn: string;
+
+  /**
+   * @generated from field: string debit_column = 10;
+   */
+  debitColumn: string;
+
+  /**
+   * @generated from field: string credit_column = 11;
+   */
+  creditColumn: string;
+
+  /**
+   * @generated from field: string currency_column = 12;
+   */
+  currencyColumn: string;
+
+  /**
+   * @generated from field: string account_column = 13;
+   */
+  accountColumn: string;
+
+  /**
+   * @generated from field: string default_currency = 14;
+   */
+  defaultCurrency: string;
+
+  /**
+   * @generated from field: string created_at = 15;
+   */
+  createdAt: string;
+};
+
+/**
+ * Describes the message api.v1.CsvTemplate.
+ * Use `create(CsvTemplateSchema)` to create a new message.
+ */
+export const CsvTemplateSchema: GenMessage<CsvTemplate> = /*@__PURE__*/
+  messageDesc(file_api_v1_csv_templates, 0);
+
+/**
+ * @generated from message api.v1.CsvTemplateRow
+ */
+export type CsvTemplateRow = Message<"api.v1.CsvTemplateRow"> & {
+  /**
+   * @generated from field: int32 row = 1;
+   */
+  row: number;
+
+  /**
+   * @generated from field: string posted_date = 2;
+   */
+  postedDate: string;
+
+  /**
+   * @generated from field: string description = 3;
+   */
+  description: string;
+
+  /**
+   * @generated from field: int64 amount = 4;
+   */
+  amount: bigint;
+
+  /**
+   * @generated from field: string currency = 5;
+   */
+  currency: string;
+
+  /**
+   * @generated from field: string entry_type = 6;
+   */
+  entryType: string;
+
+  /**
+   * @generated from field: string account_number = 7;
+   */
+  accountNumber: string;
+
+  /**
+   * @generated from field: string transaction_id = 8;
+   */
+  transactionId: string;
+};
+
+/**
+ * Describes the message api.v1.CsvTemplateRow.
+ * Use `create(CsvTemplateRowSchema)` to create a new message.
+ */
+export const CsvTemplateRowSchema: GenMessage<CsvTemplateRow> = /*@__PURE__*/
+  messageDesc(file_api_v1_csv_templates, 1);
+
+/**
+ * @generated from message api.v1.CsvTemplateRowError
+ */
+export type CsvTemplateRowError = Message<"api.v1.CsvTemplateRowError"> & {
+  /**
+   * @generated from field: int32 row = 1;
+   */
+  row: number;
+
+  /**
+   * @generated from field: string message = 2;
+   */
+  message: string;
+};
+
+/**
+ * Describes the message api.v1.CsvTemplateRowError.
+ * Use `create(CsvTemplateRowErrorSchema)` to create a new message.
+ */
+export const CsvTemplateRowErrorSchema: GenMessage<CsvTemplateRowError> = /*@__PURE__*/
+  messageDesc(file_api_v1_csv_templates, 2);
+
+/**
+ * @generated from message api.v1.ListCsvTemplatesRequest
+ */
+export type ListCsvTemplatesRequest = Message<"api.v1.ListCsvTemplatesRequest"> & {
+};
+
+/**
+ * Describes the message api.v1.ListCsvTemplatesRequest.
+ * Use `create(ListCsvTemplatesRequestSchema)` to create a new message.
+ */
+export const ListCsvTemplatesRequestSchema: GenMessage<ListCsvTemplatesRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_csv_templates, 3);
+
+/**
+ * @generated from message api.v1.ListCsvTemplatesResponse
+ */
+export type ListCsvTemplatesResponse = Message<"api.v1.ListCsvTemplatesResponse"> & {
+  /**
+   * @generated from field: repeated api.v1.CsvTemplate templates = 1;
+   */
+  templates: CsvTemplate[];
+};
+
+/**
+ * Describes the message api.v1.ListCsvTemplatesResponse.
+ * Use `create(ListCsvTemplatesResponseSchema)` to create a new message.
+ */
+export const ListCsvTemplatesResponseSchema: GenMessage<ListCsvTemplatesResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_csv_templates, 4);
+
+/**
+ * @generated from message api.v1.CreateCsvTemplateRequest
+ */
+export type CreateCsvTemplateRequest = Message<"api.v1.CreateCsvTemplateRequest"> & {
+  /**
+   * @generated from field: api.v1.CsvTemplate template = 1;
+   */
+  template?: CsvTemplate;
+};
+
+/**
+ * Describes the message api.v1.CreateCsvTemplateRequest.
+ * Use `create(CreateCsvTemplateRequestSchema)` to create a new message.
+ */
+export const CreateCsvTemplateRequestSchema: GenMessage<CreateCsvTemplateRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_csv_templates, 5);
+
+/**
+ * @generated from message api.v1.CreateCsvTemplateResponse
+ */
+export type CreateCsvTemplateResponse = Message<"api.v1.CreateCsvTemplateResponse"> & {
+  /**
+   * @generated from field: api.v1.CsvTemplate template = 1;
+   */
+  template?: CsvTemplate;
+};
+
+/**
+ * Describes the message api.v1.CreateCsvTemplateResponse.
+ * Use `create(CreateCsvTemplateResponseSchema)` to create a new message.
+ */
+export const CreateCsvTemplateResponseSchema: GenMessage<CreateCsvTemplateResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_csv_templates, 6);
+
+/**
+ * @generated from message api.v1.UpdateCsvTemplateRequest
+ */
+export type UpdateCsvTemplateRequest = Message<"api.v1.UpdateCsvTemplateRequest"> & {
+  /**
+   * @generated from field: api.v1.CsvTemplate template = 1;
+   */
+  template?: CsvTemplate;
+};
+
+/**
+ * Describes the message api.v1.UpdateCsvTemplateRequest.
+ * Use `create(UpdateCsvTemplateRequestSchema)` to create a new message.
+ */
+export const UpdateCsvTemplateRequestSchema: GenMessage<UpdateCsvTemplateRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_csv_templates, 7);
+
+/**
+ * @generated from message api.v1.UpdateCsvTemplateResponse
+ */
+export type UpdateCsvTemplateResponse = Message<"api.v1.UpdateCsvTemplateResponse"> & {
+};
+
+/**
+ * Describes the message api.v1.UpdateCsvTemplateResponse.
+ * Use `create(UpdateCsvTemplateResponseSchema)` to create a new message.
+ */
+export const UpdateCsvTemplateResponseSchema: GenMessage<UpdateCsvTemplateResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_csv_templates, 8);
+
+/**
+ * @generated from message api.v1.DeleteCsvTemplateRequest
+ */
+export type DeleteCsvTemplateRequest = Message<"api.v1.DeleteCsvTemplateRequest"> & {
+  /**
+   * @generated from field: int32 id = 1;
+   */
+  id: number;
+};
+
+/**
+ * Describes the message api.v1.DeleteCsvTemplateRequest.
+ * Use `create(DeleteCsvTemplateRequestSchema)` to create a new message.
+ */
+export const DeleteCsvTemplateRequestSchema: GenMessage<DeleteCsvTemplateRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_csv_templates, 9);
+
+/**
+ * @generated from message api.v1.DeleteCsvTemplateResponse
+ */
+export type DeleteCsvTemplateResponse = Message<"api.v1.DeleteCsvTemplateResponse"> & {
+};
+
+/**
+ * Describes the message api.v1.DeleteCsvTemplateResponse.
+ * Use `create(DeleteCsvTemplateResponseSchema)` to create a new message.
+ */
+export const DeleteCsvTemplateResponseSchema: GenMessage<DeleteCsvTemplateResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_csv_templates, 10);
+
+/**
+ * @generated from message api.v1.TestCsvTemplateRequest
+ */
+export type TestCsvTemplateRequest = Message<"api.v1.TestCsvTemplateRequest"> & {
+  /**
+   * @generated from field: api.v1.CsvTemplate template = 1;
+   */
+  template?: CsvTemplate;
+
+  /**
+   * @generated from field: int32 report_id = 2;
+   */
+  reportId: number;
+
+  /**
+   * @generated from field: int32 limit = 3;
+   */
+  limit: number;
+};
+
+/**
+ * Describes the message api.v1.TestCsvTemplateRequest.
+ * Use `create(TestCsvTemplateRequestSchema)` to create a new message.
+ */
+export const TestCsvTemplateRequestSchema: GenMessage<TestCsvTemplateRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_csv_templates, 11);
+
+/**
+ * @generated from message api.v1.TestCsvTemplateResponse
+ */
+export type TestCsvTemplateResponse = Message<"api.v1.TestCsvTemplateResponse"> & {
+  /**
+   * @generated from field: bool signature_matches = 1;
+   */
+  signatureMatches: boolean;
+
+  /**
+   * @generated from field: repeated api.v1.CsvTemplateRow rows = 2;
+   */
+  rows: CsvTemplateRow[];
+
+  /**
+   * @generated from field: repeated api.v1.CsvTemplateRowError errors = 3;
+   */
+  errors: CsvTemplateRowError[];
+
+  /**
+   * @generated from field: int32 total_rows = 4;
+   */
+  totalRows: number;
+};
+
+/**
+ * Describes the message api.v1.TestCsvTemplateResponse.
+ * Use `create(TestCsvTemplateResponseSchema)` to create a new message.
+ */
+export const TestCsvTemplateResponseSchema: GenMessage<TestCsvTemplateResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_csv_templates, 12);
+
+/**
+ * @generated from service api.v1.CsvTemplateService
+ */
+export const CsvTemplateService: GenService<{
+  /**
+   * @generated from rpc api.v1.CsvTemplateService.ListCsvTemplates
+   */
+  listCsvTemplates: {
+    methodKind: "unary";
+    input: typeof ListCsvTemplatesRequestSchema;
+    output: typeof ListCsvTemplatesResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.CsvTemplateService.CreateCsvTemplate
+   */
+  createCsvTemplate: {
+    methodKind: "unary";
+    input: typeof CreateCsvTemplateRequestSchema;
+    output: typeof CreateCsvTemplateResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.CsvTemplateService.UpdateCsvTemplate
+   */
+  updateCsvTemplate: {
+    methodKind: "unary";
+    input: typeof UpdateCsvTemplateRequestSchema;
+    output: typeof UpdateCsvTemplateResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.CsvTemplateService.DeleteCsvTemplate
+   */
+  deleteCsvTemplate: {
+    methodKind: "unary";
+    input: typeof DeleteCsvTemplateRequestSchema;
+    output: typeof DeleteCsvTemplateResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.CsvTemplateService.TestCsvTemplate
+   */
+  testCsvTemplate: {
+    methodKind: "unary";
+    input: typeof TestCsvTemplateRequestSchema;
+    output: typeof TestCsvTemplateResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_api_v1_csv_templates, 0);
+
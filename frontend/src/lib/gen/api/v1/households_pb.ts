@@ -0,0 +1,449 @@
+// @generated by protoc-gen-es v2.10.1 with parameter "target=ts"
+// @generated from file api/v1/households.proto (package api.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file api/v1/households.proto.
+ */
+export const file_api_v1_households: GenFile = /*@__PURE__*/
+  fileDesc("ChdhcGkvdjEvaG91c2Vob2xkcy5wcm90bxIGYXBpLnYxIl4KCUhvdXNlaG9sZBIKCgJpZBgBIAEoBRIMCgRuYW1lGAIgASgJEgwKBHJvbGUYAyABKAkSFQoNYmFzZV9jdXJyZW5jeRgEIAEoCRISCgpjcmVhdGVkX2F0GAUgASgJIlUKD0hvdXNlaG9sZE1lbWJlchIPCgd1c2VyX2lkGAEgASgFEhAKCHVzZXJuYW1lGAIgASgJEgwKBHJvbGUYAyABKAkSEQoJam9pbmVkX2F0GAQgASgJIhcKFUxpc3RIb3VzZWhvbGRzUmVxdWVzdCI/ChZMaXN0SG91c2Vob2xkc1Jlc3BvbnNlEiUKCmhvdXNlaG9sZHMYASADKAsyES5hcGkudjEuSG91c2Vob2xkIiYKFkNyZWF0ZUhvdXNlaG9sZFJlcXVlc3QSDAoEbmFtZRgBIAEoCSI/ChdDcmVhdGVIb3VzZWhvbGRSZXNwb25zZRIkCglob3VzZWhvbGQYASABKAsyES5hcGkudjEuSG91c2Vob2xkIlMKFlVwZGF0ZUhvdXNlaG9sZFJlcXVlc3QSFAoMaG91c2Vob2xkX2lkGAEgASgFEgwKBG5hbWUYAiABKAkSFQoNYmFzZV9jdXJyZW5jeRgDIAEoCSI/ChdVcGRhdGVIb3VzZWhvbGRSZXNwb25zZRIkCglob3VzZWhvbGQYASABKAsyES5hcGkudjEuSG91c2Vob2xkIjMKG0xpc3RIb3VzZWhvbGRNZW1iZXJzUmVxdWVzdBIUCgxob3VzZWhvbGRfaWQYASABKAUiSAocTGlzdEhvdXNlaG9sZE1lbWJlcnNSZXNwb25zZRIoCgdtZW1iZXJzGAEgAygLMhcuYXBpLnYxLkhvdXNlaG9sZE1lbWJlciJTChxVcGRhdGVIb3VzZWhvbGRNZW1iZXJSZXF1ZXN0EhQKDGhvdXNlaG9sZF9pZBgBIAEoBRIPCgd1c2VyX2lkGAIgASgFEgwKBHJvbGUYAyABKAkiHwodVXBkYXRlSG91c2Vob2xkTWVtYmVyUmVzcG9uc2UiRQocUmVtb3ZlSG91c2Vob2xkTWVtYmVyUmVxdWVzdBIUCgxob3VzZWhvbGRfaWQYASABKAUSDwoHdXNlcl9pZBgCIAEoBSIfCh1SZW1vdmVIb3VzZWhvbGRNZW1iZXJSZXNwb25zZSJGCiBDcmVhdGVIb3VzZWhvbGRJbnZpdGF0aW9uUmVxdWVzdBIUCgxob3VzZWhvbGRfaWQYASABKAUSDAoEcm9sZRgCIAEoCSJGCiFDcmVhdGVIb3VzZWhvbGRJbnZpdGF0aW9uUmVzcG9uc2USDQoFdG9rZW4YASABKAkSEgoKZXhwaXJlc19hdBgCIAEoCSIxCiBBY2NlcHRIb3VzZWhvbGRJbnZpdGF0aW9uUmVxdWVzdBINCgV0b2tlbhgBIAEoCSJJCiFBY2NlcHRIb3VzZWhvbGRJbnZpdGF0aW9uUmVzcG9uc2USJAoJaG91c2Vob2xkGAEgASgLMhEuYXBpLnYxLkhvdXNlaG9sZDK0BgoQSG91c2Vob2xkU2VydmljZRJUCg5MaXN0SG91c2Vob2xkcxIdLmFwaS52MS5MaXN0SG91c2Vob2xkc1JlcXVlc3QaHi5hcGkudjEuTGlzdEhvdXNlaG9sZHNSZXNwb25zZSIDkAIBElQKD0NyZWF0ZUhvdXNlaG9sZBIeLmFwaS52MS5DcmVhdGVIb3VzZWhvbGRSZXF1ZXN0Gh8uYXBpLnYxLkNyZWF0ZUhvdXNlaG9sZFJlc3BvbnNlIgASVAoPVXBkYXRlSG91c2Vob2xkEh4uYXBpLnYxLlVwZGF0ZUhvdXNlaG9sZFJlcXVlc3QaHy5hcGkudjEuVXBkYXRlSG91c2Vob2xkUmVzcG9uc2UiABJmChRMaXN0SG91c2Vob2xkTWVtYmVycxIjLmFwaS52MS5MaXN0SG91c2Vob2xkTWVtYmVyc1JlcXVlc3QaJC5hcGkudjEuTGlzdEhvdXNlaG9sZE1lbWJlcnNSZXNwb25zZSIDkAIBEmYKFVVwZGF0ZUhvdXNlaG9sZE1lbWJlchIkLmFwaS52MS5VcGRhdGVIb3VzZWhvbGRNZW1iZXJSZXF1ZXN0GiUuYXBpLnYxLlVwZGF0ZUhvdXNlaG9sZE1lbWJlclJlc3BvbnNlIgASZgoVUmVtb3ZlSG91c2Vob2xkTWVtYmVyEiQuYXBpLnYxLlJlbW92ZUhvdXNlaG9sZE1lbWJlclJlcXVlc3QaJS5hcGkudjEuUmVtb3ZlSG91c2Vob2xkTWVtYmVyUmVzcG9uc2UiABJyChlDcmVhdGVIb3VzZWhvbGRJbnZpdGF0aW9uEiguYXBpLnYxLkNyZWF0ZUhvdXNlaG9sZEludml0YXRpb25SZXF1ZXN0GikuYXBpLnYxLkNyZWF0ZUhvdXNlaG9sZEludml0YXRpb25SZXNwb25zZSIAEnIKGUFjY2VwdEhvdXNlaG9sZEludml0YXRpb24SKC5hcGkudjEuQWNjZXB0SG91c2Vob2xkSW52aXRhdGlvblJlcXVlc3QaKS5hcGkudjEuQWNjZXB0SG91c2Vob2xkSW52aXRhdGlvblJlc3BvbnNlIgBCegoKY29tLmFwaS52MUIPSG91c2Vob2xkc1Byb3RvUAFaImNhc2h0cmFjay9iYWNrZW5kL2dlbi9hcGkvdjE7YXBpdjGiAgNBWFiqAgZBcGkuVjHKAgZBcGlcVjHiAhJBcGlcVjFcR1BCTWV0YWRhdGHqAgdBcGk6OlYxYgZwcm90bzM");
+
+/**
+ * @generated from message api.v1.Household
+ */
+export type Household = Message<"api.v1.Household"> & {
+  /**
+   * @generated from field: int32 id = 1;
+   */
+  id: number;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string role = 3;
+   */
+  role: string;
+
+  /**
+   * @generated from field: string base_currency = 4;
+   */
+  baseCurrency: string;
+
+  /**
+   * @generated from field: string created_at = 5;
+   */
+  createdAt: string;
+};
+
+/**
+ * Describes the message api.v1.Household.
+ * Use `create(HouseholdSchema)` to create a new message.
+ */
+export const HouseholdSchema: GenMessage<Household> = /*@__PURE__*/
+  messageDesc(file_api_v1_households, 0);
+
+/**
+ * @generated from message api.v1.HouseholdMember
+ */
+export type HouseholdMember = Message<"api.v1.HouseholdMember"> & {
+  /**
+   * @generated from field: int32 user_id = 1;
+   */
+  userId: number;
+
+  /**
+   * @generated from field: string username = 2;
+   */
+  username: string;
+
+  /**
+   * @generated from field: string role = 3;
+   */
+  role: string;
+
+  /**
+   * @generated from field: string joined_at = 4;
+   */
+  joinedAt: string;
+};
+
+/**
+ * Describes the message api.v1.HouseholdMember.
+ * Use `create(HouseholdMemberSchema)` to create a new message.
+ */
+export const HouseholdMemberSchema: GenMessage<HouseholdMember> = /*@__PURE__*/
+  messageDesc(file_api_v1_households, 1);
+
+/**
+ * @generated from message api.v1.ListHouseholdsRequest
+ */
+export type ListHouseholdsRequest = Message<"api.v1.ListHouseholdsRequest"> & {
+};
+
+/**
+ * Describes the message api.v1.ListHouseholdsRequest.
+ * Use `create(ListHouseholdsRequestSchema)` to create a new message.
+ */
+export const ListHouseholdsRequestSchema: GenMessage<ListHouseholdsRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_households, 2);
+
+/**
+ * @generated from message api.v1.ListHouseholdsResponse
+ */
+export type ListHouseholdsResponse = Message<"api.v1.ListHouseholdsResponse"> & {
+  /**
+   * @generated from field: repeated api.v1.Household households = 1;
+   */
+  households: Household[];
+};
+
+/**
+ * Describes the message api.v1.ListHouseholdsResponse.
+ * Use `create(ListHouseholdsResponseSchema)` to create a new message.
+ */
+export const ListHouseholdsResponseSchema: GenMessage<ListHouseholdsResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_households, 3);
+
+/**
+ * @generated from message api.v1.CreateHouseholdRequest
+ */
+export type CreateHouseholdRequest = Message<"api.v1.CreateHouseholdRequest"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message api.v1.CreateHouseholdRequest.
+ * Use `create(CreateHouseholdRequestSchema)` to create a new message.
+ */
+export const CreateHouseholdRequestSchema: GenMessage<CreateHouseholdRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_households, 4);
+
+/**
+ * @generated from message api.v1.CreateHouseholdResponse
+ */
+export type CreateHouseholdResponse = Message<"api.v1.CreateHouseholdResponse"> & {
+  /**
+   * @generated from field: api.v1.Household household = 1;
+   */
+  household?: Household;
+};
+
+/**
+ * Describes the message api.v1.CreateHouseholdResponse.
+ * Use `create(CreateHouseholdResponseSchema)` to create a new message.
+ */
+export const CreateHouseholdResponseSchema: GenMessage<CreateHouseholdResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_households, 5);
+
+/**
+ * @generated from message api.v1.UpdateHouseholdRequest
+ */
+export type UpdateHouseholdRequest = Message<"api.v1.UpdateHouseholdRequest"> & {
+  /**
+   * @generated from field: int32 household_id = 1;
+   */
+  householdId: number;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string base_currency = 3;
+   */
+  baseCurrency: string;
+};
+
+/**
+ * Describes the message api.v1.UpdateHouseholdRequest.
+ * Use `create(UpdateHouseholdRequestSchema)` to create a new message.
+ */
+export const UpdateHouseholdRequestSchema: GenMessage<UpdateHouseholdRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_households, 6);
+
+/**
+ * @generated from message api.v1.UpdateHouseholdResponse
+ */
+export type UpdateHouseholdResponse = Message<"api.v1.UpdateHouseholdResponse"> & {
+  /**
+   * @generated from field: api.v1.Household household = 1;
+   */
+  household?: Household;
+};
+
+/**
+ * Describes the message api.v1.UpdateHouseholdResponse.
+ * Use `create(UpdateHouseholdResponseSchema)` to create a new message.
+ */
+export const UpdateHouseholdResponseSchema: GenMessage<UpdateHouseholdResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_households, 7);
+
+/**
+ * @generated from message api.v1.ListHouseholdMembersRequest
+ */
+export type ListHouseholdMembersRequest = Message<"api.v1.ListHouseholdMembersRequest"> & {
+  /**
+   * @generated from field: int32 household_id = 1;
+   */
+  householdId: number;
+};
+
+/**
+ * Describes the message api.v1.ListHouseholdMembersRequest.
+ * Use `create(ListHouseholdMembersRequestSchema)` to create a new message.
+ */
+export const ListHouseholdMembersRequestSchema: GenMessage<ListHouseholdMembersRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_households, 8);
+
+/**
+ * @generated from message api.v1.ListHouseholdMembersResponse
+ */
+export type ListHouseholdMembersResponse = Message<"api.v1.ListHouseholdMembersResponse"> & {
+  /**
+   * @generated from field: repeated api.v1.HouseholdMember members = 1;
+   */
+  members: HouseholdMember[];
+};
+
+/**
+ * Describes the message api.v1.ListHouseholdMembersResponse.
+ * Use `create(ListHouseholdMembersResponseSchema)` to create a new message.
+ */
+export const ListHouseholdMembersResponseSchema: GenMessage<ListHouseholdMembersResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_households, 9);
+
+/**
+ * @generated from message api.v1.UpdateHouseholdMemberRequest
+ */
+export type UpdateHouseholdMemberRequest = Message<"api.v1.UpdateHouseholdMemberRequest"> & {
+  /**
+   * @generated from field: int32 household_id = 1;
+   */
+  householdId: number;
+
+  /**
+   * @generated from field: int32 user_id = 2;
+   */
+  userId: number;
+
+  /**
+   * @generated from field: string role = 3;
+   */
+  role: string;
+};
+
+/**
+ * Describes the message api.v1.UpdateHouseholdMemberRequest.
+ * Use `create(UpdateHouseholdMemberRequestSchema)` to create a new message.
+ */
+export const UpdateHouseholdMemberRequestSchema: GenMessage<UpdateHouseholdMemberRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_households, 10);
+
+/**
+ * @generated from message api.v1.UpdateHouseholdMemberResponse
+ */
+export type UpdateHouseholdMemberResponse = Message<"api.v1.UpdateHouseholdMemberResponse"> & {
+};
+
+/**
+ * Describes the message api.v1.UpdateHouseholdMemberResponse.
+ * Use `create(UpdateHouseholdMemberResponseSchema)` to create a new message.
+ */
+export const UpdateHouseholdMemberResponseSchema: GenMessage<UpdateHouseholdMemberResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_households, 11);
+
+/**
+ * @generated from message api.v1.RemoveHouseholdMemberRequest
+ */
+export type RemoveHouseholdMemberRequest = Message<"api.v1.RemoveHouseholdMemberRequest"> & {
+  /**
+   * @generated from field: int32 household_id = 1;
+   */
+  householdId: number;
+
+  /**
+   * @generated from field: int32 user_id = 2;
+   */
+  userId: number;
+};
+
+/**
+ * Describes the message api.v1.RemoveHouseholdMemberRequest.
+ * Use `create(RemoveHouseholdMemberRequestSchema)` to create a new message.
+ */
+export const RemoveHouseholdMemberRequestSchema: GenMessage<RemoveHouseholdMemberRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_households, 12);
+
+/**
+ * @generated from message api.v1.RemoveHouseholdMemberResponse
+ */
+export type RemoveHouseholdMemberResponse = Message<"api.v1.RemoveHouseholdMemberResponse"> & {
+};
+
+/**
+ * Describes the message api.v1.RemoveHouseholdMemberResponse.
+ * Use `create(RemoveHouseholdMemberResponseSchema)` to create a new message.
+ */
+export const RemoveHouseholdMemberResponseSchema: GenMessage<RemoveHouseholdMemberResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_households, 13);
+
+/**
+ * @generated from message api.v1.CreateHouseholdInvitationRequest
+ */
+export type CreateHouseholdInvitationRequest = Message<"api.v1.CreateHouseholdInvitationRequest"> & {
+  /**
+   * @generated from field: int32 household_id = 1;
+   */
+  householdId: number;
+
+  /**
+   * @generated from field: string role = 2;
+   */
+  role: string;
+};
+
+/**
+ * Describes the message api.v1.CreateHouseholdInvitationRequest.
+ * Use `create(CreateHouseholdInvitationRequestSchema)` to create a new message.
+ */
+export const CreateHouseholdInvitationRequestSchema: GenMessage<CreateHouseholdInvitationRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_households, 14);
+
+/**
+ * @generated from message api.v1.CreateHouseholdInvitationResponse
+ */
+export type CreateHouseholdInvitationResponse = Message<"api.v1.CreateHouseholdInvitationResponse"> & {
+  /**
+   * @generated from field: string token = 1;
+   */
+  token: string;
+
+  /**
+   * @generated from field: string expires_at = 2;
+   */
+  expiresAt: string;
+};
+
+/**
+ * Describes the message api.v1.CreateHouseholdInvitationResponse.
+ * Use `create(CreateHouseholdInvitationResponseSchema)` to create a new message.
+ */
+export const CreateHouseholdInvitationResponseSchema: GenMessage<CreateHouseholdInvitationResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_households, 15);
+
+/**
+ * @generated from message api.v1.AcceptHouseholdInvitationRequest
+ */
+export type AcceptHouseholdInvitationRequest = Message<"api.v1.AcceptHouseholdInvitationRequest"> & {
+  /**
+   * @generated from field: string token = 1;
+   */
+  token: string;
+};
+
+/**
+ * Describes the message api.v1.AcceptHouseholdInvitationRequest.
+ * Use `create(AcceptHouseholdInvitationRequestSchema)` to create a new message.
+ */
+export const AcceptHouseholdInvitationRequestSchema: GenMessage<AcceptHouseholdInvitationRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_households, 16);
+
+/**
+ * @generated from message api.v1.AcceptHouseholdInvitationResponse
+ */
+export type AcceptHouseholdInvitationResponse = Message<"api.v1.AcceptHouseholdInvitationResponse"> & {
+  /**
+   * @generated from field: api.v1.Household household = 1;
+   */
+  household?: Household;
+};
+
+/**
+ * Describes the message api.v1.AcceptHouseholdInvitationResponse.
+ * Use `create(AcceptHouseholdInvitationResponseSchema)` to create a new message.
+ */
+export const AcceptHouseholdInvitationResponseSchema: GenMessage<AcceptHouseholdInvitationResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_households, 17);
+
+/**
+ * @generated from service api.v1.HouseholdService
+ */
+export const HouseholdService: GenService<{
+  /**
+   * @generated from rpc api.v1.HouseholdService.ListHouseholds
+   */
+  listHouseholds: {
+    methodKind: "unary";
+    input: typeof ListHouseholdsRequestSchema;
+    output: typeof ListHouseholdsResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.HouseholdService.CreateHousehold
+   */
+  createHousehold: {
+    methodKind: "unary";
+    input: typeof CreateHouseholdRequestSchema;
+    output: typeof CreateHouseholdResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.HouseholdService.UpdateHousehold
+   */
+  updateHousehold: {
+    methodKind: "unary";
+    input: typeof UpdateHouseholdRequestSchema;
+    output: typeof UpdateHouseholdResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.HouseholdService.ListHouseholdMembers
+   */
+  listHouseholdMembers: {
+    methodKind: "unary";
+    input: typeof ListHouseholdMembersRequestSchema;
+    output: typeof ListHouseholdMembersResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.HouseholdService.UpdateHouseholdMember
+   */
+  updateHouseholdMember: {
+    methodKind: "unary";
+    input: typeof UpdateHouseholdMemberRequestSchema;
+    output: typeof UpdateHouseholdMemberResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.HouseholdService.RemoveHouseholdMember
+   */
+  removeHouseholdMember: {
+    methodKind: "unary";
+    input: typeof RemoveHouseholdMemberRequestSchema;
+    output: typeof RemoveHouseholdMemberResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.HouseholdService.CreateHouseholdInvitation
+   */
+  createHouseholdInvitation: {
+    methodKind: "unary";
+    input: typeof CreateHouseholdInvitationRequestSchema;
+    output: typeof CreateHouseholdInvitationResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.HouseholdService.AcceptHouseholdInvitation
+   */
+  acceptHouseholdInvitation: {
+    methodKind: "unary";
+    input: typeof AcceptHouseholdInvitationRequestSchema;
+    output: typeof AcceptHouseholdInvitationResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_api_v1_households, 0);
+
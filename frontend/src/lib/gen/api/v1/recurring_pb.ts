@@ -0,0 +1,312 @@
+// @generated by protoc-gen-es v2.10.1 with parameter "target=ts"
+// @generated from file api/v1/recurring.proto (package api.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file api/v1/recurring.proto.
+ */
+export const file_api_v1_recurring: GenFile = /*@__PURE__*/
+  fileDesc("ChZhcGkvdjEvcmVjdXJyaW5nLnByb3RvEgZhcGkudjEiqQIKD1JlY3VycmluZ1NlcmllcxIKCgJpZBgBIAEoBRIMCgRuYW1lGAIgASgJEhAKCG1lcmNoYW50GAMgASgJEg8KB2NhZGVuY2UYBCABKAkSDgoGYW1vdW50GAUgASgDEhAKCGN1cnJlbmN5GAYgASgJEg4KBnN0YXR1cxgHIAEoCRIUCgxjaGFyZ2VfY291bnQYCCABKAUSGAoQbGFzdF9jaGFyZ2VfZGF0ZRgJIAEoCRIaChJsYXN0X2NoYXJnZV9hbW91bnQYCiABKAMSGAoQbmV4dF9jaGFyZ2VfZGF0ZRgLIAEoCRIaChJuZXh0X2NoYXJnZV9hbW91bnQYDCABKAMSFQoNcHJpY2VfY2hhbmdlZBgNIAEoCBIOCgZtaXNzZWQYDiABKAgiGAoWRGV0ZWN0UmVjdXJyaW5nUmVxdWVzdCIqChdEZXRlY3RSZWN1cnJpbmdSZXNwb25zZRIPCgdjcmVhdGVkGAEgASgFIi8KFExpc3RSZWN1cnJpbmdSZXF1ZXN0EhcKD2luY2x1ZGVfaWdub3JlZBgBIAEoCCJAChVMaXN0UmVjdXJyaW5nUmVzcG9uc2USJwoGc2VyaWVzGAEgAygLMhcuYXBpLnYxLlJlY3VycmluZ1NlcmllcyIlChdDb25maXJtUmVjdXJyaW5nUmVxdWVzdBIKCgJpZBgBIAEoBSIaChhDb25maXJtUmVjdXJyaW5nUmVzcG9uc2UiJAoWSWdub3JlUmVjdXJyaW5nUmVxdWVzdBIKCgJpZBgBIAEoBSIZChdJZ25vcmVSZWN1cnJpbmdSZXNwb25zZSJTChZVcGRhdGVSZWN1cnJpbmdSZXF1ZXN0EgoKAmlkGAEgASgFEgwKBG5hbWUYAiABKAkSDwoHY2FkZW5jZRgDIAEoCRIOCgZhbW91bnQYBCABKAMiGQoXVXBkYXRlUmVjdXJyaW5nUmVzcG9uc2UywAMKEFJlY3VycmluZ1NlcnZpY2USVAoPRGV0ZWN0UmVjdXJyaW5nEh4uYXBpLnYxLkRldGVjdFJlY3VycmluZ1JlcXVlc3QaHy5hcGkudjEuRGV0ZWN0UmVjdXJyaW5nUmVzcG9uc2UiABJRCg1MaXN0UmVjdXJyaW5nEhwuYXBpLnYxLkxpc3RSZWN1cnJpbmdSZXF1ZXN0Gh0uYXBpLnYxLkxpc3RSZWN1cnJpbmdSZXNwb25zZSIDkAIBElcKEENvbmZpcm1SZWN1cnJpbmcSHy5hcGkudjEuQ29uZmlybVJlY3VycmluZ1JlcXVlc3QaIC5hcGkudjEuQ29uZmlybVJlY3VycmluZ1Jlc3BvbnNlIgASVAoPSWdub3JlUmVjdXJyaW5nEh4uYXBpLnYxLklnbm9yZVJlY3VycmluZ1JlcXVlc3QaHy5hcGkudjEuSWdub3JlUmVjdXJyaW5nUmVzcG9uc2UiABJUCg9VcGRhdGVSZWN1cnJpbmcSHi5hcGkudjEuVXBkYXRlUmVjdXJyaW5nUmVxdWVzdBofLmFwaS52MS5VcGRhdGVSZWN1cnJpbmdSZXNwb25zZSIAQnkKCmNvbS5hcGkudjFCDlJlY3VycmluZ1Byb3RvUAFaImNhc2h0cmFjay9iYWNrZW5kL2dlbi9hcGkvdjE7YXBpdjGiAgNBWFiqAgZBcGkuVjHKAgZBcGlcVjHiAhJBcGlcVjFcR1BCTWV0YWRhdGHqAgdBcGk6OlYxYgZwcm90bzM");
+
+/**
+ * @generated from message api.v1.RecurringSeries
+ */
+export type RecurringSeries = Message<"api.v1.RecurringSeries"> & {
+  /**
+   * @generated from field: int32 id = 1;
+   */
+  id: number;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string merchant = 3;
+   */
+  merchant: string;
+
+  /**
+   * @generated from field: string cadence = 4;
+   */
+  cadence: string;
+
+  /**
+   * @generated from field: int64 amount = 5;
+   */
+  amount: bigint;
+
+  /**
+   * @generated from field: string currency = 6;
+   */
+  currency: string;
+
+  /**
+   * @generated from field: string status = 7;
+   */
+  status: string;
+
+  /**
+   * @generated from field: int32 charge_count = 8;
+   */
+  chargeCount: number;
+
+  /**
+   * @generated from field: string last_charge_date = 9;
+   */
+  lastChargeDate: string;
+
+  /**
+   * @generated from field: int64 last_charge_amount = 10;
+   */
+  lastChargeAmount: bigint;
+
+  /**
+   * @generated from field: string next_charge_date = 11;
+   */
+  nextChargeDate: string;
+
+  /**
+   * @generated from field: int64 next_charge_amount = 12;
+   */
+  nextChargeAmount: bigint;
+
+  /**
+   * @generated from field: bool price_changed = 13;
+   */
+  priceChanged: boolean;
+
+  /**
+   * @generated from field: bool missed = 14;
+   */
+  missed: boolean;
+};
+
+/**
+ * Describes the message api.v1.RecurringSeries.
+ * Use `create(RecurringSeriesSchema)` to create a new message.
+ */
+export const RecurringSeriesSchema: GenMessage<RecurringSeries> = /*@__PURE__*/
+  messageDesc(file_api_v1_recurring, 0);
+
+/**
+ * @generated from message api.v1.DetectRecurringRequest
+ */
+export type DetectRecurringRequest = Message<"api.v1.DetectRecurringRequest"> & {
+};
+
+/**
+ * Describes the message api.v1.DetectRecurringRequest.
+ * Use `create(DetectRecurringRequestSchema)` to create a new message.
+ */
+export const DetectRecurringRequestSchema: GenMessage<DetectRecurringRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_recurring, 1);
+
+/**
+ * @generated from message api.v1.DetectRecurringResponse
+ */
+export type DetectRecurringResponse = Message<"api.v1.DetectRecurringResponse"> & {
+  /**
+   * @generated from field: int32 created = 1;
+   */
+  created: number;
+};
+
+/**
+ * Describes the message api.v1.DetectRecurringResponse.
+ * Use `create(DetectRecurringResponseSchema)` to create a new message.
+ */
+export const DetectRecurringResponseSchema: GenMessage<DetectRecurringResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_recurring, 2);
+
+/**
+ * @generated from message api.v1.ListRecurringRequest
+ */
+export type ListRecurringRequest = Message<"api.v1.ListRecurringRequest"> & {
+  /**
+   * @generated from field: bool include_ignored = 1;
+   */
+  includeIgnored: boolean;
+};
+
+/**
+ * Describes the message api.v1.ListRecurringRequest.
+ * Use `create(ListRecurringRequestSchema)` to create a new message.
+ */
+export const ListRecurringRequestSchema: GenMessage<ListRecurringRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_recurring, 3);
+
+/**
+ * @generated from message api.v1.ListRecurringResponse
+ */
+export type ListRecurringResponse = Message<"api.v1.ListRecurringResponse"> & {
+  /**
+   * @generated from field: repeated api.v1.RecurringSeries series = 1;
+   */
+  series: RecurringSeries[];
+};
+
+/**
+ * Describes the message api.v1.ListRecurringResponse.
+ * Use `create(ListRecurringResponseSchema)` to create a new message.
+ */
+export const ListRecurringResponseSchema: GenMessage<ListRecurringResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_recurring, 4);
+
+/**
+ * @generated from message api.v1.ConfirmRecurringRequest
+ */
+export type ConfirmRecurringRequest = Message<"api.v1.ConfirmRecurringRequest"> & {
+  /**
+   * @generated from field: int32 id = 1;
+   */
+  id: number;
+};
+
+/**
+ * Describes the message api.v1.ConfirmRecurringRequest.
+ * Use `create(ConfirmRecurringRequestSchema)` to create a new message.
+ */
+export const ConfirmRecurringRequestSchema: GenMessage<ConfirmRecurringRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_recurring, 5);
+
+/**
+ * @generated from message api.v1.ConfirmRecurringResponse
+ */
+export type ConfirmRecurringResponse = Message<"api.v1.ConfirmRecurringResponse"> & {
+};
+
+/**
+ * Describes the message api.v1.ConfirmRecurringResponse.
+ * Use `create(ConfirmRecurringResponseSchema)` to create a new message.
+ */
+export const ConfirmRecurringResponseSchema: GenMessage<ConfirmRecurringResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_recurring, 6);
+
+/**
+ * @generated from message api.v1.IgnoreRecurringRequest
+ */
+export type IgnoreRecurringRequest = Message<"api.v1.IgnoreRecurringRequest"> & {
+  /**
+   * @generated from field: int32 id = 1;
+   */
+  id: number;
+};
+
+/**
+ * Describes the message api.v1.IgnoreRecurringRequest.
+ * Use `create(IgnoreRecurringRequestSchema)` to create a new message.
+ */
+export const IgnoreRecurringRequestSchema: GenMessage<IgnoreRecurringRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_recurring, 7);
+
+/**
+ * @generated from message api.v1.IgnoreRecurringResponse
+ */
+export type IgnoreRecurringResponse = Message<"api.v1.IgnoreRecurringResponse"> & {
+};
+
+/**
+ * Describes the message api.v1.IgnoreRecurringResponse.
+ * Use `create(IgnoreRecurringResponseSchema)` to create a new message.
+ */
+export const IgnoreRecurringResponseSchema: GenMessage<IgnoreRecurringResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_recurring, 8);
+
+/**
+ * @generated from message api.v1.UpdateRecurringRequest
+ */
+export type UpdateRecurringRequest = Message<"api.v1.UpdateRecurringRequest"> & {
+  /**
+   * @generated from field: int32 id = 1;
+   */
+  id: number;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string cadence = 3;
+   */
+  cadence: string;
+
+  /**
+   * @generated from field: int64 amount = 4;
+   */
+  amount: bigint;
+};
+
+/**
+ * Describes the message api.v1.UpdateRecurringRequest.
+ * Use `create(UpdateRecurringRequestSchema)` to create a new message.
+ */
+export const UpdateRecurringRequestSchema: GenMessage<UpdateRecurringRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_recurring, 9);
+
+/**
+ * @generated from message api.v1.UpdateRecurringResponse
+ */
+export type UpdateRecurringResponse = Message<"api.v1.UpdateRecurringResponse"> & {
+};
+
+/**
+ * Describes the message api.v1.UpdateRecurringResponse.
+ * Use `create(UpdateRecurringResponseSchema)` to create a new message.
+ */
+export const UpdateRecurringResponseSchema: GenMessage<UpdateRecurringResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_recurring, 10);
+
+/**
+ * @generated from service api.v1.RecurringService
+ */
+export const RecurringService: GenService<{
+  /**
+   * @generated from rpc api.v1.RecurringService.DetectRecurring
+   */
+  detectRecurring: {
+    methodKind: "unary";
+    input: typeof DetectRecurringRequestSchema;
+    output: typeof DetectRecurringResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.RecurringService.ListRecurring
+   */
+  listRecurring: {
+    methodKind: "unary";
+    input: typeof ListRecurringRequestSchema;
+    output: typeof ListRecurringResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.RecurringService.ConfirmRecurring
+   */
+  confirmRecurring: {
+    methodKind: "unary";
+    input: typeof ConfirmRecurringRequestSchema;
+    output: typeof ConfirmRecurringResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.RecurringService.IgnoreRecurring
+   */
+  ignoreRecurring: {
+    methodKind: "unary";
+    input: typeof IgnoreRecurringRequestSchema;
+    output: typeof IgnoreRecurringResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.RecurringService.UpdateRecurring
+   */
+  updateRecurring: {
+    methodKind: "unary";
+    input: typeof UpdateRecurringRequestSchema;
+    output: typeof UpdateRecurringResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_api_v1_recurring, 0);
+
@@ -10,7 +10,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file api/v1/reports.proto.
  */
 export const file_api_v1_reports: GenFile = /*@__PURE__*/
-  fileDesc("ChRhcGkvdjEvcmVwb3J0cy5wcm90bxIGYXBpLnYxIn8KClJlcG9ydEluZm8SCgoCaWQYASABKAUSEAoIZmlsZW5hbWUYAiABKAkSEgoKc2l6ZV9ieXRlcxgDIAEoBRIOCgZzdGF0dXMYBCABKAkSEwoLdXBsb2FkZWRfYXQYBSABKAkSGgoSc3RhdHVzX2Rlc2NyaXB0aW9uGAYgASgJIksKE1VwbG9hZFJlcG9ydFJlcXVlc3QSEAoIZmlsZW5hbWUYASABKAkSDAoEZGF0YRgCIAEoDBIUCgxjb250ZW50X3R5cGUYAyABKAkiFgoUVXBsb2FkUmVwb3J0UmVzcG9uc2UiFAoSTGlzdFJlcG9ydHNSZXF1ZXN0IjoKE0xpc3RSZXBvcnRzUmVzcG9uc2USIwoHcmVwb3J0cxgBIAMoCzISLmFwaS52MS5SZXBvcnRJbmZvIiMKFURvd25sb2FkUmVwb3J0UmVxdWVzdBIKCgJpZBgBIAEoBSJOChZEb3dubG9hZFJlcG9ydFJlc3BvbnNlEgwKBGRhdGEYASABKAwSEAoIZmlsZW5hbWUYAiABKAkSFAoMY29udGVudF90eXBlGAMgASgJIiEKE0RlbGV0ZVJlcG9ydFJlcXVlc3QSCgoCaWQYASABKAUiFgoURGVsZXRlUmVwb3J0UmVzcG9uc2UyxgIKDVJlcG9ydFNlcnZpY2USSwoMVXBsb2FkUmVwb3J0EhsuYXBpLnYxLlVwbG9hZFJlcG9ydFJlcXVlc3QaHC5hcGkudjEuVXBsb2FkUmVwb3J0UmVzcG9uc2UiABJICgtMaXN0UmVwb3J0cxIaLmFwaS52MS5MaXN0UmVwb3J0c1JlcXVlc3QaGy5hcGkudjEuTGlzdFJlcG9ydHNSZXNwb25zZSIAElEKDkRvd25sb2FkUmVwb3J0Eh0uYXBpLnYxLkRvd25sb2FkUmVwb3J0UmVxdWVzdBoeLmFwaS52MS5Eb3dubG9hZFJlcG9ydFJlc3BvbnNlIgASSwoMRGVsZXRlUmVwb3J0EhsuYXBpLnYxLkRlbGV0ZVJlcG9ydFJlcXVlc3QaHC5hcGkudjEuRGVsZXRlUmVwb3J0UmVzcG9uc2UiAEJ3Cgpjb20uYXBpLnYxQgxSZXBvcnRzUHJvdG9QAVoiY2FzaHRyYWNrL2JhY2tlbmQvZ2VuL2FwaS92MTthcGl2MaICA0FYWKoCBkFwaS5WMcoCBkFwaVxWMeICEkFwaVxWMVxHUEJNZXRhZGF0YeoCB0FwaTo6VjFiBnByb3RvMw");
+  fileDesc("ChRhcGkvdjEvcmVwb3J0cy5wcm90bxIGYXBpLnYxIrQBCgpSZXBvcnRJbmZvEgoKAmlkGAEgASgFEhAKCGZpbGVuYW1lGAIgASgJEhIKCnNpemVfYnl0ZXMYAyABKAUSDgoGc3RhdHVzGAQgASgJEhMKC3VwbG9hZGVkX2F0GAUgASgJEhoKEnN0YXR1c19kZXNjcmlwdGlvbhgGIAEoCRIZChF0cmFuc2FjdGlvbl9jb3VudBgHIAEoBRIYChBkaWFnbm9zdGljX2NvdW50GAggASgFIlEKEFJlcG9ydERpYWdub3N0aWMSCwoDcm93GAEgASgFEhAKCHNldmVyaXR5GAIgASgJEg4KBnJlY29yZBgDIAEoCRIOCgZyZWFzb24YBCABKAkiSwoTVXBsb2FkUmVwb3J0UmVxdWVzdBIQCghmaWxlbmFtZRgBIAEoCRIMCgRkYXRhGAIgASgMEhQKDGNvbnRlbnRfdHlwZRgDIAEoCSIWChRVcGxvYWRSZXBvcnRSZXNwb25zZSIUChJMaXN0UmVwb3J0c1JlcXVlc3QiOgoTTGlzdFJlcG9ydHNSZXNwb25zZRIjCgdyZXBvcnRzGAEgAygLMhIuYXBpLnYxLlJlcG9ydEluZm8iIwoVRG93bmxvYWRSZXBvcnRSZXF1ZXN0EgoKAmlkGAEgASgFIk4KFkRvd25sb2FkUmVwb3J0UmVzcG9uc2USDAoEZGF0YRgBIAEoDBIQCghmaWxlbmFtZRgCIAEoCRIUCgxjb250ZW50X3R5cGUYAyABKAkiIQoTRGVsZXRlUmVwb3J0UmVxdWVzdBIKCgJpZBgBIAEoBSIWChREZWxldGVSZXBvcnRSZXNwb25zZSIVChNXYXRjaFJlcG9ydHNSZXF1ZXN0IksKFFdhdGNoUmVwb3J0c1Jlc3BvbnNlEiIKBnJlcG9ydBgBIAEoCzISLmFwaS52MS5SZXBvcnRJbmZvEg8KB2RlbGV0ZWQYAiABKAgiKQobR2V0UmVwb3J0RGlhZ25vc3RpY3NSZXF1ZXN0EgoKAmlkGAEgASgFIk0KHEdldFJlcG9ydERpYWdub3N0aWNzUmVzcG9uc2USLQoLZGlhZ25vc3RpY3MYASADKAsyGC5hcGkudjEuUmVwb3J0RGlhZ25vc3RpYyIkChZSZXByb2Nlc3NSZXBvcnRSZXF1ZXN0EgoKAmlkGAEgASgFIhkKF1JlcHJvY2Vzc1JlcG9ydFJlc3BvbnNlIjgKF1JlcHJvY2Vzc1JlcG9ydHNSZXF1ZXN0EgsKA2lkcxgBIAMoBRIQCghvdXRkYXRlZBgCIAEoCCInChhSZXByb2Nlc3NSZXBvcnRzUmVzcG9uc2USCwoDaWRzGAEgAygFMrUFCg1SZXBvcnRTZXJ2aWNlEksKDFVwbG9hZFJlcG9ydBIbLmFwaS52MS5VcGxvYWRSZXBvcnRSZXF1ZXN0GhwuYXBpLnYxLlVwbG9hZFJlcG9ydFJlc3BvbnNlIgASSwoLTGlzdFJlcG9ydHMSGi5hcGkudjEuTGlzdFJlcG9ydHNSZXF1ZXN0GhsuYXBpLnYxLkxpc3RSZXBvcnRzUmVzcG9uc2UiA5ACARJUCg5Eb3dubG9hZFJlcG9ydBIdLmFwaS52MS5Eb3dubG9hZFJlcG9ydFJlcXVlc3QaHi5hcGkudjEuRG93bmxvYWRSZXBvcnRSZXNwb25zZSIDkAIBEksKDERlbGV0ZVJlcG9ydBIbLmFwaS52MS5EZWxldGVSZXBvcnRSZXF1ZXN0GhwuYXBpLnYxLkRlbGV0ZVJlcG9ydFJlc3BvbnNlIgASUAoMV2F0Y2hSZXBvcnRzEhsuYXBpLnYxLldhdGNoUmVwb3J0c1JlcXVlc3QaHC5hcGkudjEuV2F0Y2hSZXBvcnRzUmVzcG9uc2UiA5ACATABEmYKFEdldFJlcG9ydERpYWdub3N0aWNzEiMuYXBpLnYxLkdldFJlcG9ydERpYWdub3N0aWNzUmVxdWVzdBokLmFwaS52MS5HZXRSZXBvcnREaWFnbm9zdGljc1Jlc3BvbnNlIgOQAgESVAoPUmVwcm9jZXNzUmVwb3J0Eh4uYXBpLnYxLlJlcHJvY2Vzc1JlcG9ydFJlcXVlc3QaHy5hcGkudjEuUmVwcm9jZXNzUmVwb3J0UmVzcG9uc2UiABJXChBSZXByb2Nlc3NSZXBvcnRzEh8uYXBpLnYxLlJlcHJvY2Vzc1JlcG9ydHNSZXF1ZXN0GiAuYXBpLnYxLlJlcHJvY2Vzc1JlcG9ydHNSZXNwb25zZSIAQncKCmNvbS5hcGkudjFCDFJlcG9ydHNQcm90b1ABWiJjYXNodHJhY2svYmFja2VuZC9nZW4vYXBpL3YxO2FwaXYxogIDQVhYqgIGQXBpLlYxygIGQXBpXFYx4gISQXBpXFYxXEdQQk1ldGFkYXRh6gIHQXBpOjpWMWIGcHJvdG8z");
 
 /**
  * @generated from message api.v1.ReportInfo
@@ -45,6 +45,16 @@ export type ReportInfo = Message<"api.v1.ReportInfo"> & {
    * @generated from field: string status_description = 6;
    */
   statusDescription: string;
+
+  /**
+   * @generated from field: int32 transaction_count = 7;
+   */
+  transactionCount: number;
+
+  /**
+   * @generated from field: int32 diagnostic_count = 8;
+   */
+  diagnosticCount: number;
 };
 
 /**
@@ -54,6 +64,38 @@ export type ReportInfo = Message<"api.v1.ReportInfo"> & {
 export const ReportInfoSchema: GenMessage<ReportInfo> = /*@__PURE__*/
   messageDesc(file_api_v1_reports, 0);
 
+/**
+ * @generated from message api.v1.ReportDiagnostic
+ */
+export type ReportDiagnostic = Message<"api.v1.ReportDiagnostic"> & {
+  /**
+   * @generated from field: int32 row = 1;
+   */
+  row: number;
+
+  /**
+   * @generated from field: string severity = 2;
+   */
+  severity: string;
+
+  /**
+   * @generated from field: string record = 3;
+   */
+  record: string;
+
+  /**
+   * @generated from field: string reason = 4;
+   */
+  reason: string;
+};
+
+/**
+ * Describes the message api.v1.ReportDiagnostic.
+ * Use `create(ReportDiagnosticSchema)` to create a new message.
+ */
+export const ReportDiagnosticSchema: GenMessage<ReportDiagnostic> = /*@__PURE__*/
+  messageDesc(file_api_v1_reports, 1);
+
 /**
  * @generated from message api.v1.UploadReportRequest
  */
@@ -79,7 +121,7 @@ export type UploadReportRequest = Message<"api.v1.UploadReportRequest"> & {
  * Use `create(UploadReportRequestSchema)` to create a new message.
  */
 export const UploadReportRequestSchema: GenMessage<UploadReportRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_reports, 1);
+  messageDesc(file_api_v1_reports, 2);
 
 /**
  * @generated from message api.v1.UploadReportResponse
@@ -92,7 +134,7 @@ export type UploadReportResponse = Message<"api.v1.UploadReportResponse"> & {
  * Use `create(UploadReportResponseSchema)` to create a new message.
  */
 export const UploadReportResponseSchema: GenMessage<UploadReportResponse> = /*@__PURE__*/
-  messageDesc(file_api_v1_reports, 2);
+  messageDesc(file_api_v1_reports, 3);
 
 /**
  * @generated from message api.v1.ListReportsRequest
@@ -105,7 +147,7 @@ export type ListReportsRequest = Message<"api.v1.ListReportsRequest"> & {
  * Use `create(ListReportsRequestSchema)` to create a new message.
  */
 export const ListReportsRequestSchema: GenMessage<ListReportsRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_reports, 3);
+  messageDesc(file_api_v1_reports, 4);
 
 /**
  * @generated from message api.v1.ListReportsResponse
@@ -122,7 +164,7 @@ export type ListReportsResponse = Message<"api.v1.ListReportsResponse"> & {
  * Use `create(ListReportsResponseSchema)` to create a new message.
  */
 export const ListReportsResponseSchema: GenMessage<ListReportsResponse> = /*@__PURE__*/
-  messageDesc(file_api_v1_reports, 4);
+  messageDesc(file_api_v1_reports, 5);
 
 /**
  * @generated from message api.v1.DownloadReportRequest
@@ -139,7 +181,7 @@ export type DownloadReportRequest = Message<"api.v1.DownloadReportRequest"> & {
  * Use `create(DownloadReportRequestSchema)` to create a new message.
  */
 export const DownloadReportRequestSchema: GenMessage<DownloadReportRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_reports, 5);
+  messageDesc(file_api_v1_reports, 6);
 
 /**
  * @generated from message api.v1.DownloadReportResponse
@@ -166,7 +208,7 @@ export type DownloadReportResponse = Message<"api.v1.DownloadReportResponse"> &
  * Use `create(DownloadReportResponseSchema)` to create a new message.
  */
 export const DownloadReportResponseSchema: GenMessage<DownloadReportResponse> = /*@__PURE__*/
-  messageDesc(file_api_v1_reports, 6);
+  messageDesc(file_api_v1_reports, 7);
 
 /**
  * @generated from message api.v1.DeleteReportRequest
@@ -183,7 +225,7 @@ export type DeleteReportRequest = Message<"api.v1.DeleteReportRequest"> & {
  * Use `create(DeleteReportRequestSchema)` to create a new message.
  */
 export const DeleteReportRequestSchema: GenMessage<DeleteReportRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_reports, 7);
+  messageDesc(file_api_v1_reports, 8);
 
 /**
  * @generated from message api.v1.DeleteReportResponse
@@ -196,7 +238,145 @@ export type DeleteReportResponse = Message<"api.v1.DeleteReportResponse"> & {
  * Use `create(DeleteReportResponseSchema)` to create a new message.
  */
 export const DeleteReportResponseSchema: GenMessage<DeleteReportResponse> = /*@__PURE__*/
-  messageDesc(file_api_v1_reports, 8);
+  messageDesc(file_api_v1_reports, 9);
+
+/**
+ * @generated from message api.v1.WatchReportsRequest
+ */
+export type WatchReportsRequest = Message<"api.v1.WatchReportsRequest"> & {
+};
+
+/**
+ * Describes the message api.v1.WatchReportsRequest.
+ * Use `create(WatchReportsRequestSchema)` to create a new message.
+ */
+export const WatchReportsRequestSchema: GenMessage<WatchReportsRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_reports, 10);
+
+/**
+ * @generated from message api.v1.WatchReportsResponse
+ */
+export type WatchReportsResponse = Message<"api.v1.WatchReportsResponse"> & {
+  /**
+   * @generated from field: api.v1.ReportInfo report = 1;
+   */
+  report?: ReportInfo;
+
+  /**
+   * @generated from field: bool deleted = 2;
+   */
+  deleted: boolean;
+};
+
+/**
+ * Describes the message api.v1.WatchReportsResponse.
+ * Use `create(WatchReportsResponseSchema)` to create a new message.
+ */
+export const WatchReportsResponseSchema: GenMessage<WatchReportsResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_reports, 11);
+
+/**
+ * @generated from message api.v1.GetReportDiagnosticsRequest
+ */
+export type GetReportDiagnosticsRequest = Message<"api.v1.GetReportDiagnosticsRequest"> & {
+  /**
+   * @generated from field: int32 id = 1;
+   */
+  id: number;
+};
+
+/**
+ * Describes the message api.v1.GetReportDiagnosticsRequest.
+ * Use `create(GetReportDiagnosticsRequestSchema)` to create a new message.
+ */
+export const GetReportDiagnosticsRequestSchema: GenMessage<GetReportDiagnosticsRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_reports, 12);
+
+/**
+ * @generated from message api.v1.GetReportDiagnosticsResponse
+ */
+export type GetReportDiagnosticsResponse = Message<"api.v1.GetReportDiagnosticsResponse"> & {
+  /**
+   * @generated from field: repeated api.v1.ReportDiagnostic diagnostics = 1;
+   */
+  diagnostics: ReportDiagnostic[];
+};
+
+/**
+ * Describes the message api.v1.GetReportDiagnosticsResponse.
+ * Use `create(GetReportDiagnosticsResponseSchema)` to create a new message.
+ */
+export const GetReportDiagnosticsResponseSchema: GenMessage<GetReportDiagnosticsResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_reports, 13);
+
+/**
+ * @generated from message api.v1.ReprocessReportRequest
+ */
+export type ReprocessReportRequest = Message<"api.v1.ReprocessReportRequest"> & {
+  /**
+   * @generated from field: int32 id = 1;
+   */
+  id: number;
+};
+
+/**
+ * Describes the message api.v1.ReprocessReportRequest.
+ * Use `create(ReprocessReportRequestSchema)` to create a new message.
+ */
+export const ReprocessReportRequestSchema: GenMessage<ReprocessReportRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_reports, 14);
+
+/**
+ * @generated from message api.v1.ReprocessReportResponse
+ */
+export type ReprocessReportResponse = Message<"api.v1.ReprocessReportResponse"> & {
+};
+
+/**
+ * Describes the message api.v1.ReprocessReportResponse.
+ * Use `create(ReprocessReportResponseSchema)` to create a new message.
+ */
+export const ReprocessReportResponseSchema: GenMessage<ReprocessReportResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_reports, 15);
+
+/**
+ * @generated from message api.v1.ReprocessReportsRequest
+ */
+export type ReprocessReportsRequest = Message<"api.v1.ReprocessReportsRequest"> & {
+  /**
+   * @generated from field: repeated int32 ids = 1;
+   */
+  ids: number[];
+
+  /**
+   * @generated from field: bool outdated = 2;
+   */
+  outdated: boolean;
+};
+
+/**
+ * Describes the message api.v1.ReprocessReportsRequest.
+ * Use `create(ReprocessReportsRequestSchema)` to create a new message.
+ */
+export const ReprocessReportsRequestSchema: GenMessage<ReprocessReportsRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_reports, 16);
+
+/**
+ * @generated from message api.v1.ReprocessReportsResponse
+ */
+export type ReprocessReportsResponse = Message<"api.v1.ReprocessReportsResponse"> & {
+  /**
+   * @generated from field: repeated int32 ids = 1;
+   */
+  ids: number[];
+};
+
+/**
+ * Describes the message api.v1.ReprocessReportsResponse.
+ * Use `create(ReprocessReportsResponseSchema)` to create a new message.
+ */
+export const ReprocessReportsResponseSchema: GenMessage<ReprocessReportsResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_reports, 17);
 
 /**
  * @generated from service api.v1.ReportService
@@ -234,6 +414,38 @@ export const ReportService: GenService<{
     input: typeof DeleteReportRequestSchema;
     output: typeof DeleteReportResponseSchema;
   },
+  /**
+   * @generated from rpc api.v1.ReportService.WatchReports
+   */
+  watchReports: {
+    methodKind: "server_streaming";
+    input: typeof WatchReportsRequestSchema;
+    output: typeof WatchReportsResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.ReportService.GetReportDiagnostics
+   */
+  getReportDiagnostics: {
+    methodKind: "unary";
+    input: typeof GetReportDiagnosticsRequestSchema;
+    output: typeof GetReportDiagnosticsResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.ReportService.ReprocessReport
+   */
+  reprocessReport: {
+    methodKind: "unary";
+    input: typeof ReprocessReportRequestSchema;
+    output: typeof ReprocessReportResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.ReportService.ReprocessReports
+   */
+  reprocessReports: {
+    methodKind: "unary";
+    input: typeof ReprocessReportsRequestSchema;
+    output: typeof ReprocessReportsResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_api_v1_reports, 0);
 
@@ -10,7 +10,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file api/v1/transactions.proto.
  */
 export const file_api_v1_transactions: GenFile = /*@__PURE__*/
-  fileDesc("ChlhcGkvdjEvdHJhbnNhY3Rpb25zLnByb3RvEgZhcGkudjEi0AIKC1RyYW5zYWN0aW9uEgoKAmlkGAEgASgFEhYKDnNvdXJjZV9maWxlX2lkGAIgASgFEhcKD3NvdXJjZV9maWxlX3JvdxgDIAEoBRITCgtwYXJzZXJfbmFtZRgEIAEoCRITCgtwb3N0ZWRfZGF0ZRgFIAEoCRITCgtkZXNjcmlwdGlvbhgGIAEoCRIOCgZhbW91bnQYByABKAMSEAoIY3VycmVuY3kYCCABKAkSFgoOdHJhbnNhY3Rpb25faWQYCSABKAkSEgoKZW50cnlfdHlwZRgKIAEoCRIdChVzb3VyY2VfYWNjb3VudF9udW1iZXIYCyABKAkSGgoSc291cmNlX2NhcmRfbnVtYmVyGAwgASgJEhIKCmNyZWF0ZWRfYXQYDSABKAkSGAoLY2F0ZWdvcnlfaWQYDiABKAVIAIgBAUIOCgxfY2F0ZWdvcnlfaWQisAEKElRyYW5zYWN0aW9uU3VtbWFyeRINCgVjb3VudBgBIAEoBRINCgV0b3RhbBgCIAEoAxIPCgdhdmVyYWdlGAMgASgDEg4KBm1lZGlhbhgEIAEoAxIQCghjdXJyZW5jeRgFIAEoCRIXCg91bmlxdWVfYWNjb3VudHMYBiABKAUSGAoQZGF0ZV9yYW5nZV9zdGFydBgHIAEoCRIWCg5kYXRlX3JhbmdlX2VuZBgIIAEoCSLfAQoXTGlzdFRyYW5zYWN0aW9uc1JlcXVlc3QSEQoJZnJvbV9kYXRlGAEgASgJEg8KB3RvX2RhdGUYAiABKAkSFgoOc291cmNlX2ZpbGVfaWQYAyABKAUSEgoKZW50cnlfdHlwZRgEIAEoCRITCgtzZWFyY2hfdGV4dBgFIAEoCRITCgtjYXRlZ29yeV9pZBgGIAEoBRIWCg5hY2NvdW50X251bWJlchgHIAEoCRITCgtjYXJkX251bWJlchgIIAEoCRINCgVsaW1pdBgJIAEoBRIOCgZvZmZzZXQYCiABKAUiawoYTGlzdFRyYW5zYWN0aW9uc1Jlc3BvbnNlEiIKBWl0ZW1zGAEgAygLMhMuYXBpLnYxLlRyYW5zYWN0aW9uEisKB3N1bW1hcnkYAiABKAsyGi5hcGkudjEuVHJhbnNhY3Rpb25TdW1tYXJ5ImQKIFVwZGF0ZVRyYW5zYWN0aW9uQ2F0ZWdvcnlSZXF1ZXN0EhYKDnRyYW5zYWN0aW9uX2lkGAEgASgFEhgKC2NhdGVnb3J5X2lkGAIgASgFSACIAQFCDgoMX2NhdGVnb3J5X2lkIiMKIVVwZGF0ZVRyYW5zYWN0aW9uQ2F0ZWdvcnlSZXNwb25zZTLhAQoSVHJhbnNhY3Rpb25TZXJ2aWNlElcKEExpc3RUcmFuc2FjdGlvbnMSHy5hcGkudjEuTGlzdFRyYW5zYWN0aW9uc1JlcXVlc3QaIC5hcGkudjEuTGlzdFRyYW5zYWN0aW9uc1Jlc3BvbnNlIgAScgoZVXBkYXRlVHJhbnNhY3Rpb25DYXRlZ29yeRIoLmFwaS52MS5VcGRhdGVUcmFuc2FjdGlvbkNhdGVnb3J5UmVxdWVzdBopLmFwaS52MS5VcGRhdGVUcmFuc2FjdGlvbkNhdGVnb3J5UmVzcG9uc2UiAEJ8Cgpjb20uYXBpLnYxQhFUcmFuc2FjdGlvbnNQcm90b1ABWiJjYXNodHJhY2svYmFja2VuZC9nZW4vYXBpL3YxO2FwaXYxogIDQVhYqgIGQXBpLlYxygIGQXBpXFYx4gISQXBpXFYxXEdQQk1ldGFkYXRh6gIHQXBpOjpWMWIGcHJvdG8z");
+  fileDesc("ChlhcGkvdjEvdHJhbnNhY3Rpb25zLnByb3RvEgZhcGkudjEi+gIKC1RyYW5zYWN0aW9uEgoKAmlkGAEgASgFEhYKDnNvdXJjZV9maWxlX2lkGAIgASgFEhcKD3NvdXJjZV9maWxlX3JvdxgDIAEoBRITCgtwYXJzZXJfbmFtZRgEIAEoCRITCgtwb3N0ZWRfZGF0ZRgFIAEoCRITCgtkZXNjcmlwdGlvbhgGIAEoCRIOCgZhbW91bnQYByABKAMSEAoIY3VycmVuY3kYCCABKAkSFgoOdHJhbnNhY3Rpb25faWQYCSABKAkSEgoKZW50cnlfdHlwZRgKIAEoCRIdChVzb3VyY2VfYWNjb3VudF9udW1iZXIYCyABKAkSGgoSc291cmNlX2NhcmRfbnVtYmVyGAwgASgJEhIKCmNyZWF0ZWRfYXQYDSABKAkSGAoLY2F0ZWdvcnlfaWQYDiABKAVIAIgBARIoCgZzcGxpdHMYDyADKAsyGC5hcGkudjEuVHJhbnNhY3Rpb25TcGxpdEIOCgxfY2F0ZWdvcnlfaWQiWAoQVHJhbnNhY3Rpb25TcGxpdBIKCgJpZBgBIAEoBRIYCgtjYXRlZ29yeV9pZBgCIAEoBUgAiAEBEg4KBmFtb3VudBgDIAEoA0IOCgxfY2F0ZWdvcnlfaWQisAEKElRyYW5zYWN0aW9uU3VtbWFyeRINCgVjb3VudBgBIAEoBRINCgV0b3RhbBgCIAEoAxIPCgdhdmVyYWdlGAMgASgDEg4KBm1lZGlhbhgEIAEoAxIQCghjdXJyZW5jeRgFIAEoCRIXCg91bmlxdWVfYWNjb3VudHMYBiABKAUSGAoQZGF0ZV9yYW5nZV9zdGFydBgHIAEoCRIWCg5kYXRlX3JhbmdlX2VuZBgIIAEoCSKVAgoXTGlzdFRyYW5zYWN0aW9uc1JlcXVlc3QSEQoJZnJvbV9kYXRlGAEgASgJEg8KB3RvX2RhdGUYAiABKAkSFgoOc291cmNlX2ZpbGVfaWQYAyABKAUSEgoKZW50cnlfdHlwZRgEIAEoCRITCgtzZWFyY2hfdGV4dBgFIAEoCRITCgtjYXRlZ29yeV9pZBgGIAEoBRIWCg5hY2NvdW50X251bWJlchgHIAEoCRITCgtjYXJkX251bWJlchgIIAEoCRINCgVsaW1pdBgJIAEoBRIOCgZvZmZzZXQYCiABKAUSHgoRaW5jbHVkZV90cmFuc2ZlcnMYCyABKAhIAIgBAUIUChJfaW5jbHVkZV90cmFuc2ZlcnMiawoYTGlzdFRyYW5zYWN0aW9uc1Jlc3BvbnNlEiIKBWl0ZW1zGAEgAygLMhMuYXBpLnYxLlRyYW5zYWN0aW9uEisKB3N1bW1hcnkYAiABKAsyGi5hcGkudjEuVHJhbnNhY3Rpb25TdW1tYXJ5ImQKIFVwZGF0ZVRyYW5zYWN0aW9uQ2F0ZWdvcnlSZXF1ZXN0EhYKDnRyYW5zYWN0aW9uX2lkGAEgASgFEhgKC2NhdGVnb3J5X2lkGAIgASgFSACIAQFCDgoMX2NhdGVnb3J5X2lkIiMKIVVwZGF0ZVRyYW5zYWN0aW9uQ2F0ZWdvcnlSZXNwb25zZSKGAgoeR2V0VHJhbnNhY3Rpb25BbmFseXRpY3NSZXF1ZXN0EhEKCWZyb21fZGF0ZRgBIAEoCRIPCgd0b19kYXRlGAIgASgJEhYKDnNvdXJjZV9maWxlX2lkGAMgASgFEhIKCmVudHJ5X3R5cGUYBCABKAkSEwoLc2VhcmNoX3RleHQYBSABKAkSEwoLY2F0ZWdvcnlfaWQYBiABKAUSFgoOYWNjb3VudF9udW1iZXIYByABKAkSEwoLY2FyZF9udW1iZXIYCCABKAkSEAoIaW50ZXJ2YWwYCSABKAkSEAoIZ3JvdXBfYnkYCiABKAkSGQoRaW5jbHVkZV90cmFuc2ZlcnMYCyABKAgimgEKGVRyYW5zYWN0aW9uQW5hbHl0aWNzUG9pbnQSFAoMcGVyaW9kX3N0YXJ0GAEgASgJEhgKC2NhdGVnb3J5X2lkGAIgASgFSACIAQESDQoFY291bnQYAyABKAUSDgoGaW5jb21lGAQgASgDEg8KB2V4cGVuc2UYBSABKAMSDQoFdG90YWwYBiABKANCDgoMX2NhdGVnb3J5X2lkIooBCh9HZXRUcmFuc2FjdGlvbkFuYWx5dGljc1Jlc3BvbnNlEjEKBnBvaW50cxgBIAMoCzIhLmFwaS52MS5UcmFuc2FjdGlvbkFuYWx5dGljc1BvaW50EhAKCGN1cnJlbmN5GAIgASgJEhAKCGludGVydmFsGAMgASgJEhAKCGdyb3VwX2J5GAQgASgJIl8KG1NldFRyYW5zYWN0aW9uU3BsaXRzUmVxdWVzdBIWCg50cmFuc2FjdGlvbl9pZBgBIAEoBRIoCgZzcGxpdHMYAiADKAsyGC5hcGkudjEuVHJhbnNhY3Rpb25TcGxpdCJIChxTZXRUcmFuc2FjdGlvblNwbGl0c1Jlc3BvbnNlEigKBnNwbGl0cxgBIAMoCzIYLmFwaS52MS5UcmFuc2FjdGlvblNwbGl0IjgKHkRlbGV0ZVRyYW5zYWN0aW9uU3BsaXRzUmVxdWVzdBIWCg50cmFuc2FjdGlvbl9pZBgBIAEoBSIhCh9EZWxldGVUcmFuc2FjdGlvblNwbGl0c1Jlc3BvbnNlIo4BChNUcmFuc2FjdGlvblRyYW5zZmVyEgoKAmlkGAEgASgFEg4KBnN0YXR1cxgCIAEoCRIiCgVkZWJpdBgDIAEoCzITLmFwaS52MS5UcmFuc2FjdGlvbhIjCgZjcmVkaXQYBCABKAsyEy5hcGkudjEuVHJhbnNhY3Rpb24SEgoKY3JlYXRlZF9hdBgFIAEoCSIYChZEZXRlY3RUcmFuc2ZlcnNSZXF1ZXN0IioKF0RldGVjdFRyYW5zZmVyc1Jlc3BvbnNlEg8KB2NyZWF0ZWQYASABKAUiJgoUTGlzdFRyYW5zZmVyc1JlcXVlc3QSDgoGc3RhdHVzGAEgASgJIkMKFUxpc3RUcmFuc2ZlcnNSZXNwb25zZRIqCgVpdGVtcxgBIAMoCzIbLmFwaS52MS5UcmFuc2FjdGlvblRyYW5zZmVyIi0KFkNvbmZpcm1UcmFuc2ZlclJlcXVlc3QSEwoLdHJhbnNmZXJfaWQYASABKAUiGQoXQ29uZmlybVRyYW5zZmVyUmVzcG9uc2UiLAoVVW5saW5rVHJhbnNmZXJSZXF1ZXN0EhMKC3RyYW5zZmVyX2lkGAEgASgFIhgKFlVubGlua1RyYW5zZmVyUmVzcG9uc2UiXQoZRXhwb3J0VHJhbnNhY3Rpb25zUmVxdWVzdBIwCgdmaWx0ZXJzGAEgASgLMh8uYXBpLnYxLkxpc3RUcmFuc2FjdGlvbnNSZXF1ZXN0Eg4KBmZvcm1hdBgCIAEoCSJTChpFeHBvcnRUcmFuc2FjdGlvbnNSZXNwb25zZRINCgVjaHVuaxgBIAEoDBIQCghmaWxlbmFtZRgCIAEoCRIUCgxjb250ZW50X3R5cGUYAyABKAkiWAoUTGVkZ2VyQWNjb3VudE1hcHBpbmcSCgoCaWQYASABKAUSDAoEa2luZBgCIAEoCRIOCgZzb3VyY2UYAyABKAkSFgoObGVkZ2VyX2FjY291bnQYBCABKAkiIgogTGlzdExlZGdlckFjY291bnRNYXBwaW5nc1JlcXVlc3QiUwohTGlzdExlZGdlckFjY291bnRNYXBwaW5nc1Jlc3BvbnNlEi4KCG1hcHBpbmdzGAEgAygLMhwuYXBpLnYxLkxlZGdlckFjY291bnRNYXBwaW5nIlYKHlNldExlZGdlckFjY291bnRNYXBwaW5nUmVxdWVzdBIMCgRraW5kGAEgASgJEg4KBnNvdXJjZRgCIAEoCRIWCg5sZWRnZXJfYWNjb3VudBgDIAEoCSJQCh9TZXRMZWRnZXJBY2NvdW50TWFwcGluZ1Jlc3BvbnNlEi0KB21hcHBpbmcYASABKAsyHC5hcGkudjEuTGVkZ2VyQWNjb3VudE1hcHBpbmciLwohRGVsZXRlTGVkZ2VyQWNjb3VudE1hcHBpbmdSZXF1ZXN0EgoKAmlkGAEgASgFIiQKIkRlbGV0ZUxlZGdlckFjY291bnRNYXBwaW5nUmVzcG9uc2UyugoKElRyYW5zYWN0aW9uU2VydmljZRJaChBMaXN0VHJhbnNhY3Rpb25zEh8uYXBpLnYxLkxpc3RUcmFuc2FjdGlvbnNSZXF1ZXN0GiAuYXBpLnYxLkxpc3RUcmFuc2FjdGlvbnNSZXNwb25zZSIDkAIBEnIKGVVwZGF0ZVRyYW5zYWN0aW9uQ2F0ZWdvcnkSKC5hcGkudjEuVXBkYXRlVHJhbnNhY3Rpb25DYXRlZ29yeVJlcXVlc3QaKS5hcGkudjEuVXBkYXRlVHJhbnNhY3Rpb25DYXRlZ29yeVJlc3BvbnNlIgASbwoXR2V0VHJhbnNhY3Rpb25BbmFseXRpY3MSJi5hcGkudjEuR2V0VHJhbnNhY3Rpb25BbmFseXRpY3NSZXF1ZXN0GicuYXBpLnYxLkdldFRyYW5zYWN0aW9uQW5hbHl0aWNzUmVzcG9uc2UiA5ACARJjChRTZXRUcmFuc2FjdGlvblNwbGl0cxIjLmFwaS52MS5TZXRUcmFuc2FjdGlvblNwbGl0c1JlcXVlc3QaJC5hcGkudjEuU2V0VHJhbnNhY3Rpb25TcGxpdHNSZXNwb25zZSIAEmwKF0RlbGV0ZVRyYW5zYWN0aW9uU3BsaXRzEiYuYXBpLnYxLkRlbGV0ZVRyYW5zYWN0aW9uU3BsaXRzUmVxdWVzdBonLmFwaS52MS5EZWxldGVUcmFuc2FjdGlvblNwbGl0c1Jlc3BvbnNlIgASVAoPRGV0ZWN0VHJhbnNmZXJzEh4uYXBpLnYxLkRldGVjdFRyYW5zZmVyc1JlcXVlc3QaHy5hcGkudjEuRGV0ZWN0VHJhbnNmZXJzUmVzcG9uc2UiABJRCg1MaXN0VHJhbnNmZXJzEhwuYXBpLnYxLkxpc3RUcmFuc2ZlcnNSZXF1ZXN0Gh0uYXBpLnYxLkxpc3RUcmFuc2ZlcnNSZXNwb25zZSIDkAIBElQKD0NvbmZpcm1UcmFuc2ZlchIeLmFwaS52MS5Db25maXJtVHJhbnNmZXJSZXF1ZXN0Gh8uYXBpLnYxLkNvbmZpcm1UcmFuc2ZlclJlc3BvbnNlIgASUQoOVW5saW5rVHJhbnNmZXISHS5hcGkudjEuVW5saW5rVHJhbnNmZXJSZXF1ZXN0Gh4uYXBpLnYxLlVubGlua1RyYW5zZmVyUmVzcG9uc2UiABJiChJFeHBvcnRUcmFuc2FjdGlvbnMSIS5hcGkudjEuRXhwb3J0VHJhbnNhY3Rpb25zUmVxdWVzdBoiLmFwaS52MS5FeHBvcnRUcmFuc2FjdGlvbnNSZXNwb25zZSIDkAIBMAESdQoZTGlzdExlZGdlckFjY291bnRNYXBwaW5ncxIoLmFwaS52MS5MaXN0TGVkZ2VyQWNjb3VudE1hcHBpbmdzUmVxdWVzdBopLmFwaS52MS5MaXN0TGVkZ2VyQWNjb3VudE1hcHBpbmdzUmVzcG9uc2UiA5ACARJsChdTZXRMZWRnZXJBY2NvdW50TWFwcGluZxImLmFwaS52MS5TZXRMZWRnZXJBY2NvdW50TWFwcGluZ1JlcXVlc3QaJy5hcGkudjEuU2V0TGVkZ2VyQWNjb3VudE1hcHBpbmdSZXNwb25zZSIAEnUKGkRlbGV0ZUxlZGdlckFjY291bnRNYXBwaW5nEikuYXBpLnYxLkRlbGV0ZUxlZGdlckFjY291bnRNYXBwaW5nUmVxdWVzdBoqLmFwaS52MS5EZWxldGVMZWRnZXJBY2NvdW50TWFwcGluZ1Jlc3BvbnNlIgBCfAoKY29tLmFwaS52MUIRVHJhbnNhY3Rpb25zUHJvdG9QAVoiY2FzaHRyYWNrL2JhY2tlbmQvZ2VuL2FwaS92MTthcGl2MaICA0FYWKoCBkFwaS5WMcoCBkFwaVxWMeICEkFwaVxWMVxHUEJNZXRhZGF0YeoCB0FwaTo6VjFiBnByb3RvMw");
 
 /**
  * @generated from message api.v1.Transaction
@@ -85,6 +85,11 @@ export type Transaction = Message<"api.v1.Transaction"> & {
    * @generated from field: optional int32 category_id = 14;
    */
   categoryId?: number;
+
+  /**
+   * @generated from field: repeated api.v1.TransactionSplit splits = 15;
+   */
+  splits: TransactionSplit[];
 };
 
 /**
@@ -94,6 +99,33 @@ export type Transaction = Message<"api.v1.Transaction"> & {
 export const TransactionSchema: GenMessage<Transaction> = /*@__PURE__*/
   messageDesc(file_api_v1_transactions, 0);
 
+/**
+ * @generated from message api.v1.TransactionSplit
+ */
+export type TransactionSplit = Message<"api.v1.TransactionSplit"> & {
+  /**
+   * @generated from field: int32 id = 1;
+   */
+  id: number;
+
+  /**
+   * @generated from field: optional int32 category_id = 2;
+   */
+  categoryId?: number;
+
+  /**
+   * @generated from field: int64 amount = 3;
+   */
+  amount: bigint;
+};
+
+/**
+ * Describes the message api.v1.TransactionSplit.
+ * Use `create(TransactionSplitSchema)` to create a new message.
+ */
+export const TransactionSplitSchema: GenMessage<TransactionSplit> = /*@__PURE__*/
+  messageDesc(file_api_v1_transactions, 1);
+
 /**
  * @generated from message api.v1.TransactionSummary
  */
@@ -144,7 +176,7 @@ export type TransactionSummary = Message<"api.v1.TransactionSummary"> & {
  * Use `create(TransactionSummarySchema)` to create a new message.
  */
 export const TransactionSummarySchema: GenMessage<TransactionSummary> = /*@__PURE__*/
-  messageDesc(file_api_v1_transactions, 1);
+  messageDesc(file_api_v1_transactions, 2);
 
 /**
  * @generated from message api.v1.ListTransactionsRequest
@@ -199,6 +231,14 @@ export type ListTransactionsRequest = Message<"api.v1.ListTransactionsRequest">
    * @generated from field: int32 offset = 10;
    */
   offset: number;
+
+  /**
+   * Transfers between own accounts are always listed. When unset, the summary leaves them out and
+   * ExportTransactions keeps them.
+   *
+   * @generated from field: optional bool include_transfers = 11;
+   */
+  includeTransfers?: boolean;
 };
 
 /**
@@ -206,7 +246,7 @@ export type ListTransactionsRequest = Message<"api.v1.ListTransactionsRequest">
  * Use `create(ListTransactionsRequestSchema)` to create a new message.
  */
 export const ListTransactionsRequestSchema: GenMessage<ListTransactionsRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_transactions, 2);
+  messageDesc(file_api_v1_transactions, 3);
 
 /**
  * @generated from message api.v1.ListTransactionsResponse
@@ -228,7 +268,7 @@ export type ListTransactionsResponse = Message<"api.v1.ListTransactionsResponse"
  * Use `create(ListTransactionsResponseSchema)` to create a new message.
  */
 export const ListTransactionsResponseSchema: GenMessage<ListTransactionsResponse> = /*@__PURE__*/
-  messageDesc(file_api_v1_transactions, 3);
+  messageDesc(file_api_v1_transactions, 4);
 
 /**
  * @generated from message api.v1.UpdateTransactionCategoryRequest
@@ -250,7 +290,7 @@ export type UpdateTransactionCategoryRequest = Message<"api.v1.UpdateTransaction
  * Use `create(UpdateTransactionCategoryRequestSchema)` to create a new message.
  */
 export const UpdateTransactionCategoryRequestSchema: GenMessage<UpdateTransactionCategoryRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_transactions, 4);
+  messageDesc(file_api_v1_transactions, 5);
 
 /**
  * @generated from message api.v1.UpdateTransactionCategoryResponse
@@ -263,7 +303,563 @@ export type UpdateTransactionCategoryResponse = Message<"api.v1.UpdateTransactio
  * Use `create(UpdateTransactionCategoryResponseSchema)` to create a new message.
  */
 export const UpdateTransactionCategoryResponseSchema: GenMessage<UpdateTransactionCategoryResponse> = /*@__PURE__*/
-  messageDesc(file_api_v1_transactions, 5);
+  messageDesc(file_api_v1_transactions, 6);
+
+/**
+ * @generated from message api.v1.GetTransactionAnalyticsRequest
+ */
+export type GetTransactionAnalyticsRequest = Message<"api.v1.GetTransactionAnalyticsRequest"> & {
+  /**
+   * @generated from field: string from_date = 1;
+   */
+  fromDate: string;
+
+  /**
+   * @generated from field: string to_date = 2;
+   */
+  toDate: string;
+
+  /**
+   * @generated from field: int32 source_file_id = 3;
+   */
+  sourceFileId: number;
+
+  /**
+   * @generated from field: string entry_type = 4;
+   */
+  entryType: string;
+
+  /**
+   * @generated from field: string search_text = 5;
+   */
+  searchText: string;
+
+  /**
+   * @generated from field: int32 category_id = 6;
+   */
+  categoryId: number;
+
+  /**
+   * @generated from field: string account_number = 7;
+   */
+  accountNumber: string;
+
+  /**
+   * @generated from field: string card_number = 8;
+   */
+  cardNumber: string;
+
+  /**
+   * @generated from field: string interval = 9;
+   */
+  interval: string;
+
+  /**
+   * @generated from field: string group_by = 10;
+   */
+  groupBy: string;
+
+  /**
+   * @generated from field: bool include_transfers = 11;
+   */
+  includeTransfers: boolean;
+};
+
+/**
+ * Describes the message api.v1.GetTransactionAnalyticsRequest.
+ * Use `create(GetTransactionAnalyticsRequestSchema)` to create a new message.
+ */
+export const GetTransactionAnalyticsRequestSchema: GenMessage<GetTransactionAnalyticsRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_transactions, 7);
+
+/**
+ * @generated from message api.v1.TransactionAnalyticsPoint
+ */
+export type TransactionAnalyticsPoint = Message<"api.v1.TransactionAnalyticsPoint"> & {
+  /**
+   * @generated from field: string period_start = 1;
+   */
+  periodStart: string;
+
+  /**
+   * @generated from field: optional int32 category_id = 2;
+   */
+  categoryId?: number;
+
+  /**
+   * @generated from field: int32 count = 3;
+   */
+  count: number;
+
+  /**
+   * @generated from field: int64 income = 4;
+   */
+  income: bigint;
+
+  /**
+   * @generated from field: int64 expense = 5;
+   */
+  expense: bigint;
+
+  /**
+   * @generated from field: int64 total = 6;
+   */
+  total: bigint;
+};
+
+/**
+ * Describes the message api.v1.TransactionAnalyticsPoint.
+ * Use `create(TransactionAnalyticsPointSchema)` to create a new message.
+ */
+export const TransactionAnalyticsPointSchema: GenMessage<TransactionAnalyticsPoint> = /*@__PURE__*/
+  messageDesc(file_api_v1_transactions, 8);
+
+/**
+ * @generated from message api.v1.GetTransactionAnalyticsResponse
+ */
+export type GetTransactionAnalyticsResponse = Message<"api.v1.GetTransactionAnalyticsResponse"> & {
+  /**
+   * @generated from field: repeated api.v1.TransactionAnalyticsPoint points = 1;
+   */
+  points: TransactionAnalyticsPoint[];
+
+  /**
+   * @generated from field: string currency = 2;
+   */
+  currency: string;
+
+  /**
+   * @generated from field: string interval = 3;
+   */
+  interval: string;
+
+  /**
+   * @generated from field: string group_by = 4;
+   */
+  groupBy: string;
+};
+
+/**
+ * Describes the message api.v1.GetTransactionAnalyticsResponse.
+ * Use `create(GetTransactionAnalyticsResponseSchema)` to create a new message.
+ */
+export const GetTransactionAnalyticsResponseSchema: GenMessage<GetTransactionAnalyticsResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_transactions, 9);
+
+/**
+ * @generated from message api.v1.SetTransactionSplitsRequest
+ */
+export type SetTransactionSplitsRequest = Message<"api.v1.SetTransactionSplitsRequest"> & {
+  /**
+   * @generated from field: int32 transaction_id = 1;
+   */
+  transactionId: number;
+
+  /**
+   * @generated from field: repeated api.v1.TransactionSplit splits = 2;
+   */
+  splits: TransactionSplit[];
+};
+
+/**
+ * Describes the message api.v1.SetTransactionSplitsRequest.
+ * Use `create(SetTransactionSplitsRequestSchema)` to create a new message.
+ */
+export const SetTransactionSplitsRequestSchema: GenMessage<SetTransactionSplitsRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_transactions, 10);
+
+/**
+ * @generated from message api.v1.SetTransactionSplitsResponse
+ */
+export type SetTransactionSplitsResponse = Message<"api.v1.SetTransactionSplitsResponse"> & {
+  /**
+   * @generated from field: repeated api.v1.TransactionSplit splits = 1;
+   */
+  splits: TransactionSplit[];
+};
+
+/**
+ * Describes the message api.v1.SetTransactionSplitsResponse.
+ * Use `create(SetTransactionSplitsResponseSchema)` to create a new message.
+ */
+export const SetTransactionSplitsResponseSchema: GenMessage<SetTransactionSplitsResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_transactions, 11);
+
+/**
+ * @generated from message api.v1.DeleteTransactionSplitsRequest
+ */
+export type DeleteTransactionSplitsRequest = Message<"api.v1.DeleteTransactionSplitsRequest"> & {
+  /**
+   * @generated from field: int32 transaction_id = 1;
+   */
+  transactionId: number;
+};
+
+/**
+ * Describes the message api.v1.DeleteTransactionSplitsRequest.
+ * Use `create(DeleteTransactionSplitsRequestSchema)` to create a new message.
+ */
+export const DeleteTransactionSplitsRequestSchema: GenMessage<DeleteTransactionSplitsRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_transactions, 12);
+
+/**
+ * @generated from message api.v1.DeleteTransactionSplitsResponse
+ */
+export type DeleteTransactionSplitsResponse = Message<"api.v1.DeleteTransactionSplitsResponse"> & {
+};
+
+/**
+ * Describes the message api.v1.DeleteTransactionSplitsResponse.
+ * Use `create(DeleteTransactionSplitsResponseSchema)` to create a new message.
+ */
+export const DeleteTransactionSplitsResponseSchema: GenMessage<DeleteTransactionSplitsResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_transactions, 13);
+
+/**
+ * @generated from message api.v1.TransactionTransfer
+ */
+export type TransactionTransfer = Message<"api.v1.TransactionTransfer"> & {
+  /**
+   * @generated from field: int32 id = 1;
+   */
+  id: number;
+
+  /**
+   * @generated from field: string status = 2;
+   */
+  status: string;
+
+  /**
+   * @generated from field: api.v1.Transaction debit = 3;
+   */
+  debit?: Transaction;
+
+  /**
+   * @generated from field: api.v1.Transaction credit = 4;
+   */
+  credit?: Transaction;
+
+  /**
+   * @generated from field: string created_at = 5;
+   */
+  createdAt: string;
+};
+
+/**
+ * Describes the message api.v1.TransactionTransfer.
+ * Use `create(TransactionTransferSchema)` to create a new message.
+ */
+export const TransactionTransferSchema: GenMessage<TransactionTransfer> = /*@__PURE__*/
+  messageDesc(file_api_v1_transactions, 14);
+
+/**
+ * @generated from message api.v1.DetectTransfersRequest
+ */
+export type DetectTransfersRequest = Message<"api.v1.DetectTransfersRequest"> & {
+};
+
+/**
+ * Describes the message api.v1.DetectTransfersRequest.
+ * Use `create(DetectTransfersRequestSchema)` to create a new message.
+ */
+export const DetectTransfersRequestSchema: GenMessage<DetectTransfersRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_transactions, 15);
+
+/**
+ * @generated from message api.v1.DetectTransfersResponse
+ */
+export type DetectTransfersResponse = Message<"api.v1.DetectTransfersResponse"> & {
+  /**
+   * @generated from field: int32 created = 1;
+   */
+  created: number;
+};
+
+/**
+ * Describes the message api.v1.DetectTransfersResponse.
+ * Use `create(DetectTransfersResponseSchema)` to create a new message.
+ */
+export const DetectTransfersResponseSchema: GenMessage<DetectTransfersResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_transactions, 16);
+
+/**
+ * @generated from message api.v1.ListTransfersRequest
+ */
+export type ListTransfersRequest = Message<"api.v1.ListTransfersRequest"> & {
+  /**
+   * @generated from field: string status = 1;
+   */
+  status: string;
+};
+
+/**
+ * Describes the message api.v1.ListTransfersRequest.
+ * Use `create(ListTransfersRequestSchema)` to create a new message.
+ */
+export const ListTransfersRequestSchema: GenMessage<ListTransfersRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_transactions, 17);
+
+/**
+ * @generated from message api.v1.ListTransfersResponse
+ */
+export type ListTransfersResponse = Message<"api.v1.ListTransfersResponse"> & {
+  /**
+   * @generated from field: repeated api.v1.TransactionTransfer items = 1;
+   */
+  items: TransactionTransfer[];
+};
+
+/**
+ * Describes the message api.v1.ListTransfersResponse.
+ * Use `create(ListTransfersResponseSchema)` to create a new message.
+ */
+export const ListTransfersResponseSchema: GenMessage<ListTransfersResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_transactions, 18);
+
+/**
+ * @generated from message api.v1.ConfirmTransferRequest
+ */
+export type ConfirmTransferRequest = Message<"api.v1.ConfirmTransferRequest"> & {
+  /**
+   * @generated from field: int32 transfer_id = 1;
+   */
+  transferId: number;
+};
+
+/**
+ * Describes the message api.v1.ConfirmTransferRequest.
+ * Use `create(ConfirmTransferRequestSchema)` to create a new message.
+ */
+export const ConfirmTransferRequestSchema: GenMessage<ConfirmTransferRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_transactions, 19);
+
+/**
+ * @generated from message api.v1.ConfirmTransferResponse
+ */
+export type ConfirmTransferResponse = Message<"api.v1.ConfirmTransferResponse"> & {
+};
+
+/**
+ * Describes the message api.v1.ConfirmTransferResponse.
+ * Use `create(ConfirmTransferResponseSchema)` to create a new message.
+ */
+export const ConfirmTransferResponseSchema: GenMessage<ConfirmTransferResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_transactions, 20);
+
+/**
+ * @generated from message api.v1.UnlinkTransferRequest
+ */
+export type UnlinkTransferRequest = Message<"api.v1.UnlinkTransferRequest"> & {
+  /**
+   * @generated from field: int32 transfer_id = 1;
+   */
+  transferId: number;
+};
+
+/**
+ * Describes the message api.v1.UnlinkTransferRequest.
+ * Use `create(UnlinkTransferRequestSchema)` to create a new message.
+ */
+export const UnlinkTransferRequestSchema: GenMessage<UnlinkTransferRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_transactions, 21);
+
+/**
+ * @generated from message api.v1.UnlinkTransferResponse
+ */
+export type UnlinkTransferResponse = Message<"api.v1.UnlinkTransferResponse"> & {
+};
+
+/**
+ * Describes the message api.v1.UnlinkTransferResponse.
+ * Use `create(UnlinkTransferResponseSchema)` to create a new message.
+ */
+export const UnlinkTransferResponseSchema: GenMessage<UnlinkTransferResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_transactions, 22);
+
+/**
+ * @generated from message api.v1.ExportTransactionsRequest
+ */
+export type ExportTransactionsRequest = Message<"api.v1.ExportTransactionsRequest"> & {
+  /**
+   * @generated from field: api.v1.ListTransactionsRequest filters = 1;
+   */
+  filters?: ListTransactionsRequest;
+
+  /**
+   * @generated from field: string format = 2;
+   */
+  format: string;
+};
+
+/**
+ * Describes the message api.v1.ExportTransactionsRequest.
+ * Use `create(ExportTransactionsRequestSchema)` to create a new message.
+ */
+export const ExportTransactionsRequestSchema: GenMessage<ExportTransactionsRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_transactions, 23);
+
+/**
+ * @generated from message api.v1.ExportTransactionsResponse
+ */
+export type ExportTransactionsResponse = Message<"api.v1.ExportTransactionsResponse"> & {
+  /**
+   * @generated from field: bytes chunk = 1;
+   */
+  chunk: Uint8Array;
+
+  /**
+   * @generated from field: string filename = 2;
+   */
+  filename: string;
+
+  /**
+   * @generated from field: string content_type = 3;
+   */
+  contentType: string;
+};
+
+/**
+ * Describes the message api.v1.ExportTransactionsResponse.
+ * Use `create(ExportTransactionsResponseSchema)` to create a new message.
+ */
+export const ExportTransactionsResponseSchema: GenMessage<ExportTransactionsResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_transactions, 24);
+
+/**
+ * @generated from message api.v1.LedgerAccountMapping
+ */
+export type LedgerAccountMapping = Message<"api.v1.LedgerAccountMapping"> & {
+  /**
+   * @generated from field: int32 id = 1;
+   */
+  id: number;
+
+  /**
+   * @generated from field: string kind = 2;
+   */
+  kind: string;
+
+  /**
+   * @generated from field: string source = 3;
+   */
+  source: string;
+
+  /**
+   * @generated from field: string ledger_account = 4;
+   */
+  ledgerAccount: string;
+};
+
+/**
+ * Describes the message api.v1.LedgerAccountMapping.
+ * Use `create(LedgerAccountMappingSchema)` to create a new message.
+ */
+export const LedgerAccountMappingSchema: GenMessage<LedgerAccountMapping> = /*@__PURE__*/
+  messageDesc(file_api_v1_transactions, 25);
+
+/**
+ * @generated from message api.v1.ListLedgerAccountMappingsRequest
+ */
+export type ListLedgerAccountMappingsRequest = Message<"api.v1.ListLedgerAccountMappingsRequest"> & {
+};
+
+/**
+ * Describes the message api.v1.ListLedgerAccountMappingsRequest.
+ * Use `create(ListLedgerAccountMappingsRequestSchema)` to create a new message.
+ */
+export const ListLedgerAccountMappingsRequestSchema: GenMessage<ListLedgerAccountMappingsRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_transactions, 26);
+
+/**
+ * @generated from message api.v1.ListLedgerAccountMappingsResponse
+ */
+export type ListLedgerAccountMappingsResponse = Message<"api.v1.ListLedgerAccountMappingsResponse"> & {
+  /**
+   * @generated from field: repeated api.v1.LedgerAccountMapping mappings = 1;
+   */
+  mappings: LedgerAccountMapping[];
+};
+
+/**
+ * Describes the message api.v1.ListLedgerAccountMappingsResponse.
+ * Use `create(ListLedgerAccountMappingsResponseSchema)` to create a new message.
+ */
+export const ListLedgerAccountMappingsResponseSchema: GenMessage<ListLedgerAccountMappingsResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_transactions, 27);
+
+/**
+ * @generated from message api.v1.SetLedgerAccountMappingRequest
+ */
+export type SetLedgerAccountMappingRequest = Message<"api.v1.SetLedgerAccountMappingRequest"> & {
+  /**
+   * @generated from field: string kind = 1;
+   */
+  kind: string;
+
+  /**
+   * @generated from field: string source = 2;
+   */
+  source: string;
+
+  /**
+   * @generated from field: string ledger_account = 3;
+   */
+  ledgerAccount: string;
+};
+
+/**
+ * Describes the message api.v1.SetLedgerAccountMappingRequest.
+ * Use `create(SetLedgerAccountMappingRequestSchema)` to create a new message.
+ */
+export const SetLedgerAccountMappingRequestSchema: GenMessage<SetLedgerAccountMappingRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_transactions, 28);
+
+/**
+ * @generated from message api.v1.SetLedgerAccountMappingResponse
+ */
+export type SetLedgerAccountMappingResponse = Message<"api.v1.SetLedgerAccountMappingResponse"> & {
+  /**
+   * @generated from field: api.v1.LedgerAccountMapping mapping = 1;
+   */
+  mapping?: LedgerAccountMapping;
+};
+
+/**
+ * Describes the message api.v1.SetLedgerAccountMappingResponse.
+ * Use `create(SetLedgerAccountMappingResponseSchema)` to create a new message.
+ */
+export const SetLedgerAccountMappingResponseSchema: GenMessage<SetLedgerAccountMappingResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_transactions, 29);
+
+/**
+ * @generated from message api.v1.DeleteLedgerAccountMappingRequest
+ */
+export type DeleteLedgerAccountMappingRequest = Message<"api.v1.DeleteLedgerAccountMappingRequest"> & {
+  /**
+   * @generated from field: int32 id = 1;
+   */
+  id: number;
+};
+
+/**
+ * Describes the message api.v1.DeleteLedgerAccountMappingRequest.
+ * Use `create(DeleteLedgerAccountMappingRequestSchema)` to create a new message.
+ */
+export const DeleteLedgerAccountMappingRequestSchema: GenMessage<DeleteLedgerAccountMappingRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_transactions, 30);
+
+/**
+ * @generated from message api.v1.DeleteLedgerAccountMappingResponse
+ */
+export type DeleteLedgerAccountMappingResponse = Message<"api.v1.DeleteLedgerAccountMappingResponse"> & {
+};
+
+/**
+ * Describes the message api.v1.DeleteLedgerAccountMappingResponse.
+ * Use `create(DeleteLedgerAccountMappingResponseSchema)` to create a new message.
+ */
+export const DeleteLedgerAccountMappingResponseSchema: GenMessage<DeleteLedgerAccountMappingResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_transactions, 31);
 
 /**
  * @generated from service api.v1.TransactionService
@@ -285,6 +881,94 @@ export const TransactionService: GenService<{
     input: typeof UpdateTransactionCategoryRequestSchema;
     output: typeof UpdateTransactionCategoryResponseSchema;
   },
+  /**
+   * @generated from rpc api.v1.TransactionService.GetTransactionAnalytics
+   */
+  getTransactionAnalytics: {
+    methodKind: "unary";
+    input: typeof GetTransactionAnalyticsRequestSchema;
+    output: typeof GetTransactionAnalyticsResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.TransactionService.SetTransactionSplits
+   */
+  setTransactionSplits: {
+    methodKind: "unary";
+    input: typeof SetTransactionSplitsRequestSchema;
+    output: typeof SetTransactionSplitsResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.TransactionService.DeleteTransactionSplits
+   */
+  deleteTransactionSplits: {
+    methodKind: "unary";
+    input: typeof DeleteTransactionSplitsRequestSchema;
+    output: typeof DeleteTransactionSplitsResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.TransactionService.DetectTransfers
+   */
+  detectTransfers: {
+    methodKind: "unary";
+    input: typeof DetectTransfersRequestSchema;
+    output: typeof DetectTransfersResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.TransactionService.ListTransfers
+   */
+  listTransfers: {
+    methodKind: "unary";
+    input: typeof ListTransfersRequestSchema;
+    output: typeof ListTransfersResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.TransactionService.ConfirmTransfer
+   */
+  confirmTransfer: {
+    methodKind: "unary";
+    input: typeof ConfirmTransferRequestSchema;
+    output: typeof ConfirmTransferResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.TransactionService.UnlinkTransfer
+   */
+  unlinkTransfer: {
+    methodKind: "unary";
+    input: typeof UnlinkTransferRequestSchema;
+    output: typeof UnlinkTransferResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.TransactionService.ExportTransactions
+   */
+  exportTransactions: {
+    methodKind: "server_streaming";
+    input: typeof ExportTransactionsRequestSchema;
+    output: typeof ExportTransactionsResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.TransactionService.ListLedgerAccountMappings
+   */
+  listLedgerAccountMappings: {
+    methodKind: "unary";
+    input: typeof ListLedgerAccountMappingsRequestSchema;
+    output: typeof ListLedgerAccountMappingsResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.TransactionService.SetLedgerAccountMapping
+   */
+  setLedgerAccountMapping: {
+    methodKind: "unary";
+    input: typeof SetLedgerAccountMappingRequestSchema;
+    output: typeof SetLedgerAccountMappingResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.TransactionService.DeleteLedgerAccountMapping
+   */
+  deleteLedgerAccountMapping: {
+    methodKind: "unary";
+    input: typeof DeleteLedgerAccountMappingRequestSchema;
+    output: typeof DeleteLedgerAccountMappingResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_api_v1_transactions, 0);
 